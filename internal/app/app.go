@@ -15,9 +15,19 @@ import (
 	"github.com/Employee-s-file-cabinet/backend/internal/service/auth/model/password"
 	"github.com/Employee-s-file-cabinet/backend/internal/service/auth/model/token"
 	authdb "github.com/Employee-s-file-cabinet/backend/internal/service/auth/repo/postgres"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/benefit"
+	benefitdb "github.com/Employee-s-file-cabinet/backend/internal/service/benefit/repo/postgres"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/calendar"
+	calendardb "github.com/Employee-s-file-cabinet/backend/internal/service/calendar/repo/postgres"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/compensation"
+	compensationdb "github.com/Employee-s-file-cabinet/backend/internal/service/compensation/repo/postgres"
 	"github.com/Employee-s-file-cabinet/backend/internal/service/recovery"
 	recoverydb "github.com/Employee-s-file-cabinet/backend/internal/service/recovery/repo/postgres"
 	recoverykv "github.com/Employee-s-file-cabinet/backend/internal/service/recovery/repo/ttlmap"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/recruiting"
+	recruitingdb "github.com/Employee-s-file-cabinet/backend/internal/service/recruiting/repo/postgres"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/staffing"
+	staffingdb "github.com/Employee-s-file-cabinet/backend/internal/service/staffing/repo/postgres"
 	"github.com/Employee-s-file-cabinet/backend/internal/service/user"
 	userdb "github.com/Employee-s-file-cabinet/backend/internal/service/user/repo/postgres"
 	users3 "github.com/Employee-s-file-cabinet/backend/internal/service/user/repo/s3"
@@ -35,6 +45,36 @@ func Run(ctx context.Context, cfg *config.Config, logger *slog.Logger) error {
 		return err
 	}
 
+	// create staffing service
+	staffingDBRepo, err := staffingdb.NewStorage(db)
+	if err != nil {
+		return err
+	}
+	staffingService := staffing.NewService(staffingDBRepo)
+
+	// create compensation service
+	compensationDBRepo, err := compensationdb.NewStorage(db)
+	if err != nil {
+		return err
+	}
+	compensationService := compensation.NewService(compensationDBRepo, compensationDBRepo)
+
+	// create benefit service
+	benefitDBRepo, err := benefitdb.NewStorage(db)
+	if err != nil {
+		return err
+	}
+	benefitService := benefit.NewService(benefitDBRepo)
+
+	// create production calendar service
+	calendarDBRepo, err := calendardb.NewStorage(db)
+	if err != nil {
+		return err
+	}
+	calendarService := calendar.NewService(calendarDBRepo)
+
+	smtpClient := smtp.NewMock(cfg.Mail)
+
 	// create user service
 	userDBRepo, err := userdb.NewUserStorage(db)
 	if err != nil {
@@ -44,7 +84,14 @@ func Run(ctx context.Context, cfg *config.Config, logger *slog.Logger) error {
 	if err != nil {
 		return err
 	}
-	userService := user.NewService(userDBRepo, userFileRepo)
+	userService := user.NewService(userDBRepo, userFileRepo, staffingService, calendarService, smtpClient, cfg.User, logger)
+
+	// create recruiting service
+	recruitingDBRepo, err := recruitingdb.NewStorage(db)
+	if err != nil {
+		return err
+	}
+	recruitingService := recruiting.NewService(recruitingDBRepo, userFileRepo, userService, logger)
 
 	// create auth service
 	tokenMng, err := token.NewPasetoMaker(cfg.HTTP.Token.SecretKey, cfg.HTTP.Token.Lifetime)
@@ -65,10 +112,10 @@ func Run(ctx context.Context, cfg *config.Config, logger *slog.Logger) error {
 	if err != nil {
 		return err
 	}
-	smtpClient := smtp.NewMock(cfg.Mail)
 	recoveryService := recovery.NewService(recoveryDBRepo, recoveryKeyRepo, smtpClient, passVerification, cfg.Recovery)
 
-	srv, err := httpsrv.New(cfg.HTTP, cfg.EnvType, userService, authService, recoveryService, logger)
+	srv, err := httpsrv.New(cfg.HTTP, cfg.EnvType,
+		userService, authService, recoveryService, staffingService, compensationService, benefitService, calendarService, recruitingService, logger)
 	if err != nil {
 		return err
 	}
@@ -77,6 +124,12 @@ func Run(ctx context.Context, cfg *config.Config, logger *slog.Logger) error {
 	eg.Go(func() error {
 		return srv.Run(ectx)
 	})
+	eg.Go(func() error {
+		return userService.RunProbationReminders(ectx)
+	})
+	eg.Go(func() error {
+		return userService.RunNotifications(ectx)
+	})
 
 	return eg.Wait()
 }
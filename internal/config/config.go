@@ -11,12 +11,14 @@ import (
 	repopg "github.com/Employee-s-file-cabinet/backend/internal/repo/postgresql"
 	repos3 "github.com/Employee-s-file-cabinet/backend/internal/repo/s3"
 	"github.com/Employee-s-file-cabinet/backend/internal/service/recovery"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user"
 )
 
 type Config struct {
 	EnvType  env.Type        `env:"ENV_TYPE" env-required:"production"`
 	LogLevel slog.Level      `env:"LOG_LEVEL" env-default:"INFO" env-description:"importance or severity of a log event (DEBUG/INFO/WARN/ERROR)"`
 	Recovery recovery.Config `env-prefix:"RECOVERY_"`
+	User     user.Config     `env-prefix:"USER_"`
 	HTTP     http.Config     `env-prefix:"HTTP_"`
 	PG       repopg.Config   `env-prefix:"PG_"`
 	S3       repos3.Config   `env-prefix:"S3_"`
@@ -14,12 +14,96 @@ import (
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (GET /absences)
+	ListAllAbsences(w http.ResponseWriter, r *http.Request, params ListAllAbsencesParams)
+
+	// (GET /absences/report)
+	GetAbsenceReport(w http.ResponseWriter, r *http.Request, params GetAbsenceReportParams)
+
+	// (GET /benefits)
+	ListBenefits(w http.ResponseWriter, r *http.Request)
+
+	// (POST /benefits)
+	AddBenefit(w http.ResponseWriter, r *http.Request)
+
+	// (GET /benefits/report)
+	BenefitCostReport(w http.ResponseWriter, r *http.Request, params BenefitCostReportParams)
+
+	// (GET /benefits/{benefit_id})
+	GetBenefit(w http.ResponseWriter, r *http.Request, benefitID uint64)
+
+	// (PUT /benefits/{benefit_id})
+	PutBenefit(w http.ResponseWriter, r *http.Request, benefitID uint64)
+
+	// (GET /calendar/count)
+	CountCalendarDays(w http.ResponseWriter, r *http.Request, params CountCalendarDaysParams)
+
+	// (GET /calendar/{year})
+	ListCalendarDays(w http.ResponseWriter, r *http.Request, year uint64)
+
+	// (PUT /calendar/{year})
+	PutCalendarDays(w http.ResponseWriter, r *http.Request, year uint64)
+
+	// (POST /calendar/{year}/import)
+	ImportCalendar(w http.ResponseWriter, r *http.Request, year uint64)
+
+	// (GET /candidates)
+	ListCandidates(w http.ResponseWriter, r *http.Request, params ListCandidatesParams)
+
+	// (POST /candidates)
+	AddCandidate(w http.ResponseWriter, r *http.Request)
+
+	// (GET /candidates/{candidate_id})
+	GetCandidate(w http.ResponseWriter, r *http.Request, candidateID uint64)
+
+	// (PUT /candidates/{candidate_id})
+	PutCandidate(w http.ResponseWriter, r *http.Request, candidateID uint64)
+
+	// (GET /candidates/{candidate_id}/cvs)
+	ListCandidateCVs(w http.ResponseWriter, r *http.Request, candidateID uint64)
+
+	// (POST /candidates/{candidate_id}/cvs)
+	UploadCandidateCV(w http.ResponseWriter, r *http.Request, candidateID uint64)
+
+	// (GET /candidates/{candidate_id}/cvs/{cv_id})
+	DownloadCandidateCV(w http.ResponseWriter, r *http.Request, candidateID, cvID uint64)
+
+	// (POST /candidates/{candidate_id}/hire)
+	HireCandidate(w http.ResponseWriter, r *http.Request, candidateID uint64, params HireCandidateParams)
+
+	// (GET /candidates/{candidate_id}/notes)
+	ListCandidateNotes(w http.ResponseWriter, r *http.Request, candidateID uint64)
+
+	// (POST /candidates/{candidate_id}/notes)
+	AddCandidateNote(w http.ResponseWriter, r *http.Request, candidateID uint64)
+
+	// (PUT /candidates/{candidate_id}/stage)
+	PutCandidateStage(w http.ResponseWriter, r *http.Request, candidateID uint64)
+
 	// (GET /departments)
 	ListDepartments(w http.ResponseWriter, r *http.Request)
 
+	// (GET /duplicates)
+	ListDuplicates(w http.ResponseWriter, r *http.Request)
+
 	// (GET /health)
 	Health(w http.ResponseWriter, r *http.Request)
 
+	// (GET /indexations)
+	ListIndexationRuns(w http.ResponseWriter, r *http.Request)
+
+	// (POST /indexations)
+	AddIndexationRun(w http.ResponseWriter, r *http.Request)
+
+	// (POST /indexations/preview)
+	PreviewIndexationRun(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /indexations/{run_id})
+	RevertIndexationRun(w http.ResponseWriter, r *http.Request, runID uint64)
+
+	// (GET /indexations/{run_id})
+	GetIndexationRun(w http.ResponseWriter, r *http.Request, runID uint64)
+
 	// (POST /login)
 	Login(w http.ResponseWriter, r *http.Request)
 
@@ -32,11 +116,89 @@ type ServerInterface interface {
 	// (POST /login/init-change-password)
 	InitChangePassword(w http.ResponseWriter, r *http.Request)
 
+	// (GET /military/liable)
+	ListMilitaryLiable(w http.ResponseWriter, r *http.Request, params ListMilitaryLiableParams)
+
+	// (GET /military/notifications)
+	ListMilitaryNotifications(w http.ResponseWriter, r *http.Request, params ListMilitaryNotificationsParams)
+
+	// (GET /number-sequences)
+	ListNumberSequences(w http.ResponseWriter, r *http.Request)
+
+	// (POST /number-sequences)
+	AddNumberSequence(w http.ResponseWriter, r *http.Request)
+
+	// (PUT /number-sequences/{sequence_id})
+	PutNumberSequence(w http.ResponseWriter, r *http.Request, sequenceID uint64)
+
+	// (GET /onboarding/overdue)
+	ListOverdueOnboarding(w http.ResponseWriter, r *http.Request, params ListOverdueOnboardingParams)
+
+	// (GET /onboarding/templates)
+	ListOnboardingTemplates(w http.ResponseWriter, r *http.Request)
+
+	// (POST /onboarding/templates)
+	AddOnboardingTemplate(w http.ResponseWriter, r *http.Request)
+
+	// (DELETE /onboarding/templates/{template_id})
+	DeleteOnboardingTemplate(w http.ResponseWriter, r *http.Request, templateID uint64)
+
+	// (GET /onboarding/templates/{template_id})
+	GetOnboardingTemplate(w http.ResponseWriter, r *http.Request, templateID uint64)
+
+	// (PUT /onboarding/templates/{template_id})
+	PutOnboardingTemplate(w http.ResponseWriter, r *http.Request, templateID uint64)
+
+	// (GET /orders)
+	ListOrders(w http.ResponseWriter, r *http.Request, params ListOrdersParams)
+
+	// (POST /orders)
+	AddOrder(w http.ResponseWriter, r *http.Request)
+
+	// (GET /orders/{order_id})
+	GetOrder(w http.ResponseWriter, r *http.Request, orderID uint64)
+
+	// (PATCH /orders/{order_id})
+	PatchOrder(w http.ResponseWriter, r *http.Request, orderID uint64)
+
+	// (PUT /orders/{order_id})
+	PutOrder(w http.ResponseWriter, r *http.Request, orderID uint64)
+
+	// (GET /positions/{position_id}/holders)
+	ListPositionHolders(w http.ResponseWriter, r *http.Request, positionID uint64, params ListPositionHoldersParams)
+
+	// (GET /probations)
+	ListProbations(w http.ResponseWriter, r *http.Request, params ListProbationsParams)
+
+	// (GET /staffing)
+	ListStaffUnits(w http.ResponseWriter, r *http.Request, params ListStaffUnitsParams)
+
+	// (POST /staffing)
+	AddStaffUnit(w http.ResponseWriter, r *http.Request)
+
+	// (GET /staffing/vacancies)
+	ListStaffingVacancies(w http.ResponseWriter, r *http.Request, params ListStaffingVacanciesParams)
+
+	// (GET /staffing/{unit_id})
+	GetStaffUnit(w http.ResponseWriter, r *http.Request, unitID uint64)
+
+	// (PUT /staffing/{unit_id})
+	PutStaffUnit(w http.ResponseWriter, r *http.Request, unitID uint64)
+
+	// (GET /timesheets/{year}/{month})
+	GetTimesheet(w http.ResponseWriter, r *http.Request, year, month uint64, params GetTimesheetParams)
+
+	// (DELETE /timesheets/{year}/{month}/employees/{user_id}/days/{day})
+	DeleteTimesheetDay(w http.ResponseWriter, r *http.Request, year, month, userID, day uint64)
+
+	// (PUT /timesheets/{year}/{month}/employees/{user_id}/days/{day})
+	PutTimesheetDay(w http.ResponseWriter, r *http.Request, year, month, userID, day uint64)
+
 	// (GET /users)
 	ListUsers(w http.ResponseWriter, r *http.Request, params ListUsersParams)
 
 	// (POST /users)
-	AddUser(w http.ResponseWriter, r *http.Request)
+	AddUser(w http.ResponseWriter, r *http.Request, params AddUserParams)
 
 	// (GET /users/{user_id})
 	GetUser(w http.ResponseWriter, r *http.Request, userID uint64, params GetUserParams)
@@ -45,7 +207,46 @@ type ServerInterface interface {
 	PatchUser(w http.ResponseWriter, r *http.Request, userID uint64)
 
 	// (PUT /users/{user_id})
-	PutUser(w http.ResponseWriter, r *http.Request, userID uint64)
+	PutUser(w http.ResponseWriter, r *http.Request, userID uint64, params PutUserParams)
+
+	// (GET /users/{user_id}/absences)
+	ListAbsences(w http.ResponseWriter, r *http.Request, userID uint64)
+
+	// (POST /users/{user_id}/absences)
+	AddAbsence(w http.ResponseWriter, r *http.Request, userID uint64)
+
+	// (DELETE /users/{user_id}/absences/{absence_id})
+	DeleteAbsence(w http.ResponseWriter, r *http.Request, userID, absenceID uint64)
+
+	// (GET /users/{user_id}/absences/{absence_id})
+	GetAbsence(w http.ResponseWriter, r *http.Request, userID, absenceID uint64)
+
+	// (PUT /users/{user_id}/absences/{absence_id})
+	PutAbsence(w http.ResponseWriter, r *http.Request, userID, absenceID uint64)
+
+	// (GET /users/{user_id}/benefits)
+	ListBenefitEnrolments(w http.ResponseWriter, r *http.Request, userID uint64)
+
+	// (POST /users/{user_id}/benefits)
+	AddBenefitEnrolment(w http.ResponseWriter, r *http.Request, userID uint64)
+
+	// (DELETE /users/{user_id}/benefits/{enrolment_id})
+	DeleteBenefitEnrolment(w http.ResponseWriter, r *http.Request, userID, enrolmentID uint64)
+
+	// (PUT /users/{user_id}/benefits/{enrolment_id})
+	PutBenefitEnrolment(w http.ResponseWriter, r *http.Request, userID, enrolmentID uint64)
+
+	// (GET /users/{user_id}/compensations)
+	ListCompensations(w http.ResponseWriter, r *http.Request, userID uint64)
+
+	// (POST /users/{user_id}/compensations)
+	AddCompensation(w http.ResponseWriter, r *http.Request, userID uint64)
+
+	// (GET /users/{user_id}/compensations/{compensation_id})
+	GetCompensation(w http.ResponseWriter, r *http.Request, userID, compensationID uint64)
+
+	// (PUT /users/{user_id}/compensations/{compensation_id})
+	PutCompensation(w http.ResponseWriter, r *http.Request, userID, compensationID uint64)
 
 	// (GET /users/{user_id}/contracts)
 	ListContracts(w http.ResponseWriter, r *http.Request, userID uint64)
@@ -65,6 +266,24 @@ type ServerInterface interface {
 	// (PUT /users/{user_id}/contracts/{contract_id})
 	PutContract(w http.ResponseWriter, r *http.Request, userID, contractID uint64)
 
+	// (GET /users/{user_id}/contracts/{contract_id}/amendments)
+	ListAmendments(w http.ResponseWriter, r *http.Request, userID, contractID uint64)
+
+	// (POST /users/{user_id}/contracts/{contract_id}/amendments)
+	AddAmendment(w http.ResponseWriter, r *http.Request, userID, contractID uint64)
+
+	// (GET /users/{user_id}/contracts/{contract_id}/amendments/{amendment_id})
+	GetAmendment(w http.ResponseWriter, r *http.Request, userID, contractID, amendmentID uint64)
+
+	// (PUT /users/{user_id}/contracts/{contract_id}/amendments/{amendment_id})
+	PutAmendment(w http.ResponseWriter, r *http.Request, userID, contractID, amendmentID uint64)
+
+	// (PUT /users/{user_id}/contracts/{contract_id}/probation)
+	PutProbationResult(w http.ResponseWriter, r *http.Request, userID, contractID uint64)
+
+	// (GET /users/{user_id}/contracts/{contract_id}/terms)
+	GetContractTerms(w http.ResponseWriter, r *http.Request, userID, contractID uint64, params GetContractTermsParams)
+
 	// (GET /users/{user_id}/educations)
 	ListEducations(w http.ResponseWriter, r *http.Request, userID uint64)
 
@@ -83,6 +302,42 @@ type ServerInterface interface {
 	// (PUT /users/{user_id}/educations/{education_id})
 	PutEducation(w http.ResponseWriter, r *http.Request, userID, educationID uint64)
 
+	// (GET /users/{user_id}/experiences)
+	ListExperiences(w http.ResponseWriter, r *http.Request, userID uint64)
+
+	// (POST /users/{user_id}/experiences)
+	AddExperience(w http.ResponseWriter, r *http.Request, userID uint64)
+
+	// (GET /users/{user_id}/experiences/length)
+	GetExperienceLength(w http.ResponseWriter, r *http.Request, userID uint64)
+
+	// (DELETE /users/{user_id}/experiences/{experience_id})
+	DeleteExperience(w http.ResponseWriter, r *http.Request, userID, experienceID uint64)
+
+	// (GET /users/{user_id}/experiences/{experience_id})
+	GetExperience(w http.ResponseWriter, r *http.Request, userID, experienceID uint64)
+
+	// (PUT /users/{user_id}/experiences/{experience_id})
+	PutExperience(w http.ResponseWriter, r *http.Request, userID, experienceID uint64)
+
+	// (GET /users/{user_id}/indexations)
+	ListIndexations(w http.ResponseWriter, r *http.Request, userID uint64)
+
+	// (DELETE /users/{user_id}/military)
+	DeleteMilitary(w http.ResponseWriter, r *http.Request, userID uint64)
+
+	// (GET /users/{user_id}/military)
+	GetMilitary(w http.ResponseWriter, r *http.Request, userID uint64)
+
+	// (PUT /users/{user_id}/military)
+	PutMilitary(w http.ResponseWriter, r *http.Request, userID uint64)
+
+	// (GET /users/{user_id}/onboarding)
+	ListOnboardingItems(w http.ResponseWriter, r *http.Request, userID uint64)
+
+	// (PATCH /users/{user_id}/onboarding/{item_id})
+	PatchOnboardingItem(w http.ResponseWriter, r *http.Request, userID, itemID uint64)
+
 	// (GET /users/{user_id}/passports)
 	ListPassports(w http.ResponseWriter, r *http.Request, userID uint64)
 
@@ -125,6 +380,21 @@ type ServerInterface interface {
 	// (POST /users/{user_id}/photo)
 	UploadPhoto(w http.ResponseWriter, r *http.Request, userID uint64)
 
+	// (GET /users/{user_id}/relatives)
+	ListRelatives(w http.ResponseWriter, r *http.Request, userID uint64)
+
+	// (POST /users/{user_id}/relatives)
+	AddRelative(w http.ResponseWriter, r *http.Request, userID uint64)
+
+	// (DELETE /users/{user_id}/relatives/{relative_id})
+	DeleteRelative(w http.ResponseWriter, r *http.Request, userID, relativeID uint64)
+
+	// (GET /users/{user_id}/relatives/{relative_id})
+	GetRelative(w http.ResponseWriter, r *http.Request, userID, relativeID uint64)
+
+	// (PUT /users/{user_id}/relatives/{relative_id})
+	PutRelative(w http.ResponseWriter, r *http.Request, userID, relativeID uint64)
+
 	// (GET /users/{user_id}/scans)
 	ListScans(w http.ResponseWriter, r *http.Request, userID uint64)
 
@@ -137,6 +407,9 @@ type ServerInterface interface {
 	// (GET /users/{user_id}/scans/{scan_id})
 	GetScan(w http.ResponseWriter, r *http.Request, userID, scanID uint64)
 
+	// (POST /users/{user_id}/termination)
+	TerminateUser(w http.ResponseWriter, r *http.Request, userID uint64)
+
 	// (GET /users/{user_id}/trainings)
 	ListTrainings(w http.ResponseWriter, r *http.Request, userID uint64)
 
@@ -159,7 +432,10 @@ type ServerInterface interface {
 	ListVacations(w http.ResponseWriter, r *http.Request, userID uint64)
 
 	// (POST /users/{user_id}/vacations)
-	AddVacation(w http.ResponseWriter, r *http.Request, userID uint64)
+	AddVacation(w http.ResponseWriter, r *http.Request, userID uint64, params AddVacationParams)
+
+	// (GET /users/{user_id}/vacations/balance)
+	GetVacationBalance(w http.ResponseWriter, r *http.Request, userID uint64)
 
 	// (DELETE /users/{user_id}/vacations/{vacation_id})
 	DeleteVacation(w http.ResponseWriter, r *http.Request, userID, vacationID uint64)
@@ -171,7 +447,64 @@ type ServerInterface interface {
 	PatchVacation(w http.ResponseWriter, r *http.Request, userID, vacationID uint64)
 
 	// (PUT /users/{user_id}/vacations/{vacation_id})
-	PutVacation(w http.ResponseWriter, r *http.Request, userID, vacationID uint64)
+	PutVacation(w http.ResponseWriter, r *http.Request, userID, vacationID uint64, params PutVacationParams)
+	// (GET /users/{user_id}/work_permits)
+	ListWorkPermits(w http.ResponseWriter, r *http.Request, userID uint64)
+	// (POST /users/{user_id}/work_permits)
+	AddWorkPermit(w http.ResponseWriter, r *http.Request, userID uint64)
+	// (GET /users/{user_id}/work_permits/check)
+	CheckWorkPermits(w http.ResponseWriter, r *http.Request, userID uint64)
+	// (DELETE /users/{user_id}/work_permits/{work_permit_id})
+	DeleteWorkPermit(w http.ResponseWriter, r *http.Request, userID, workPermitID uint64)
+	// (GET /users/{user_id}/work_permits/{work_permit_id})
+	GetWorkPermit(w http.ResponseWriter, r *http.Request, userID, workPermitID uint64)
+	// (PUT /users/{user_id}/work_permits/{work_permit_id})
+	PutWorkPermit(w http.ResponseWriter, r *http.Request, userID, workPermitID uint64)
+	// (GET /vacancies)
+	ListVacancies(w http.ResponseWriter, r *http.Request, params ListVacanciesParams)
+
+	// (POST /vacancies)
+	AddVacancy(w http.ResponseWriter, r *http.Request)
+
+	// (GET /vacancies/{vacancy_id})
+	GetVacancy(w http.ResponseWriter, r *http.Request, vacancyID uint64)
+
+	// (PUT /vacancies/{vacancy_id})
+	PutVacancy(w http.ResponseWriter, r *http.Request, vacancyID uint64)
+
+	// (GET /vacation-requests)
+	ListVacationRequests(w http.ResponseWriter, r *http.Request)
+
+	// (POST /vacation-requests)
+	AddVacationRequest(w http.ResponseWriter, r *http.Request)
+
+	// (GET /vacation-requests/incoming)
+	ListIncomingVacationRequests(w http.ResponseWriter, r *http.Request)
+
+	// (GET /vacation-requests/{request_id})
+	GetVacationRequest(w http.ResponseWriter, r *http.Request, requestID uint64)
+
+	// (PATCH /vacation-requests/{request_id})
+	PatchVacationRequest(w http.ResponseWriter, r *http.Request, requestID uint64, params PatchVacationRequestParams)
+
+	// (PUT /vacation-requests/{request_id})
+	PutVacationRequest(w http.ResponseWriter, r *http.Request, requestID uint64)
+
+	// (GET /vacations)
+	ListAllVacations(w http.ResponseWriter, r *http.Request, params ListAllVacationsParams)
+
+	// (GET /vacations/balances)
+	ListVacationBalances(w http.ResponseWriter, r *http.Request, params ListVacationBalancesParams)
+	// (GET /vacations/schedules/{year})
+	GetVacationSchedule(w http.ResponseWriter, r *http.Request, year uint64, params GetVacationScheduleParams)
+	// (PATCH /vacations/schedules/{year})
+	PatchVacationSchedule(w http.ResponseWriter, r *http.Request, year uint64)
+	// (POST /vacations/schedules/{year}/items)
+	AddVacationScheduleItem(w http.ResponseWriter, r *http.Request, year uint64)
+	// (DELETE /vacations/schedules/{year}/items/{item_id})
+	DeleteVacationScheduleItem(w http.ResponseWriter, r *http.Request, year, itemID uint64)
+	// (PUT /vacations/schedules/{year}/items/{item_id})
+	PutVacationScheduleItem(w http.ResponseWriter, r *http.Request, year, itemID uint64)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -183,14 +516,141 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// ListDepartments operation middleware
-func (siw *ServerInterfaceWrapper) ListDepartments(w http.ResponseWriter, r *http.Request) {
+// ListAllAbsences operation middleware
+func (siw *ServerInterfaceWrapper) ListAllAbsences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAllAbsencesParams
+
+	// ------------- Optional query parameter "department_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "department_id", r.URL.Query(), &params.DepartmentID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "department_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "date_from" -------------
+
+	if paramValue := r.URL.Query().Get("date_from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "date_from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "date_from", r.URL.Query(), &params.DateFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date_from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "date_to" -------------
+
+	if paramValue := r.URL.Query().Get("date_to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "date_to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "date_to", r.URL.Query(), &params.DateTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAllAbsences(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetAbsenceReport operation middleware
+func (siw *ServerInterfaceWrapper) GetAbsenceReport(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAbsenceReportParams
+
+	// ------------- Optional query parameter "department_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "department_id", r.URL.Query(), &params.DepartmentID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "department_id", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "date_from" -------------
+
+	if paramValue := r.URL.Query().Get("date_from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "date_from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "date_from", r.URL.Query(), &params.DateFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date_from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "date_to" -------------
+
+	if paramValue := r.URL.Query().Get("date_to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "date_to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "date_to", r.URL.Query(), &params.DateTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListDepartments(w, r)
+		siw.Handler.GetAbsenceReport(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -200,12 +660,14 @@ func (siw *ServerInterfaceWrapper) ListDepartments(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Health operation middleware
-func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request) {
+// ListBenefits operation middleware
+func (siw *ServerInterfaceWrapper) ListBenefits(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Health(w, r)
+		siw.Handler.ListBenefits(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -215,12 +677,14 @@ func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Login operation middleware
-func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+// AddBenefit operation middleware
+func (siw *ServerInterfaceWrapper) AddBenefit(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Login(w, r)
+		siw.Handler.AddBenefit(w, r)
 	}))
 
 	handler = chimwr.AllowContentType("application/json")(handler)
@@ -232,32 +696,3688 @@ func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request)
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CheckKey operation middleware
-func (siw *ServerInterfaceWrapper) CheckKey(w http.ResponseWriter, r *http.Request) {
+// BenefitCostReport operation middleware
+func (siw *ServerInterfaceWrapper) BenefitCostReport(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params CheckKeyParams
+	var params BenefitCostReportParams
 
-	// ------------- Required query parameter "key" -------------
+	// ------------- Required query parameter "date_from" -------------
 
-	if paramValue := r.URL.Query().Get("key"); paramValue != "" {
+	if paramValue := r.URL.Query().Get("date_from"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "key"})
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "date_from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "date_from", r.URL.Query(), &params.DateFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date_from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "date_to" -------------
+
+	if paramValue := r.URL.Query().Get("date_to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "date_to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "date_to", r.URL.Query(), &params.DateTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "department_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "department_id", r.URL.Query(), &params.DepartmentID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "department_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BenefitCostReport(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetBenefit operation middleware
+func (siw *ServerInterfaceWrapper) GetBenefit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "benefit_id" -------------
+	var benefitID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "benefit_id", runtime.ParamLocationPath, chi.URLParam(r, "benefit_id"), &benefitID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "benefit_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBenefit(w, r, benefitID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutBenefit operation middleware
+func (siw *ServerInterfaceWrapper) PutBenefit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "benefit_id" -------------
+	var benefitID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "benefit_id", runtime.ParamLocationPath, chi.URLParam(r, "benefit_id"), &benefitID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "benefit_id", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "key", r.URL.Query(), &params.Key)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutBenefit(w, r, benefitID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CountCalendarDays operation middleware
+func (siw *ServerInterfaceWrapper) CountCalendarDays(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CountCalendarDaysParams
+
+	// ------------- Required query parameter "date_from" -------------
+
+	if paramValue := r.URL.Query().Get("date_from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "date_from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "date_from", r.URL.Query(), &params.DateFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date_from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "date_to" -------------
+
+	if paramValue := r.URL.Query().Get("date_to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "date_to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "date_to", r.URL.Query(), &params.DateTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date_to", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CountCalendarDays(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListCalendarDays operation middleware
+func (siw *ServerInterfaceWrapper) ListCalendarDays(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "year", runtime.ParamLocationPath, chi.URLParam(r, "year"), &year)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCalendarDays(w, r, year)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutCalendarDays operation middleware
+func (siw *ServerInterfaceWrapper) PutCalendarDays(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "year", runtime.ParamLocationPath, chi.URLParam(r, "year"), &year)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutCalendarDays(w, r, year)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ImportCalendar operation middleware
+func (siw *ServerInterfaceWrapper) ImportCalendar(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "year", runtime.ParamLocationPath, chi.URLParam(r, "year"), &year)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ImportCalendar(w, r, year)
+	}))
+
+	handler = chimwr.AllowContentType("multipart/form-data")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListCandidates operation middleware
+func (siw *ServerInterfaceWrapper) ListCandidates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListCandidatesParams
+
+	// ------------- Optional query parameter "vacancy_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "vacancy_id", r.URL.Query(), &params.VacancyID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vacancy_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "stage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "stage", r.URL.Query(), &params.Stage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stage", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCandidates(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddCandidate operation middleware
+func (siw *ServerInterfaceWrapper) AddCandidate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddCandidate(w, r)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCandidate operation middleware
+func (siw *ServerInterfaceWrapper) GetCandidate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "candidate_id" -------------
+	var candidateID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "candidate_id", runtime.ParamLocationPath, chi.URLParam(r, "candidate_id"), &candidateID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "candidate_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCandidate(w, r, candidateID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutCandidate operation middleware
+func (siw *ServerInterfaceWrapper) PutCandidate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "candidate_id" -------------
+	var candidateID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "candidate_id", runtime.ParamLocationPath, chi.URLParam(r, "candidate_id"), &candidateID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "candidate_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutCandidate(w, r, candidateID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListCandidateCVs operation middleware
+func (siw *ServerInterfaceWrapper) ListCandidateCVs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "candidate_id" -------------
+	var candidateID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "candidate_id", runtime.ParamLocationPath, chi.URLParam(r, "candidate_id"), &candidateID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "candidate_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCandidateCVs(w, r, candidateID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// UploadCandidateCV operation middleware
+func (siw *ServerInterfaceWrapper) UploadCandidateCV(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "candidate_id" -------------
+	var candidateID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "candidate_id", runtime.ParamLocationPath, chi.URLParam(r, "candidate_id"), &candidateID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "candidate_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadCandidateCV(w, r, candidateID)
+	}))
+
+	handler = chimwr.AllowContentType("multipart/form-data")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DownloadCandidateCV operation middleware
+func (siw *ServerInterfaceWrapper) DownloadCandidateCV(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "candidate_id" -------------
+	var candidateID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "candidate_id", runtime.ParamLocationPath, chi.URLParam(r, "candidate_id"), &candidateID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "candidate_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "cv_id" -------------
+	var cvID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "cv_id", runtime.ParamLocationPath, chi.URLParam(r, "cv_id"), &cvID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "cv_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DownloadCandidateCV(w, r, candidateID, cvID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// HireCandidate operation middleware
+func (siw *ServerInterfaceWrapper) HireCandidate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "candidate_id" -------------
+	var candidateID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "candidate_id", runtime.ParamLocationPath, chi.URLParam(r, "candidate_id"), &candidateID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "candidate_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HireCandidateParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HireCandidate(w, r, candidateID, params)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListCandidateNotes operation middleware
+func (siw *ServerInterfaceWrapper) ListCandidateNotes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "candidate_id" -------------
+	var candidateID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "candidate_id", runtime.ParamLocationPath, chi.URLParam(r, "candidate_id"), &candidateID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "candidate_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCandidateNotes(w, r, candidateID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddCandidateNote operation middleware
+func (siw *ServerInterfaceWrapper) AddCandidateNote(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "candidate_id" -------------
+	var candidateID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "candidate_id", runtime.ParamLocationPath, chi.URLParam(r, "candidate_id"), &candidateID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "candidate_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddCandidateNote(w, r, candidateID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutCandidateStage operation middleware
+func (siw *ServerInterfaceWrapper) PutCandidateStage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "candidate_id" -------------
+	var candidateID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "candidate_id", runtime.ParamLocationPath, chi.URLParam(r, "candidate_id"), &candidateID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "candidate_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutCandidateStage(w, r, candidateID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListDepartments operation middleware
+func (siw *ServerInterfaceWrapper) ListDepartments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDepartments(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListDuplicates operation middleware
+func (siw *ServerInterfaceWrapper) ListDuplicates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListDuplicates(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// Health operation middleware
+func (siw *ServerInterfaceWrapper) Health(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Health(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListIndexationRuns operation middleware
+func (siw *ServerInterfaceWrapper) ListIndexationRuns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListIndexationRuns(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddIndexationRun operation middleware
+func (siw *ServerInterfaceWrapper) AddIndexationRun(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddIndexationRun(w, r)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PreviewIndexationRun operation middleware
+func (siw *ServerInterfaceWrapper) PreviewIndexationRun(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PreviewIndexationRun(w, r)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RevertIndexationRun operation middleware
+func (siw *ServerInterfaceWrapper) RevertIndexationRun(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "run_id" -------------
+	var runID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "run_id", runtime.ParamLocationPath, chi.URLParam(r, "run_id"), &runID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "run_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevertIndexationRun(w, r, runID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetIndexationRun operation middleware
+func (siw *ServerInterfaceWrapper) GetIndexationRun(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "run_id" -------------
+	var runID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "run_id", runtime.ParamLocationPath, chi.URLParam(r, "run_id"), &runID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "run_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetIndexationRun(w, r, runID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Login(w, r)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CheckKey operation middleware
+func (siw *ServerInterfaceWrapper) CheckKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CheckKeyParams
+
+	// ------------- Required query parameter "key" -------------
+
+	if paramValue := r.URL.Query().Get("key"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "key"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "key", r.URL.Query(), &params.Key)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CheckKey(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ChangePassword operation middleware
+func (siw *ServerInterfaceWrapper) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ChangePassword(w, r)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// InitChangePassword operation middleware
+func (siw *ServerInterfaceWrapper) InitChangePassword(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.InitChangePassword(w, r)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListMilitaryLiable operation middleware
+func (siw *ServerInterfaceWrapper) ListMilitaryLiable(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMilitaryLiableParams
+
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "rank" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "rank", r.URL.Query(), &params.Rank)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "rank", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_terminated" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_terminated", r.URL.Query(), &params.IncludeTerminated)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_terminated", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMilitaryLiable(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListMilitaryNotifications operation middleware
+func (siw *ServerInterfaceWrapper) ListMilitaryNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMilitaryNotificationsParams
+
+	// ------------- Required query parameter "date_from" -------------
+
+	if paramValue := r.URL.Query().Get("date_from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "date_from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "date_from", r.URL.Query(), &params.DateFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date_from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "date_to" -------------
+
+	if paramValue := r.URL.Query().Get("date_to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "date_to"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "date_to", r.URL.Query(), &params.DateTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date_to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMilitaryNotifications(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListNumberSequences operation middleware
+func (siw *ServerInterfaceWrapper) ListNumberSequences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListNumberSequences(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddNumberSequence operation middleware
+func (siw *ServerInterfaceWrapper) AddNumberSequence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddNumberSequence(w, r)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutNumberSequence operation middleware
+func (siw *ServerInterfaceWrapper) PutNumberSequence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "sequence_id" -------------
+	var sequenceID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "sequence_id", runtime.ParamLocationPath, chi.URLParam(r, "sequence_id"), &sequenceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sequence_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutNumberSequence(w, r, sequenceID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListOverdueOnboarding operation middleware
+func (siw *ServerInterfaceWrapper) ListOverdueOnboarding(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOverdueOnboardingParams
+
+	// ------------- Optional query parameter "department_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "department_id", r.URL.Query(), &params.DepartmentID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "department_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOverdueOnboarding(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListOnboardingTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListOnboardingTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOnboardingTemplates(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddOnboardingTemplate operation middleware
+func (siw *ServerInterfaceWrapper) AddOnboardingTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddOnboardingTemplate(w, r)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteOnboardingTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOnboardingTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "template_id" -------------
+	var templateID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "template_id", runtime.ParamLocationPath, chi.URLParam(r, "template_id"), &templateID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "template_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOnboardingTemplate(w, r, templateID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetOnboardingTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetOnboardingTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "template_id" -------------
+	var templateID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "template_id", runtime.ParamLocationPath, chi.URLParam(r, "template_id"), &templateID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "template_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOnboardingTemplate(w, r, templateID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutOnboardingTemplate operation middleware
+func (siw *ServerInterfaceWrapper) PutOnboardingTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "template_id" -------------
+	var templateID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "template_id", runtime.ParamLocationPath, chi.URLParam(r, "template_id"), &templateID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "template_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutOnboardingTemplate(w, r, templateID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListOrders operation middleware
+func (siw *ServerInterfaceWrapper) ListOrders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListOrdersParams
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", r.URL.Query(), &params.Type)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "type", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "year" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "year", r.URL.Query(), &params.Year)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOrders(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddOrder operation middleware
+func (siw *ServerInterfaceWrapper) AddOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddOrder(w, r)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetOrder operation middleware
+func (siw *ServerInterfaceWrapper) GetOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "order_id" -------------
+	var orderID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "order_id", runtime.ParamLocationPath, chi.URLParam(r, "order_id"), &orderID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOrder(w, r, orderID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PatchOrder operation middleware
+func (siw *ServerInterfaceWrapper) PatchOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "order_id" -------------
+	var orderID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "order_id", runtime.ParamLocationPath, chi.URLParam(r, "order_id"), &orderID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchOrder(w, r, orderID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutOrder operation middleware
+func (siw *ServerInterfaceWrapper) PutOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "order_id" -------------
+	var orderID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "order_id", runtime.ParamLocationPath, chi.URLParam(r, "order_id"), &orderID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "order_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutOrder(w, r, orderID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListPositionHolders operation middleware
+func (siw *ServerInterfaceWrapper) ListPositionHolders(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "position_id" -------------
+	var positionID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "position_id", runtime.ParamLocationPath, chi.URLParam(r, "position_id"), &positionID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "position_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListPositionHoldersParams
+
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date", r.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPositionHolders(w, r, positionID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListProbations operation middleware
+func (siw *ServerInterfaceWrapper) ListProbations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListProbationsParams
+
+	// ------------- Optional query parameter "days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "days", r.URL.Query(), &params.Days)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "days", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListProbations(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListStaffUnits operation middleware
+func (siw *ServerInterfaceWrapper) ListStaffUnits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListStaffUnitsParams
+
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date", r.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "department_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "department_id", r.URL.Query(), &params.DepartmentID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "department_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListStaffUnits(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddStaffUnit operation middleware
+func (siw *ServerInterfaceWrapper) AddStaffUnit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddStaffUnit(w, r)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListStaffingVacancies operation middleware
+func (siw *ServerInterfaceWrapper) ListStaffingVacancies(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListStaffingVacanciesParams
+
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date", r.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "department_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "department_id", r.URL.Query(), &params.DepartmentID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "department_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListStaffingVacancies(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetStaffUnit operation middleware
+func (siw *ServerInterfaceWrapper) GetStaffUnit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "unit_id" -------------
+	var unitID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "unit_id", runtime.ParamLocationPath, chi.URLParam(r, "unit_id"), &unitID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unit_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStaffUnit(w, r, unitID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutStaffUnit operation middleware
+func (siw *ServerInterfaceWrapper) PutStaffUnit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "unit_id" -------------
+	var unitID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "unit_id", runtime.ParamLocationPath, chi.URLParam(r, "unit_id"), &unitID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "unit_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutStaffUnit(w, r, unitID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetTimesheet operation middleware
+func (siw *ServerInterfaceWrapper) GetTimesheet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "year", runtime.ParamLocationPath, chi.URLParam(r, "year"), &year)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "month" -------------
+	var month uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "month", runtime.ParamLocationPath, chi.URLParam(r, "month"), &month)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "month", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTimesheetParams
+
+	// ------------- Required query parameter "department_id" -------------
+
+	if paramValue := r.URL.Query().Get("department_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "department_id"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "department_id", r.URL.Query(), &params.DepartmentID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "department_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetTimesheet(w, r, year, month, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteTimesheetDay operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTimesheetDay(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "year", runtime.ParamLocationPath, chi.URLParam(r, "year"), &year)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "month" -------------
+	var month uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "month", runtime.ParamLocationPath, chi.URLParam(r, "month"), &month)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "month", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "day" -------------
+	var day uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "day", runtime.ParamLocationPath, chi.URLParam(r, "day"), &day)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "day", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteTimesheetDay(w, r, year, month, userID, day)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutTimesheetDay operation middleware
+func (siw *ServerInterfaceWrapper) PutTimesheetDay(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "year", runtime.ParamLocationPath, chi.URLParam(r, "year"), &year)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "month" -------------
+	var month uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "month", runtime.ParamLocationPath, chi.URLParam(r, "month"), &month)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "month", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "day" -------------
+	var day uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "day", runtime.ParamLocationPath, chi.URLParam(r, "day"), &day)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "day", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutTimesheetDay(w, r, year, month, userID, day)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUsersParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "query" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort_by", r.URL.Query(), &params.SortBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_by", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "include_terminated" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_terminated", r.URL.Query(), &params.IncludeTerminated)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "include_terminated", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListUsers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddUser operation middleware
+func (siw *ServerInterfaceWrapper) AddUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddUserParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddUser(w, r, params)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetUser operation middleware
+func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUserParams
+
+	// ------------- Optional query parameter "expanded" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "expanded", r.URL.Query(), &params.Expanded)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expanded", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetUser(w, r, userID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PatchUser operation middleware
+func (siw *ServerInterfaceWrapper) PatchUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchUser(w, r, userID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutUser operation middleware
+func (siw *ServerInterfaceWrapper) PutUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutUserParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutUser(w, r, userID, params)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListAbsences operation middleware
+func (siw *ServerInterfaceWrapper) ListAbsences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAbsences(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddAbsence operation middleware
+func (siw *ServerInterfaceWrapper) AddAbsence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddAbsence(w, r, userID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteAbsence operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAbsence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "absence_id" -------------
+	var absenceID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "absence_id", runtime.ParamLocationPath, chi.URLParam(r, "absence_id"), &absenceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "absence_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAbsence(w, r, userID, absenceID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetAbsence operation middleware
+func (siw *ServerInterfaceWrapper) GetAbsence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "absence_id" -------------
+	var absenceID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "absence_id", runtime.ParamLocationPath, chi.URLParam(r, "absence_id"), &absenceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "absence_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAbsence(w, r, userID, absenceID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutAbsence operation middleware
+func (siw *ServerInterfaceWrapper) PutAbsence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "absence_id" -------------
+	var absenceID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "absence_id", runtime.ParamLocationPath, chi.URLParam(r, "absence_id"), &absenceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "absence_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAbsence(w, r, userID, absenceID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListBenefitEnrolments operation middleware
+func (siw *ServerInterfaceWrapper) ListBenefitEnrolments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListBenefitEnrolments(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddBenefitEnrolment operation middleware
+func (siw *ServerInterfaceWrapper) AddBenefitEnrolment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddBenefitEnrolment(w, r, userID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteBenefitEnrolment operation middleware
+func (siw *ServerInterfaceWrapper) DeleteBenefitEnrolment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "enrolment_id" -------------
+	var enrolmentID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "enrolment_id", runtime.ParamLocationPath, chi.URLParam(r, "enrolment_id"), &enrolmentID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "enrolment_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteBenefitEnrolment(w, r, userID, enrolmentID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutBenefitEnrolment operation middleware
+func (siw *ServerInterfaceWrapper) PutBenefitEnrolment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "enrolment_id" -------------
+	var enrolmentID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "enrolment_id", runtime.ParamLocationPath, chi.URLParam(r, "enrolment_id"), &enrolmentID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "enrolment_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutBenefitEnrolment(w, r, userID, enrolmentID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListCompensations operation middleware
+func (siw *ServerInterfaceWrapper) ListCompensations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCompensations(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddCompensation operation middleware
+func (siw *ServerInterfaceWrapper) AddCompensation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddCompensation(w, r, userID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetCompensation operation middleware
+func (siw *ServerInterfaceWrapper) GetCompensation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "compensation_id" -------------
+	var compensationID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "compensation_id", runtime.ParamLocationPath, chi.URLParam(r, "compensation_id"), &compensationID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "compensation_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCompensation(w, r, userID, compensationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutCompensation operation middleware
+func (siw *ServerInterfaceWrapper) PutCompensation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "compensation_id" -------------
+	var compensationID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "compensation_id", runtime.ParamLocationPath, chi.URLParam(r, "compensation_id"), &compensationID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "compensation_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutCompensation(w, r, userID, compensationID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListContracts operation middleware
+func (siw *ServerInterfaceWrapper) ListContracts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListContracts(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddContract operation middleware
+func (siw *ServerInterfaceWrapper) AddContract(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddContract(w, r, userID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteContract operation middleware
+func (siw *ServerInterfaceWrapper) DeleteContract(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "contract_id" -------------
+	var contractID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "contract_id", runtime.ParamLocationPath, chi.URLParam(r, "contract_id"), &contractID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contract_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteContract(w, r, userID, contractID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetContract operation middleware
+func (siw *ServerInterfaceWrapper) GetContract(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "contract_id" -------------
+	var contractID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "contract_id", runtime.ParamLocationPath, chi.URLParam(r, "contract_id"), &contractID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contract_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetContract(w, r, userID, contractID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PatchContract operation middleware
+func (siw *ServerInterfaceWrapper) PatchContract(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "contract_id" -------------
+	var contractID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "contract_id", runtime.ParamLocationPath, chi.URLParam(r, "contract_id"), &contractID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contract_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchContract(w, r, userID, contractID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutContract operation middleware
+func (siw *ServerInterfaceWrapper) PutContract(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "contract_id" -------------
+	var contractID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "contract_id", runtime.ParamLocationPath, chi.URLParam(r, "contract_id"), &contractID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contract_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutContract(w, r, userID, contractID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListAmendments operation middleware
+func (siw *ServerInterfaceWrapper) ListAmendments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "contract_id" -------------
+	var contractID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "contract_id", runtime.ParamLocationPath, chi.URLParam(r, "contract_id"), &contractID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contract_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListAmendments(w, r, userID, contractID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddAmendment operation middleware
+func (siw *ServerInterfaceWrapper) AddAmendment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "contract_id" -------------
+	var contractID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "contract_id", runtime.ParamLocationPath, chi.URLParam(r, "contract_id"), &contractID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contract_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddAmendment(w, r, userID, contractID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetAmendment operation middleware
+func (siw *ServerInterfaceWrapper) GetAmendment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "contract_id" -------------
+	var contractID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "contract_id", runtime.ParamLocationPath, chi.URLParam(r, "contract_id"), &contractID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contract_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "amendment_id" -------------
+	var amendmentID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "amendment_id", runtime.ParamLocationPath, chi.URLParam(r, "amendment_id"), &amendmentID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amendment_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAmendment(w, r, userID, contractID, amendmentID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutAmendment operation middleware
+func (siw *ServerInterfaceWrapper) PutAmendment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "contract_id" -------------
+	var contractID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "contract_id", runtime.ParamLocationPath, chi.URLParam(r, "contract_id"), &contractID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contract_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "amendment_id" -------------
+	var amendmentID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "amendment_id", runtime.ParamLocationPath, chi.URLParam(r, "amendment_id"), &amendmentID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "amendment_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutAmendment(w, r, userID, contractID, amendmentID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutProbationResult operation middleware
+func (siw *ServerInterfaceWrapper) PutProbationResult(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "contract_id" -------------
+	var contractID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "contract_id", runtime.ParamLocationPath, chi.URLParam(r, "contract_id"), &contractID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contract_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutProbationResult(w, r, userID, contractID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetContractTerms operation middleware
+func (siw *ServerInterfaceWrapper) GetContractTerms(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "contract_id" -------------
+	var contractID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "contract_id", runtime.ParamLocationPath, chi.URLParam(r, "contract_id"), &contractID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contract_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetContractTermsParams
+
+	// ------------- Optional query parameter "date" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "date", r.URL.Query(), &params.Date)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetContractTerms(w, r, userID, contractID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListEducations operation middleware
+func (siw *ServerInterfaceWrapper) ListEducations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListEducations(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddEducation operation middleware
+func (siw *ServerInterfaceWrapper) AddEducation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddEducation(w, r, userID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteEducation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEducation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "education_id" -------------
+	var educationID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "education_id", runtime.ParamLocationPath, chi.URLParam(r, "education_id"), &educationID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "education_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteEducation(w, r, userID, educationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetEducation operation middleware
+func (siw *ServerInterfaceWrapper) GetEducation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "education_id" -------------
+	var educationID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "education_id", runtime.ParamLocationPath, chi.URLParam(r, "education_id"), &educationID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "education_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetEducation(w, r, userID, educationID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PatchEducation operation middleware
+func (siw *ServerInterfaceWrapper) PatchEducation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "education_id" -------------
+	var educationID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "education_id", runtime.ParamLocationPath, chi.URLParam(r, "education_id"), &educationID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "education_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchEducation(w, r, userID, educationID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutEducation operation middleware
+func (siw *ServerInterfaceWrapper) PutEducation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "education_id" -------------
+	var educationID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "education_id", runtime.ParamLocationPath, chi.URLParam(r, "education_id"), &educationID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "education_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutEducation(w, r, userID, educationID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListExperiences operation middleware
+func (siw *ServerInterfaceWrapper) ListExperiences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListExperiences(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddExperience operation middleware
+func (siw *ServerInterfaceWrapper) AddExperience(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddExperience(w, r, userID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetExperienceLength operation middleware
+func (siw *ServerInterfaceWrapper) GetExperienceLength(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExperienceLength(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteExperience operation middleware
+func (siw *ServerInterfaceWrapper) DeleteExperience(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "experience_id" -------------
+	var experienceID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "experience_id", runtime.ParamLocationPath, chi.URLParam(r, "experience_id"), &experienceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "experience_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteExperience(w, r, userID, experienceID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetExperience operation middleware
+func (siw *ServerInterfaceWrapper) GetExperience(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "experience_id" -------------
+	var experienceID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "experience_id", runtime.ParamLocationPath, chi.URLParam(r, "experience_id"), &experienceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "experience_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetExperience(w, r, userID, experienceID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutExperience operation middleware
+func (siw *ServerInterfaceWrapper) PutExperience(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "experience_id" -------------
+	var experienceID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "experience_id", runtime.ParamLocationPath, chi.URLParam(r, "experience_id"), &experienceID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "experience_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutExperience(w, r, userID, experienceID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListIndexations operation middleware
+func (siw *ServerInterfaceWrapper) ListIndexations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListIndexations(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteMilitary operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMilitary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMilitary(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetMilitary operation middleware
+func (siw *ServerInterfaceWrapper) GetMilitary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMilitary(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutMilitary operation middleware
+func (siw *ServerInterfaceWrapper) PutMilitary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutMilitary(w, r, userID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListOnboardingItems operation middleware
+func (siw *ServerInterfaceWrapper) ListOnboardingItems(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListOnboardingItems(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PatchOnboardingItem operation middleware
+func (siw *ServerInterfaceWrapper) PatchOnboardingItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "item_id" -------------
+	var itemID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "item_id", runtime.ParamLocationPath, chi.URLParam(r, "item_id"), &itemID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchOnboardingItem(w, r, userID, itemID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListPassports operation middleware
+func (siw *ServerInterfaceWrapper) ListPassports(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListPassports(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddPassport operation middleware
+func (siw *ServerInterfaceWrapper) AddPassport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddPassport(w, r, userID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeletePassport operation middleware
+func (siw *ServerInterfaceWrapper) DeletePassport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "passport_id" -------------
+	var passportID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePassport(w, r, userID, passportID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetPassport operation middleware
+func (siw *ServerInterfaceWrapper) GetPassport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "passport_id" -------------
+	var passportID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPassportParams
+
+	// ------------- Optional query parameter "expanded" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "expanded", r.URL.Query(), &params.Expanded)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expanded", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPassport(w, r, userID, passportID, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PatchPassport operation middleware
+func (siw *ServerInterfaceWrapper) PatchPassport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "passport_id" -------------
+	var passportID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchPassport(w, r, userID, passportID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutPassport operation middleware
+func (siw *ServerInterfaceWrapper) PutPassport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "passport_id" -------------
+	var passportID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutPassport(w, r, userID, passportID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListVisas operation middleware
+func (siw *ServerInterfaceWrapper) ListVisas(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "passport_id" -------------
+	var passportID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CheckKey(w, r, params)
+		siw.Handler.ListVisas(w, r, userID, passportID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -267,29 +4387,34 @@ func (siw *ServerInterfaceWrapper) CheckKey(w http.ResponseWriter, r *http.Reque
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ChangePassword operation middleware
-func (siw *ServerInterfaceWrapper) ChangePassword(w http.ResponseWriter, r *http.Request) {
+// AddVisa operation middleware
+func (siw *ServerInterfaceWrapper) AddVisa(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ChangePassword(w, r)
-	}))
+	var err error
 
-	handler = chimwr.AllowContentType("application/json")(handler)
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	// ------------- Path parameter "passport_id" -------------
+	var passportID uint64
 
-// InitChangePassword operation middleware
-func (siw *ServerInterfaceWrapper) InitChangePassword(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.InitChangePassword(w, r)
+		siw.Handler.AddVisa(w, r, userID, passportID)
 	}))
 
 	handler = chimwr.AllowContentType("application/json")(handler)
@@ -301,72 +4426,45 @@ func (siw *ServerInterfaceWrapper) InitChangePassword(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListUsers operation middleware
-func (siw *ServerInterfaceWrapper) ListUsers(w http.ResponseWriter, r *http.Request) {
+// DeleteVisa operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVisa(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListUsersParams
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "query" -------------
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
 
-	err = runtime.BindQueryParameter("form", true, false, "query", r.URL.Query(), &params.Query)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "query", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Path parameter "passport_id" -------------
+	var passportID uint64
 
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "sort_by" -------------
+	// ------------- Path parameter "visa_id" -------------
+	var visaID uint64
 
-	err = runtime.BindQueryParameter("form", true, false, "sort_by", r.URL.Query(), &params.SortBy)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "visa_id", runtime.ParamLocationPath, chi.URLParam(r, "visa_id"), &visaID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort_by", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "visa_id", Err: err})
 		return
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListUsers(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
-	}
-
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
-
-// AddUser operation middleware
-func (siw *ServerInterfaceWrapper) AddUser(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddUser(w, r)
+		siw.Handler.DeleteVisa(w, r, userID, passportID, visaID)
 	}))
 
-	handler = chimwr.AllowContentType("application/json")(handler)
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
@@ -374,8 +4472,8 @@ func (siw *ServerInterfaceWrapper) AddUser(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetUser operation middleware
-func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Request) {
+// GetVisa operation middleware
+func (siw *ServerInterfaceWrapper) GetVisa(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -389,21 +4487,28 @@ func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	// ------------- Path parameter "passport_id" -------------
+	var passportID uint64
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUserParams
+	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
+		return
+	}
 
-	// ------------- Optional query parameter "expanded" -------------
+	// ------------- Path parameter "visa_id" -------------
+	var visaID uint64
 
-	err = runtime.BindQueryParameter("form", true, false, "expanded", r.URL.Query(), &params.Expanded)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "visa_id", runtime.ParamLocationPath, chi.URLParam(r, "visa_id"), &visaID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expanded", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "visa_id", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetUser(w, r, userID, params)
+		siw.Handler.GetVisa(w, r, userID, passportID, visaID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -413,8 +4518,8 @@ func (siw *ServerInterfaceWrapper) GetUser(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PatchUser operation middleware
-func (siw *ServerInterfaceWrapper) PatchUser(w http.ResponseWriter, r *http.Request) {
+// PatchVisa operation middleware
+func (siw *ServerInterfaceWrapper) PatchVisa(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -428,10 +4533,28 @@ func (siw *ServerInterfaceWrapper) PatchUser(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	// ------------- Path parameter "passport_id" -------------
+	var passportID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "visa_id" -------------
+	var visaID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "visa_id", runtime.ParamLocationPath, chi.URLParam(r, "visa_id"), &visaID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "visa_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchUser(w, r, userID)
+		siw.Handler.PatchVisa(w, r, userID, passportID, visaID)
 	}))
 
 	handler = chimwr.AllowContentType("application/json")(handler)
@@ -443,8 +4566,8 @@ func (siw *ServerInterfaceWrapper) PatchUser(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutUser operation middleware
-func (siw *ServerInterfaceWrapper) PutUser(w http.ResponseWriter, r *http.Request) {
+// PutVisa operation middleware
+func (siw *ServerInterfaceWrapper) PutVisa(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -458,10 +4581,28 @@ func (siw *ServerInterfaceWrapper) PutUser(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	// ------------- Path parameter "passport_id" -------------
+	var passportID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "visa_id" -------------
+	var visaID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "visa_id", runtime.ParamLocationPath, chi.URLParam(r, "visa_id"), &visaID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "visa_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutUser(w, r, userID)
+		siw.Handler.PutVisa(w, r, userID, passportID, visaID)
 	}))
 
 	handler = chimwr.AllowContentType("application/json")(handler)
@@ -473,8 +4614,8 @@ func (siw *ServerInterfaceWrapper) PutUser(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListContracts operation middleware
-func (siw *ServerInterfaceWrapper) ListContracts(w http.ResponseWriter, r *http.Request) {
+// DownloadPhoto operation middleware
+func (siw *ServerInterfaceWrapper) DownloadPhoto(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -488,10 +4629,10 @@ func (siw *ServerInterfaceWrapper) ListContracts(w http.ResponseWriter, r *http.
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListContracts(w, r, userID)
+		siw.Handler.DownloadPhoto(w, r, userID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -501,8 +4642,8 @@ func (siw *ServerInterfaceWrapper) ListContracts(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AddContract operation middleware
-func (siw *ServerInterfaceWrapper) AddContract(w http.ResponseWriter, r *http.Request) {
+// UploadPhoto operation middleware
+func (siw *ServerInterfaceWrapper) UploadPhoto(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -516,13 +4657,13 @@ func (siw *ServerInterfaceWrapper) AddContract(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddContract(w, r, userID)
+		siw.Handler.UploadPhoto(w, r, userID)
 	}))
 
-	handler = chimwr.AllowContentType("application/json")(handler)
+	handler = chimwr.AllowContentType("image/png", "image/jpeg")(handler)
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
@@ -531,8 +4672,8 @@ func (siw *ServerInterfaceWrapper) AddContract(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteContract operation middleware
-func (siw *ServerInterfaceWrapper) DeleteContract(w http.ResponseWriter, r *http.Request) {
+// ListRelatives operation middleware
+func (siw *ServerInterfaceWrapper) ListRelatives(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -546,19 +4687,10 @@ func (siw *ServerInterfaceWrapper) DeleteContract(w http.ResponseWriter, r *http
 		return
 	}
 
-	// ------------- Path parameter "contract_id" -------------
-	var contractID uint64
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "contract_id", runtime.ParamLocationPath, chi.URLParam(r, "contract_id"), &contractID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contract_id", Err: err})
-		return
-	}
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteContract(w, r, userID, contractID)
+		siw.Handler.ListRelatives(w, r, userID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -568,8 +4700,8 @@ func (siw *ServerInterfaceWrapper) DeleteContract(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetContract operation middleware
-func (siw *ServerInterfaceWrapper) GetContract(w http.ResponseWriter, r *http.Request) {
+// AddRelative operation middleware
+func (siw *ServerInterfaceWrapper) AddRelative(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -583,21 +4715,14 @@ func (siw *ServerInterfaceWrapper) GetContract(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// ------------- Path parameter "contract_id" -------------
-	var contractID uint64
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "contract_id", runtime.ParamLocationPath, chi.URLParam(r, "contract_id"), &contractID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contract_id", Err: err})
-		return
-	}
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetContract(w, r, userID, contractID)
+		siw.Handler.AddRelative(w, r, userID)
 	}))
 
+	handler = chimwr.AllowContentType("application/json")(handler)
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
@@ -605,8 +4730,8 @@ func (siw *ServerInterfaceWrapper) GetContract(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PatchContract operation middleware
-func (siw *ServerInterfaceWrapper) PatchContract(w http.ResponseWriter, r *http.Request) {
+// DeleteRelative operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRelative(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -620,23 +4745,21 @@ func (siw *ServerInterfaceWrapper) PatchContract(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// ------------- Path parameter "contract_id" -------------
-	var contractID uint64
+	// ------------- Path parameter "relative_id" -------------
+	var relativeID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "contract_id", runtime.ParamLocationPath, chi.URLParam(r, "contract_id"), &contractID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relative_id", runtime.ParamLocationPath, chi.URLParam(r, "relative_id"), &relativeID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contract_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relative_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchContract(w, r, userID, contractID)
+		siw.Handler.DeleteRelative(w, r, userID, relativeID)
 	}))
 
-	handler = chimwr.AllowContentType("application/json")(handler)
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
@@ -644,8 +4767,8 @@ func (siw *ServerInterfaceWrapper) PatchContract(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutContract operation middleware
-func (siw *ServerInterfaceWrapper) PutContract(w http.ResponseWriter, r *http.Request) {
+// GetRelative operation middleware
+func (siw *ServerInterfaceWrapper) GetRelative(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -659,23 +4782,21 @@ func (siw *ServerInterfaceWrapper) PutContract(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// ------------- Path parameter "contract_id" -------------
-	var contractID uint64
+	// ------------- Path parameter "relative_id" -------------
+	var relativeID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "contract_id", runtime.ParamLocationPath, chi.URLParam(r, "contract_id"), &contractID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relative_id", runtime.ParamLocationPath, chi.URLParam(r, "relative_id"), &relativeID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "contract_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relative_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutContract(w, r, userID, contractID)
+		siw.Handler.GetRelative(w, r, userID, relativeID)
 	}))
 
-	handler = chimwr.AllowContentType("application/json")(handler)
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
@@ -683,8 +4804,8 @@ func (siw *ServerInterfaceWrapper) PutContract(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListEducations operation middleware
-func (siw *ServerInterfaceWrapper) ListEducations(w http.ResponseWriter, r *http.Request) {
+// PutRelative operation middleware
+func (siw *ServerInterfaceWrapper) PutRelative(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -698,12 +4819,23 @@ func (siw *ServerInterfaceWrapper) ListEducations(w http.ResponseWriter, r *http
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	// ------------- Path parameter "relative_id" -------------
+	var relativeID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "relative_id", runtime.ParamLocationPath, chi.URLParam(r, "relative_id"), &relativeID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "relative_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListEducations(w, r, userID)
+		siw.Handler.PutRelative(w, r, userID, relativeID)
 	}))
 
+	handler = chimwr.AllowContentType("application/json")(handler)
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
@@ -711,8 +4843,8 @@ func (siw *ServerInterfaceWrapper) ListEducations(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AddEducation operation middleware
-func (siw *ServerInterfaceWrapper) AddEducation(w http.ResponseWriter, r *http.Request) {
+// ListScans operation middleware
+func (siw *ServerInterfaceWrapper) ListScans(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -726,14 +4858,12 @@ func (siw *ServerInterfaceWrapper) AddEducation(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddEducation(w, r, userID)
+		siw.Handler.ListScans(w, r, userID)
 	}))
 
-	handler = chimwr.AllowContentType("application/json")(handler)
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
@@ -741,8 +4871,8 @@ func (siw *ServerInterfaceWrapper) AddEducation(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteEducation operation middleware
-func (siw *ServerInterfaceWrapper) DeleteEducation(w http.ResponseWriter, r *http.Request) {
+// UploadScan operation middleware
+func (siw *ServerInterfaceWrapper) UploadScan(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -750,27 +4880,20 @@ func (siw *ServerInterfaceWrapper) DeleteEducation(w http.ResponseWriter, r *htt
 	// ------------- Path parameter "user_id" -------------
 	var userID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "education_id" -------------
-	var educationID uint64
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "education_id", runtime.ParamLocationPath, chi.URLParam(r, "education_id"), &educationID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "education_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteEducation(w, r, userID, educationID)
+		siw.Handler.UploadScan(w, r, userID)
 	}))
 
+	handler = chimwr.AllowContentType("multipart/form-data")(handler)
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
@@ -778,8 +4901,8 @@ func (siw *ServerInterfaceWrapper) DeleteEducation(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetEducation operation middleware
-func (siw *ServerInterfaceWrapper) GetEducation(w http.ResponseWriter, r *http.Request) {
+// DeleteScan operation middleware
+func (siw *ServerInterfaceWrapper) DeleteScan(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -793,19 +4916,19 @@ func (siw *ServerInterfaceWrapper) GetEducation(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// ------------- Path parameter "education_id" -------------
-	var educationID uint64
+	// ------------- Path parameter "scan_id" -------------
+	var scanID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "education_id", runtime.ParamLocationPath, chi.URLParam(r, "education_id"), &educationID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scan_id", runtime.ParamLocationPath, chi.URLParam(r, "scan_id"), &scanID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "education_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scan_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetEducation(w, r, userID, educationID)
+		siw.Handler.DeleteScan(w, r, userID, scanID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -815,8 +4938,8 @@ func (siw *ServerInterfaceWrapper) GetEducation(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PatchEducation operation middleware
-func (siw *ServerInterfaceWrapper) PatchEducation(w http.ResponseWriter, r *http.Request) {
+// GetScan operation middleware
+func (siw *ServerInterfaceWrapper) GetScan(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -830,23 +4953,21 @@ func (siw *ServerInterfaceWrapper) PatchEducation(w http.ResponseWriter, r *http
 		return
 	}
 
-	// ------------- Path parameter "education_id" -------------
-	var educationID uint64
+	// ------------- Path parameter "scan_id" -------------
+	var scanID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "education_id", runtime.ParamLocationPath, chi.URLParam(r, "education_id"), &educationID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "scan_id", runtime.ParamLocationPath, chi.URLParam(r, "scan_id"), &scanID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "education_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scan_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchEducation(w, r, userID, educationID)
+		siw.Handler.GetScan(w, r, userID, scanID)
 	}))
 
-	handler = chimwr.AllowContentType("application/json")(handler)
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
@@ -854,8 +4975,8 @@ func (siw *ServerInterfaceWrapper) PatchEducation(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutEducation operation middleware
-func (siw *ServerInterfaceWrapper) PutEducation(w http.ResponseWriter, r *http.Request) {
+// TerminateUser operation middleware
+func (siw *ServerInterfaceWrapper) TerminateUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -869,19 +4990,10 @@ func (siw *ServerInterfaceWrapper) PutEducation(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	// ------------- Path parameter "education_id" -------------
-	var educationID uint64
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "education_id", runtime.ParamLocationPath, chi.URLParam(r, "education_id"), &educationID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "education_id", Err: err})
-		return
-	}
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutEducation(w, r, userID, educationID)
+		siw.Handler.TerminateUser(w, r, userID)
 	}))
 
 	handler = chimwr.AllowContentType("application/json")(handler)
@@ -893,8 +5005,8 @@ func (siw *ServerInterfaceWrapper) PutEducation(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListPassports operation middleware
-func (siw *ServerInterfaceWrapper) ListPassports(w http.ResponseWriter, r *http.Request) {
+// ListTrainings operation middleware
+func (siw *ServerInterfaceWrapper) ListTrainings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -908,10 +5020,10 @@ func (siw *ServerInterfaceWrapper) ListPassports(w http.ResponseWriter, r *http.
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListPassports(w, r, userID)
+		siw.Handler.ListTrainings(w, r, userID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -921,8 +5033,8 @@ func (siw *ServerInterfaceWrapper) ListPassports(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AddPassport operation middleware
-func (siw *ServerInterfaceWrapper) AddPassport(w http.ResponseWriter, r *http.Request) {
+// AddTraining operation middleware
+func (siw *ServerInterfaceWrapper) AddTraining(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -936,10 +5048,10 @@ func (siw *ServerInterfaceWrapper) AddPassport(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddPassport(w, r, userID)
+		siw.Handler.AddTraining(w, r, userID)
 	}))
 
 	handler = chimwr.AllowContentType("application/json")(handler)
@@ -951,8 +5063,8 @@ func (siw *ServerInterfaceWrapper) AddPassport(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeletePassport operation middleware
-func (siw *ServerInterfaceWrapper) DeletePassport(w http.ResponseWriter, r *http.Request) {
+// DeleteTraining operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTraining(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -966,19 +5078,19 @@ func (siw *ServerInterfaceWrapper) DeletePassport(w http.ResponseWriter, r *http
 		return
 	}
 
-	// ------------- Path parameter "passport_id" -------------
-	var passportID uint64
+	// ------------- Path parameter "training_id" -------------
+	var trainingID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "training_id", runtime.ParamLocationPath, chi.URLParam(r, "training_id"), &trainingID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "training_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeletePassport(w, r, userID, passportID)
+		siw.Handler.DeleteTraining(w, r, userID, trainingID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -988,8 +5100,8 @@ func (siw *ServerInterfaceWrapper) DeletePassport(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetPassport operation middleware
-func (siw *ServerInterfaceWrapper) GetPassport(w http.ResponseWriter, r *http.Request) {
+// GetTraining operation middleware
+func (siw *ServerInterfaceWrapper) GetTraining(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1003,30 +5115,19 @@ func (siw *ServerInterfaceWrapper) GetPassport(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// ------------- Path parameter "passport_id" -------------
-	var passportID uint64
+	// ------------- Path parameter "training_id" -------------
+	var trainingID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "training_id", runtime.ParamLocationPath, chi.URLParam(r, "training_id"), &trainingID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "training_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPassportParams
-
-	// ------------- Optional query parameter "expanded" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "expanded", r.URL.Query(), &params.Expanded)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "expanded", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPassport(w, r, userID, passportID, params)
+		siw.Handler.GetTraining(w, r, userID, trainingID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1036,8 +5137,8 @@ func (siw *ServerInterfaceWrapper) GetPassport(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PatchPassport operation middleware
-func (siw *ServerInterfaceWrapper) PatchPassport(w http.ResponseWriter, r *http.Request) {
+// PatchTraining operation middleware
+func (siw *ServerInterfaceWrapper) PatchTraining(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1051,19 +5152,19 @@ func (siw *ServerInterfaceWrapper) PatchPassport(w http.ResponseWriter, r *http.
 		return
 	}
 
-	// ------------- Path parameter "passport_id" -------------
-	var passportID uint64
+	// ------------- Path parameter "training_id" -------------
+	var trainingID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "training_id", runtime.ParamLocationPath, chi.URLParam(r, "training_id"), &trainingID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "training_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchPassport(w, r, userID, passportID)
+		siw.Handler.PatchTraining(w, r, userID, trainingID)
 	}))
 
 	handler = chimwr.AllowContentType("application/json")(handler)
@@ -1075,8 +5176,8 @@ func (siw *ServerInterfaceWrapper) PatchPassport(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutPassport operation middleware
-func (siw *ServerInterfaceWrapper) PutPassport(w http.ResponseWriter, r *http.Request) {
+// PutTraining operation middleware
+func (siw *ServerInterfaceWrapper) PutTraining(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1090,19 +5191,19 @@ func (siw *ServerInterfaceWrapper) PutPassport(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	// ------------- Path parameter "passport_id" -------------
-	var passportID uint64
+	// ------------- Path parameter "training_id" -------------
+	var trainingID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "training_id", runtime.ParamLocationPath, chi.URLParam(r, "training_id"), &trainingID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "training_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutPassport(w, r, userID, passportID)
+		siw.Handler.PutTraining(w, r, userID, trainingID)
 	}))
 
 	handler = chimwr.AllowContentType("application/json")(handler)
@@ -1114,8 +5215,8 @@ func (siw *ServerInterfaceWrapper) PutPassport(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListVisas operation middleware
-func (siw *ServerInterfaceWrapper) ListVisas(w http.ResponseWriter, r *http.Request) {
+// ListVacations operation middleware
+func (siw *ServerInterfaceWrapper) ListVacations(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1129,19 +5230,10 @@ func (siw *ServerInterfaceWrapper) ListVisas(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	// ------------- Path parameter "passport_id" -------------
-	var passportID uint64
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
-		return
-	}
-
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListVisas(w, r, userID, passportID)
+		siw.Handler.ListVacations(w, r, userID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1151,8 +5243,8 @@ func (siw *ServerInterfaceWrapper) ListVisas(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AddVisa operation middleware
-func (siw *ServerInterfaceWrapper) AddVisa(w http.ResponseWriter, r *http.Request) {
+// AddVacation operation middleware
+func (siw *ServerInterfaceWrapper) AddVacation(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1166,19 +5258,21 @@ func (siw *ServerInterfaceWrapper) AddVisa(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	// ------------- Path parameter "passport_id" -------------
-	var passportID uint64
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddVacationParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddVisa(w, r, userID, passportID)
+		siw.Handler.AddVacation(w, r, userID, params)
 	}))
 
 	handler = chimwr.AllowContentType("application/json")(handler)
@@ -1190,8 +5284,8 @@ func (siw *ServerInterfaceWrapper) AddVisa(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteVisa operation middleware
-func (siw *ServerInterfaceWrapper) DeleteVisa(w http.ResponseWriter, r *http.Request) {
+// GetVacationBalance operation middleware
+func (siw *ServerInterfaceWrapper) GetVacationBalance(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1205,28 +5299,47 @@ func (siw *ServerInterfaceWrapper) DeleteVisa(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// ------------- Path parameter "passport_id" -------------
-	var passportID uint64
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetVacationBalance(w, r, userID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteVacation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVacation(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "user_id" -------------
+	var userID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "visa_id" -------------
-	var visaID uint64
+	// ------------- Path parameter "vacation_id" -------------
+	var vacationID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "visa_id", runtime.ParamLocationPath, chi.URLParam(r, "visa_id"), &visaID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "vacation_id", runtime.ParamLocationPath, chi.URLParam(r, "vacation_id"), &vacationID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "visa_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vacation_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteVisa(w, r, userID, passportID, visaID)
+		siw.Handler.DeleteVacation(w, r, userID, vacationID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1236,8 +5349,8 @@ func (siw *ServerInterfaceWrapper) DeleteVisa(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetVisa operation middleware
-func (siw *ServerInterfaceWrapper) GetVisa(w http.ResponseWriter, r *http.Request) {
+// GetVacation operation middleware
+func (siw *ServerInterfaceWrapper) GetVacation(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1251,28 +5364,19 @@ func (siw *ServerInterfaceWrapper) GetVisa(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	// ------------- Path parameter "passport_id" -------------
-	var passportID uint64
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "visa_id" -------------
-	var visaID uint64
+	// ------------- Path parameter "vacation_id" -------------
+	var vacationID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "visa_id", runtime.ParamLocationPath, chi.URLParam(r, "visa_id"), &visaID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "vacation_id", runtime.ParamLocationPath, chi.URLParam(r, "vacation_id"), &vacationID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "visa_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vacation_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVisa(w, r, userID, passportID, visaID)
+		siw.Handler.GetVacation(w, r, userID, vacationID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1282,8 +5386,8 @@ func (siw *ServerInterfaceWrapper) GetVisa(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PatchVisa operation middleware
-func (siw *ServerInterfaceWrapper) PatchVisa(w http.ResponseWriter, r *http.Request) {
+// PatchVacation operation middleware
+func (siw *ServerInterfaceWrapper) PatchVacation(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1297,28 +5401,19 @@ func (siw *ServerInterfaceWrapper) PatchVisa(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	// ------------- Path parameter "passport_id" -------------
-	var passportID uint64
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "visa_id" -------------
-	var visaID uint64
+	// ------------- Path parameter "vacation_id" -------------
+	var vacationID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "visa_id", runtime.ParamLocationPath, chi.URLParam(r, "visa_id"), &visaID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "vacation_id", runtime.ParamLocationPath, chi.URLParam(r, "vacation_id"), &vacationID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "visa_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vacation_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchVisa(w, r, userID, passportID, visaID)
+		siw.Handler.PatchVacation(w, r, userID, vacationID)
 	}))
 
 	handler = chimwr.AllowContentType("application/json")(handler)
@@ -1330,8 +5425,8 @@ func (siw *ServerInterfaceWrapper) PatchVisa(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutVisa operation middleware
-func (siw *ServerInterfaceWrapper) PutVisa(w http.ResponseWriter, r *http.Request) {
+// PutVacation operation middleware
+func (siw *ServerInterfaceWrapper) PutVacation(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1345,28 +5440,30 @@ func (siw *ServerInterfaceWrapper) PutVisa(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	// ------------- Path parameter "passport_id" -------------
-	var passportID uint64
+	// ------------- Path parameter "vacation_id" -------------
+	var vacationID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "passport_id", runtime.ParamLocationPath, chi.URLParam(r, "passport_id"), &passportID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "vacation_id", runtime.ParamLocationPath, chi.URLParam(r, "vacation_id"), &vacationID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "passport_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vacation_id", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "visa_id" -------------
-	var visaID uint64
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutVacationParams
+
+	// ------------- Optional query parameter "force" -------------
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "visa_id", runtime.ParamLocationPath, chi.URLParam(r, "visa_id"), &visaID)
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "visa_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutVisa(w, r, userID, passportID, visaID)
+		siw.Handler.PutVacation(w, r, userID, vacationID, params)
 	}))
 
 	handler = chimwr.AllowContentType("application/json")(handler)
@@ -1378,8 +5475,8 @@ func (siw *ServerInterfaceWrapper) PutVisa(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DownloadPhoto operation middleware
-func (siw *ServerInterfaceWrapper) DownloadPhoto(w http.ResponseWriter, r *http.Request) {
+// ListWorkPermits operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkPermits(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1393,10 +5490,10 @@ func (siw *ServerInterfaceWrapper) DownloadPhoto(w http.ResponseWriter, r *http.
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DownloadPhoto(w, r, userID)
+		siw.Handler.ListWorkPermits(w, r, userID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1406,8 +5503,8 @@ func (siw *ServerInterfaceWrapper) DownloadPhoto(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UploadPhoto operation middleware
-func (siw *ServerInterfaceWrapper) UploadPhoto(w http.ResponseWriter, r *http.Request) {
+// AddWorkPermit operation middleware
+func (siw *ServerInterfaceWrapper) AddWorkPermit(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1421,13 +5518,13 @@ func (siw *ServerInterfaceWrapper) UploadPhoto(w http.ResponseWriter, r *http.Re
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UploadPhoto(w, r, userID)
+		siw.Handler.AddWorkPermit(w, r, userID)
 	}))
 
-	handler = chimwr.AllowContentType("image/png", "image/jpeg")(handler)
+	handler = chimwr.AllowContentType("application/json")(handler)
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
@@ -1436,8 +5533,8 @@ func (siw *ServerInterfaceWrapper) UploadPhoto(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListScans operation middleware
-func (siw *ServerInterfaceWrapper) ListScans(w http.ResponseWriter, r *http.Request) {
+// CheckWorkPermits operation middleware
+func (siw *ServerInterfaceWrapper) CheckWorkPermits(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1451,10 +5548,10 @@ func (siw *ServerInterfaceWrapper) ListScans(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListScans(w, r, userID)
+		siw.Handler.CheckWorkPermits(w, r, userID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1464,8 +5561,8 @@ func (siw *ServerInterfaceWrapper) ListScans(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UploadScan operation middleware
-func (siw *ServerInterfaceWrapper) UploadScan(w http.ResponseWriter, r *http.Request) {
+// DeleteWorkPermit operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWorkPermit(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1479,14 +5576,21 @@ func (siw *ServerInterfaceWrapper) UploadScan(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	// ------------- Path parameter "work_permit_id" -------------
+	var workPermitID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "work_permit_id", runtime.ParamLocationPath, chi.URLParam(r, "work_permit_id"), &workPermitID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "work_permit_id", Err: err})
+		return
+	}
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UploadScan(w, r, userID)
+		siw.Handler.DeleteWorkPermit(w, r, userID, workPermitID)
 	}))
 
-	handler = chimwr.AllowContentType("multipart/form-data")(handler)
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
@@ -1494,8 +5598,8 @@ func (siw *ServerInterfaceWrapper) UploadScan(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteScan operation middleware
-func (siw *ServerInterfaceWrapper) DeleteScan(w http.ResponseWriter, r *http.Request) {
+// GetWorkPermit operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkPermit(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1509,19 +5613,19 @@ func (siw *ServerInterfaceWrapper) DeleteScan(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	// ------------- Path parameter "scan_id" -------------
-	var scanID uint64
+	// ------------- Path parameter "work_permit_id" -------------
+	var workPermitID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "scan_id", runtime.ParamLocationPath, chi.URLParam(r, "scan_id"), &scanID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "work_permit_id", runtime.ParamLocationPath, chi.URLParam(r, "work_permit_id"), &workPermitID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scan_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "work_permit_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteScan(w, r, userID, scanID)
+		siw.Handler.GetWorkPermit(w, r, userID, workPermitID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1531,8 +5635,8 @@ func (siw *ServerInterfaceWrapper) DeleteScan(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetScan operation middleware
-func (siw *ServerInterfaceWrapper) GetScan(w http.ResponseWriter, r *http.Request) {
+// PutWorkPermit operation middleware
+func (siw *ServerInterfaceWrapper) PutWorkPermit(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -1546,21 +5650,23 @@ func (siw *ServerInterfaceWrapper) GetScan(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
-	// ------------- Path parameter "scan_id" -------------
-	var scanID uint64
+	// ------------- Path parameter "work_permit_id" -------------
+	var workPermitID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "scan_id", runtime.ParamLocationPath, chi.URLParam(r, "scan_id"), &scanID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "work_permit_id", runtime.ParamLocationPath, chi.URLParam(r, "work_permit_id"), &workPermitID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scan_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "work_permit_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetScan(w, r, userID, scanID)
+		siw.Handler.PutWorkPermit(w, r, userID, workPermitID)
 	}))
 
+	handler = chimwr.AllowContentType("application/json")(handler)
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
@@ -1568,27 +5674,48 @@ func (siw *ServerInterfaceWrapper) GetScan(w http.ResponseWriter, r *http.Reques
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListTrainings operation middleware
-func (siw *ServerInterfaceWrapper) ListTrainings(w http.ResponseWriter, r *http.Request) {
+// ListVacancies operation middleware
+func (siw *ServerInterfaceWrapper) ListVacancies(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "user_id" -------------
-	var userID uint64
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListVacanciesParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListVacancies(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddVacancy operation middleware
+func (siw *ServerInterfaceWrapper) AddVacancy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTrainings(w, r, userID)
+		siw.Handler.AddVacancy(w, r)
 	}))
 
+	handler = chimwr.AllowContentType("application/json")(handler)
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
@@ -1596,29 +5723,27 @@ func (siw *ServerInterfaceWrapper) ListTrainings(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AddTraining operation middleware
-func (siw *ServerInterfaceWrapper) AddTraining(w http.ResponseWriter, r *http.Request) {
+// GetVacancy operation middleware
+func (siw *ServerInterfaceWrapper) GetVacancy(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "user_id" -------------
-	var userID uint64
+	// ------------- Path parameter "vacancy_id" -------------
+	var vacancyID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "vacancy_id", runtime.ParamLocationPath, chi.URLParam(r, "vacancy_id"), &vacancyID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vacancy_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddTraining(w, r, userID)
+		siw.Handler.GetVacancy(w, r, vacancyID)
 	}))
 
-	handler = chimwr.AllowContentType("application/json")(handler)
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
@@ -1626,34 +5751,44 @@ func (siw *ServerInterfaceWrapper) AddTraining(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteTraining operation middleware
-func (siw *ServerInterfaceWrapper) DeleteTraining(w http.ResponseWriter, r *http.Request) {
+// PutVacancy operation middleware
+func (siw *ServerInterfaceWrapper) PutVacancy(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "user_id" -------------
-	var userID uint64
+	// ------------- Path parameter "vacancy_id" -------------
+	var vacancyID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "vacancy_id", runtime.ParamLocationPath, chi.URLParam(r, "vacancy_id"), &vacancyID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vacancy_id", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "training_id" -------------
-	var trainingID uint64
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "training_id", runtime.ParamLocationPath, chi.URLParam(r, "training_id"), &trainingID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "training_id", Err: err})
-		return
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutVacancy(w, r, vacancyID)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListVacationRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListVacationRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteTraining(w, r, userID, trainingID)
+		siw.Handler.ListVacationRequests(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1663,34 +5798,61 @@ func (siw *ServerInterfaceWrapper) DeleteTraining(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetTraining operation middleware
-func (siw *ServerInterfaceWrapper) GetTraining(w http.ResponseWriter, r *http.Request) {
+// AddVacationRequest operation middleware
+func (siw *ServerInterfaceWrapper) AddVacationRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	// ------------- Path parameter "user_id" -------------
-	var userID uint64
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddVacationRequest(w, r)
+	}))
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
-		return
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Path parameter "training_id" -------------
-	var trainingID uint64
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "training_id", runtime.ParamLocationPath, chi.URLParam(r, "training_id"), &trainingID)
+// ListIncomingVacationRequests operation middleware
+func (siw *ServerInterfaceWrapper) ListIncomingVacationRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListIncomingVacationRequests(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetVacationRequest operation middleware
+func (siw *ServerInterfaceWrapper) GetVacationRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "request_id" -------------
+	var requestID uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "request_id", runtime.ParamLocationPath, chi.URLParam(r, "request_id"), &requestID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "training_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "request_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetTraining(w, r, userID, trainingID)
+		siw.Handler.GetVacationRequest(w, r, requestID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1700,34 +5862,36 @@ func (siw *ServerInterfaceWrapper) GetTraining(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PatchTraining operation middleware
-func (siw *ServerInterfaceWrapper) PatchTraining(w http.ResponseWriter, r *http.Request) {
+// PatchVacationRequest operation middleware
+func (siw *ServerInterfaceWrapper) PatchVacationRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "user_id" -------------
-	var userID uint64
+	// ------------- Path parameter "request_id" -------------
+	var requestID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "request_id", runtime.ParamLocationPath, chi.URLParam(r, "request_id"), &requestID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "request_id", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "training_id" -------------
-	var trainingID uint64
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "training_id", runtime.ParamLocationPath, chi.URLParam(r, "training_id"), &trainingID)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchVacationRequestParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "training_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchTraining(w, r, userID, trainingID)
+		siw.Handler.PatchVacationRequest(w, r, requestID, params)
 	}))
 
 	handler = chimwr.AllowContentType("application/json")(handler)
@@ -1739,34 +5903,25 @@ func (siw *ServerInterfaceWrapper) PatchTraining(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutTraining operation middleware
-func (siw *ServerInterfaceWrapper) PutTraining(w http.ResponseWriter, r *http.Request) {
+// PutVacationRequest operation middleware
+func (siw *ServerInterfaceWrapper) PutVacationRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "user_id" -------------
-	var userID uint64
-
-	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
-		return
-	}
-
-	// ------------- Path parameter "training_id" -------------
-	var trainingID uint64
+	// ------------- Path parameter "request_id" -------------
+	var requestID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "training_id", runtime.ParamLocationPath, chi.URLParam(r, "training_id"), &trainingID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "request_id", runtime.ParamLocationPath, chi.URLParam(r, "request_id"), &requestID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "training_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "request_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutTraining(w, r, userID, trainingID)
+		siw.Handler.PutVacationRequest(w, r, requestID)
 	}))
 
 	handler = chimwr.AllowContentType("application/json")(handler)
@@ -1778,25 +5933,57 @@ func (siw *ServerInterfaceWrapper) PutTraining(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListVacations operation middleware
-func (siw *ServerInterfaceWrapper) ListVacations(w http.ResponseWriter, r *http.Request) {
+// ListAllVacations operation middleware
+func (siw *ServerInterfaceWrapper) ListAllVacations(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "user_id" -------------
-	var userID uint64
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAllVacationsParams
+
+	// ------------- Optional query parameter "department_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "department_id", r.URL.Query(), &params.DepartmentID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "department_id", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "date_from" -------------
+
+	if paramValue := r.URL.Query().Get("date_from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "date_from"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "date_from", r.URL.Query(), &params.DateFrom)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date_from", Err: err})
+		return
+	}
+
+	// ------------- Required query parameter "date_to" -------------
+
+	if paramValue := r.URL.Query().Get("date_to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "date_to"})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	err = runtime.BindQueryParameter("form", true, true, "date_to", r.URL.Query(), &params.DateTo)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "date_to", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListVacations(w, r, userID)
+		siw.Handler.ListAllVacations(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1806,29 +5993,37 @@ func (siw *ServerInterfaceWrapper) ListVacations(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// AddVacation operation middleware
-func (siw *ServerInterfaceWrapper) AddVacation(w http.ResponseWriter, r *http.Request) {
+// ListVacationBalances operation middleware
+func (siw *ServerInterfaceWrapper) ListVacationBalances(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "user_id" -------------
-	var userID uint64
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListVacationBalancesParams
+
+	// ------------- Optional query parameter "department_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "department_id", r.URL.Query(), &params.DepartmentID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "department_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "format", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.AddVacation(w, r, userID)
+		siw.Handler.ListVacationBalances(w, r, params)
 	}))
 
-	handler = chimwr.AllowContentType("application/json")(handler)
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
@@ -1836,34 +6031,36 @@ func (siw *ServerInterfaceWrapper) AddVacation(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// DeleteVacation operation middleware
-func (siw *ServerInterfaceWrapper) DeleteVacation(w http.ResponseWriter, r *http.Request) {
+// GetVacationSchedule operation middleware
+func (siw *ServerInterfaceWrapper) GetVacationSchedule(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "user_id" -------------
-	var userID uint64
+	// ------------- Path parameter "year" -------------
+	var year uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "year", runtime.ParamLocationPath, chi.URLParam(r, "year"), &year)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "vacation_id" -------------
-	var vacationID uint64
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "vacation_id", runtime.ParamLocationPath, chi.URLParam(r, "vacation_id"), &vacationID)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetVacationScheduleParams
+
+	// ------------- Optional query parameter "department_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "department_id", r.URL.Query(), &params.DepartmentID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vacation_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "department_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
-
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteVacation(w, r, userID, vacationID)
+		siw.Handler.GetVacationSchedule(w, r, year, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1873,36 +6070,59 @@ func (siw *ServerInterfaceWrapper) DeleteVacation(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetVacation operation middleware
-func (siw *ServerInterfaceWrapper) GetVacation(w http.ResponseWriter, r *http.Request) {
+// PatchVacationSchedule operation middleware
+func (siw *ServerInterfaceWrapper) PatchVacationSchedule(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "user_id" -------------
-	var userID uint64
+	// ------------- Path parameter "year" -------------
+	var year uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "year", runtime.ParamLocationPath, chi.URLParam(r, "year"), &year)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "vacation_id" -------------
-	var vacationID uint64
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "vacation_id", runtime.ParamLocationPath, chi.URLParam(r, "vacation_id"), &vacationID)
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchVacationSchedule(w, r, year)
+	}))
+
+	handler = chimwr.AllowContentType("application/json")(handler)
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// AddVacationScheduleItem operation middleware
+func (siw *ServerInterfaceWrapper) AddVacationScheduleItem(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "year" -------------
+	var year uint64
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "year", runtime.ParamLocationPath, chi.URLParam(r, "year"), &year)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vacation_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetVacation(w, r, userID, vacationID)
+		siw.Handler.AddVacationScheduleItem(w, r, year)
 	}))
 
+	handler = chimwr.AllowContentType("application/json")(handler)
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
@@ -1910,38 +6130,36 @@ func (siw *ServerInterfaceWrapper) GetVacation(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PatchVacation operation middleware
-func (siw *ServerInterfaceWrapper) PatchVacation(w http.ResponseWriter, r *http.Request) {
+// DeleteVacationScheduleItem operation middleware
+func (siw *ServerInterfaceWrapper) DeleteVacationScheduleItem(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "user_id" -------------
-	var userID uint64
+	// ------------- Path parameter "year" -------------
+	var year uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "year", runtime.ParamLocationPath, chi.URLParam(r, "year"), &year)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "vacation_id" -------------
-	var vacationID uint64
+	// ------------- Path parameter "item_id" -------------
+	var itemID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "vacation_id", runtime.ParamLocationPath, chi.URLParam(r, "vacation_id"), &vacationID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "item_id", runtime.ParamLocationPath, chi.URLParam(r, "item_id"), &itemID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vacation_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PatchVacation(w, r, userID, vacationID)
+		siw.Handler.DeleteVacationScheduleItem(w, r, year, itemID)
 	}))
 
-	handler = chimwr.AllowContentType("application/json")(handler)
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
 	}
@@ -1949,34 +6167,34 @@ func (siw *ServerInterfaceWrapper) PatchVacation(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// PutVacation operation middleware
-func (siw *ServerInterfaceWrapper) PutVacation(w http.ResponseWriter, r *http.Request) {
+// PutVacationScheduleItem operation middleware
+func (siw *ServerInterfaceWrapper) PutVacationScheduleItem(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "user_id" -------------
-	var userID uint64
+	// ------------- Path parameter "year" -------------
+	var year uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "user_id", runtime.ParamLocationPath, chi.URLParam(r, "user_id"), &userID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "year", runtime.ParamLocationPath, chi.URLParam(r, "year"), &year)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "year", Err: err})
 		return
 	}
 
-	// ------------- Path parameter "vacation_id" -------------
-	var vacationID uint64
+	// ------------- Path parameter "item_id" -------------
+	var itemID uint64
 
-	err = runtime.BindStyledParameterWithLocation("simple", false, "vacation_id", runtime.ParamLocationPath, chi.URLParam(r, "vacation_id"), &vacationID)
+	err = runtime.BindStyledParameterWithLocation("simple", false, "item_id", runtime.ParamLocationPath, chi.URLParam(r, "item_id"), &itemID)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "vacation_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "item_id", Err: err})
 		return
 	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, nil)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PutVacation(w, r, userID, vacationID)
+		siw.Handler.PutVacationScheduleItem(w, r, year, itemID)
 	}))
 
 	handler = chimwr.AllowContentType("application/json")(handler)
@@ -2101,12 +6319,96 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/absences", wrapper.ListAllAbsences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/absences/report", wrapper.GetAbsenceReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/benefits", wrapper.ListBenefits)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/benefits", wrapper.AddBenefit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/benefits/report", wrapper.BenefitCostReport)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/benefits/{benefit_id}", wrapper.GetBenefit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/benefits/{benefit_id}", wrapper.PutBenefit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/calendar/count", wrapper.CountCalendarDays)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/calendar/{year}", wrapper.ListCalendarDays)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/calendar/{year}", wrapper.PutCalendarDays)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/calendar/{year}/import", wrapper.ImportCalendar)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/candidates", wrapper.ListCandidates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/candidates", wrapper.AddCandidate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/candidates/{candidate_id}", wrapper.GetCandidate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/candidates/{candidate_id}", wrapper.PutCandidate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/candidates/{candidate_id}/cvs", wrapper.ListCandidateCVs)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/candidates/{candidate_id}/cvs", wrapper.UploadCandidateCV)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/candidates/{candidate_id}/cvs/{cv_id}", wrapper.DownloadCandidateCV)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/candidates/{candidate_id}/hire", wrapper.HireCandidate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/candidates/{candidate_id}/notes", wrapper.ListCandidateNotes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/candidates/{candidate_id}/notes", wrapper.AddCandidateNote)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/candidates/{candidate_id}/stage", wrapper.PutCandidateStage)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/departments", wrapper.ListDepartments)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/duplicates", wrapper.ListDuplicates)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/health", wrapper.Health)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/indexations", wrapper.ListIndexationRuns)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/indexations", wrapper.AddIndexationRun)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/indexations/preview", wrapper.PreviewIndexationRun)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/indexations/{run_id}", wrapper.RevertIndexationRun)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/indexations/{run_id}", wrapper.GetIndexationRun)
+	})
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/login", wrapper.Login)
 	})
@@ -2119,6 +6421,84 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/login/init-change-password", wrapper.InitChangePassword)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/military/liable", wrapper.ListMilitaryLiable)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/military/notifications", wrapper.ListMilitaryNotifications)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/number-sequences", wrapper.ListNumberSequences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/number-sequences", wrapper.AddNumberSequence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/number-sequences/{sequence_id}", wrapper.PutNumberSequence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/onboarding/overdue", wrapper.ListOverdueOnboarding)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/onboarding/templates", wrapper.ListOnboardingTemplates)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/onboarding/templates", wrapper.AddOnboardingTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/onboarding/templates/{template_id}", wrapper.DeleteOnboardingTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/onboarding/templates/{template_id}", wrapper.GetOnboardingTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/onboarding/templates/{template_id}", wrapper.PutOnboardingTemplate)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/orders", wrapper.ListOrders)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/orders", wrapper.AddOrder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/orders/{order_id}", wrapper.GetOrder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/orders/{order_id}", wrapper.PatchOrder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/orders/{order_id}", wrapper.PutOrder)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/positions/{position_id}/holders", wrapper.ListPositionHolders)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/probations", wrapper.ListProbations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/staffing", wrapper.ListStaffUnits)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/staffing", wrapper.AddStaffUnit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/staffing/vacancies", wrapper.ListStaffingVacancies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/staffing/{unit_id}", wrapper.GetStaffUnit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/staffing/{unit_id}", wrapper.PutStaffUnit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/timesheets/{year}/{month}", wrapper.GetTimesheet)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/timesheets/{year}/{month}/employees/{user_id}/days/{day}", wrapper.DeleteTimesheetDay)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/timesheets/{year}/{month}/employees/{user_id}/days/{day}", wrapper.PutTimesheetDay)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users", wrapper.ListUsers)
 	})
@@ -2134,6 +6514,45 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/users/{user_id}", wrapper.PutUser)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/absences", wrapper.ListAbsences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{user_id}/absences", wrapper.AddAbsence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/{user_id}/absences/{absence_id}", wrapper.DeleteAbsence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/absences/{absence_id}", wrapper.GetAbsence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/users/{user_id}/absences/{absence_id}", wrapper.PutAbsence)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/benefits", wrapper.ListBenefitEnrolments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{user_id}/benefits", wrapper.AddBenefitEnrolment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/{user_id}/benefits/{enrolment_id}", wrapper.DeleteBenefitEnrolment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/users/{user_id}/benefits/{enrolment_id}", wrapper.PutBenefitEnrolment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/compensations", wrapper.ListCompensations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{user_id}/compensations", wrapper.AddCompensation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/compensations/{compensation_id}", wrapper.GetCompensation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/users/{user_id}/compensations/{compensation_id}", wrapper.PutCompensation)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/{user_id}/contracts", wrapper.ListContracts)
 	})
@@ -2152,6 +6571,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/users/{user_id}/contracts/{contract_id}", wrapper.PutContract)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/contracts/{contract_id}/amendments", wrapper.ListAmendments)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{user_id}/contracts/{contract_id}/amendments", wrapper.AddAmendment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/contracts/{contract_id}/amendments/{amendment_id}", wrapper.GetAmendment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/users/{user_id}/contracts/{contract_id}/amendments/{amendment_id}", wrapper.PutAmendment)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/users/{user_id}/contracts/{contract_id}/probation", wrapper.PutProbationResult)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/contracts/{contract_id}/terms", wrapper.GetContractTerms)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/{user_id}/educations", wrapper.ListEducations)
 	})
@@ -2170,6 +6607,42 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/users/{user_id}/educations/{education_id}", wrapper.PutEducation)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/experiences", wrapper.ListExperiences)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{user_id}/experiences", wrapper.AddExperience)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/experiences/length", wrapper.GetExperienceLength)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/{user_id}/experiences/{experience_id}", wrapper.DeleteExperience)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/experiences/{experience_id}", wrapper.GetExperience)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/users/{user_id}/experiences/{experience_id}", wrapper.PutExperience)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/indexations", wrapper.ListIndexations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/{user_id}/military", wrapper.DeleteMilitary)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/military", wrapper.GetMilitary)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/users/{user_id}/military", wrapper.PutMilitary)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/onboarding", wrapper.ListOnboardingItems)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/users/{user_id}/onboarding/{item_id}", wrapper.PatchOnboardingItem)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/{user_id}/passports", wrapper.ListPassports)
 	})
@@ -2212,6 +6685,21 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/users/{user_id}/photo", wrapper.UploadPhoto)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/relatives", wrapper.ListRelatives)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{user_id}/relatives", wrapper.AddRelative)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/{user_id}/relatives/{relative_id}", wrapper.DeleteRelative)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/relatives/{relative_id}", wrapper.GetRelative)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/users/{user_id}/relatives/{relative_id}", wrapper.PutRelative)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/{user_id}/scans", wrapper.ListScans)
 	})
@@ -2224,6 +6712,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/{user_id}/scans/{scan_id}", wrapper.GetScan)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{user_id}/termination", wrapper.TerminateUser)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/users/{user_id}/trainings", wrapper.ListTrainings)
 	})
@@ -2248,6 +6739,9 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Post(options.BaseURL+"/users/{user_id}/vacations", wrapper.AddVacation)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/vacations/balance", wrapper.GetVacationBalance)
+	})
 	r.Group(func(r chi.Router) {
 		r.Delete(options.BaseURL+"/users/{user_id}/vacations/{vacation_id}", wrapper.DeleteVacation)
 	})
@@ -2260,6 +6754,75 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Put(options.BaseURL+"/users/{user_id}/vacations/{vacation_id}", wrapper.PutVacation)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/work_permits", wrapper.ListWorkPermits)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/users/{user_id}/work_permits", wrapper.AddWorkPermit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/work_permits/check", wrapper.CheckWorkPermits)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/users/{user_id}/work_permits/{work_permit_id}", wrapper.DeleteWorkPermit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/users/{user_id}/work_permits/{work_permit_id}", wrapper.GetWorkPermit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/users/{user_id}/work_permits/{work_permit_id}", wrapper.PutWorkPermit)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/vacancies", wrapper.ListVacancies)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/vacancies", wrapper.AddVacancy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/vacancies/{vacancy_id}", wrapper.GetVacancy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/vacancies/{vacancy_id}", wrapper.PutVacancy)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/vacation-requests", wrapper.ListVacationRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/vacation-requests", wrapper.AddVacationRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/vacation-requests/incoming", wrapper.ListIncomingVacationRequests)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/vacation-requests/{request_id}", wrapper.GetVacationRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/vacation-requests/{request_id}", wrapper.PatchVacationRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/vacation-requests/{request_id}", wrapper.PutVacationRequest)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/vacations", wrapper.ListAllVacations)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/vacations/balances", wrapper.ListVacationBalances)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/vacations/schedules/{year}", wrapper.GetVacationSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/vacations/schedules/{year}", wrapper.PatchVacationSchedule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/vacations/schedules/{year}/items", wrapper.AddVacationScheduleItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/vacations/schedules/{year}/items/{item_id}", wrapper.DeleteVacationScheduleItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/vacations/schedules/{year}/items/{item_id}", wrapper.PutVacationScheduleItem)
+	})
 
 	return r
 }
@@ -4,6 +4,8 @@
 package api
 
 import (
+	"time"
+
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
@@ -13,18 +15,98 @@ const (
 	BearerAuthScopes bearerAuthScopesType = "bearerAuth.Scopes"
 )
 
+// Defines values for AbsenceType.
+const (
+	BusinessTrip AbsenceType = "business_trip"
+	Sick         AbsenceType = "sick"
+	Study        AbsenceType = "study"
+	Unpaid       AbsenceType = "unpaid"
+)
+
+// Defines values for AddStaffUnitRequestRate.
+const (
+	AddStaffUnitRequestRateN025 AddStaffUnitRequestRate = 0.25
+	AddStaffUnitRequestRateN05  AddStaffUnitRequestRate = 0.5
+	AddStaffUnitRequestRateN075 AddStaffUnitRequestRate = 0.75
+	AddStaffUnitRequestRateN1   AddStaffUnitRequestRate = 1
+)
+
+// Defines values for CalendarDayType.
+const (
+	CalendarDayTypeDayOff    CalendarDayType = "day_off"
+	CalendarDayTypeHoliday   CalendarDayType = "holiday"
+	CalendarDayTypeShortened CalendarDayType = "shortened"
+	CalendarDayTypeWorking   CalendarDayType = "working"
+)
+
+// Defines values for CandidateStage.
+const (
+	CandidateStageHired     CandidateStage = "hired"
+	CandidateStageInterview CandidateStage = "interview"
+	CandidateStageNew       CandidateStage = "new"
+	CandidateStageOffer     CandidateStage = "offer"
+	CandidateStageRejected  CandidateStage = "rejected"
+	CandidateStageScreening CandidateStage = "screening"
+)
+
 // Defines values for ContractType.
 const (
 	Permanent ContractType = "permanent"
 	Temporary ContractType = "temporary"
 )
 
+// Defines values for DuplicateReason.
+const (
+	DuplicateReasonInsurance DuplicateReason = "insurance"
+	DuplicateReasonName      DuplicateReason = "name"
+	DuplicateReasonPassport  DuplicateReason = "passport"
+	DuplicateReasonTaxpayer  DuplicateReason = "taxpayer"
+)
+
+// Defines values for ExportFormat.
+const (
+	Csv  ExportFormat = "csv"
+	Json ExportFormat = "json"
+	Xlsx ExportFormat = "xlsx"
+)
+
 // Defines values for Gender.
 const (
 	Female Gender = "female"
 	Male   Gender = "male"
 )
 
+// Defines values for MilitaryNotificationEvent.
+const (
+	Changed         MilitaryNotificationEvent = "changed"
+	Deregistered    MilitaryNotificationEvent = "deregistered"
+	PositionChanged MilitaryNotificationEvent = "position_changed"
+	Registered      MilitaryNotificationEvent = "registered"
+	Terminated      MilitaryNotificationEvent = "terminated"
+)
+
+// Defines values for OrderAction.
+const (
+	OrderActionCancel OrderAction = "cancel"
+	OrderActionSign   OrderAction = "sign"
+)
+
+// Defines values for OrderStatus.
+const (
+	OrderStatusCancelled OrderStatus = "cancelled"
+	OrderStatusDraft     OrderStatus = "draft"
+	OrderStatusSigned    OrderStatus = "signed"
+)
+
+// Defines values for OrderType.
+const (
+	OrderTypeHiring      OrderType = "hiring"
+	OrderTypeOther       OrderType = "other"
+	OrderTypeTermination OrderType = "termination"
+	OrderTypeTransfer    OrderType = "transfer"
+	OrderTypeVacation    OrderType = "vacation"
+)
+
 // Defines values for PassportType.
 const (
 	External   PassportType = "external"
@@ -32,8 +114,33 @@ const (
 	Internal   PassportType = "internal"
 )
 
+// Defines values for ProbationOutcome.
+const (
+	Extended ProbationOutcome = "extended"
+	Failed   ProbationOutcome = "failed"
+	Passed   ProbationOutcome = "passed"
+)
+
+// Defines values for PutStaffUnitRequestRate.
+const (
+	PutStaffUnitRequestRateN025 PutStaffUnitRequestRate = 0.25
+	PutStaffUnitRequestRateN05  PutStaffUnitRequestRate = 0.5
+	PutStaffUnitRequestRateN075 PutStaffUnitRequestRate = 0.75
+	PutStaffUnitRequestRateN1   PutStaffUnitRequestRate = 1
+)
+
+// Defines values for RelationType.
+const (
+	RelationTypeChild  RelationType = "child"
+	RelationTypeOther  RelationType = "other"
+	RelationTypeParent RelationType = "parent"
+	RelationTypeSpouse RelationType = "spouse"
+)
+
 // Defines values for ScanType.
 const (
+	ScanTypeAbsence                ScanType = "absence"
+	ScanTypeAmendment              ScanType = "amendment"
 	ScanTypeBabyBirth              ScanType = "baby_birth"
 	ScanTypeBriefing               ScanType = "briefing"
 	ScanTypeContract               ScanType = "contract"
@@ -41,6 +148,7 @@ const (
 	ScanTypeInsurance              ScanType = "insurance"
 	ScanTypeMarriage               ScanType = "marriage"
 	ScanTypeMilitary               ScanType = "military"
+	ScanTypeOrder                  ScanType = "order"
 	ScanTypeOther                  ScanType = "other"
 	ScanTypePassport               ScanType = "passport"
 	ScanTypePersonalDataProcessing ScanType = "personal_data_processing"
@@ -49,6 +157,81 @@ const (
 	ScanTypeWorkPermit             ScanType = "work_permit"
 )
 
+// Defines values for SequenceEntity.
+const (
+	ContractNumber  SequenceEntity = "contract_number"
+	OrderNumber     SequenceEntity = "order_number"
+	PersonnelNumber SequenceEntity = "personnel_number"
+)
+
+// Defines values for StaffUnitRate.
+const (
+	StaffUnitRateN025 StaffUnitRate = 0.25
+	StaffUnitRateN05  StaffUnitRate = 0.5
+	StaffUnitRateN075 StaffUnitRate = 0.75
+	StaffUnitRateN1   StaffUnitRate = 1
+)
+
+// Defines values for TerminationReason.
+const (
+	Agreement          TerminationReason = "agreement"
+	Circumstances      TerminationReason = "circumstances"
+	ContractExpiry     TerminationReason = "contract_expiry"
+	EmployeeInitiative TerminationReason = "employee_initiative"
+	EmployerInitiative TerminationReason = "employer_initiative"
+	Transfer           TerminationReason = "transfer"
+)
+
+// Defines values for TimesheetCode.
+const (
+	TimesheetCodeBusinessTrip TimesheetCode = "К"
+	TimesheetCodeSick         TimesheetCode = "Б"
+	TimesheetCodeStudy        TimesheetCode = "У"
+	TimesheetCodeTruancy      TimesheetCode = "ПР"
+	TimesheetCodeUnknown      TimesheetCode = "НН"
+	TimesheetCodeUnpaid       TimesheetCode = "ДО"
+	TimesheetCodeVacation     TimesheetCode = "ОТ"
+	TimesheetCodeWeekend      TimesheetCode = "В"
+	TimesheetCodeWork         TimesheetCode = "Я"
+)
+
+// Defines values for TimesheetFormat.
+const (
+	TimesheetFormatJson TimesheetFormat = "json"
+	TimesheetFormatPdf  TimesheetFormat = "pdf"
+	TimesheetFormatXlsx TimesheetFormat = "xlsx"
+)
+
+// Defines values for VacancyStatus.
+const (
+	Closed VacancyStatus = "closed"
+	Open   VacancyStatus = "open"
+)
+
+// Defines values for VacationRequestAction.
+const (
+	VacationRequestActionApprove VacationRequestAction = "approve"
+	VacationRequestActionCancel  VacationRequestAction = "cancel"
+	VacationRequestActionReject  VacationRequestAction = "reject"
+	VacationRequestActionSubmit  VacationRequestAction = "submit"
+)
+
+// Defines values for VacationRequestStatus.
+const (
+	VacationRequestStatusApproved  VacationRequestStatus = "approved"
+	VacationRequestStatusCancelled VacationRequestStatus = "cancelled"
+	VacationRequestStatusDraft     VacationRequestStatus = "draft"
+	VacationRequestStatusPending   VacationRequestStatus = "pending"
+	VacationRequestStatusRejected  VacationRequestStatus = "rejected"
+)
+
+// Defines values for VacationScheduleStatus.
+const (
+	VacationScheduleStatusApproved VacationScheduleStatus = "approved"
+	VacationScheduleStatusDraft    VacationScheduleStatus = "draft"
+	VacationScheduleStatusLocked   VacationScheduleStatus = "locked"
+)
+
 // Defines values for VisaNumberEntries.
 const (
 	Mult VisaNumberEntries = "mult"
@@ -69,14 +252,163 @@ const (
 	ListUsersParamsSortByDepartment ListUsersParamsSortBy = "department"
 )
 
+// Absence absence of the employee other than a vacation, both dates are inclusive
+type Absence struct {
+	Comment  string             `json:"comment"`
+	DateFrom openapi_types.Date `json:"date_from"`
+	DateTo   openapi_types.Date `json:"date_to"`
+
+	// DocumentNumber number of the document confirming the absence (e.g. the sick-leave certificate)
+	DocumentNumber string `json:"document_number"`
+
+	// HasScan the scan of the absence type is uploaded for the absence
+	HasScan bool   `json:"has_scan"`
+	ID      uint64 `json:"id"`
+
+	// Type sick - sick leave, unpaid - unpaid leave, business_trip - business trip, study - study leave
+	Type AbsenceType `json:"type"`
+}
+
+// AbsenceReportItem days of the employee absences within the period by type
+type AbsenceReportItem struct {
+	BusinessTripDays int    `json:"business_trip_days"`
+	Department       string `json:"department"`
+	FirstName        string `json:"first_name"`
+	LastName         string `json:"last_name"`
+	MiddleName       string `json:"middle_name"`
+	Position         string `json:"position"`
+	SickDays         int    `json:"sick_days"`
+	StudyDays        int    `json:"study_days"`
+	TotalDays        int    `json:"total_days"`
+	UnpaidDays       int    `json:"unpaid_days"`
+	UserID           uint64 `json:"user_id"`
+}
+
+// AbsenceReportResponse defines model for AbsenceReportResponse.
+type AbsenceReportResponse = []AbsenceReportItem
+
+// AbsenceType sick - sick leave, unpaid - unpaid leave, business_trip - business trip, study - study leave
+type AbsenceType string
+
+// AddAbsenceRequest defines model for AddAbsenceRequest.
+type AddAbsenceRequest struct {
+	Comment  *string            `json:"comment,omitempty"`
+	DateFrom openapi_types.Date `json:"date_from"`
+	DateTo   openapi_types.Date `json:"date_to"`
+
+	// DocumentNumber number of the document confirming the absence (e.g. the sick-leave certificate)
+	DocumentNumber *string `json:"document_number,omitempty"`
+
+	// Type sick - sick leave, unpaid - unpaid leave, business_trip - business trip, study - study leave
+	Type AbsenceType `json:"type"`
+}
+
+// AddAmendmentRequest defines model for AddAmendmentRequest.
+type AddAmendmentRequest struct {
+	// Date date of signing
+	Date openapi_types.Date `json:"date"`
+
+	// DateTo new last day of the temporary contract
+	DateTo *openapi_types.Date `json:"date_to,omitempty"`
+
+	// Description other changed terms
+	Description *string `json:"description,omitempty"`
+
+	// EffectiveFrom first day the changed terms are in effect
+	EffectiveFrom openapi_types.Date `json:"effective_from"`
+	Number        string             `json:"number"`
+
+	// PositionID new position
+	PositionID *uint64 `json:"position_id,omitempty"`
+
+	// Salary new gross salary per month, in their minor unit form
+	Salary *int64 `json:"salary,omitempty"`
+
+	// WorkTypeID new work type
+	WorkTypeID *uint64 `json:"work_type_id,omitempty"`
+}
+
+// AddBenefitEnrolmentRequest defines model for AddBenefitEnrolmentRequest.
+type AddBenefitEnrolmentRequest struct {
+	BenefitID uint64 `json:"benefit_id"`
+
+	// DateFrom first day of the benefit use
+	DateFrom openapi_types.Date `json:"date_from"`
+
+	// DateTo last day of the benefit use
+	DateTo openapi_types.Date `json:"date_to"`
+}
+
+// AddBenefitRequest defines model for AddBenefitRequest.
+type AddBenefitRequest struct {
+	// Cost monthly cost per employee, in their minor unit form
+	Cost int64 `json:"cost"`
+
+	// DepartmentID only employees of the department are eligible
+	DepartmentID *uint64 `json:"department_id,omitempty"`
+
+	// Grade only employees of the grade are eligible
+	Grade *string `json:"grade,omitempty"`
+
+	// MinTenureMonths minimum tenure in the company to be eligible
+	MinTenureMonths int    `json:"min_tenure_months"`
+	Title           string `json:"title"`
+}
+
+// AddCandidateNoteRequest defines model for AddCandidateNoteRequest.
+type AddCandidateNoteRequest struct {
+	Text string `json:"text"`
+}
+
+// AddCandidateRequest defines model for AddCandidateRequest.
+type AddCandidateRequest struct {
+	DateOfBirth *openapi_types.Date `json:"date_of_birth,omitempty"`
+
+	// Email personal email
+	Email      *openapi_types.Email `json:"email,omitempty"`
+	FirstName  string               `json:"first_name"`
+	LastName   string               `json:"last_name"`
+	MiddleName *string              `json:"middle_name,omitempty"`
+	Phone      *string              `json:"phone,omitempty"`
+	VacancyID  uint64               `json:"vacancy_id"`
+}
+
+// AddCompensationRequest defines model for AddCompensationRequest.
+type AddCompensationRequest struct {
+	ContractID uint64 `json:"contract_id"`
+
+	// Currency ISO 4217 currency code
+	Currency string `json:"currency"`
+
+	// DateFrom date from which the compensation is effective
+	DateFrom openapi_types.Date `json:"date_from"`
+
+	// IncomeTax tax paid to revenue service per month, in their minor unit form
+	IncomeTax int64 `json:"income_tax"`
+
+	// Salary gross salary per month, in their minor unit form
+	Salary int64 `json:"salary"`
+
+	// SalaryRate salary rate coefficient
+	SalaryRate *float64 `json:"salary_rate,omitempty"`
+
+	// SocialSecurityTax tax paid to social services per month, in their minor unit form
+	SocialSecurityTax int64 `json:"social_security_tax"`
+}
+
 // AddContractRequest defines model for AddContractRequest.
 type AddContractRequest struct {
-	DateFrom        openapi_types.Date  `json:"date_from"`
-	DateTo          *openapi_types.Date `json:"date_to,omitempty"`
-	Number          string              `json:"number"`
-	Type            ContractType        `json:"type"`
-	ProbationPeriod *uint               `json:"probation_period,omitempty"`
-	WorkTypeID      uint64              `json:"work_type_id"`
+	DateFrom openapi_types.Date  `json:"date_from"`
+	DateTo   *openapi_types.Date `json:"date_to,omitempty"`
+
+	// ExtraVacationDays additional annual paid leave days of the contract (irregular working hours, harmful working conditions, etc.)
+	ExtraVacationDays *uint `json:"extra_vacation_days,omitempty"`
+
+	// Number the next number of the contract sequence by default
+	Number          *string      `json:"number,omitempty"`
+	Type            ContractType `json:"type"`
+	ProbationPeriod *uint        `json:"probation_period,omitempty"`
+	WorkTypeID      uint64       `json:"work_type_id"`
 }
 
 // AddEducationRequest defines model for AddEducationRequest.
@@ -91,14 +423,122 @@ type AddEducationRequest struct {
 	Program           string             `json:"program"`
 }
 
+// AddExperienceRequest defines model for AddExperienceRequest.
+type AddExperienceRequest struct {
+	Awards      string `json:"awards"`
+	CompanyName string `json:"company_name"`
+
+	// DateFrom start date of work
+	DateFrom openapi_types.Date `json:"date_from"`
+
+	// DateTo end date of work
+	DateTo openapi_types.Date `json:"date_to"`
+
+	// Functional job responsibilities
+	Functional string `json:"functional"`
+	Position   string `json:"position"`
+}
+
+// AddNumberSequenceRequest defines model for AddNumberSequenceRequest.
+type AddNumberSequenceRequest struct {
+	// Padding minimal number of digits, the number is padded with zeros
+	Padding uint `json:"padding"`
+
+	// Prefix text before the number
+	Prefix string `json:"prefix"`
+
+	// Suffix text after the number
+	Suffix string `json:"suffix"`
+	Title  string `json:"title"`
+
+	// Types order types numbered by the sequence, they are moved from other sequences
+	Types []OrderType `json:"types,omitempty"`
+
+	// WithYear the year is added after the prefix
+	WithYear bool `json:"with_year"`
+
+	// YearlyReset the numbers start from 1 every year
+	YearlyReset bool `json:"yearly_reset"`
+}
+
+// AddOnboardingTemplateRequest defines model for AddOnboardingTemplateRequest.
+type AddOnboardingTemplateRequest struct {
+	// DepartmentID template for the department only (empty - any department)
+	DepartmentID *uint64                  `json:"department_id,omitempty"`
+	Items        []OnboardingTemplateItem `json:"items"`
+
+	// PositionID template for the position only (empty - any position)
+	PositionID *uint64 `json:"position_id,omitempty"`
+	Title      string  `json:"title"`
+}
+
+// AddOrderRequest defines model for AddOrderRequest.
+type AddOrderRequest struct {
+	// ContractID contract of the hiring
+	ContractID *uint64 `json:"contract_id,omitempty"`
+
+	// EffectiveDate day of the event
+	EffectiveDate openapi_types.Date `json:"effective_date"`
+
+	// Title the title of the type by default
+	Title *string `json:"title,omitempty"`
+
+	// Type personnel event the order is issued on
+	Type   OrderType `json:"type"`
+	UserID uint64    `json:"user_id"`
+
+	// VacationID vacation of the employee
+	VacationID *uint64 `json:"vacation_id,omitempty"`
+}
+
 // AddPassportRequest defines model for AddPassportRequest.
 type AddPassportRequest struct {
 	IssuedBy   string             `json:"issued_by"`
 	IssuedDate openapi_types.Date `json:"issued_date"`
-	Number     string             `json:"number"`
-	Type       PassportType       `json:"type"`
+
+	// Number series and number without spaces: 10 digits for internal, 9 digits for external, 5-20 capital latin letters and digits for foreigners passport
+	Number string       `json:"number"`
+	Type   PassportType `json:"type"`
 }
 
+// AddRelativeRequest defines model for AddRelativeRequest.
+type AddRelativeRequest struct {
+	DateOfBirth openapi_types.Date `json:"date_of_birth"`
+
+	// Disabled the relative (e.g. the child) is disabled
+	Disabled   *bool   `json:"disabled,omitempty"`
+	FirstName  string  `json:"first_name"`
+	LastName   string  `json:"last_name"`
+	MiddleName *string `json:"middle_name,omitempty"`
+
+	// Type spouse, child, parent or other family member or dependent
+	Type RelationType `json:"type"`
+}
+
+// AddStaffUnitRequest defines model for AddStaffUnitRequest.
+type AddStaffUnitRequest struct {
+	// DateFrom date from which the staff unit (version) is effective
+	DateFrom     openapi_types.Date `json:"date_from"`
+	DepartmentID uint64             `json:"department_id"`
+	Grade        string             `json:"grade"`
+	PositionID   uint64             `json:"position_id"`
+
+	// Quantity number of planned slots
+	Quantity uint `json:"quantity"`
+
+	// Rate workload rate of a slot
+	Rate AddStaffUnitRequestRate `json:"rate"`
+
+	// SalaryMax upper bound of the salary band
+	SalaryMax uint64 `json:"salary_max"`
+
+	// SalaryMin lower bound of the salary band
+	SalaryMin uint64 `json:"salary_min"`
+}
+
+// AddStaffUnitRequestRate workload rate of a slot
+type AddStaffUnitRequestRate float64
+
 // AddTrainingRequest defines model for AddTrainingRequest.
 type AddTrainingRequest struct {
 	// Cost cost per person, in their minor unit form
@@ -115,20 +555,26 @@ type AddTrainingRequest struct {
 
 // AddUserRequest defines model for AddUserRequest.
 type AddUserRequest struct {
-	DateOfBirth         openapi_types.Date  `json:"date_of_birth"`
-	DepartmentID        uint64              `json:"department_id"`
-	Email               openapi_types.Email `json:"email"`
-	FirstName           string              `json:"first_name"`
-	ForeignLanguages    []string            `json:"foreign_languages,omitempty"`
-	Gender              Gender              `json:"gender"`
-	Grade               string              `json:"grade"`
-	Insurance           Insurance           `json:"insurance"`
-	LastName            string              `json:"last_name"`
-	MiddleName          string              `json:"middle_name"`
-	Military            *Military           `json:"military,omitempty"`
-	Nationality         string              `json:"nationality"`
-	PhoneNumbers        PhoneNumbers        `json:"phone_numbers"`
-	PlaceOfBirth        string              `json:"place_of_birth"`
+	DateOfBirth      openapi_types.Date  `json:"date_of_birth"`
+	DepartmentID     uint64              `json:"department_id"`
+	Email            openapi_types.Email `json:"email"`
+	FirstName        string              `json:"first_name"`
+	ForeignLanguages []string            `json:"foreign_languages,omitempty"`
+	Gender           Gender              `json:"gender"`
+	Grade            string              `json:"grade"`
+	Insurance        Insurance           `json:"insurance"`
+	LastName         string              `json:"last_name"`
+	MiddleName       string              `json:"middle_name"`
+	Military         *Military           `json:"military,omitempty"`
+	Nationality      string              `json:"nationality"`
+
+	// PersonnelNumber personnel number, the next number of the sequence by default
+	PersonnelNumber *string      `json:"personnel_number,omitempty"`
+	PhoneNumbers    PhoneNumbers `json:"phone_numbers"`
+	PlaceOfBirth    string       `json:"place_of_birth"`
+
+	// PositionDate the date the department and the position are held from, today by default
+	PositionDate        *openapi_types.Date `json:"position_date,omitempty"`
 	PositionID          uint64              `json:"position_id"`
 	RegistrationAddress string              `json:"registration_address"`
 	ResidentialAddress  string              `json:"residential_address"`
@@ -137,21 +583,204 @@ type AddUserRequest struct {
 	WorkingModel        *WorkingModel       `json:"working_model,omitempty"`
 }
 
+// AddVacancyRequest defines model for AddVacancyRequest.
+type AddVacancyRequest struct {
+	DepartmentID uint64  `json:"department_id"`
+	Description  *string `json:"description,omitempty"`
+	PositionID   uint64  `json:"position_id"`
+	Title        string  `json:"title"`
+}
+
 // AddVacationRequest defines model for AddVacationRequest.
 type AddVacationRequest struct {
 	DateFrom openapi_types.Date `json:"date_from"`
 	DateTo   openapi_types.Date `json:"date_to"`
 }
 
+// AddVacationRequestRequest defines model for AddVacationRequestRequest.
+type AddVacationRequestRequest struct {
+	Comment  *string            `json:"comment,omitempty"`
+	DateFrom openapi_types.Date `json:"date_from"`
+	DateTo   openapi_types.Date `json:"date_to"`
+}
+
+// AddVacationScheduleItemRequest defines model for AddVacationScheduleItemRequest.
+type AddVacationScheduleItemRequest struct {
+	DateFrom openapi_types.Date `json:"date_from"`
+	DateTo   openapi_types.Date `json:"date_to"`
+	UserID   uint64             `json:"user_id"`
+}
+
 // AddVisaRequest defines model for AddVisaRequest.
 type AddVisaRequest struct {
-	IssuedState   string             `json:"issued_state"`
+	IssuedState string `json:"issued_state"`
+
+	// Number 5-20 capital latin letters and digits, 9 digits for the Russian visa in the foreigners passport
 	Number        string             `json:"number"`
 	NumberEntries VisaNumberEntries  `json:"number_entries"`
 	ValidFrom     openapi_types.Date `json:"valid_from"`
 	ValidTo       openapi_types.Date `json:"valid_to"`
 }
 
+// AddWorkPermitRequest defines model for AddWorkPermitRequest.
+type AddWorkPermitRequest struct {
+	Number    string             `json:"number"`
+	ValidFrom openapi_types.Date `json:"valid_from"`
+	ValidTo   openapi_types.Date `json:"valid_to"`
+}
+
+// Amendment amendment (supplementary agreement) to the contract, the terms which are not set are kept
+type Amendment struct {
+	// Date date of signing
+	Date openapi_types.Date `json:"date"`
+
+	// DateTo new last day of the temporary contract
+	DateTo *openapi_types.Date `json:"date_to,omitempty"`
+
+	// Description other changed terms
+	Description string `json:"description"`
+
+	// EffectiveFrom first day the changed terms are in effect
+	EffectiveFrom openapi_types.Date `json:"effective_from"`
+	HasScan       bool               `json:"has_scan"`
+	ID            uint64             `json:"id"`
+	Number        string             `json:"number"`
+
+	// PositionID new position
+	PositionID *uint64 `json:"position_id,omitempty"`
+
+	// Salary new gross salary per month, in their minor unit form
+	Salary *int64 `json:"salary,omitempty"`
+
+	// WorkTypeID new work type
+	WorkTypeID *uint64 `json:"work_type_id,omitempty"`
+}
+
+// Benefit defines model for Benefit.
+type Benefit struct {
+	// Cost monthly cost per employee, in their minor unit form
+	Cost int64 `json:"cost"`
+
+	// DepartmentID only employees of the department are eligible
+	DepartmentID *uint64 `json:"department_id,omitempty"`
+
+	// Grade only employees of the grade are eligible
+	Grade *string `json:"grade,omitempty"`
+	ID    uint64  `json:"id"`
+
+	// MinTenureMonths minimum tenure in the company to be eligible
+	MinTenureMonths int    `json:"min_tenure_months"`
+	Title           string `json:"title"`
+}
+
+// BenefitCost benefit costs of the department within the period
+type BenefitCost struct {
+	// Cost cost within the period, in their minor unit form
+	Cost         int64  `json:"cost"`
+	Department   string `json:"department"`
+	DepartmentID uint64 `json:"department_id"`
+
+	// Employees employees using benefits
+	Employees  int `json:"employees"`
+	Enrolments int `json:"enrolments"`
+}
+
+// BenefitCostReportResponse defines model for BenefitCostReportResponse.
+type BenefitCostReportResponse = []BenefitCost
+
+// BenefitEnrolment defines model for BenefitEnrolment.
+type BenefitEnrolment struct {
+	// Benefit benefit title
+	Benefit   string `json:"benefit"`
+	BenefitID uint64 `json:"benefit_id"`
+
+	// Cost monthly cost per employee, in their minor unit form
+	Cost int64 `json:"cost"`
+
+	// DateFrom first day of the benefit use
+	DateFrom openapi_types.Date `json:"date_from"`
+
+	// DateTo last day of the benefit use
+	DateTo openapi_types.Date `json:"date_to"`
+	ID     uint64             `json:"id"`
+
+	// Version version of the enrolment to be sent in If-Match on its update
+	Version int64 `json:"version"`
+}
+
+// CalendarDay day of the production calendar differing from the five-day working week
+type CalendarDay struct {
+	Date  openapi_types.Date `json:"date"`
+	Title *string            `json:"title,omitempty"`
+
+	// Type holiday - public holiday, day_off - day off moved from another day,
+	// working - working weekend day, shortened - pre-holiday shortened working day
+	Type CalendarDayType `json:"type"`
+}
+
+// CalendarDayType holiday - public holiday, day_off - day off moved from another day,
+// working - working weekend day, shortened - pre-holiday shortened working day
+type CalendarDayType string
+
+// CalendarDaysCount number of days of the period by the production calendar
+type CalendarDaysCount struct {
+	// CalendarDays days of the period, public holidays excluded
+	CalendarDays int `json:"calendar_days"`
+
+	// Holidays public holidays of the period
+	Holidays int `json:"holidays"`
+
+	// WorkingDays working days of the period
+	WorkingDays int `json:"working_days"`
+}
+
+// Candidate applicant for the vacancy
+type Candidate struct {
+	CreatedAt   time.Time           `json:"created_at"`
+	DateOfBirth *openapi_types.Date `json:"date_of_birth,omitempty"`
+
+	// Email personal email
+	Email      openapi_types.Email `json:"email"`
+	FirstName  string              `json:"first_name"`
+	ID         uint64              `json:"id"`
+	LastName   string              `json:"last_name"`
+	MiddleName string              `json:"middle_name"`
+	Phone      string              `json:"phone"`
+
+	// Stage stage of the candidate in the recruiting pipeline, hired is set only by the hire action
+	Stage CandidateStage `json:"stage"`
+
+	// UserID the employee created from the hired candidate
+	UserID    *uint64 `json:"user_id,omitempty"`
+	VacancyID uint64  `json:"vacancy_id"`
+}
+
+// CandidateCV file of the candidate's resume
+type CandidateCV struct {
+	ContentType string `json:"content_type"`
+	FileName    string `json:"file_name"`
+	ID          uint64 `json:"id"`
+
+	// Size file size in bytes
+	Size       int64     `json:"size"`
+	UploadedAt time.Time `json:"uploaded_at"`
+}
+
+// CandidateNote note on the candidate, e.g. the interview feedback
+type CandidateNote struct {
+	// AuthorID the user who wrote the note
+	AuthorID  uint64    `json:"author_id"`
+	CreatedAt time.Time `json:"created_at"`
+	ID        uint64    `json:"id"`
+
+	// Stage stage of the candidate in the recruiting pipeline, hired is set only by the hire action
+	Stage CandidateStage `json:"stage"`
+	Text  string         `json:"text"`
+}
+
+// CandidateStage stage of the candidate in the recruiting pipeline, hired is set only by the hire action
+type CandidateStage string
+
 // ChangePasswordRequest defines model for ChangePasswordRequest.
 type ChangePasswordRequest struct {
 	// Key a special key sent to the employee’s email
@@ -161,16 +790,67 @@ type ChangePasswordRequest struct {
 	Password string `json:"password"`
 }
 
+// Compensation defines model for Compensation.
+type Compensation struct {
+	ContractID uint64 `json:"contract_id"`
+
+	// Currency ISO 4217 currency code
+	Currency string `json:"currency"`
+
+	// DateFrom date from which the compensation is effective
+	DateFrom openapi_types.Date `json:"date_from"`
+	ID       uint64             `json:"id"`
+
+	// IncomeTax tax paid to revenue service per month, in their minor unit form
+	IncomeTax int64 `json:"income_tax"`
+
+	// Salary gross salary per month, in their minor unit form
+	Salary int64 `json:"salary"`
+
+	// SalaryRate salary rate coefficient
+	SalaryRate *float64 `json:"salary_rate,omitempty"`
+
+	// SocialSecurityTax tax paid to social services per month, in their minor unit form
+	SocialSecurityTax int64 `json:"social_security_tax"`
+}
+
 // Contract defines model for Contract.
 type Contract struct {
-	DateFrom        openapi_types.Date  `json:"date_from"`
-	DateTo          *openapi_types.Date `json:"date_to,omitempty"`
-	HasScan         *bool               `json:"has_scan,omitempty"`
-	ID              uint64              `json:"id"`
-	Number          string              `json:"number"`
-	Type            ContractType        `json:"type"`
+	DateFrom openapi_types.Date  `json:"date_from"`
+	DateTo   *openapi_types.Date `json:"date_to,omitempty"`
+
+	// ExtraVacationDays additional annual paid leave days of the contract (irregular working hours, harmful working conditions, etc.)
+	ExtraVacationDays *uint `json:"extra_vacation_days,omitempty"`
+
+	// HasAmendments the amended contract is read-only, its terms are changed by amendments
+	HasAmendments *bool        `json:"has_amendments,omitempty"`
+	HasScan       *bool        `json:"has_scan,omitempty"`
+	ID            uint64       `json:"id"`
+	Number        string       `json:"number"`
+	Type          ContractType `json:"type"`
+
+	// ProbationEnd last day of the probation period, moved to the next working day by the production calendar
+	ProbationEnd    *openapi_types.Date `json:"probation_end,omitempty"`
 	ProbationPeriod *uint               `json:"probation_period,omitempty"`
-	WorkTypeID      uint64              `json:"work_type_id"`
+
+	// ProbationResult decision on the probation period of the contract
+	ProbationResult *ProbationResult `json:"probation_result,omitempty"`
+	WorkTypeID      uint64           `json:"work_type_id"`
+}
+
+// ContractTerms terms of the contract in effect on the date
+type ContractTerms struct {
+	// AmendmentID the last amendment in effect, absent for the original terms
+	AmendmentID *uint64             `json:"amendment_id,omitempty"`
+	Date        openapi_types.Date  `json:"date"`
+	DateTo      *openapi_types.Date `json:"date_to,omitempty"`
+
+	// PositionID set if changed by the amendments
+	PositionID *uint64 `json:"position_id,omitempty"`
+
+	// Salary set if changed by the amendments, in their minor unit form
+	Salary     *int64 `json:"salary,omitempty"`
+	WorkTypeID uint64 `json:"work_type_id"`
 }
 
 // ContractType defines model for ContractType.
@@ -188,6 +868,16 @@ type Department struct {
 	UsersNumber int `json:"users_number"`
 }
 
+// Duplicate group of the users which are probably the same person
+type Duplicate struct {
+	// Reason why the users are considered to be the same person, name is for similar names and the same date of birth
+	Reason  DuplicateReason `json:"reason"`
+	UserIDs []uint64        `json:"user_ids"`
+}
+
+// DuplicateReason why the users are considered to be the same person, name is for similar names and the same date of birth
+type DuplicateReason string
+
 // Education defines model for Education.
 type Education struct {
 	// DateFrom date of commencement of studies
@@ -202,16 +892,112 @@ type Education struct {
 	Program           string             `json:"program"`
 }
 
-// Error defines model for Error.
-type Error struct {
-	Code    *int   `json:"code,omitempty"`
-	Message string `json:"message"`
+// EmployeeVacationBalance balance of the annual paid leave of the employee
+type EmployeeVacationBalance struct {
+	// Accrued days accrued since the employment
+	Accrued float64 `json:"accrued"`
+
+	// AnnualDays annual paid leave days of the current contract
+	AnnualDays uint `json:"annual_days"`
+
+	// Balance days left
+	Balance    float64 `json:"balance"`
+	Department string  `json:"department"`
+	FirstName  string  `json:"first_name"`
+	LastName   string  `json:"last_name"`
+	MiddleName string  `json:"middle_name"`
+	Position   string  `json:"position"`
+
+	// Used days of the vacations, public holidays excluded
+	Used   uint   `json:"used"`
+	UserID uint64 `json:"user_id"`
+}
+
+// EmployeeVacation vacation of the employee
+type EmployeeVacation struct {
+	DateFrom   openapi_types.Date `json:"date_from"`
+	DateTo     openapi_types.Date `json:"date_to"`
+	Department string             `json:"department"`
+	FirstName  string             `json:"first_name"`
+	ID         uint64             `json:"id"`
+	LastName   string             `json:"last_name"`
+	MiddleName string             `json:"middle_name"`
+	Position   string             `json:"position"`
+	UserID     uint64             `json:"user_id"`
+}
+
+// EmployeeAbsence absence of the employee
+type EmployeeAbsence struct {
+	Comment    string             `json:"comment"`
+	DateFrom   openapi_types.Date `json:"date_from"`
+	DateTo     openapi_types.Date `json:"date_to"`
+	Department string             `json:"department"`
+
+	// DocumentNumber number of the document confirming the absence (e.g. the sick-leave certificate)
+	DocumentNumber string `json:"document_number"`
+	FirstName      string `json:"first_name"`
+	HasScan        bool   `json:"has_scan"`
+	ID             uint64 `json:"id"`
+	LastName       string `json:"last_name"`
+	MiddleName     string `json:"middle_name"`
+	Position       string `json:"position"`
+
+	// Type sick - sick leave, unpaid - unpaid leave, business_trip - business trip, study - study leave
+	Type   AbsenceType `json:"type"`
+	UserID uint64      `json:"user_id"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	Code    *int   `json:"code,omitempty"`
+	Message string `json:"message"`
+}
+
+// ExpandedPassport defines model for ExpandedPassport.
+type ExpandedPassport struct {
+	Passport
+	Visas []Visa `json:"visas"`
+}
+
+// Experience defines model for Experience.
+type Experience struct {
+	Awards      string `json:"awards"`
+	CompanyName string `json:"company_name"`
+
+	// DateFrom start date of work
+	DateFrom openapi_types.Date `json:"date_from"`
+
+	// DateTo end date of work
+	DateTo openapi_types.Date `json:"date_to"`
+
+	// Functional job responsibilities
+	Functional string `json:"functional"`
+	ID         uint64 `json:"id"`
+	Position   string `json:"position"`
+}
+
+// ExperienceLength defines model for ExperienceLength.
+type ExperienceLength struct {
+	// Continuous length of work experience, a month is 30 days and a year is 12 months
+	Continuous WorkLength `json:"continuous"`
+
+	// Total length of work experience, a month is 30 days and a year is 12 months
+	Total WorkLength `json:"total"`
 }
 
-// ExpandedPassport defines model for ExpandedPassport.
-type ExpandedPassport struct {
-	Passport
-	Visas []Visa `json:"visas"`
+// ExportFormat response format, csv and xlsx are returned as attachments
+type ExportFormat string
+
+// Family facts about the employee's children on the current date
+type Family struct {
+	ChildrenUnder14 int `json:"children_under_14"`
+
+	// ChildrenUnder18 the standard tax deduction is provided for each child
+	ChildrenUnder18         int `json:"children_under_18"`
+	DisabledChildrenUnder18 int `json:"disabled_children_under_18"`
+
+	// ExtraLeaveEligible the employee is entitled to the additional unpaid leave of up to 14 days (two or more children under 14 or a disabled child under 18)
+	ExtraLeaveEligible bool `json:"extra_leave_eligible"`
 }
 
 // Gender defines model for Gender.
@@ -229,13 +1015,20 @@ type GetExpandedPassportResponse = ExpandedPassport
 // GetExpandedUserResponse defines model for GetExpandedUserResponse.
 type GetExpandedUserResponse struct {
 	GetUserResponse
-	Contracts  []Contract         `json:"contracts"`
-	Educations []Education        `json:"educations"`
-	Passports  []ExpandedPassport `json:"passports"`
-	Trainings  []Training         `json:"trainings"`
-	Vacations  []Vacation         `json:"vacations"`
+	Contracts  []Contract  `json:"contracts"`
+	Educations []Education `json:"educations"`
+
+	// Family facts about the employee's children on the current date
+	Family    Family             `json:"family"`
+	Passports []ExpandedPassport `json:"passports"`
+	Relatives []Relative         `json:"relatives"`
+	Trainings []Training         `json:"trainings"`
+	Vacations []Vacation         `json:"vacations"`
 }
 
+// GetExperienceResponse defines model for GetExperienceResponse.
+type GetExperienceResponse = Experience
+
 // GetPassportResponse defines model for GetPassportResponse.
 type GetPassportResponse = Passport
 
@@ -259,15 +1052,19 @@ type GetUserResponse struct {
 	Military               *Military              `json:"military,omitempty"`
 	Nationality            string                 `json:"nationality"`
 	PersonalDataProcessing PersonalDataProcessing `json:"personal_data_processing"`
-	PhoneNumbers           PhoneNumbers           `json:"phone_numbers"`
-	PlaceOfBirth           string                 `json:"place_of_birth"`
-	PositionID             uint64                 `json:"position_id"`
-	PositionTrack          PositionTrack          `json:"position_track"`
-	RegistrationAddress    string                 `json:"registration_address"`
-	ResidentialAddress     string                 `json:"residential_address"`
-	Taxpayer               Taxpayer               `json:"taxpayer"`
-	WorkPermit             *WorkPermit            `json:"work_permit,omitempty"`
-	WorkingModel           *WorkingModel          `json:"working_model,omitempty"`
+
+	// PersonnelNumber personnel number
+	PersonnelNumber     string        `json:"personnel_number"`
+	PhoneNumbers        PhoneNumbers  `json:"phone_numbers"`
+	PlaceOfBirth        string        `json:"place_of_birth"`
+	PositionID          uint64        `json:"position_id"`
+	PositionTrack       PositionTrack `json:"position_track"`
+	RegistrationAddress string        `json:"registration_address"`
+	ResidentialAddress  string        `json:"residential_address"`
+	Taxpayer            Taxpayer      `json:"taxpayer"`
+	Termination         *Termination  `json:"termination,omitempty"`
+	WorkPermit          *WorkPermit   `json:"work_permit,omitempty"`
+	WorkingModel        *WorkingModel `json:"working_model,omitempty"`
 }
 
 // GetVacationResponse defines model for GetVacationResponse.
@@ -276,6 +1073,101 @@ type GetVacationResponse = Vacation
 // GetVisaResponse defines model for GetVisaResponse.
 type GetVisaResponse = Visa
 
+// GetWorkPermitResponse defines model for GetWorkPermitResponse.
+type GetWorkPermitResponse = WorkPermitRecord
+
+// HireCandidateRequest employee data absent in the candidate, the names and the phone are taken from the candidate, the position and the department from the vacancy
+type HireCandidateRequest struct {
+	// DateOfBirth required if the candidate has no date of birth
+	DateOfBirth *openapi_types.Date `json:"date_of_birth,omitempty"`
+
+	// Email work email of the employee
+	Email               openapi_types.Email `json:"email"`
+	Gender              Gender              `json:"gender"`
+	Grade               string              `json:"grade"`
+	Insurance           Insurance           `json:"insurance"`
+	Military            *Military           `json:"military,omitempty"`
+	Nationality         string              `json:"nationality"`
+	PhoneNumbers        PhoneNumbers        `json:"phone_numbers,omitempty"`
+	PlaceOfBirth        string              `json:"place_of_birth"`
+	RegistrationAddress string              `json:"registration_address"`
+	ResidentialAddress  string              `json:"residential_address"`
+	Taxpayer            Taxpayer            `json:"taxpayer"`
+	WorkPermit          *WorkPermit         `json:"work_permit,omitempty"`
+	WorkingModel        *WorkingModel       `json:"working_model,omitempty"`
+}
+
+// Indexation defines model for Indexation.
+type Indexation struct {
+	// Currency ISO 4217 currency code
+	Currency string             `json:"currency"`
+	DateFrom openapi_types.Date `json:"date_from"`
+	ID       uint64             `json:"id"`
+
+	// Percents the raise in percents, up to 2 decimal places
+	Percents float64 `json:"percents"`
+
+	// RunID bulk indexation run, if the indexation was a part of it
+	RunID *uint64 `json:"run_id,omitempty"`
+
+	// SalaryAfter gross salary per month after the indexation, in their minor unit form
+	SalaryAfter int64 `json:"salary_after"`
+
+	// SalaryBefore gross salary per month before the indexation, in their minor unit form
+	SalaryBefore int64 `json:"salary_before"`
+}
+
+// IndexationPreviewItem defines model for IndexationPreviewItem.
+type IndexationPreviewItem struct {
+	ContractID uint64 `json:"contract_id"`
+
+	// Currency ISO 4217 currency code
+	Currency   string `json:"currency"`
+	FirstName  string `json:"first_name"`
+	LastName   string `json:"last_name"`
+	MiddleName string `json:"middle_name,omitempty"`
+
+	// SalaryAfter indexed gross salary per month, in their minor unit form
+	SalaryAfter int64 `json:"salary_after"`
+
+	// SalaryBefore current gross salary per month, in their minor unit form
+	SalaryBefore int64  `json:"salary_before"`
+	UserID       uint64 `json:"user_id"`
+}
+
+// IndexationPreviewResponse defines model for IndexationPreviewResponse.
+type IndexationPreviewResponse = []IndexationPreviewItem
+
+// IndexationRun defines model for IndexationRun.
+type IndexationRun struct {
+	DateFrom openapi_types.Date `json:"date_from"`
+
+	// DepartmentID is absent if the whole company was indexed
+	DepartmentID *uint64 `json:"department_id,omitempty"`
+
+	// Employees number of indexed employees
+	Employees int    `json:"employees"`
+	ID        uint64 `json:"id"`
+
+	// Percents the raise in percents, up to 2 decimal places
+	Percents float64 `json:"percents"`
+
+	// Reversible whether the run can be reverted (until it comes into effect)
+	Reversible bool `json:"reversible"`
+}
+
+// IndexationRunRequest defines model for IndexationRunRequest.
+type IndexationRunRequest struct {
+	// DateFrom date from which indexed salaries are effective
+	DateFrom openapi_types.Date `json:"date_from"`
+
+	// DepartmentID index the department only (the whole company if absent)
+	DepartmentID *uint64 `json:"department_id,omitempty"`
+
+	// Percents the raise in percents, up to 2 decimal places
+	Percents float64 `json:"percents"`
+}
+
 // InitChangePasswordRequest defines model for InitChangePasswordRequest.
 type InitChangePasswordRequest struct {
 	// Login employee login (email)
@@ -284,22 +1176,105 @@ type InitChangePasswordRequest struct {
 
 // Insurance defines model for Insurance.
 type Insurance struct {
-	HasScan *bool  `json:"has_scan,omitempty"`
-	Number  string `json:"number"`
+	HasScan *bool `json:"has_scan,omitempty"`
+
+	// Number insurance number (SNILS), 11 digits with the control digits
+	Number string `json:"number"`
 }
 
+// ListAbsencesResponse defines model for ListAbsencesResponse.
+type ListAbsencesResponse = []Absence
+
+// ListAllAbsencesResponse defines model for ListAllAbsencesResponse.
+type ListAllAbsencesResponse = []EmployeeAbsence
+
+// ListAllVacationsResponse defines model for ListAllVacationsResponse.
+type ListAllVacationsResponse = []EmployeeVacation
+
+// ListAmendmentsResponse defines model for ListAmendmentsResponse.
+type ListAmendmentsResponse = []Amendment
+
+// ListBenefitEnrolmentsResponse defines model for ListBenefitEnrolmentsResponse.
+type ListBenefitEnrolmentsResponse = []BenefitEnrolment
+
+// ListBenefitsResponse defines model for ListBenefitsResponse.
+type ListBenefitsResponse = []Benefit
+
+// ListCalendarDaysResponse defines model for ListCalendarDaysResponse.
+type ListCalendarDaysResponse = []CalendarDay
+
+// ListCandidateCVsResponse defines model for ListCandidateCVsResponse.
+type ListCandidateCVsResponse = []CandidateCV
+
+// ListCandidateNotesResponse defines model for ListCandidateNotesResponse.
+type ListCandidateNotesResponse = []CandidateNote
+
+// ListCandidatesResponse defines model for ListCandidatesResponse.
+type ListCandidatesResponse = []Candidate
+
+// ListCompensationsResponse defines model for ListCompensationsResponse.
+type ListCompensationsResponse = []Compensation
+
 // ListContractsResponse defines model for ListContractsResponse.
 type ListContractsResponse = []Contract
 
+// ListDuplicatesResponse defines model for ListDuplicatesResponse.
+type ListDuplicatesResponse = []Duplicate
+
 // ListEducationsResponse defines model for ListEducationsResponse.
 type ListEducationsResponse = []Education
 
+// ListExperiencesResponse defines model for ListExperiencesResponse.
+type ListExperiencesResponse = []Experience
+
+// ListIndexationRunsResponse defines model for ListIndexationRunsResponse.
+type ListIndexationRunsResponse = []IndexationRun
+
+// ListIndexationsResponse defines model for ListIndexationsResponse.
+type ListIndexationsResponse = []Indexation
+
+// ListMilitaryLiableResponse defines model for ListMilitaryLiableResponse.
+type ListMilitaryLiableResponse = []MilitaryLiable
+
+// ListMilitaryNotificationsResponse defines model for ListMilitaryNotificationsResponse.
+type ListMilitaryNotificationsResponse = []MilitaryNotification
+
+// ListNumberSequencesResponse defines model for ListNumberSequencesResponse.
+type ListNumberSequencesResponse = []NumberSequence
+
+// ListOnboardingItemsResponse defines model for ListOnboardingItemsResponse.
+type ListOnboardingItemsResponse = []OnboardingItem
+
+// ListOnboardingTemplatesResponse defines model for ListOnboardingTemplatesResponse.
+type ListOnboardingTemplatesResponse = []OnboardingTemplate
+
+// ListOrdersResponse defines model for ListOrdersResponse.
+type ListOrdersResponse = []Order
+
+// ListOverdueOnboardingResponse defines model for ListOverdueOnboardingResponse.
+type ListOverdueOnboardingResponse = []OverdueOnboarding
+
 // ListPassportsResponse defines model for ListPassportsResponse.
 type ListPassportsResponse = []Passport
 
+// ListPositionHoldersResponse defines model for ListPositionHoldersResponse.
+type ListPositionHoldersResponse = []PositionHolder
+
+// ListProbationsResponse defines model for ListProbationsResponse.
+type ListProbationsResponse = []ProbationEmployee
+
+// ListRelativesResponse defines model for ListRelativesResponse.
+type ListRelativesResponse = []Relative
+
 // ListScansResponse defines model for ListScansResponse.
 type ListScansResponse = []Scan
 
+// ListStaffUnitsResponse defines model for ListStaffUnitsResponse.
+type ListStaffUnitsResponse = []StaffUnit
+
+// ListStaffingVacanciesResponse defines model for ListStaffingVacanciesResponse.
+type ListStaffingVacanciesResponse = []StaffingVacancy
+
 // ListTrainingsResponse defines model for ListTrainingsResponse.
 type ListTrainingsResponse = []Training
 
@@ -313,6 +1288,7 @@ type ListUsersItem struct {
 	MiddleName   string              `json:"middle_name"`
 	PhoneNumbers PhoneNumbers        `json:"phone_numbers"`
 	Position     string              `json:"position"`
+	Termination  *Termination        `json:"termination,omitempty"`
 }
 
 // ListUsersResponse defines model for ListUsersResponse.
@@ -328,12 +1304,24 @@ type ListUsersResponse struct {
 	Users      []ListUsersItem `json:"users"`
 }
 
+// ListVacanciesResponse defines model for ListVacanciesResponse.
+type ListVacanciesResponse = []Vacancy
+
+// ListVacationBalancesResponse defines model for ListVacationBalancesResponse.
+type ListVacationBalancesResponse = []EmployeeVacationBalance
+
+// ListVacationRequestsResponse defines model for ListVacationRequestsResponse.
+type ListVacationRequestsResponse = []VacationRequest
+
 // ListVacationsResponse defines model for ListVacationsResponse.
 type ListVacationsResponse = []Vacation
 
 // ListVisasResponse defines model for ListVisasResponse.
 type ListVisasResponse = []Visa
 
+// ListWorkPermitsResponse defines model for ListWorkPermitsResponse.
+type ListWorkPermitsResponse = []WorkPermitRecord
+
 // LoginRequest defines model for LoginRequest.
 type LoginRequest struct {
 	// Login employee login (email)
@@ -350,6 +1338,163 @@ type Military struct {
 	HasScan     *bool  `json:"has_scan,omitempty"`
 	Rank        string `json:"rank"`
 	Speciality  string `json:"speciality"`
+
+	// Version версия записи воинского учёта (ETag в GET /users/{user_id}/military)
+	Version *int64 `json:"version,omitempty"`
+}
+
+// MilitaryLiable employee liable for military service
+type MilitaryLiable struct {
+	// Category category of validity
+	Category    string             `json:"category"`
+	Comissariat string             `json:"comissariat"`
+	DateOfBirth openapi_types.Date `json:"date_of_birth"`
+	Department  string             `json:"department"`
+	FirstName   string             `json:"first_name"`
+	LastName    string             `json:"last_name"`
+	MiddleName  string             `json:"middle_name"`
+	Position    string             `json:"position"`
+	Rank        string             `json:"rank"`
+
+	// Speciality military accounting specialty
+	Speciality string `json:"speciality"`
+	UserID     uint64 `json:"user_id"`
+}
+
+// MilitaryNotification change of the employee data to be reported to the military commissariat, military fields are empty for the deregistered employee
+type MilitaryNotification struct {
+	// Category category of validity
+	Category    string                    `json:"category"`
+	Comissariat string                    `json:"comissariat"`
+	Date        openapi_types.Date        `json:"date"`
+	DateOfBirth openapi_types.Date        `json:"date_of_birth"`
+	Department  string                    `json:"department"`
+	Event       MilitaryNotificationEvent `json:"event"`
+	FirstName   string                    `json:"first_name"`
+	LastName    string                    `json:"last_name"`
+	MiddleName  string                    `json:"middle_name"`
+	Position    string                    `json:"position"`
+	Rank        string                    `json:"rank"`
+
+	// Speciality military accounting specialty
+	Speciality string `json:"speciality"`
+	UserID     uint64 `json:"user_id"`
+}
+
+// MilitaryNotificationEvent defines model for MilitaryNotification.Event.
+type MilitaryNotificationEvent string
+
+// OnboardingItem item of the employee onboarding checklist
+type OnboardingItem struct {
+	Completed   bool               `json:"completed"`
+	CompletedAt *time.Time         `json:"completed_at,omitempty"`
+	DueDate     openapi_types.Date `json:"due_date"`
+	ID          uint64             `json:"id"`
+	ScanType    *ScanType          `json:"scan_type,omitempty"`
+	Title       string             `json:"title"`
+}
+
+// OnboardingTemplate onboarding checklist template for new employees
+type OnboardingTemplate struct {
+	// DepartmentID template for the department only (empty - any department)
+	DepartmentID *uint64                  `json:"department_id,omitempty"`
+	ID           uint64                   `json:"id"`
+	Items        []OnboardingTemplateItem `json:"items"`
+
+	// PositionID template for the position only (empty - any position)
+	PositionID *uint64 `json:"position_id,omitempty"`
+	Title      string  `json:"title"`
+}
+
+// OnboardingTemplateItem item of the checklist template, the item with scan_type is completed automatically when a scan of the type is uploaded
+type OnboardingTemplateItem struct {
+	// DueDays days after the employee is added
+	DueDays  uint      `json:"due_days"`
+	ScanType *ScanType `json:"scan_type,omitempty"`
+	Title    string    `json:"title"`
+}
+
+// OverdueOnboarding employee with overdue onboarding items
+type OverdueOnboarding struct {
+	Department string           `json:"department"`
+	FirstName  string           `json:"first_name"`
+	Items      []OnboardingItem `json:"items"`
+	LastName   string           `json:"last_name"`
+	MiddleName string           `json:"middle_name"`
+	Position   string           `json:"position"`
+	UserID     uint64           `json:"user_id"`
+}
+
+// Order HR order on the personnel event of the employee
+type Order struct {
+	// ContractID contract of the hiring
+	ContractID *uint64 `json:"contract_id,omitempty"`
+
+	// Date date of signing
+	Date *openapi_types.Date `json:"date,omitempty"`
+
+	// EffectiveDate day of the event
+	EffectiveDate openapi_types.Date `json:"effective_date"`
+	FirstName     string             `json:"first_name"`
+
+	// HasScan the signed scan is attached
+	HasScan    bool   `json:"has_scan"`
+	ID         uint64 `json:"id"`
+	LastName   string `json:"last_name"`
+	MiddleName string `json:"middle_name"`
+
+	// Number set when the order is signed
+	Number *string `json:"number,omitempty"`
+
+	// Status draft - the order is being prepared, signed - the order is signed and numbered,
+	// cancelled - the draft is cancelled
+	Status OrderStatus `json:"status"`
+
+	// Title the title of the type by default
+	Title string `json:"title"`
+
+	// Type personnel event the order is issued on
+	Type   OrderType `json:"type"`
+	UserID uint64    `json:"user_id"`
+
+	// VacationID vacation of the employee
+	VacationID *uint64 `json:"vacation_id,omitempty"`
+}
+
+// OrderAction sign - sign the draft and assign it the next number, cancel - cancel the draft
+type OrderAction string
+
+// OrderStatus draft - the order is being prepared, signed - the order is signed and numbered,
+// cancelled - the draft is cancelled
+type OrderStatus string
+
+// OrderType personnel event the order is issued on
+type OrderType string
+
+// NumberSequence format of the numbers, e.g. ТД-2024-0001, the numbers are allocated without gaps
+type NumberSequence struct {
+	// Entity kind of the numbers allocated by the sequence
+	Entity SequenceEntity `json:"entity"`
+	ID     uint64         `json:"id"`
+
+	// Padding minimal number of digits, the number is padded with zeros
+	Padding uint `json:"padding"`
+
+	// Prefix text before the number
+	Prefix string `json:"prefix"`
+
+	// Suffix text after the number
+	Suffix string `json:"suffix"`
+	Title  string `json:"title"`
+
+	// Types order types numbered by the sequence of the order numbers
+	Types []OrderType `json:"types,omitempty"`
+
+	// WithYear the year is added after the prefix
+	WithYear bool `json:"with_year"`
+
+	// YearlyReset the numbers start from 1 every year
+	YearlyReset bool `json:"yearly_reset"`
 }
 
 // Passport defines model for Passport.
@@ -358,9 +1503,11 @@ type Passport struct {
 	ID         uint64             `json:"id"`
 	IssuedBy   string             `json:"issued_by"`
 	IssuedDate openapi_types.Date `json:"issued_date"`
-	Number     string             `json:"number"`
-	Type       PassportType       `json:"type"`
-	VisasCount uint               `json:"visas_count"`
+
+	// Number series and number without spaces: 10 digits for internal, 9 digits for external, 5-20 capital latin letters and digits for foreigners passport
+	Number     string       `json:"number"`
+	Type       PassportType `json:"type"`
+	VisasCount uint         `json:"visas_count"`
 }
 
 // PassportType defines model for PassportType.
@@ -386,12 +1533,28 @@ type PatchEducationRequest struct {
 	Program           *string             `json:"program,omitempty"`
 }
 
+// PatchOnboardingItemRequest defines model for PatchOnboardingItemRequest.
+type PatchOnboardingItemRequest struct {
+	Completed bool `json:"completed"`
+}
+
+// PatchOrderRequest defines model for PatchOrderRequest.
+type PatchOrderRequest struct {
+	// Action sign - sign the draft and assign it the next number, cancel - cancel the draft
+	Action OrderAction `json:"action"`
+
+	// Date date of signing, today by default
+	Date *openapi_types.Date `json:"date,omitempty"`
+}
+
 // PatchPassportRequest defines model for PatchPassportRequest.
 type PatchPassportRequest struct {
 	IssuedBy   *string             `json:"issued_by,omitempty"`
 	IssuedDate *openapi_types.Date `json:"issued_date,omitempty"`
-	Number     *string             `json:"number,omitempty"`
-	Type       *PassportType       `json:"type,omitempty"`
+
+	// Number series and number without spaces: 10 digits for internal, 9 digits for external, 5-20 capital latin letters and digits for foreigners passport
+	Number *string       `json:"number,omitempty"`
+	Type   *PassportType `json:"type,omitempty"`
 }
 
 // PatchTrainingRequest defines model for PatchTrainingRequest.
@@ -410,27 +1573,30 @@ type PatchTrainingRequest struct {
 
 // PatchUserRequest defines model for PatchUserRequest.
 type PatchUserRequest struct {
-	DateOfBirth         *openapi_types.Date  `json:"date_of_birth,omitempty"`
-	DepartmentID        *uint64              `json:"department_id,omitempty"`
-	Email               *openapi_types.Email `json:"email,omitempty"`
-	FirstName           *string              `json:"first_name,omitempty"`
-	ForeignLanguages    []string             `json:"foreign_languages,omitempty"`
-	Gender              *Gender              `json:"gender,omitempty"`
-	Grade               *string              `json:"grade,omitempty"`
-	Insurance           *Insurance           `json:"insurance,omitempty"`
-	LastName            *string              `json:"last_name,omitempty"`
-	MiddleName          *string              `json:"middle_name,omitempty"`
-	Military            *Military            `json:"military,omitempty"`
-	Nationality         *string              `json:"nationality,omitempty"`
-	PhoneNumbers        PhoneNumbers         `json:"phone_numbers,omitempty"`
-	PlaceOfBirth        *string              `json:"place_of_birth,omitempty"`
-	PositionID          *uint64              `json:"position_id,omitempty"`
-	PositionTrack       PositionTrack        `json:"position_track,omitempty"`
-	RegistrationAddress *string              `json:"registration_address,omitempty"`
-	ResidentialAddress  *string              `json:"residential_address,omitempty"`
-	Taxpayer            *Taxpayer            `json:"taxpayer,omitempty"`
-	WorkPermit          *WorkPermit          `json:"work_permit,omitempty"`
-	WorkingModel        *WorkingModel        `json:"working_model,omitempty"`
+	DateOfBirth      *openapi_types.Date  `json:"date_of_birth,omitempty"`
+	DepartmentID     *uint64              `json:"department_id,omitempty"`
+	Email            *openapi_types.Email `json:"email,omitempty"`
+	FirstName        *string              `json:"first_name,omitempty"`
+	ForeignLanguages []string             `json:"foreign_languages,omitempty"`
+	Gender           *Gender              `json:"gender,omitempty"`
+	Grade            *string              `json:"grade,omitempty"`
+	Insurance        *Insurance           `json:"insurance,omitempty"`
+	LastName         *string              `json:"last_name,omitempty"`
+	MiddleName       *string              `json:"middle_name,omitempty"`
+	Military         *Military            `json:"military,omitempty"`
+	Nationality      *string              `json:"nationality,omitempty"`
+	PhoneNumbers     PhoneNumbers         `json:"phone_numbers,omitempty"`
+	PlaceOfBirth     *string              `json:"place_of_birth,omitempty"`
+
+	// PositionDate the date the department and the position are held from, today by default
+	PositionDate        *openapi_types.Date `json:"position_date,omitempty"`
+	PositionID          *uint64             `json:"position_id,omitempty"`
+	PositionTrack       PositionTrack       `json:"position_track,omitempty"`
+	RegistrationAddress *string             `json:"registration_address,omitempty"`
+	ResidentialAddress  *string             `json:"residential_address,omitempty"`
+	Taxpayer            *Taxpayer           `json:"taxpayer,omitempty"`
+	WorkPermit          *WorkPermit         `json:"work_permit,omitempty"`
+	WorkingModel        *WorkingModel       `json:"working_model,omitempty"`
 }
 
 // PatchVacationRequest defines model for PatchVacationRequest.
@@ -439,63 +1605,384 @@ type PatchVacationRequest struct {
 	DateTo   *openapi_types.Date `json:"date_to,omitempty"`
 }
 
+// PatchVacationRequestRequest defines model for PatchVacationRequestRequest.
+type PatchVacationRequestRequest struct {
+	// Action submit - send the draft for approval, cancel - withdraw the draft or pending request,
+	// approve - approve the pending request (by the head it's passed to HR), reject - reject the pending request
+	Action VacationRequestAction `json:"action"`
+
+	// Comment reason of the decision
+	Comment *string `json:"comment,omitempty"`
+}
+
+// PatchVacationScheduleRequest defines model for PatchVacationScheduleRequest.
+type PatchVacationScheduleRequest struct {
+	// Status draft - vacations are planned, approved - the schedule is approved
+	// (it's returned to draft to be changed), locked - the schedule is final
+	Status VacationScheduleStatus `json:"status"`
+}
+
 // PatchVisaRequest defines model for PatchVisaRequest.
 type PatchVisaRequest struct {
-	IssuedState   *string             `json:"issued_state,omitempty"`
+	IssuedState *string `json:"issued_state,omitempty"`
+
+	// Number 5-20 capital latin letters and digits, 9 digits for the Russian visa in the foreigners passport
 	Number        *string             `json:"number,omitempty"`
 	NumberEntries *VisaNumberEntries  `json:"number_entries,omitempty"`
 	ValidFrom     *openapi_types.Date `json:"valid_from,omitempty"`
 	ValidTo       *openapi_types.Date `json:"valid_to,omitempty"`
 }
 
+// Period period of time, both dates are inclusive
+type Period struct {
+	DateFrom openapi_types.Date `json:"date_from"`
+	DateTo   openapi_types.Date `json:"date_to"`
+}
+
 // PersonalDataProcessing defines model for PersonalDataProcessing.
 type PersonalDataProcessing struct {
 	HasScan bool `json:"has_scan"`
 }
 
-type PhoneNumber string
+type PhoneNumber string
+
+// PhoneNumbers defines model for PhoneNumbers.
+type PhoneNumbers map[string]PhoneNumber
+
+// PositionHolder defines model for PositionHolder.
+type PositionHolder struct {
+	DateFrom openapi_types.Date `json:"date_from"`
+
+	// DateTo is absent if the employee still holds the position
+	DateTo       *openapi_types.Date `json:"date_to,omitempty"`
+	Department   string              `json:"department"`
+	DepartmentID uint64              `json:"department_id"`
+	FirstName    string              `json:"first_name"`
+	LastName     string              `json:"last_name"`
+	MiddleName   string              `json:"middle_name,omitempty"`
+	UserID       uint64              `json:"user_id"`
+}
+
+// PositionTrack defines model for PositionTrack.
+type PositionTrack = []PositionTrackItem
+
+// PositionTrackItem defines model for PositionTrackItem.
+type PositionTrackItem struct {
+	DateFrom openapi_types.Date `json:"date_from"`
+
+	// DateTo is absent for the current position
+	DateTo       *openapi_types.Date `json:"date_to,omitempty"`
+	DepartmentID uint64              `json:"department_id"`
+	PositionID   uint64              `json:"position_id"`
+}
+
+// ProbationEmployee employee whose probation period ends soon
+type ProbationEmployee struct {
+	ContractID     uint64 `json:"contract_id"`
+	ContractNumber string `json:"contract_number"`
+
+	// DateFrom first day of the contract
+	DateFrom   openapi_types.Date `json:"date_from"`
+	Department string             `json:"department"`
+	FirstName  string             `json:"first_name"`
+	LastName   string             `json:"last_name"`
+	MiddleName string             `json:"middle_name"`
+
+	// Outcome set if the probation period is extended
+	Outcome  *ProbationOutcome `json:"outcome,omitempty"`
+	Position string            `json:"position"`
+
+	// ProbationEnd last day of the probation period
+	ProbationEnd openapi_types.Date `json:"probation_end"`
+	UserID       uint64             `json:"user_id"`
+}
+
+// ProbationOutcome outcome of the probation period
+type ProbationOutcome string
+
+// ProbationResult decision on the probation period of the contract
+type ProbationResult struct {
+	Comment   string    `json:"comment"`
+	DecidedAt time.Time `json:"decided_at"`
+
+	// ExtendedTo new last day of the extended probation period
+	ExtendedTo *openapi_types.Date `json:"extended_to,omitempty"`
+
+	// Outcome outcome of the probation period
+	Outcome ProbationOutcome `json:"outcome"`
+
+	// Version version of the result to be sent in If-Match on its update
+	Version int64 `json:"version"`
+}
+
+// PutAbsenceRequest defines model for PutAbsenceRequest.
+type PutAbsenceRequest struct {
+	Comment  *string            `json:"comment,omitempty"`
+	DateFrom openapi_types.Date `json:"date_from"`
+	DateTo   openapi_types.Date `json:"date_to"`
+
+	// DocumentNumber number of the document confirming the absence (e.g. the sick-leave certificate)
+	DocumentNumber *string `json:"document_number,omitempty"`
+
+	// Type sick - sick leave, unpaid - unpaid leave, business_trip - business trip, study - study leave
+	Type AbsenceType `json:"type"`
+}
+
+// PutAmendmentRequest defines model for PutAmendmentRequest.
+type PutAmendmentRequest struct {
+	// Date date of signing
+	Date openapi_types.Date `json:"date"`
+
+	// DateTo new last day of the temporary contract
+	DateTo *openapi_types.Date `json:"date_to,omitempty"`
+
+	// Description other changed terms
+	Description *string `json:"description,omitempty"`
+
+	// EffectiveFrom first day the changed terms are in effect
+	EffectiveFrom openapi_types.Date `json:"effective_from"`
+	Number        string             `json:"number"`
+
+	// PositionID new position
+	PositionID *uint64 `json:"position_id,omitempty"`
+
+	// Salary new gross salary per month, in their minor unit form
+	Salary *int64 `json:"salary,omitempty"`
+
+	// WorkTypeID new work type
+	WorkTypeID *uint64 `json:"work_type_id,omitempty"`
+}
+
+// PutBenefitEnrolmentRequest defines model for PutBenefitEnrolmentRequest.
+type PutBenefitEnrolmentRequest struct {
+	// DateFrom first day of the benefit use
+	DateFrom openapi_types.Date `json:"date_from"`
+
+	// DateTo last day of the benefit use
+	DateTo openapi_types.Date `json:"date_to"`
+}
+
+// PutBenefitRequest defines model for PutBenefitRequest.
+type PutBenefitRequest struct {
+	// Cost monthly cost per employee, in their minor unit form
+	Cost int64 `json:"cost"`
+
+	// DepartmentID only employees of the department are eligible
+	DepartmentID *uint64 `json:"department_id,omitempty"`
+
+	// Grade only employees of the grade are eligible
+	Grade *string `json:"grade,omitempty"`
+
+	// MinTenureMonths minimum tenure in the company to be eligible
+	MinTenureMonths int    `json:"min_tenure_months"`
+	Title           string `json:"title"`
+}
+
+// PutCalendarDaysRequest defines model for PutCalendarDaysRequest.
+type PutCalendarDaysRequest struct {
+	Days []CalendarDay `json:"days"`
+}
+
+// PutCandidateRequest defines model for PutCandidateRequest.
+type PutCandidateRequest struct {
+	DateOfBirth *openapi_types.Date `json:"date_of_birth,omitempty"`
+
+	// Email personal email
+	Email      *openapi_types.Email `json:"email,omitempty"`
+	FirstName  string               `json:"first_name"`
+	LastName   string               `json:"last_name"`
+	MiddleName *string              `json:"middle_name,omitempty"`
+	Phone      *string              `json:"phone,omitempty"`
+	VacancyID  uint64               `json:"vacancy_id"`
+}
+
+// PutCandidateStageRequest defines model for PutCandidateStageRequest.
+type PutCandidateStageRequest struct {
+	// Stage stage of the candidate in the recruiting pipeline, hired is set only by the hire action
+	Stage CandidateStage `json:"stage"`
+}
+
+// PutCompensationRequest defines model for PutCompensationRequest.
+type PutCompensationRequest struct {
+	// Currency ISO 4217 currency code
+	Currency string `json:"currency"`
+
+	// DateFrom date from which the compensation is effective
+	DateFrom openapi_types.Date `json:"date_from"`
+
+	// IncomeTax tax paid to revenue service per month, in their minor unit form
+	IncomeTax int64 `json:"income_tax"`
+
+	// Salary gross salary per month, in their minor unit form
+	Salary int64 `json:"salary"`
+
+	// SalaryRate salary rate coefficient
+	SalaryRate *float64 `json:"salary_rate,omitempty"`
+
+	// SocialSecurityTax tax paid to social services per month, in their minor unit form
+	SocialSecurityTax int64 `json:"social_security_tax"`
+}
+
+// PutContractRequest defines model for PutContractRequest.
+type PutContractRequest struct {
+	DateFrom openapi_types.Date  `json:"date_from"`
+	DateTo   *openapi_types.Date `json:"date_to,omitempty"`
+
+	// ExtraVacationDays additional annual paid leave days of the contract (irregular working hours, harmful working conditions, etc.)
+	ExtraVacationDays *uint        `json:"extra_vacation_days,omitempty"`
+	Number            string       `json:"number"`
+	Type              ContractType `json:"type"`
+	ProbationPeriod   *uint        `json:"probation_period,omitempty"`
+	WorkTypeID        uint64       `json:"work_type_id"`
+}
+
+// PutEducationRequest defines model for PutEducationRequest.
+type PutEducationRequest struct {
+	// DateFrom date of commencement of studies
+	DateFrom openapi_types.Date `json:"date_from"`
+
+	// DateTo date of graduation
+	DateTo            openapi_types.Date `json:"date_to"`
+	IssuedInstitution string             `json:"issued_institution"`
+	Number            string             `json:"number"`
+	Program           string             `json:"program"`
+}
+
+// PutExperienceRequest defines model for PutExperienceRequest.
+type PutExperienceRequest struct {
+	Awards      string `json:"awards"`
+	CompanyName string `json:"company_name"`
+
+	// DateFrom start date of work
+	DateFrom openapi_types.Date `json:"date_from"`
+
+	// DateTo end date of work
+	DateTo openapi_types.Date `json:"date_to"`
+
+	// Functional job responsibilities
+	Functional string `json:"functional"`
+	Position   string `json:"position"`
+}
+
+// PutNumberSequenceRequest defines model for PutNumberSequenceRequest.
+type PutNumberSequenceRequest struct {
+	// Padding minimal number of digits, the number is padded with zeros
+	Padding uint `json:"padding"`
+
+	// Prefix text before the number
+	Prefix string `json:"prefix"`
+
+	// Suffix text after the number
+	Suffix string `json:"suffix"`
+	Title  string `json:"title"`
+
+	// Types order types numbered by the sequence of the order numbers, they are moved from other sequences
+	Types []OrderType `json:"types,omitempty"`
+
+	// WithYear the year is added after the prefix
+	WithYear bool `json:"with_year"`
+
+	// YearlyReset the numbers start from 1 every year
+	YearlyReset bool `json:"yearly_reset"`
+}
+
+// PutOnboardingTemplateRequest defines model for PutOnboardingTemplateRequest.
+type PutOnboardingTemplateRequest struct {
+	// DepartmentID template for the department only (empty - any department)
+	DepartmentID *uint64                  `json:"department_id,omitempty"`
+	Items        []OnboardingTemplateItem `json:"items"`
+
+	// PositionID template for the position only (empty - any position)
+	PositionID *uint64 `json:"position_id,omitempty"`
+	Title      string  `json:"title"`
+}
+
+// PutOrderRequest defines model for PutOrderRequest.
+type PutOrderRequest struct {
+	// ContractID contract of the hiring
+	ContractID *uint64 `json:"contract_id,omitempty"`
+
+	// EffectiveDate day of the event
+	EffectiveDate openapi_types.Date `json:"effective_date"`
+
+	// Title the title of the type by default
+	Title *string `json:"title,omitempty"`
+
+	// Type personnel event the order is issued on
+	Type   OrderType `json:"type"`
+	UserID uint64    `json:"user_id"`
+
+	// VacationID vacation of the employee
+	VacationID *uint64 `json:"vacation_id,omitempty"`
+}
+
+// PutPassportRequest defines model for PutPassportRequest.
+type PutPassportRequest struct {
+	IssuedBy   string             `json:"issued_by"`
+	IssuedDate openapi_types.Date `json:"issued_date"`
+
+	// Number series and number without spaces: 10 digits for internal, 9 digits for external, 5-20 capital latin letters and digits for foreigners passport
+	Number string       `json:"number"`
+	Type   PassportType `json:"type"`
+}
 
-// PhoneNumbers defines model for PhoneNumbers.
-type PhoneNumbers map[string]PhoneNumber
+// PutProbationResultRequest defines model for PutProbationResultRequest.
+type PutProbationResultRequest struct {
+	Comment *string `json:"comment,omitempty"`
 
-// PositionTrack defines model for PositionTrack.
-type PositionTrack = []PositionTrackItem
+	// ExtendedTo required for the extended probation period, must be later than its current end
+	ExtendedTo *openapi_types.Date `json:"extended_to,omitempty"`
 
-// PositionTrackItem defines model for PositionTrackItem.
-type PositionTrackItem struct {
-	DateFrom   openapi_types.Date  `json:"date_from"`
-	DateTo     *openapi_types.Date `json:"date_to,omitempty"`
-	PositionID uint64              `json:"position_id"`
+	// Outcome outcome of the probation period
+	Outcome ProbationOutcome `json:"outcome"`
 }
 
-// PutContractRequest defines model for PutContractRequest.
-type PutContractRequest struct {
-	DateFrom        openapi_types.Date  `json:"date_from"`
-	DateTo          *openapi_types.Date `json:"date_to,omitempty"`
-	Number          string              `json:"number"`
-	Type            ContractType        `json:"type"`
-	ProbationPeriod *uint               `json:"probation_period,omitempty"`
-	WorkTypeID      uint64              `json:"work_type_id"`
+// PutRelativeRequest defines model for PutRelativeRequest.
+type PutRelativeRequest struct {
+	DateOfBirth openapi_types.Date `json:"date_of_birth"`
+
+	// Disabled the relative (e.g. the child) is disabled
+	Disabled   *bool   `json:"disabled,omitempty"`
+	FirstName  string  `json:"first_name"`
+	LastName   string  `json:"last_name"`
+	MiddleName *string `json:"middle_name,omitempty"`
+
+	// Type spouse, child, parent or other family member or dependent
+	Type RelationType `json:"type"`
 }
 
-// PutEducationRequest defines model for PutEducationRequest.
-type PutEducationRequest struct {
-	// DateFrom date of commencement of studies
+// PutStaffUnitRequest defines model for PutStaffUnitRequest.
+type PutStaffUnitRequest struct {
+	// DateFrom date from which the staff unit (version) is effective
 	DateFrom openapi_types.Date `json:"date_from"`
+	Grade    string             `json:"grade"`
 
-	// DateTo date of graduation
-	DateTo            openapi_types.Date `json:"date_to"`
-	IssuedInstitution string             `json:"issued_institution"`
-	Number            string             `json:"number"`
-	Program           string             `json:"program"`
+	// Quantity number of planned slots
+	Quantity uint `json:"quantity"`
+
+	// Rate workload rate of a slot
+	Rate PutStaffUnitRequestRate `json:"rate"`
+
+	// SalaryMax upper bound of the salary band
+	SalaryMax uint64 `json:"salary_max"`
+
+	// SalaryMin lower bound of the salary band
+	SalaryMin uint64 `json:"salary_min"`
 }
 
-// PutPassportRequest defines model for PutPassportRequest.
-type PutPassportRequest struct {
-	IssuedBy   string             `json:"issued_by"`
-	IssuedDate openapi_types.Date `json:"issued_date"`
-	Number     string             `json:"number"`
-	Type       PassportType       `json:"type"`
+// PutStaffUnitRequestRate workload rate of a slot
+type PutStaffUnitRequestRate float64
+
+// PutTimesheetDayRequest defines model for PutTimesheetDayRequest.
+type PutTimesheetDayRequest struct {
+	// Code letter code of the day of form T-13: Я - attendance, В - weekend or holiday, ОТ - annual paid leave, Б - sick leave, К - business trip, ДО - unpaid leave, У - study leave, ПР - truancy, НН - absence for unknown reasons
+	Code TimesheetCode `json:"code"`
+
+	// Hours worked hours of the day
+	Hours *int `json:"hours,omitempty"`
+
+	// Note reason of the correction
+	Note string `json:"note"`
 }
 
 // PutTrainingRequest defines model for PutTrainingRequest.
@@ -514,20 +2001,26 @@ type PutTrainingRequest struct {
 
 // PutUserRequest defines model for PutUserRequest.
 type PutUserRequest struct {
-	DateOfBirth         openapi_types.Date  `json:"date_of_birth"`
-	DepartmentID        uint64              `json:"department_id"`
-	Email               openapi_types.Email `json:"email"`
-	FirstName           string              `json:"first_name"`
-	ForeignLanguages    []string            `json:"foreign_languages,omitempty"`
-	Gender              Gender              `json:"gender"`
-	Grade               string              `json:"grade"`
-	Insurance           Insurance           `json:"insurance"`
-	LastName            string              `json:"last_name"`
-	MiddleName          string              `json:"middle_name"`
-	Military            *Military           `json:"military,omitempty"`
-	Nationality         string              `json:"nationality"`
-	PhoneNumbers        PhoneNumbers        `json:"phone_numbers"`
-	PlaceOfBirth        string              `json:"place_of_birth"`
+	DateOfBirth      openapi_types.Date  `json:"date_of_birth"`
+	DepartmentID     uint64              `json:"department_id"`
+	Email            openapi_types.Email `json:"email"`
+	FirstName        string              `json:"first_name"`
+	ForeignLanguages []string            `json:"foreign_languages,omitempty"`
+	Gender           Gender              `json:"gender"`
+	Grade            string              `json:"grade"`
+	Insurance        Insurance           `json:"insurance"`
+	LastName         string              `json:"last_name"`
+	MiddleName       string              `json:"middle_name"`
+	Military         *Military           `json:"military,omitempty"`
+	Nationality      string              `json:"nationality"`
+
+	// PersonnelNumber personnel number, kept if absent
+	PersonnelNumber *string      `json:"personnel_number,omitempty"`
+	PhoneNumbers    PhoneNumbers `json:"phone_numbers"`
+	PlaceOfBirth    string       `json:"place_of_birth"`
+
+	// PositionDate the date the department and the position are held from, today by default
+	PositionDate        *openapi_types.Date `json:"position_date,omitempty"`
 	PositionID          uint64              `json:"position_id"`
 	PositionTrack       PositionTrack       `json:"position_track"`
 	RegistrationAddress string              `json:"registration_address"`
@@ -537,21 +2030,75 @@ type PutUserRequest struct {
 	WorkingModel        *WorkingModel       `json:"working_model,omitempty"`
 }
 
+// PutVacancyRequest defines model for PutVacancyRequest.
+type PutVacancyRequest struct {
+	DepartmentID uint64  `json:"department_id"`
+	Description  *string `json:"description,omitempty"`
+	PositionID   uint64  `json:"position_id"`
+
+	// Status open vacancies accept new candidates
+	Status VacancyStatus `json:"status"`
+	Title  string        `json:"title"`
+}
+
 // PutVacationRequest defines model for PutVacationRequest.
 type PutVacationRequest struct {
 	DateFrom openapi_types.Date `json:"date_from"`
 	DateTo   openapi_types.Date `json:"date_to"`
 }
 
+// PutVacationRequestRequest defines model for PutVacationRequestRequest.
+type PutVacationRequestRequest struct {
+	Comment  *string            `json:"comment,omitempty"`
+	DateFrom openapi_types.Date `json:"date_from"`
+	DateTo   openapi_types.Date `json:"date_to"`
+}
+
+// PutVacationScheduleItemRequest defines model for PutVacationScheduleItemRequest.
+type PutVacationScheduleItemRequest struct {
+	DateFrom openapi_types.Date `json:"date_from"`
+	DateTo   openapi_types.Date `json:"date_to"`
+}
+
 // PutVisaRequest defines model for PutVisaRequest.
 type PutVisaRequest struct {
-	IssuedState   string             `json:"issued_state"`
+	IssuedState string `json:"issued_state"`
+
+	// Number 5-20 capital latin letters and digits, 9 digits for the Russian visa in the foreigners passport
 	Number        string             `json:"number"`
 	NumberEntries VisaNumberEntries  `json:"number_entries"`
 	ValidFrom     openapi_types.Date `json:"valid_from"`
 	ValidTo       openapi_types.Date `json:"valid_to"`
 }
 
+// PutWorkPermitRequest defines model for PutWorkPermitRequest.
+type PutWorkPermitRequest struct {
+	Number    string             `json:"number"`
+	ValidFrom openapi_types.Date `json:"valid_from"`
+	ValidTo   openapi_types.Date `json:"valid_to"`
+}
+
+// RelationType spouse, child, parent or other family member or dependent
+type RelationType string
+
+// Relative family member or dependent of the employee
+type Relative struct {
+	DateOfBirth openapi_types.Date `json:"date_of_birth"`
+
+	// Disabled the relative (e.g. the child) is disabled
+	Disabled  bool   `json:"disabled"`
+	FirstName string `json:"first_name"`
+
+	// HasScan the certificate of the relation is uploaded: the marriage certificate of the spouse, the birth certificate of the child
+	HasScan    bool   `json:"has_scan"`
+	ID         uint64 `json:"id"`
+	LastName   string `json:"last_name"`
+	MiddleName string `json:"middle_name"`
+
+	// Type spouse, child, parent or other family member or dependent
+	Type RelationType `json:"type"`
+}
+
 // Scan defines model for Scan.
 type Scan struct {
 	Description *string `json:"description,omitempty"`
@@ -569,10 +2116,127 @@ type Scan struct {
 // ScanType defines model for ScanType.
 type ScanType string
 
+// SequenceEntity kind of the numbers allocated by the sequence
+type SequenceEntity string
+
+// StaffUnit defines model for StaffUnit.
+type StaffUnit struct {
+	// DateFrom date from which the staff unit (version) is effective
+	DateFrom openapi_types.Date `json:"date_from"`
+
+	// DateTo is absent if the staff unit (version) is effective indefinitely
+	DateTo       *openapi_types.Date `json:"date_to,omitempty"`
+	Department   string              `json:"department"`
+	DepartmentID uint64              `json:"department_id"`
+	Grade        string              `json:"grade"`
+	ID           uint64              `json:"id"`
+	Position     string              `json:"position"`
+	PositionID   uint64              `json:"position_id"`
+
+	// Quantity number of planned slots
+	Quantity uint `json:"quantity"`
+
+	// Rate workload rate of a slot
+	Rate StaffUnitRate `json:"rate"`
+
+	// SalaryMax upper bound of the salary band
+	SalaryMax uint64 `json:"salary_max"`
+
+	// SalaryMin lower bound of the salary band
+	SalaryMin uint64 `json:"salary_min"`
+}
+
+// StaffUnitRate workload rate of a slot
+type StaffUnitRate float64
+
+// StaffingVacancy defines model for StaffingVacancy.
+type StaffingVacancy struct {
+	Department   string `json:"department"`
+	DepartmentID uint64 `json:"department_id"`
+
+	// Filled slots held by employees, by the salary rates of the employees
+	Filled     float64 `json:"filled"`
+	Position   string  `json:"position"`
+	PositionID uint64  `json:"position_id"`
+
+	// Slots planned slots, by the rates of the staff units
+	Slots float64 `json:"slots"`
+
+	// Vacant free slots
+	Vacant float64 `json:"vacant"`
+}
+
 // Taxpayer defines model for Taxpayer.
 type Taxpayer struct {
-	HasScan *bool  `json:"has_scan,omitempty"`
-	Number  string `json:"number"`
+	HasScan *bool `json:"has_scan,omitempty"`
+
+	// Number personal taxpayer number (INN), 12 digits with the control digits
+	Number string `json:"number"`
+}
+
+// Termination defines model for Termination.
+type Termination struct {
+	// Date the last working day
+	Date openapi_types.Date `json:"date"`
+
+	// Reason legal reason of the termination (part 1 of article 77 of the Labour Code):
+	// * agreement - agreement of the parties (clause 1)
+	// * contract_expiry - expiry of the employment contract (clause 2)
+	// * employee_initiative - at the employee's initiative (clause 3)
+	// * employer_initiative - at the employer's initiative (clause 4)
+	// * transfer - transfer to another employer (clause 5)
+	// * circumstances - circumstances beyond the control of the parties (clause 10)
+	Reason TerminationReason `json:"reason"`
+}
+
+// TerminationReason legal reason of the termination (part 1 of article 77 of the Labour Code):
+// * agreement - agreement of the parties (clause 1)
+// * contract_expiry - expiry of the employment contract (clause 2)
+// * employee_initiative - at the employee's initiative (clause 3)
+// * employer_initiative - at the employer's initiative (clause 4)
+// * transfer - transfer to another employer (clause 5)
+// * circumstances - circumstances beyond the control of the parties (clause 10)
+type TerminationReason string
+
+// Timesheet monthly timesheet of the department (form T-13)
+type Timesheet struct {
+	Department   string         `json:"department"`
+	DepartmentID uint64         `json:"department_id"`
+	Month        int            `json:"month"`
+	Rows         []TimesheetRow `json:"rows"`
+	Year         int            `json:"year"`
+}
+
+// TimesheetCode letter code of the day of form T-13: Я - attendance, В - weekend or holiday, ОТ - annual paid leave, Б - sick leave, К - business trip, ДО - unpaid leave, У - study leave, ПР - truancy, НН - absence for unknown reasons
+type TimesheetCode string
+
+// TimesheetDay day of the employee in the timesheet, the code is empty out of the employment
+type TimesheetDay struct {
+	// Code letter code of the day of form T-13, empty out of the employment
+	Code string `json:"code"`
+
+	// Corrected the day is corrected manually
+	Corrected bool               `json:"corrected"`
+	Date      openapi_types.Date `json:"date"`
+	Hours     int                `json:"hours"`
+
+	// Note reason of the manual correction
+	Note *string `json:"note,omitempty"`
+}
+
+// TimesheetFormat response format, xlsx and pdf are returned as attachments
+type TimesheetFormat string
+
+// TimesheetRow days of the employee in the timesheet
+type TimesheetRow struct {
+	Days        []TimesheetDay `json:"days"`
+	FirstName   string         `json:"first_name"`
+	LastName    string         `json:"last_name"`
+	MiddleName  string         `json:"middle_name"`
+	Position    string         `json:"position"`
+	UserID      uint64         `json:"user_id"`
+	WorkedDays  int            `json:"worked_days"`
+	WorkedHours int            `json:"worked_hours"`
 }
 
 // Training defines model for Training.
@@ -593,6 +2257,9 @@ type Training struct {
 
 // UserFinance defines model for UserFinance.
 type UserFinance struct {
+	// Currency ISO 4217 currency code
+	Currency *string `json:"currency,omitempty"`
+
 	// IncomeTax tax paid to revenue service per month, in their minor unit form
 	IncomeTax *int64 `json:"income_tax,omitempty"`
 
@@ -603,6 +2270,28 @@ type UserFinance struct {
 	SocialSecurityTax *int64 `json:"social_security_tax,omitempty"`
 }
 
+// Vacancy opening for the position of the department
+type Vacancy struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// Department department title
+	Department   string `json:"department"`
+	DepartmentID uint64 `json:"department_id"`
+	Description  string `json:"description"`
+	ID           uint64 `json:"id"`
+
+	// Position position title
+	Position   string `json:"position"`
+	PositionID uint64 `json:"position_id"`
+
+	// Status open vacancies accept new candidates
+	Status VacancyStatus `json:"status"`
+	Title  string        `json:"title"`
+}
+
+// VacancyStatus open vacancies accept new candidates
+type VacancyStatus string
+
 // Vacation defines model for Vacation.
 type Vacation struct {
 	DateFrom openapi_types.Date `json:"date_from"`
@@ -610,11 +2299,97 @@ type Vacation struct {
 	ID       uint64             `json:"id"`
 }
 
+// VacationBalance balance of the annual paid leave on the current date
+type VacationBalance struct {
+	// Accrued days accrued since the employment
+	Accrued float64 `json:"accrued"`
+
+	// AnnualDays annual paid leave days of the current contract
+	AnnualDays uint `json:"annual_days"`
+
+	// Balance days left
+	Balance float64 `json:"balance"`
+
+	// Used days of the vacations, public holidays excluded
+	Used uint `json:"used"`
+}
+
+// VacationSchedule yearly vacation schedule
+type VacationSchedule struct {
+	ApprovedAt *time.Time             `json:"approved_at,omitempty"`
+	Items      []VacationScheduleItem `json:"items"`
+	LockedAt   *time.Time             `json:"locked_at,omitempty"`
+
+	// Status draft - vacations are planned, approved - the schedule is approved
+	// (it's returned to draft to be changed), locked - the schedule is final
+	Status VacationScheduleStatus `json:"status"`
+	Year   int                    `json:"year"`
+}
+
+// VacationScheduleItem planned vacation of the employee
+type VacationScheduleItem struct {
+	DateFrom     openapi_types.Date `json:"date_from"`
+	DateTo       openapi_types.Date `json:"date_to"`
+	Department   string             `json:"department"`
+	DepartmentID uint64             `json:"department_id"`
+	FirstName    string             `json:"first_name"`
+	ID           uint64             `json:"id"`
+	LastName     string             `json:"last_name"`
+	MiddleName   string             `json:"middle_name"`
+	Position     string             `json:"position"`
+	UserID       uint64             `json:"user_id"`
+}
+
+// VacationScheduleStatus draft - vacations are planned, approved - the schedule is approved
+// (it's returned to draft to be changed), locked - the schedule is final
+type VacationScheduleStatus string
+
+// VacationRequest request of the employee for a vacation
+type VacationRequest struct {
+	Comment   string             `json:"comment"`
+	DateFrom  openapi_types.Date `json:"date_from"`
+	DateTo    openapi_types.Date `json:"date_to"`
+	DecidedAt *time.Time         `json:"decided_at,omitempty"`
+
+	// DecidedBy user who approved or rejected the request finally
+	DecidedBy       *uint64    `json:"decided_by,omitempty"`
+	DecisionComment string     `json:"decision_comment"`
+	FirstName       string     `json:"first_name"`
+	HeadApprovedAt  *time.Time `json:"head_approved_at,omitempty"`
+
+	// HeadID department head deciding the request before HR
+	HeadID     *uint64 `json:"head_id,omitempty"`
+	ID         uint64  `json:"id"`
+	LastName   string  `json:"last_name"`
+	MiddleName string  `json:"middle_name"`
+
+	// Status draft - the request is prepared by the employee, pending - the request awaits the decision
+	// of the department head (if any) and then HR, approved - the vacation is added,
+	// rejected - the request is rejected by the head or HR, cancelled - the request is cancelled by the employee
+	Status      VacationRequestStatus `json:"status"`
+	SubmittedAt *time.Time            `json:"submitted_at,omitempty"`
+	UserID      uint64                `json:"user_id"`
+
+	// VacationID vacation added for the approved request
+	VacationID *uint64 `json:"vacation_id,omitempty"`
+}
+
+// VacationRequestAction submit - send the draft for approval, cancel - withdraw the draft or pending request,
+// approve - approve the pending request (by the head it's passed to HR), reject - reject the pending request
+type VacationRequestAction string
+
+// VacationRequestStatus draft - the request is prepared by the employee, pending - the request awaits the decision
+// of the department head (if any) and then HR, approved - the vacation is added,
+// rejected - the request is rejected by the head or HR, cancelled - the request is cancelled by the employee
+type VacationRequestStatus string
+
 // Visa defines model for Visa.
 type Visa struct {
-	HasScan       bool               `json:"has_scan"`
-	ID            uint64             `json:"id"`
-	IssuedState   string             `json:"issued_state"`
+	HasScan     bool   `json:"has_scan"`
+	ID          uint64 `json:"id"`
+	IssuedState string `json:"issued_state"`
+
+	// Number 5-20 capital latin letters and digits, 9 digits for the Russian visa in the foreigners passport
 	Number        string             `json:"number"`
 	NumberEntries VisaNumberEntries  `json:"number_entries"`
 	ValidFrom     openapi_types.Date `json:"valid_from"`
@@ -624,12 +2399,45 @@ type Visa struct {
 // VisaNumberEntries defines model for VisaNumberEntries.
 type VisaNumberEntries string
 
+// Warnings the operation is done, the warnings are to be checked
+type Warnings struct {
+	Warnings []string `json:"warnings"`
+}
+
+// WorkLength length of work experience, a month is 30 days and a year is 12 months
+type WorkLength struct {
+	Days   int `json:"days"`
+	Months int `json:"months"`
+	Years  int `json:"years"`
+}
+
 // WorkPermit defines model for WorkPermit.
 type WorkPermit struct {
 	HasScan   *bool              `json:"has_scan,omitempty"`
 	Number    string             `json:"number"`
 	ValidFrom openapi_types.Date `json:"valid_from"`
 	ValidTo   openapi_types.Date `json:"valid_to"`
+
+	// Version версия разрешения на работу (ETag в GET /users/{user_id}/work_permits/{work_permit_id})
+	Version *int64 `json:"version,omitempty"`
+}
+
+// WorkPermitCheck defines model for WorkPermitCheck.
+type WorkPermitCheck struct {
+	// Uncovered periods of the contracts not covered by the work permits
+	Uncovered []Period `json:"uncovered"`
+
+	// Valid all the contracts are covered by the work permits
+	Valid bool `json:"valid"`
+}
+
+// WorkPermitRecord defines model for WorkPermitRecord.
+type WorkPermitRecord struct {
+	HasScan   bool               `json:"has_scan"`
+	ID        uint64             `json:"id"`
+	Number    string             `json:"number"`
+	ValidFrom openapi_types.Date `json:"valid_from"`
+	ValidTo   openapi_types.Date `json:"valid_to"`
 }
 
 // WorkingModel defines model for WorkingModel.
@@ -654,17 +2462,32 @@ type ListUsersParams struct {
 
 	// SortBy type of sort result by
 	SortBy *ListUsersParamsSortBy `form:"sort_by,omitempty" json:"sort_by,omitempty"`
+
+	// IncludeTerminated include former employees
+	IncludeTerminated *bool `form:"include_terminated,omitempty" json:"include_terminated,omitempty"`
 }
 
 // ListUsersParamsSortBy defines parameters for ListUsers.
 type ListUsersParamsSortBy string
 
+// AddUserParams defines parameters for AddUser.
+type AddUserParams struct {
+	// Force ignore probable duplicates of the users
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
 // GetUserParams defines parameters for GetUser.
 type GetUserParams struct {
 	// Expanded whether to return detailed data on passports, contracts, vacations, etc. along with user data (default - no)
 	Expanded *bool `form:"expanded,omitempty" json:"expanded,omitempty"`
 }
 
+// PutUserParams defines parameters for PutUser.
+type PutUserParams struct {
+	// Force ignore probable duplicates of the users
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
 // GetPassportParams defines parameters for GetPassport.
 type GetPassportParams struct {
 	// Expanded whether to return visas along with user passport data (default - no)
@@ -679,6 +2502,224 @@ type UploadScanMultipartBody struct {
 	Type        ScanType           `json:"type"`
 }
 
+// ListPositionHoldersParams defines parameters for ListPositionHolders.
+type ListPositionHoldersParams struct {
+	// Date date on which the position was held (all holders are returned if absent)
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+}
+
+// ListStaffUnitsParams defines parameters for ListStaffUnits.
+type ListStaffUnitsParams struct {
+	// Date date of the staffing table (default - today)
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+
+	// DepartmentID return only the department staffing
+	DepartmentID *uint64 `form:"department_id,omitempty" json:"department_id,omitempty"`
+}
+
+// ListStaffingVacanciesParams defines parameters for ListStaffingVacancies.
+type ListStaffingVacanciesParams struct {
+	// Date date of the staffing table (default - today)
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+
+	// DepartmentID return only the department staffing
+	DepartmentID *uint64 `form:"department_id,omitempty" json:"department_id,omitempty"`
+}
+
+// BenefitCostReportParams defines parameters for BenefitCostReport.
+type BenefitCostReportParams struct {
+	// DateFrom first day of the period
+	DateFrom openapi_types.Date `form:"date_from" json:"date_from"`
+
+	// DateTo last day of the period
+	DateTo openapi_types.Date `form:"date_to" json:"date_to"`
+
+	// DepartmentID return only the department costs
+	DepartmentID *uint64 `form:"department_id,omitempty" json:"department_id,omitempty"`
+}
+
+// ListMilitaryLiableParams defines parameters for ListMilitaryLiable.
+type ListMilitaryLiableParams struct {
+	// Category category of validity
+	Category *string `form:"category,omitempty" json:"category,omitempty"`
+	Rank     *string `form:"rank,omitempty" json:"rank,omitempty"`
+
+	// Format response format
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+
+	// IncludeTerminated include former employees
+	IncludeTerminated *bool `form:"include_terminated,omitempty" json:"include_terminated,omitempty"`
+}
+
+// ListMilitaryNotificationsParams defines parameters for ListMilitaryNotifications.
+type ListMilitaryNotificationsParams struct {
+	// DateFrom first day of the period
+	DateFrom openapi_types.Date `form:"date_from" json:"date_from"`
+
+	// DateTo last day of the period
+	DateTo openapi_types.Date `form:"date_to" json:"date_to"`
+
+	// Format response format
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ListOverdueOnboardingParams defines parameters for ListOverdueOnboarding.
+type ListOverdueOnboardingParams struct {
+	// DepartmentID return only the department employees
+	DepartmentID *uint64 `form:"department_id,omitempty" json:"department_id,omitempty"`
+}
+
+// AddVacationParams defines parameters for AddVacation.
+type AddVacationParams struct {
+	// Force ignore warnings (e.g. the vacation exceeds the balance or the limit of absent employees)
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// PutVacationParams defines parameters for PutVacation.
+type PutVacationParams struct {
+	// Force ignore warnings (e.g. the vacation exceeds the balance or the limit of absent employees)
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// ListVacationBalancesParams defines parameters for ListVacationBalances.
+type ListVacationBalancesParams struct {
+	// DepartmentID return only the department employees
+	DepartmentID *uint64 `form:"department_id,omitempty" json:"department_id,omitempty"`
+
+	// Format response format
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// CountCalendarDaysParams defines parameters for CountCalendarDays.
+type CountCalendarDaysParams struct {
+	// DateFrom first day of the period
+	DateFrom openapi_types.Date `form:"date_from" json:"date_from"`
+
+	// DateTo last day of the period
+	DateTo openapi_types.Date `form:"date_to" json:"date_to"`
+}
+
+// ImportCalendarMultipartBody defines parameters for ImportCalendar.
+type ImportCalendarMultipartBody struct {
+	// File xmlcalendar.ru XML file (.xml) or CSV file (.csv) with date,type,title columns
+	File openapi_types.File `json:"file"`
+}
+
+// PatchVacationRequestParams defines parameters for PatchVacationRequest.
+type PatchVacationRequestParams struct {
+	// Force ignore warnings on the approval (e.g. the vacation exceeds the balance or the limit of absent employees)
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// ListAllVacationsParams defines parameters for ListAllVacations.
+type ListAllVacationsParams struct {
+	// DepartmentID return only the department employees
+	DepartmentID *uint64 `form:"department_id,omitempty" json:"department_id,omitempty"`
+
+	// DateFrom first day of the period
+	DateFrom openapi_types.Date `form:"date_from" json:"date_from"`
+
+	// DateTo last day of the period
+	DateTo openapi_types.Date `form:"date_to" json:"date_to"`
+}
+
+// GetVacationScheduleParams defines parameters for GetVacationSchedule.
+type GetVacationScheduleParams struct {
+	// DepartmentID return only the department employees
+	DepartmentID *uint64 `form:"department_id,omitempty" json:"department_id,omitempty"`
+}
+
+// ListAllAbsencesParams defines parameters for ListAllAbsences.
+type ListAllAbsencesParams struct {
+	// DepartmentID return only the department employees
+	DepartmentID *uint64 `form:"department_id,omitempty" json:"department_id,omitempty"`
+
+	// Type return only the absences of the type
+	Type *AbsenceType `form:"type,omitempty" json:"type,omitempty"`
+
+	// DateFrom first day of the period
+	DateFrom openapi_types.Date `form:"date_from" json:"date_from"`
+
+	// DateTo last day of the period
+	DateTo openapi_types.Date `form:"date_to" json:"date_to"`
+
+	// Format response format
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetAbsenceReportParams defines parameters for GetAbsenceReport.
+type GetAbsenceReportParams struct {
+	// DepartmentID return only the department employees
+	DepartmentID *uint64 `form:"department_id,omitempty" json:"department_id,omitempty"`
+
+	// DateFrom first day of the period
+	DateFrom openapi_types.Date `form:"date_from" json:"date_from"`
+
+	// DateTo last day of the period
+	DateTo openapi_types.Date `form:"date_to" json:"date_to"`
+
+	// Format response format
+	Format *ExportFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// GetTimesheetParams defines parameters for GetTimesheet.
+type GetTimesheetParams struct {
+	DepartmentID uint64 `form:"department_id" json:"department_id"`
+
+	// Format response format
+	Format *TimesheetFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ListCandidatesParams defines parameters for ListCandidates.
+type ListCandidatesParams struct {
+	VacancyID *uint64         `form:"vacancy_id,omitempty" json:"vacancy_id,omitempty"`
+	Stage     *CandidateStage `form:"stage,omitempty" json:"stage,omitempty"`
+}
+
+// UploadCandidateCVMultipartBody defines parameters for UploadCandidateCV.
+type UploadCandidateCVMultipartBody struct {
+	// File CV file, up to 20 MB
+	File openapi_types.File `json:"file"`
+}
+
+// HireCandidateParams defines parameters for HireCandidate.
+type HireCandidateParams struct {
+	// Force ignore probable duplicates of the users
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// ListVacanciesParams defines parameters for ListVacancies.
+type ListVacanciesParams struct {
+	Status *VacancyStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// ListProbationsParams defines parameters for ListProbations.
+type ListProbationsParams struct {
+	// Days number of days from today, 30 by default
+	Days *uint `form:"days,omitempty" json:"days,omitempty"`
+}
+
+// GetContractTermsParams defines parameters for GetContractTerms.
+type GetContractTermsParams struct {
+	// Date today by default
+	Date *openapi_types.Date `form:"date,omitempty" json:"date,omitempty"`
+}
+
+// ListOrdersParams defines parameters for ListOrders.
+type ListOrdersParams struct {
+	// Type return only the orders of the type
+	Type *OrderType `form:"type,omitempty" json:"type,omitempty"`
+
+	// Status return only the orders in the status
+	Status *OrderStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// UserID return only the orders of the employee
+	UserID *uint64 `form:"user_id,omitempty" json:"user_id,omitempty"`
+
+	// Year return only the orders signed in the year
+	Year *int `form:"year,omitempty" json:"year,omitempty"`
+}
+
 // LoginJSONRequestBody defines body for Login for application/json ContentType.
 type LoginJSONRequestBody = LoginRequest
 
@@ -753,3 +2794,147 @@ type PatchVacationJSONRequestBody = PatchVacationRequest
 
 // PutVacationJSONRequestBody defines body for PutVacation for application/json ContentType.
 type PutVacationJSONRequestBody = PutVacationRequest
+
+// AddStaffUnitJSONRequestBody defines body for AddStaffUnit for application/json ContentType.
+type AddStaffUnitJSONRequestBody = AddStaffUnitRequest
+
+// PutStaffUnitJSONRequestBody defines body for PutStaffUnit for application/json ContentType.
+type PutStaffUnitJSONRequestBody = PutStaffUnitRequest
+
+// AddCompensationJSONRequestBody defines body for AddCompensation for application/json ContentType.
+type AddCompensationJSONRequestBody = AddCompensationRequest
+
+// PutCompensationJSONRequestBody defines body for PutCompensation for application/json ContentType.
+type PutCompensationJSONRequestBody = PutCompensationRequest
+
+// AddIndexationRunJSONRequestBody defines body for AddIndexationRun for application/json ContentType.
+type AddIndexationRunJSONRequestBody = IndexationRunRequest
+
+// PreviewIndexationRunJSONRequestBody defines body for PreviewIndexationRun for application/json ContentType.
+type PreviewIndexationRunJSONRequestBody = IndexationRunRequest
+
+// AddExperienceJSONRequestBody defines body for AddExperience for application/json ContentType.
+type AddExperienceJSONRequestBody = AddExperienceRequest
+
+// PutExperienceJSONRequestBody defines body for PutExperience for application/json ContentType.
+type PutExperienceJSONRequestBody = PutExperienceRequest
+
+// AddBenefitJSONRequestBody defines body for AddBenefit for application/json ContentType.
+type AddBenefitJSONRequestBody = AddBenefitRequest
+
+// PutBenefitJSONRequestBody defines body for PutBenefit for application/json ContentType.
+type PutBenefitJSONRequestBody = PutBenefitRequest
+
+// AddBenefitEnrolmentJSONRequestBody defines body for AddBenefitEnrolment for application/json ContentType.
+type AddBenefitEnrolmentJSONRequestBody = AddBenefitEnrolmentRequest
+
+// PutBenefitEnrolmentJSONRequestBody defines body for PutBenefitEnrolment for application/json ContentType.
+type PutBenefitEnrolmentJSONRequestBody = PutBenefitEnrolmentRequest
+
+// PutMilitaryJSONRequestBody defines body for PutMilitary for application/json ContentType.
+type PutMilitaryJSONRequestBody = Military
+
+// AddWorkPermitJSONRequestBody defines body for AddWorkPermit for application/json ContentType.
+type AddWorkPermitJSONRequestBody = AddWorkPermitRequest
+
+// PutWorkPermitJSONRequestBody defines body for PutWorkPermit for application/json ContentType.
+type PutWorkPermitJSONRequestBody = PutWorkPermitRequest
+
+// TerminateUserJSONRequestBody defines body for TerminateUser for application/json ContentType.
+type TerminateUserJSONRequestBody = Termination
+
+// AddOnboardingTemplateJSONRequestBody defines body for AddOnboardingTemplate for application/json ContentType.
+type AddOnboardingTemplateJSONRequestBody = AddOnboardingTemplateRequest
+
+// PutOnboardingTemplateJSONRequestBody defines body for PutOnboardingTemplate for application/json ContentType.
+type PutOnboardingTemplateJSONRequestBody = PutOnboardingTemplateRequest
+
+// PatchOnboardingItemJSONRequestBody defines body for PatchOnboardingItem for application/json ContentType.
+type PatchOnboardingItemJSONRequestBody = PatchOnboardingItemRequest
+
+// PutCalendarDaysJSONRequestBody defines body for PutCalendarDays for application/json ContentType.
+type PutCalendarDaysJSONRequestBody = PutCalendarDaysRequest
+
+// ImportCalendarMultipartRequestBody defines body for ImportCalendar for multipart/form-data ContentType.
+type ImportCalendarMultipartRequestBody ImportCalendarMultipartBody
+
+// PatchVacationScheduleJSONRequestBody defines body for PatchVacationSchedule for application/json ContentType.
+type PatchVacationScheduleJSONRequestBody = PatchVacationScheduleRequest
+
+// AddVacationScheduleItemJSONRequestBody defines body for AddVacationScheduleItem for application/json ContentType.
+type AddVacationScheduleItemJSONRequestBody = AddVacationScheduleItemRequest
+
+// PutVacationScheduleItemJSONRequestBody defines body for PutVacationScheduleItem for application/json ContentType.
+type PutVacationScheduleItemJSONRequestBody = PutVacationScheduleItemRequest
+
+// AddVacationRequestJSONRequestBody defines body for AddVacationRequest for application/json ContentType.
+type AddVacationRequestJSONRequestBody = AddVacationRequestRequest
+
+// PatchVacationRequestJSONRequestBody defines body for PatchVacationRequest for application/json ContentType.
+type PatchVacationRequestJSONRequestBody = PatchVacationRequestRequest
+
+// PutVacationRequestJSONRequestBody defines body for PutVacationRequest for application/json ContentType.
+type PutVacationRequestJSONRequestBody = PutVacationRequestRequest
+
+// AddAbsenceJSONRequestBody defines body for AddAbsence for application/json ContentType.
+type AddAbsenceJSONRequestBody = AddAbsenceRequest
+
+// PutAbsenceJSONRequestBody defines body for PutAbsence for application/json ContentType.
+type PutAbsenceJSONRequestBody = PutAbsenceRequest
+
+// PutTimesheetDayJSONRequestBody defines body for PutTimesheetDay for application/json ContentType.
+type PutTimesheetDayJSONRequestBody = PutTimesheetDayRequest
+
+// AddRelativeJSONRequestBody defines body for AddRelative for application/json ContentType.
+type AddRelativeJSONRequestBody = AddRelativeRequest
+
+// PutRelativeJSONRequestBody defines body for PutRelative for application/json ContentType.
+type PutRelativeJSONRequestBody = PutRelativeRequest
+
+// AddCandidateJSONRequestBody defines body for AddCandidate for application/json ContentType.
+type AddCandidateJSONRequestBody = AddCandidateRequest
+
+// PutCandidateJSONRequestBody defines body for PutCandidate for application/json ContentType.
+type PutCandidateJSONRequestBody = PutCandidateRequest
+
+// UploadCandidateCVMultipartRequestBody defines body for UploadCandidateCV for multipart/form-data ContentType.
+type UploadCandidateCVMultipartRequestBody UploadCandidateCVMultipartBody
+
+// HireCandidateJSONRequestBody defines body for HireCandidate for application/json ContentType.
+type HireCandidateJSONRequestBody = HireCandidateRequest
+
+// AddCandidateNoteJSONRequestBody defines body for AddCandidateNote for application/json ContentType.
+type AddCandidateNoteJSONRequestBody = AddCandidateNoteRequest
+
+// PutCandidateStageJSONRequestBody defines body for PutCandidateStage for application/json ContentType.
+type PutCandidateStageJSONRequestBody = PutCandidateStageRequest
+
+// AddVacancyJSONRequestBody defines body for AddVacancy for application/json ContentType.
+type AddVacancyJSONRequestBody = AddVacancyRequest
+
+// PutVacancyJSONRequestBody defines body for PutVacancy for application/json ContentType.
+type PutVacancyJSONRequestBody = PutVacancyRequest
+
+// PutProbationResultJSONRequestBody defines body for PutProbationResult for application/json ContentType.
+type PutProbationResultJSONRequestBody = PutProbationResultRequest
+
+// AddAmendmentJSONRequestBody defines body for AddAmendment for application/json ContentType.
+type AddAmendmentJSONRequestBody = AddAmendmentRequest
+
+// PutAmendmentJSONRequestBody defines body for PutAmendment for application/json ContentType.
+type PutAmendmentJSONRequestBody = PutAmendmentRequest
+
+// AddOrderJSONRequestBody defines body for AddOrder for application/json ContentType.
+type AddOrderJSONRequestBody = AddOrderRequest
+
+// PatchOrderJSONRequestBody defines body for PatchOrder for application/json ContentType.
+type PatchOrderJSONRequestBody = PatchOrderRequest
+
+// PutOrderJSONRequestBody defines body for PutOrder for application/json ContentType.
+type PutOrderJSONRequestBody = PutOrderRequest
+
+// AddNumberSequenceJSONRequestBody defines body for AddNumberSequence for application/json ContentType.
+type AddNumberSequenceJSONRequestBody = AddNumberSequenceRequest
+
+// PutNumberSequenceJSONRequestBody defines body for PutNumberSequence for application/json ContentType.
+type PutNumberSequenceJSONRequestBody = PutNumberSequenceRequest
@@ -57,6 +57,12 @@ func TestAddContractRequest_Validate(t *testing.T) {
 
 	var c AddContractJSONRequestBody
 	rightJSONTEstHelper(context.TODO(), t, contractJSON, &c)
+
+	c = AddContractJSONRequestBody{}
+	rightJSONTEstHelper(context.TODO(), t, `{"date_from": "2018-01-17", "type": "permanent"}`, &c)
+
+	c = AddContractJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, `{"date_from": "2018-01-17", "type": "permanent", "number": " "}`, &c)
 }
 
 func TestAddUserRequest_Validate(t *testing.T) {
@@ -169,6 +175,9 @@ func TestInsurance_Validate(t *testing.T) {
 
 	var i Insurance
 	rightJSONTEstHelper(context.TODO(), t, insuranceJSON, &i)
+
+	var i2 Insurance
+	wrongJSONTEstHelper(context.TODO(), t, `{"number": "08336732478"}`, &i2)
 }
 
 func TestLoginRequest_Validate(t *testing.T) {
@@ -210,29 +219,50 @@ func TestAddPassportRequest_Validate(t *testing.T) {
 
 	var p AddPassportJSONRequestBody
 	rightJSONTEstHelper(context.TODO(), t, passportJSON, &p)
+
+	var p2 AddPassportJSONRequestBody
+	wrongJSONTEstHelper(context.TODO(), t, `{
+		"number": "33592222",
+		"issued_date": "2016-05-15",
+		"issued_by": "ГУ МВД России по г. Москве",
+		"type": "internal"
+	  }`, &p2)
 }
 
 func TestTaxpayer_Validate(t *testing.T) {
 	tests := []struct {
 		name       string
 		jsonString string
+		wantErr    bool
 	}{
 		{
-			name: "positive #1",
+			name: "positive",
 			jsonString: `{
 				"number": "500100732259"
 			}`,
 		},
 		{
-			name: "positive #2",
+			name: "negative: legal entity number",
 			jsonString: `{
 				"number": "1181111110"
 			}`,
+			wantErr: true,
+		},
+		{
+			name: "negative: wrong control digit",
+			jsonString: `{
+				"number": "500100732258"
+			}`,
+			wantErr: true,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			var tp Taxpayer
+			if tt.wantErr {
+				wrongJSONTEstHelper(context.TODO(), t, tt.jsonString, &tp)
+				return
+			}
 			rightJSONTEstHelper(context.TODO(), t, tt.jsonString, &tp)
 		})
 	}
@@ -252,6 +282,50 @@ func TestAddTrainingRequest_Validate(t *testing.T) {
 	rightJSONTEstHelper(context.TODO(), t, trainingJSON, &tr)
 }
 
+func TestAddExperienceRequest_Validate(t *testing.T) {
+	experienceJSON := `{
+		"company_name": "Horns and Hooves",
+		"position": "Software engineer",
+		"functional": "Backend development",
+		"awards": "",
+		"date_from": "2018-03-01",
+		"date_to": "2021-08-31"
+	  }`
+
+	var ex AddExperienceJSONRequestBody
+	rightJSONTEstHelper(context.TODO(), t, experienceJSON, &ex)
+
+	experienceJSON = `{
+		"company_name": "Horns and Hooves",
+		"position": "Software engineer",
+		"functional": "Backend development",
+		"awards": "",
+		"date_from": "2021-08-31",
+		"date_to": "2018-03-01"
+	  }`
+
+	ex = AddExperienceJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, experienceJSON, &ex)
+}
+
+func TestTerminateUserRequest_Validate(t *testing.T) {
+	terminationJSON := `{
+		"date": "2024-02-29",
+		"reason": "employee_initiative"
+	  }`
+
+	var tm TerminateUserJSONRequestBody
+	rightJSONTEstHelper(context.TODO(), t, terminationJSON, &tm)
+
+	terminationJSON = `{
+		"date": "2024-02-29",
+		"reason": "bored"
+	  }`
+
+	tm = TerminateUserJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, terminationJSON, &tm)
+}
+
 func TestAddVisaRequest_Validate(t *testing.T) {
 	visaJSON := `{
 		"number": "33592222",
@@ -346,3 +420,343 @@ func TestPatchContractRequest_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestAddCompensationRequest_Validate(t *testing.T) {
+	compensationJSON := `{
+		"contract_id": 127,
+		"date_from": "2023-01-17",
+		"salary": 15000000,
+		"social_security_tax": 4500000,
+		"income_tax": 1950000,
+		"currency": "RUB"
+	  }`
+
+	var c AddCompensationJSONRequestBody
+	rightJSONTEstHelper(context.TODO(), t, compensationJSON, &c)
+
+	compensationJSON2 := `{
+		"contract_id": 127,
+		"date_from": "2023-01-17",
+		"salary": 15000000,
+		"social_security_tax": 4500000,
+		"income_tax": 1950000,
+		"currency": "rub"
+	  }`
+	var c2 AddCompensationJSONRequestBody
+	wrongJSONTEstHelper(context.TODO(), t, compensationJSON2, &c2)
+}
+
+func TestIndexationRunRequest_Validate(t *testing.T) {
+	for _, percents := range []string{"5", "4.5", "0.01", "0.29", "2.75", "99.99", "100"} {
+		var r IndexationRunRequest
+		rightJSONTEstHelper(context.TODO(), t, `{"percents": `+percents+`, "date_from": "2024-03-01"}`, &r)
+	}
+	for _, percents := range []string{"0", "4.555", "-1", "100.5"} {
+		var r IndexationRunRequest
+		wrongJSONTEstHelper(context.TODO(), t, `{"percents": `+percents+`, "date_from": "2024-03-01"}`, &r)
+	}
+}
+
+func TestAddOnboardingTemplateRequest_Validate(t *testing.T) {
+	templateJSON := `{
+		"title": "Общий чек-лист нового сотрудника",
+		"items": [
+			{"title": "СНИЛС", "scan_type": "insurance", "due_days": 7},
+			{"title": "Получить пропуск", "due_days": 1}
+		]
+	  }`
+
+	var tm AddOnboardingTemplateJSONRequestBody
+	rightJSONTEstHelper(context.TODO(), t, templateJSON, &tm)
+
+	templateJSON = `{
+		"title": "Общий чек-лист нового сотрудника",
+		"items": [
+			{"title": "СНИЛС", "scan_type": "snils", "due_days": 7}
+		]
+	  }`
+
+	tm = AddOnboardingTemplateJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, templateJSON, &tm)
+
+	templateJSON = `{
+		"title": "Пустой чек-лист",
+		"items": []
+	  }`
+
+	tm = AddOnboardingTemplateJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, templateJSON, &tm)
+}
+
+func TestPutCalendarDaysRequest_Validate(t *testing.T) {
+	daysJSON := `{
+		"days": [
+			{"date": "2024-01-01", "type": "holiday", "title": "Новогодние каникулы"},
+			{"date": "2024-04-27", "type": "working", "title": "перенос с 29.04"},
+			{"date": "2024-04-29", "type": "day_off"}
+		]
+	  }`
+
+	var req PutCalendarDaysJSONRequestBody
+	rightJSONTEstHelper(context.TODO(), t, daysJSON, &req)
+
+	daysJSON = `{
+		"days": [
+			{"date": "2024-01-01", "type": "weekend"}
+		]
+	  }`
+
+	req = PutCalendarDaysJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, daysJSON, &req)
+}
+
+func TestAddVacationScheduleItemRequest_Validate(t *testing.T) {
+	itemJSON := `{
+		"user_id": 1,
+		"date_from": "2024-08-05",
+		"date_to": "2024-08-18"
+	  }`
+
+	var item AddVacationScheduleItemJSONRequestBody
+	rightJSONTEstHelper(context.TODO(), t, itemJSON, &item)
+
+	itemJSON = `{
+		"user_id": 1,
+		"date_from": "2024-08-18",
+		"date_to": "2024-08-05"
+	  }`
+
+	item = AddVacationScheduleItemJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, itemJSON, &item)
+}
+
+func TestPatchVacationRequestRequest_Validate(t *testing.T) {
+	patchJSON := `{
+		"action": "reject",
+		"comment": "the release week"
+	  }`
+
+	var patch PatchVacationRequestJSONRequestBody
+	rightJSONTEstHelper(context.TODO(), t, patchJSON, &patch)
+
+	patchJSON = `{
+		"action": "approved"
+	  }`
+
+	patch = PatchVacationRequestJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, patchJSON, &patch)
+}
+
+func TestAddAbsenceRequest_Validate(t *testing.T) {
+	absenceJSON := `{
+		"type": "sick",
+		"date_from": "2024-01-15",
+		"date_to": "2024-01-19",
+		"document_number": "910123456789"
+	  }`
+
+	var absence AddAbsenceJSONRequestBody
+	rightJSONTEstHelper(context.TODO(), t, absenceJSON, &absence)
+
+	absenceJSON = `{
+		"type": "vacation",
+		"date_from": "2024-01-15",
+		"date_to": "2024-01-19"
+	  }`
+
+	absence = AddAbsenceJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, absenceJSON, &absence)
+
+	absenceJSON = `{
+		"type": "study",
+		"date_from": "2024-01-19",
+		"date_to": "2024-01-15"
+	  }`
+
+	absence = AddAbsenceJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, absenceJSON, &absence)
+}
+
+func TestPutTimesheetDayRequest_Validate(t *testing.T) {
+	dayJSON := `{
+		"code": "Я",
+		"hours": 4,
+		"note": "left early by the head's permission"
+	  }`
+
+	var day PutTimesheetDayJSONRequestBody
+	rightJSONTEstHelper(context.TODO(), t, dayJSON, &day)
+
+	dayJSON = `{
+		"code": "Р",
+		"note": "maternity leave"
+	  }`
+
+	day = PutTimesheetDayJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, dayJSON, &day)
+
+	dayJSON = `{
+		"code": "Я",
+		"hours": 25,
+		"note": "overtime"
+	  }`
+
+	day = PutTimesheetDayJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, dayJSON, &day)
+
+	dayJSON = `{
+		"code": "НН",
+		"note": ""
+	  }`
+
+	day = PutTimesheetDayJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, dayJSON, &day)
+}
+
+func TestAddRelativeRequest_Validate(t *testing.T) {
+	relativeJSON := `{
+		"type": "child",
+		"last_name": "Иванов",
+		"first_name": "Артём",
+		"date_of_birth": "2013-09-03"
+	  }`
+
+	var relative AddRelativeJSONRequestBody
+	rightJSONTEstHelper(context.TODO(), t, relativeJSON, &relative)
+
+	relativeJSON = `{
+		"type": "cousin",
+		"last_name": "Иванов",
+		"first_name": "Артём",
+		"date_of_birth": "2013-09-03"
+	  }`
+
+	relative = AddRelativeJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, relativeJSON, &relative)
+
+	relativeJSON = `{
+		"type": "spouse",
+		"last_name": "",
+		"first_name": "Мария",
+		"date_of_birth": "1990-04-12"
+	  }`
+
+	relative = AddRelativeJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, relativeJSON, &relative)
+}
+
+func TestAddCandidateRequest_Validate(t *testing.T) {
+	candidateJSON := `{
+		"vacancy_id": 1,
+		"last_name": "Петров",
+		"first_name": "Пётр",
+		"email": "petrov@example.com",
+		"phone": "79161234567"
+	  }`
+
+	var candidate AddCandidateJSONRequestBody
+	rightJSONTEstHelper(context.TODO(), t, candidateJSON, &candidate)
+
+	candidateJSON = `{
+		"vacancy_id": 1,
+		"last_name": "Петров",
+		"first_name": "П",
+		"email": "petrov@example.com"
+	  }`
+
+	candidate = AddCandidateJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, candidateJSON, &candidate)
+
+	candidateJSON = `{
+		"vacancy_id": 0,
+		"last_name": "Петров",
+		"first_name": "Пётр"
+	  }`
+
+	candidate = AddCandidateJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, candidateJSON, &candidate)
+}
+
+func TestPutCandidateStageRequest_Validate(t *testing.T) {
+	var stage PutCandidateStageJSONRequestBody
+	rightJSONTEstHelper(context.TODO(), t, `{"stage": "offer"}`, &stage)
+
+	stage = PutCandidateStageJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, `{"stage": "probation"}`, &stage)
+}
+
+func TestPutProbationResultRequest_Validate(t *testing.T) {
+	var pr PutProbationResultJSONRequestBody
+	rightJSONTEstHelper(context.TODO(), t, `{"outcome": "passed", "comment": "Рекомендован к повышению"}`, &pr)
+
+	pr = PutProbationResultJSONRequestBody{}
+	rightJSONTEstHelper(context.TODO(), t, `{"outcome": "extended", "extended_to": "2024-04-30"}`, &pr)
+
+	pr = PutProbationResultJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, `{"outcome": "extended"}`, &pr)
+
+	pr = PutProbationResultJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, `{"outcome": "failed", "extended_to": "2024-04-30"}`, &pr)
+
+	pr = PutProbationResultJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, `{"outcome": "cancelled"}`, &pr)
+}
+
+func TestAddAmendmentRequest_Validate(t *testing.T) {
+	var a AddAmendmentJSONRequestBody
+	rightJSONTEstHelper(context.TODO(), t,
+		`{"number": "1", "date": "2024-02-20", "effective_from": "2024-03-01", "salary": 12000000}`, &a)
+
+	a = AddAmendmentJSONRequestBody{}
+	rightJSONTEstHelper(context.TODO(), t,
+		`{"number": "2", "date": "2024-02-20", "effective_from": "2024-03-01", "date_to": "2024-12-31"}`, &a)
+
+	a = AddAmendmentJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t,
+		`{"number": "3", "date": "2024-02-20", "effective_from": "2024-03-01", "date_to": "2024-02-29"}`, &a)
+
+	a = AddAmendmentJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t,
+		`{"number": " ", "date": "2024-02-20", "effective_from": "2024-03-01", "salary": -1}`, &a)
+}
+
+func TestPatchOrderRequest_Validate(t *testing.T) {
+	var p PatchOrderJSONRequestBody
+	rightJSONTEstHelper(context.TODO(), t, `{"action": "sign", "date": "2024-02-20"}`, &p)
+
+	p = PatchOrderJSONRequestBody{}
+	rightJSONTEstHelper(context.TODO(), t, `{"action": "cancel"}`, &p)
+
+	p = PatchOrderJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, `{"action": "cancel", "date": "2024-02-20"}`, &p)
+
+	p = PatchOrderJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t, `{"action": "approve"}`, &p)
+}
+
+func TestPutNumberSequenceRequest_Validate(t *testing.T) {
+	var s PutNumberSequenceJSONRequestBody
+	rightJSONTEstHelper(context.TODO(), t,
+		`{"title": "Номера договоров", "prefix": "ТД-", "with_year": true, "padding": 4, "yearly_reset": true}`, &s)
+
+	s = PutNumberSequenceJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t,
+		`{"title": "Номера договоров", "prefix": "", "with_year": false, "padding": 20, "yearly_reset": false}`, &s)
+}
+
+func TestAddNumberSequenceRequest_Validate(t *testing.T) {
+	var s AddNumberSequenceJSONRequestBody
+	rightJSONTEstHelper(context.TODO(), t,
+		`{"title": "Приказы об отпусках", "prefix": "", "suffix": "-о", "with_year": false, "padding": 0,
+		"yearly_reset": true, "types": ["vacation"]}`, &s)
+
+	s = AddNumberSequenceJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t,
+		`{"title": "Приказы", "prefix": "", "suffix": "", "with_year": false, "padding": 0,
+		"yearly_reset": true, "types": ["vacation", "vacation"]}`, &s)
+
+	s = AddNumberSequenceJSONRequestBody{}
+	wrongJSONTEstHelper(context.TODO(), t,
+		`{"title": "Приказы", "prefix": "", "suffix": "", "with_year": false, "padding": 0,
+		"yearly_reset": true, "types": ["leave"]}`, &s)
+}
@@ -3,6 +3,7 @@ package api
 
 import (
 	"context"
+	"time"
 
 	vld "github.com/muonsoft/validation"
 	"github.com/muonsoft/validation/it"
@@ -54,6 +55,11 @@ func (b AddUserJSONRequestBody) Validate(ctx context.Context, validator *vld.Val
 		vld.StringProperty("middle_name", b.MiddleName,
 			it.HasLengthBetween(2, 150)).
 			When(b.MiddleName != ""),
+		vld.When(b.PersonnelNumber != nil).
+			At(vld.PropertyName("personnel_number")).
+			Then(vld.NilString(b.PersonnelNumber,
+				it.IsNotBlank(),
+				it.HasMaxLength(50))),
 		vld.StringProperty("email", string(b.Email),
 			it.IsNotBlank(),
 			it.HasLengthBetween(5, 50)),
@@ -191,6 +197,11 @@ func (b PutUserJSONRequestBody) Validate(ctx context.Context, validator *vld.Val
 		vld.StringProperty("middle_name", b.MiddleName,
 			it.HasLengthBetween(2, 150)).
 			When(b.MiddleName != ""),
+		vld.When(b.PersonnelNumber != nil).
+			At(vld.PropertyName("personnel_number")).
+			Then(vld.NilString(b.PersonnelNumber,
+				it.IsNotBlank(),
+				it.HasMaxLength(50))),
 		vld.StringProperty("email", string(b.Email),
 			it.IsNotBlank(),
 			it.HasLengthBetween(5, 50)),
@@ -240,13 +251,19 @@ func (b PutUserJSONRequestBody) Validate(ctx context.Context, validator *vld.Val
 func (b AddContractJSONRequestBody) Validate(ctx context.Context, validator *vld.Validator) error {
 	return validator.Validate(
 		ctx,
-		vld.StringProperty("number", b.Number,
-			it.IsNotBlank(),
-			it.HasLengthBetween(2, 50)),
+		vld.When(b.Number != nil).
+			At(vld.PropertyName("number")).
+			Then(vld.NilString(b.Number,
+				it.IsNotBlank(),
+				it.HasLengthBetween(2, 50))),
 		vld.ComparableProperty[ContractType]("type",
 			b.Type,
 			it.IsNotBlankComparable[ContractType](),
 			it.IsOneOf[ContractType](Permanent, Temporary)),
+		vld.When(b.ExtraVacationDays != nil).
+			At(vld.PropertyName("extra_vacation_days")).
+			Then(vld.NilNumber[uint](b.ExtraVacationDays,
+				it.IsLessThanOrEqual[uint](365))),
 	)
 }
 
@@ -278,6 +295,135 @@ func (b PutContractJSONRequestBody) Validate(ctx context.Context, validator *vld
 			b.Type,
 			it.IsNotBlankComparable[ContractType](),
 			it.IsOneOf[ContractType](Permanent, Temporary)),
+		vld.When(b.ExtraVacationDays != nil).
+			At(vld.PropertyName("extra_vacation_days")).
+			Then(vld.NilNumber[uint](b.ExtraVacationDays,
+				it.IsLessThanOrEqual[uint](365))),
+	)
+}
+
+func (b PutNumberSequenceRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.StringProperty("title", b.Title,
+			it.IsNotBlank(),
+			it.HasMaxLength(200)),
+		vld.StringProperty("prefix", b.Prefix,
+			it.HasMaxLength(20)),
+		vld.StringProperty("suffix", b.Suffix,
+			it.HasMaxLength(20)),
+		vld.NumberProperty[uint]("padding", b.Padding,
+			it.IsLessThanOrEqual[uint](12)),
+		vld.EachComparableProperty[OrderType]("types", b.Types,
+			it.IsOneOf[OrderType](orderTypes...)),
+		vld.ComparablesProperty[OrderType]("types", b.Types,
+			it.HasUniqueValues[OrderType]()),
+	)
+}
+
+func (b AddNumberSequenceRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return PutNumberSequenceRequest(b).Validate(ctx, validator)
+}
+
+func (b AddAmendmentRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	var dateTo *time.Time
+	if b.DateTo != nil {
+		dateTo = &b.DateTo.Time
+	}
+	return validator.Validate(
+		ctx,
+		vld.StringProperty("number", b.Number,
+			it.IsNotBlank(),
+			it.HasLengthBetween(1, 50)),
+		vld.NilTimeProperty("date_to", dateTo,
+			it.IsLaterThanOrEqual(b.EffectiveFrom.Time)),
+		vld.When(b.Salary != nil).
+			At(vld.PropertyName("salary")).
+			Then(vld.NilNumber[int64](b.Salary,
+				it.IsPositive[int64]())),
+		vld.When(b.Description != nil).
+			At(vld.PropertyName("description")).
+			Then(vld.NilString(b.Description,
+				it.HasMaxLength(4000))),
+	)
+}
+
+func (b PutAmendmentRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return AddAmendmentRequest(b).Validate(ctx, validator)
+}
+
+func (p ListProbationsParams) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.When(p.Days != nil).
+			At(vld.PropertyName("days")).
+			Then(vld.NilNumber[uint](p.Days,
+				it.IsLessThanOrEqual[uint](365))),
+	)
+}
+
+func (b PutProbationResultRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	extended := b.Outcome == Extended
+	return validator.Validate(
+		ctx,
+		vld.ComparableProperty[ProbationOutcome]("outcome", b.Outcome,
+			it.IsOneOf[ProbationOutcome](Passed, Extended, Failed)),
+		vld.NilProperty("extended_to", b.ExtendedTo == nil,
+			it.IsNotNil().When(extended),
+			it.IsNil().When(!extended)),
+		vld.When(b.Comment != nil).
+			At(vld.PropertyName("comment")).
+			Then(vld.NilString(b.Comment,
+				it.HasMaxLength(1000))),
+	)
+}
+
+var orderTypes = []OrderType{
+	OrderTypeHiring,
+	OrderTypeTransfer,
+	OrderTypeVacation,
+	OrderTypeTermination,
+	OrderTypeOther,
+}
+
+func (p ListOrdersParams) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.When(p.Type != nil).
+			At(vld.PropertyName("type")).
+			Then(vld.NilComparable[OrderType](p.Type,
+				it.IsOneOf[OrderType](orderTypes...))),
+		vld.When(p.Status != nil).
+			At(vld.PropertyName("status")).
+			Then(vld.NilComparable[OrderStatus](p.Status,
+				it.IsOneOf[OrderStatus](OrderStatusDraft, OrderStatusSigned, OrderStatusCancelled))),
+	)
+}
+
+func (b AddOrderRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.ComparableProperty[OrderType]("type", b.Type,
+			it.IsOneOf[OrderType](orderTypes...)),
+		vld.NumberProperty[uint64]("user_id", b.UserID,
+			it.IsNotBlankNumber[uint64]()),
+		vld.When(b.Title != nil).
+			At(vld.PropertyName("title")).
+			Then(vld.NilString(b.Title, it.HasMaxLength(500))),
+	)
+}
+
+func (b PutOrderRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return AddOrderRequest(b).Validate(ctx, validator)
+}
+
+func (b PatchOrderRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.ComparableProperty[OrderAction]("action", b.Action,
+			it.IsOneOf[OrderAction](OrderActionSign, OrderActionCancel)),
+		vld.NilProperty("date", b.Date == nil,
+			it.IsNil().When(b.Action != OrderActionSign)),
 	)
 }
 
@@ -339,8 +485,7 @@ func (b AddPassportJSONRequestBody) Validate(ctx context.Context, validator *vld
 		vld.StringProperty("number",
 			b.Number,
 			it.IsNotBlank(),
-			it.HasLengthBetween(2, 50),
-			consistOnlyNumbersFormat()),
+			hasPassportNumberFormat(b.Type)),
 		vld.ComparableProperty[PassportType]("type",
 			b.Type,
 			it.IsNotBlankComparable[PassportType](),
@@ -352,6 +497,11 @@ func (b AddPassportJSONRequestBody) Validate(ctx context.Context, validator *vld
 }
 
 func (b PatchPassportJSONRequestBody) Validate(ctx context.Context, validator *vld.Validator) error {
+	// the number format depends on the type, it is checked only if the type is patched too
+	var passportType PassportType
+	if b.Type != nil {
+		passportType = *b.Type
+	}
 	return validator.Validate(
 		ctx,
 		vld.When(b.IssuedBy != nil).
@@ -364,7 +514,7 @@ func (b PatchPassportJSONRequestBody) Validate(ctx context.Context, validator *v
 			Then(vld.NilString(b.Number,
 				it.IsNotBlank(),
 				it.HasLengthBetween(2, 50),
-				consistOnlyNumbersFormat())),
+				hasPassportNumberFormat(passportType))),
 		vld.When(b.Type != nil).
 			At(vld.PropertyName("type")).
 			Then(vld.NilComparable(b.Type,
@@ -385,8 +535,7 @@ func (b PutPassportJSONRequestBody) Validate(ctx context.Context, validator *vld
 		vld.StringProperty("number",
 			b.Number,
 			it.IsNotBlank(),
-			it.HasLengthBetween(2, 50),
-			consistOnlyNumbersFormat()),
+			hasPassportNumberFormat(b.Type)),
 		vld.ComparableProperty[PassportType]("type",
 			b.Type,
 			it.IsNotBlankComparable[PassportType](),
@@ -405,7 +554,7 @@ func (b AddVisaJSONRequestBody) Validate(ctx context.Context, validator *vld.Val
 			it.HasLengthBetween(2, 50)),
 		vld.StringProperty("number", b.Number,
 			it.IsNotBlank(),
-			it.HasLengthBetween(2, 50)),
+			hasVisaNumberFormat()),
 		vld.ComparableProperty[VisaNumberEntries]("number_entries",
 			b.NumberEntries,
 			it.IsNotBlankComparable[VisaNumberEntries](),
@@ -428,7 +577,7 @@ func (b PatchVisaJSONRequestBody) Validate(ctx context.Context, validator *vld.V
 			At(vld.PropertyName("number")).
 			Then(vld.NilString(b.Number,
 				it.IsNotBlank(),
-				it.HasLengthBetween(2, 50))),
+				hasVisaNumberFormat())),
 		vld.When(b.NumberEntries != nil).
 			At(vld.PropertyName("number_entries")).
 			Then(vld.NilComparable(b.NumberEntries,
@@ -448,7 +597,7 @@ func (b PutVisaJSONRequestBody) Validate(ctx context.Context, validator *vld.Val
 			it.HasLengthBetween(2, 50)),
 		vld.StringProperty("number", b.Number,
 			it.IsNotBlank(),
-			it.HasLengthBetween(2, 50)),
+			hasVisaNumberFormat()),
 		vld.ComparableProperty[VisaNumberEntries]("number_entries",
 			b.NumberEntries,
 			it.IsNotBlankComparable[VisaNumberEntries](),
@@ -499,8 +648,40 @@ func (b PutTrainingJSONRequestBody) Validate(ctx context.Context, validator *vld
 	)
 }
 
+func (b AddExperienceJSONRequestBody) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.StringProperty("company_name", b.CompanyName,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.StringProperty("position", b.Position,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.TimeProperty("date_to", b.DateTo.Time,
+			it.IsLaterThanOrEqual(b.DateFrom.Time)),
+	)
+}
+
+func (b PutExperienceJSONRequestBody) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.StringProperty("company_name", b.CompanyName,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.StringProperty("position", b.Position,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.TimeProperty("date_to", b.DateTo.Time,
+			it.IsLaterThanOrEqual(b.DateFrom.Time)),
+	)
+}
+
 func (b AddVacationJSONRequestBody) Validate(ctx context.Context, validator *vld.Validator) error {
-	return validator.Validate(ctx)
+	return validator.Validate(
+		ctx,
+		vld.TimeProperty("date_to", b.DateTo.Time,
+			it.IsLaterThanOrEqual(b.DateFrom.Time)),
+	)
 }
 
 func (b PatchVacationJSONRequestBody) Validate(ctx context.Context, validator *vld.Validator) error {
@@ -508,7 +689,11 @@ func (b PatchVacationJSONRequestBody) Validate(ctx context.Context, validator *v
 }
 
 func (b PutVacationJSONRequestBody) Validate(ctx context.Context, validator *vld.Validator) error {
-	return validator.Validate(ctx)
+	return validator.Validate(
+		ctx,
+		vld.TimeProperty("date_to", b.DateTo.Time,
+			it.IsLaterThanOrEqual(b.DateFrom.Time)),
+	)
 }
 
 func (i Insurance) Validate(ctx context.Context, validator *vld.Validator) error {
@@ -560,7 +745,7 @@ func (tp Taxpayer) Validate(ctx context.Context, validator *vld.Validator) error
 		vld.StringProperty("number",
 			tp.Number,
 			it.IsNotBlank(),
-			it.HasLengthBetween(10, 12),
+			it.HasExactLength(12),
 			consistOnlyNumbersFormat(),
 			hasCorrectTaxpayerChecksum()),
 	)
@@ -578,9 +763,21 @@ func (wp WorkPermit) Validate(ctx context.Context, validator *vld.Validator) err
 			it.IsNotBlank(),
 			it.HasLengthBetween(2, 50),
 			consistOnlyNumbersFormat()), // TODO: unknown format, length
+		vld.TimeProperty("valid_to", wp.ValidTo.Time,
+			it.IsLaterThanOrEqual(wp.ValidFrom.Time)),
 	)
 }
 
+func (b AddWorkPermitRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return WorkPermit{Number: b.Number, ValidFrom: b.ValidFrom, ValidTo: b.ValidTo}.
+		Validate(ctx, validator)
+}
+
+func (b PutWorkPermitRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return WorkPermit{Number: b.Number, ValidFrom: b.ValidFrom, ValidTo: b.ValidTo}.
+		Validate(ctx, validator)
+}
+
 func (cp CheckKeyParams) Validate(ctx context.Context, validator *vld.Validator) error {
 	return validator.Validate(
 		ctx,
@@ -617,6 +814,9 @@ func (us UploadScanMultipartRequestBody) Validate(ctx context.Context, validator
 			us.Type,
 			it.IsNotBlankComparable[ScanType](),
 			it.IsOneOf[ScanType](
+				ScanTypeAbsence,
+				ScanTypeAmendment,
+				ScanTypeOrder,
 				ScanTypeBabyBirth,
 				ScanTypeBriefing,
 				ScanTypeContract,
@@ -632,3 +832,634 @@ func (us UploadScanMultipartRequestBody) Validate(ctx context.Context, validator
 				ScanTypeWorkPermit)),
 	)
 }
+
+func (b AddStaffUnitJSONRequestBody) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.NumberProperty[uint64]("department_id", b.DepartmentID, it.IsNotBlankNumber[uint64]()),
+		vld.NumberProperty[uint64]("position_id", b.PositionID, it.IsNotBlankNumber[uint64]()),
+		vld.NumberProperty[uint]("quantity", b.Quantity, it.IsGreaterThanOrEqual[uint](1)),
+		vld.ComparableProperty[AddStaffUnitRequestRate]("rate",
+			b.Rate,
+			it.IsOneOf[AddStaffUnitRequestRate](
+				AddStaffUnitRequestRateN025,
+				AddStaffUnitRequestRateN05,
+				AddStaffUnitRequestRateN075,
+				AddStaffUnitRequestRateN1)),
+		vld.StringProperty("grade", b.Grade,
+			it.IsNotBlank(),
+			it.HasLengthBetween(1, 10)),
+		vld.NumberProperty[uint64]("salary_max", b.SalaryMax,
+			it.IsGreaterThanOrEqual[uint64](b.SalaryMin)),
+	)
+}
+
+func (b PutStaffUnitJSONRequestBody) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.NumberProperty[uint]("quantity", b.Quantity, it.IsGreaterThanOrEqual[uint](1)),
+		vld.ComparableProperty[PutStaffUnitRequestRate]("rate",
+			b.Rate,
+			it.IsOneOf[PutStaffUnitRequestRate](
+				PutStaffUnitRequestRateN025,
+				PutStaffUnitRequestRateN05,
+				PutStaffUnitRequestRateN075,
+				PutStaffUnitRequestRateN1)),
+		vld.StringProperty("grade", b.Grade,
+			it.IsNotBlank(),
+			it.HasLengthBetween(1, 10)),
+		vld.NumberProperty[uint64]("salary_max", b.SalaryMax,
+			it.IsGreaterThanOrEqual[uint64](b.SalaryMin)),
+	)
+}
+
+func (b AddCompensationJSONRequestBody) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.NumberProperty[uint64]("contract_id", b.ContractID, it.IsNotBlankNumber[uint64]()),
+		vld.NumberProperty[int64]("salary", b.Salary, it.IsPositive[int64]()),
+		vld.NumberProperty[int64]("social_security_tax", b.SocialSecurityTax, it.IsPositiveOrZero[int64]()),
+		vld.NumberProperty[int64]("income_tax", b.IncomeTax, it.IsPositiveOrZero[int64]()),
+		vld.When(b.SalaryRate != nil).
+			At(vld.PropertyName("salary_rate")).
+			Then(vld.NilNumber[float64](b.SalaryRate,
+				it.IsPositive[float64]())),
+		vld.StringProperty("currency", b.Currency,
+			it.IsNotBlank(),
+			it.HasExactLength(3),
+			isCurrencyCode()),
+	)
+}
+
+func (b PutCompensationJSONRequestBody) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.NumberProperty[int64]("salary", b.Salary, it.IsPositive[int64]()),
+		vld.NumberProperty[int64]("social_security_tax", b.SocialSecurityTax, it.IsPositiveOrZero[int64]()),
+		vld.NumberProperty[int64]("income_tax", b.IncomeTax, it.IsPositiveOrZero[int64]()),
+		vld.When(b.SalaryRate != nil).
+			At(vld.PropertyName("salary_rate")).
+			Then(vld.NilNumber[float64](b.SalaryRate,
+				it.IsPositive[float64]())),
+		vld.StringProperty("currency", b.Currency,
+			it.IsNotBlank(),
+			it.HasExactLength(3),
+			isCurrencyCode()),
+	)
+}
+
+func (b IndexationRunRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.NumberProperty[float64]("percents", b.Percents,
+			it.IsBetween[float64](0.01, 100),
+			it.IsDivisibleByFloat[float64](0.01)),
+		vld.When(b.DepartmentID != nil).
+			At(vld.PropertyName("department_id")).
+			Then(vld.NilNumber[uint64](b.DepartmentID, it.IsNotBlankNumber[uint64]())),
+	)
+}
+
+func (b AddBenefitJSONRequestBody) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.StringProperty("title", b.Title,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.NumberProperty[int64]("cost", b.Cost, it.IsPositiveOrZero[int64]()),
+		vld.NumberProperty[int]("min_tenure_months", b.MinTenureMonths, it.IsPositiveOrZero[int]()),
+		vld.When(b.Grade != nil).
+			At(vld.PropertyName("grade")).
+			Then(vld.NilString(b.Grade,
+				it.IsNotBlank(),
+				it.HasLengthBetween(1, 10))),
+		vld.When(b.DepartmentID != nil).
+			At(vld.PropertyName("department_id")).
+			Then(vld.NilNumber[uint64](b.DepartmentID, it.IsNotBlankNumber[uint64]())),
+	)
+}
+
+func (b PutBenefitJSONRequestBody) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.StringProperty("title", b.Title,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.NumberProperty[int64]("cost", b.Cost, it.IsPositiveOrZero[int64]()),
+		vld.NumberProperty[int]("min_tenure_months", b.MinTenureMonths, it.IsPositiveOrZero[int]()),
+		vld.When(b.Grade != nil).
+			At(vld.PropertyName("grade")).
+			Then(vld.NilString(b.Grade,
+				it.IsNotBlank(),
+				it.HasLengthBetween(1, 10))),
+		vld.When(b.DepartmentID != nil).
+			At(vld.PropertyName("department_id")).
+			Then(vld.NilNumber[uint64](b.DepartmentID, it.IsNotBlankNumber[uint64]())),
+	)
+}
+
+func (b AddBenefitEnrolmentJSONRequestBody) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.NumberProperty[uint64]("benefit_id", b.BenefitID, it.IsNotBlankNumber[uint64]()),
+		vld.TimeProperty("date_to", b.DateTo.Time,
+			it.IsLaterThanOrEqual(b.DateFrom.Time)),
+	)
+}
+
+func (b PutBenefitEnrolmentJSONRequestBody) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.TimeProperty("date_to", b.DateTo.Time,
+			it.IsLaterThanOrEqual(b.DateFrom.Time)),
+	)
+}
+
+func (p BenefitCostReportParams) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.TimeProperty("date_to", p.DateTo.Time,
+			it.IsLaterThanOrEqual(p.DateFrom.Time)),
+	)
+}
+
+func (p ListMilitaryLiableParams) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.When(p.Format != nil).
+			At(vld.PropertyName("format")).
+			Then(vld.NilComparable[ExportFormat](p.Format,
+				it.IsOneOf[ExportFormat](Json, Csv, Xlsx))),
+	)
+}
+
+func (p ListMilitaryNotificationsParams) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.TimeProperty("date_to", p.DateTo.Time,
+			it.IsLaterThanOrEqual(p.DateFrom.Time)),
+		vld.When(p.Format != nil).
+			At(vld.PropertyName("format")).
+			Then(vld.NilComparable[ExportFormat](p.Format,
+				it.IsOneOf[ExportFormat](Json, Csv, Xlsx))),
+	)
+}
+
+func (t Termination) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.ComparableProperty[TerminationReason]("reason", t.Reason,
+			it.IsNotBlankComparable[TerminationReason](),
+			it.IsOneOf[TerminationReason](Agreement, ContractExpiry,
+				EmployeeInitiative, EmployerInitiative, Transfer, Circumstances)),
+	)
+}
+
+func (ti OnboardingTemplateItem) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.StringProperty("title", ti.Title,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.When(ti.ScanType != nil).
+			At(vld.PropertyName("scan_type")).
+			Then(vld.NilComparable[ScanType](ti.ScanType,
+				it.IsOneOf[ScanType](
+					ScanTypeAbsence,
+					ScanTypeAmendment,
+					ScanTypeOrder,
+					ScanTypeBabyBirth,
+					ScanTypeBriefing,
+					ScanTypeContract,
+					ScanTypeEducation,
+					ScanTypeInsurance,
+					ScanTypeMarriage,
+					ScanTypeMilitary,
+					ScanTypeOther,
+					ScanTypePassport,
+					ScanTypePersonalDataProcessing,
+					ScanTypeTaxpayer,
+					ScanTypeTraining,
+					ScanTypeWorkPermit))),
+	)
+}
+
+func (b AddOnboardingTemplateRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.StringProperty("title", b.Title,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.CountableProperty("items", len(b.Items), it.HasMinCount(1)),
+		vld.ValidSliceProperty[OnboardingTemplateItem]("items", b.Items),
+	)
+}
+
+func (b PutOnboardingTemplateRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return AddOnboardingTemplateRequest(b).Validate(ctx, validator)
+}
+
+func (d CalendarDay) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.ComparableProperty[CalendarDayType]("type", d.Type,
+			it.IsOneOf[CalendarDayType](
+				CalendarDayTypeDayOff,
+				CalendarDayTypeHoliday,
+				CalendarDayTypeShortened,
+				CalendarDayTypeWorking)),
+		vld.When(d.Title != nil).
+			At(vld.PropertyName("title")).
+			Then(vld.NilString(d.Title,
+				it.HasMaxLength(100))),
+	)
+}
+
+func (b PutCalendarDaysRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.ValidSliceProperty[CalendarDay]("days", b.Days),
+	)
+}
+
+func (p CountCalendarDaysParams) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.TimeProperty("date_to", p.DateTo.Time,
+			it.IsLaterThanOrEqual(p.DateFrom.Time)),
+	)
+}
+
+func (p ListAllVacationsParams) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.TimeProperty("date_to", p.DateTo.Time,
+			it.IsLaterThanOrEqual(p.DateFrom.Time)),
+	)
+}
+
+func (b PatchVacationScheduleRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.ComparableProperty[VacationScheduleStatus]("status", b.Status,
+			it.IsOneOf[VacationScheduleStatus](
+				VacationScheduleStatusDraft,
+				VacationScheduleStatusApproved,
+				VacationScheduleStatusLocked)),
+	)
+}
+
+func (b AddVacationScheduleItemRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.TimeProperty("date_to", b.DateTo.Time,
+			it.IsLaterThanOrEqual(b.DateFrom.Time)),
+	)
+}
+
+func (b PutVacationScheduleItemRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.TimeProperty("date_to", b.DateTo.Time,
+			it.IsLaterThanOrEqual(b.DateFrom.Time)),
+	)
+}
+
+func (b AddVacationRequestRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.TimeProperty("date_to", b.DateTo.Time,
+			it.IsLaterThanOrEqual(b.DateFrom.Time)),
+		vld.When(b.Comment != nil).
+			At(vld.PropertyName("comment")).
+			Then(vld.NilString(b.Comment, it.HasMaxLength(500))),
+	)
+}
+
+func (b PutVacationRequestRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.TimeProperty("date_to", b.DateTo.Time,
+			it.IsLaterThanOrEqual(b.DateFrom.Time)),
+		vld.When(b.Comment != nil).
+			At(vld.PropertyName("comment")).
+			Then(vld.NilString(b.Comment, it.HasMaxLength(500))),
+	)
+}
+
+func (b PatchVacationRequestRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.ComparableProperty[VacationRequestAction]("action", b.Action,
+			it.IsOneOf[VacationRequestAction](
+				VacationRequestActionSubmit,
+				VacationRequestActionCancel,
+				VacationRequestActionApprove,
+				VacationRequestActionReject)),
+		vld.When(b.Comment != nil).
+			At(vld.PropertyName("comment")).
+			Then(vld.NilString(b.Comment, it.HasMaxLength(500))),
+	)
+}
+
+func (b AddAbsenceRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.ComparableProperty[AbsenceType]("type", b.Type,
+			it.IsOneOf[AbsenceType](Sick, Unpaid, BusinessTrip, Study)),
+		vld.TimeProperty("date_to", b.DateTo.Time,
+			it.IsLaterThanOrEqual(b.DateFrom.Time)),
+		vld.When(b.DocumentNumber != nil).
+			At(vld.PropertyName("document_number")).
+			Then(vld.NilString(b.DocumentNumber, it.HasMaxLength(50))),
+		vld.When(b.Comment != nil).
+			At(vld.PropertyName("comment")).
+			Then(vld.NilString(b.Comment, it.HasMaxLength(500))),
+	)
+}
+
+func (b PutAbsenceRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.ComparableProperty[AbsenceType]("type", b.Type,
+			it.IsOneOf[AbsenceType](Sick, Unpaid, BusinessTrip, Study)),
+		vld.TimeProperty("date_to", b.DateTo.Time,
+			it.IsLaterThanOrEqual(b.DateFrom.Time)),
+		vld.When(b.DocumentNumber != nil).
+			At(vld.PropertyName("document_number")).
+			Then(vld.NilString(b.DocumentNumber, it.HasMaxLength(50))),
+		vld.When(b.Comment != nil).
+			At(vld.PropertyName("comment")).
+			Then(vld.NilString(b.Comment, it.HasMaxLength(500))),
+	)
+}
+
+func (p ListAllAbsencesParams) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.When(p.Type != nil).
+			At(vld.PropertyName("type")).
+			Then(vld.NilComparable[AbsenceType](p.Type,
+				it.IsOneOf[AbsenceType](Sick, Unpaid, BusinessTrip, Study))),
+		vld.TimeProperty("date_to", p.DateTo.Time,
+			it.IsLaterThanOrEqual(p.DateFrom.Time)),
+		vld.When(p.Format != nil).
+			At(vld.PropertyName("format")).
+			Then(vld.NilComparable[ExportFormat](p.Format,
+				it.IsOneOf[ExportFormat](Json, Csv, Xlsx))),
+	)
+}
+
+func (p GetAbsenceReportParams) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.TimeProperty("date_to", p.DateTo.Time,
+			it.IsLaterThanOrEqual(p.DateFrom.Time)),
+		vld.When(p.Format != nil).
+			At(vld.PropertyName("format")).
+			Then(vld.NilComparable[ExportFormat](p.Format,
+				it.IsOneOf[ExportFormat](Json, Csv, Xlsx))),
+	)
+}
+
+func (b PutTimesheetDayRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.ComparableProperty[TimesheetCode]("code", b.Code,
+			it.IsOneOf[TimesheetCode](
+				TimesheetCodeWork,
+				TimesheetCodeWeekend,
+				TimesheetCodeVacation,
+				TimesheetCodeSick,
+				TimesheetCodeBusinessTrip,
+				TimesheetCodeUnpaid,
+				TimesheetCodeStudy,
+				TimesheetCodeTruancy,
+				TimesheetCodeUnknown)),
+		vld.When(b.Hours != nil).
+			At(vld.PropertyName("hours")).
+			Then(vld.NilNumber[int](b.Hours,
+				it.IsBetween[int](0, 24))),
+		vld.StringProperty("note", b.Note,
+			it.IsNotBlank(),
+			it.HasMaxLength(500)),
+	)
+}
+
+func (p GetTimesheetParams) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.When(p.Format != nil).
+			At(vld.PropertyName("format")).
+			Then(vld.NilComparable[TimesheetFormat](p.Format,
+				it.IsOneOf[TimesheetFormat](TimesheetFormatJson, TimesheetFormatXlsx, TimesheetFormatPdf))),
+	)
+}
+
+func (b AddRelativeRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.ComparableProperty[RelationType]("type", b.Type,
+			it.IsOneOf[RelationType](
+				RelationTypeSpouse,
+				RelationTypeChild,
+				RelationTypeParent,
+				RelationTypeOther)),
+		vld.StringProperty("first_name", b.FirstName,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.StringProperty("last_name", b.LastName,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.When(b.MiddleName != nil).
+			At(vld.PropertyName("middle_name")).
+			Then(vld.NilString(b.MiddleName, it.HasMaxLength(150))),
+	)
+}
+
+func (b PutRelativeRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.ComparableProperty[RelationType]("type", b.Type,
+			it.IsOneOf[RelationType](
+				RelationTypeSpouse,
+				RelationTypeChild,
+				RelationTypeParent,
+				RelationTypeOther)),
+		vld.StringProperty("first_name", b.FirstName,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.StringProperty("last_name", b.LastName,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.When(b.MiddleName != nil).
+			At(vld.PropertyName("middle_name")).
+			Then(vld.NilString(b.MiddleName, it.HasMaxLength(150))),
+	)
+}
+
+func (p ListVacanciesParams) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.When(p.Status != nil).
+			At(vld.PropertyName("status")).
+			Then(vld.NilComparable[VacancyStatus](p.Status,
+				it.IsOneOf[VacancyStatus](Open, Closed))),
+	)
+}
+
+func (b AddVacancyRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.StringProperty("title", b.Title,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.NumberProperty[uint64]("position_id", b.PositionID,
+			it.IsNotBlankNumber[uint64]()),
+		vld.NumberProperty[uint64]("department_id", b.DepartmentID,
+			it.IsNotBlankNumber[uint64]()),
+	)
+}
+
+func (b PutVacancyRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.StringProperty("title", b.Title,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.NumberProperty[uint64]("position_id", b.PositionID,
+			it.IsNotBlankNumber[uint64]()),
+		vld.NumberProperty[uint64]("department_id", b.DepartmentID,
+			it.IsNotBlankNumber[uint64]()),
+		vld.ComparableProperty[VacancyStatus]("status", b.Status,
+			it.IsOneOf[VacancyStatus](Open, Closed)),
+	)
+}
+
+var candidateStages = []CandidateStage{
+	CandidateStageNew,
+	CandidateStageScreening,
+	CandidateStageInterview,
+	CandidateStageOffer,
+	CandidateStageHired,
+	CandidateStageRejected,
+}
+
+func (p ListCandidatesParams) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.When(p.Stage != nil).
+			At(vld.PropertyName("stage")).
+			Then(vld.NilComparable[CandidateStage](p.Stage,
+				it.IsOneOf[CandidateStage](candidateStages...))),
+	)
+}
+
+func (b AddCandidateRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.NumberProperty[uint64]("vacancy_id", b.VacancyID,
+			it.IsNotBlankNumber[uint64]()),
+		vld.StringProperty("last_name", b.LastName,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.StringProperty("first_name", b.FirstName,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.When(b.MiddleName != nil).
+			At(vld.PropertyName("middle_name")).
+			Then(vld.NilString(b.MiddleName, it.HasMaxLength(150))),
+		vld.When(b.Email != nil).
+			At(vld.PropertyName("email")).
+			Then(vld.NilString((*string)(b.Email),
+				it.HasLengthBetween(5, 50))),
+		vld.When(b.Phone != nil).
+			At(vld.PropertyName("phone")).
+			Then(vld.NilString(b.Phone, it.HasMaxLength(15))),
+	)
+}
+
+func (b PutCandidateRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.NumberProperty[uint64]("vacancy_id", b.VacancyID,
+			it.IsNotBlankNumber[uint64]()),
+		vld.StringProperty("last_name", b.LastName,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.StringProperty("first_name", b.FirstName,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.When(b.MiddleName != nil).
+			At(vld.PropertyName("middle_name")).
+			Then(vld.NilString(b.MiddleName, it.HasMaxLength(150))),
+		vld.When(b.Email != nil).
+			At(vld.PropertyName("email")).
+			Then(vld.NilString((*string)(b.Email),
+				it.HasLengthBetween(5, 50))),
+		vld.When(b.Phone != nil).
+			At(vld.PropertyName("phone")).
+			Then(vld.NilString(b.Phone, it.HasMaxLength(15))),
+	)
+}
+
+func (b PutCandidateStageRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.ComparableProperty[CandidateStage]("stage", b.Stage,
+			it.IsOneOf[CandidateStage](candidateStages...)),
+	)
+}
+
+func (b AddCandidateNoteRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.StringProperty("text", b.Text,
+			it.IsNotBlank(),
+			it.HasMaxLength(4000)),
+	)
+}
+
+func (b HireCandidateRequest) Validate(ctx context.Context, validator *vld.Validator) error {
+	return validator.Validate(
+		ctx,
+		vld.StringProperty("email", string(b.Email),
+			it.IsNotBlank(),
+			it.HasLengthBetween(5, 50)),
+		vld.ValidMapProperty[PhoneNumber]("phone_numbers", b.PhoneNumbers),
+		vld.StringProperty("grade", b.Grade,
+			it.IsNotBlank(),
+			it.HasExactLength(1)),
+		vld.When(b.WorkingModel != nil).
+			At(vld.PropertyName("working_model")).
+			Then(vld.NilComparable(b.WorkingModel,
+				it.IsOneOf[WorkingModel](
+					Hybrid,
+					InOffice,
+					Remote))),
+		vld.ComparableProperty[Gender]("gender",
+			b.Gender,
+			it.IsNotBlankComparable[Gender](),
+			it.IsOneOf[Gender](Male, Female)),
+		vld.StringProperty("place_of_birth", b.PlaceOfBirth,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.StringProperty("registration_address", b.RegistrationAddress,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.StringProperty("residential_address", b.ResidentialAddress,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.StringProperty("nationality", b.Nationality,
+			it.IsNotBlank(),
+			it.HasLengthBetween(2, 150)),
+		vld.When(b.Military != nil).
+			At(vld.PropertyName("military")).
+			Then(vld.ValidProperty("military", b.Military)),
+		vld.ValidProperty("insurance", b.Insurance),
+		vld.ValidProperty("taxpayer", b.Taxpayer),
+		vld.When(b.WorkPermit != nil).
+			At(vld.PropertyName("work_permit")).
+			Then(vld.ValidProperty("work_permit", b.WorkPermit)),
+	)
+}
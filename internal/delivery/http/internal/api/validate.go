@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	vld "github.com/muonsoft/validation"
+
+	"github.com/Employee-s-file-cabinet/backend/pkg/docnumber"
 )
 
 var (
@@ -17,6 +19,15 @@ var (
 	ErrInvalidTaxpayerChecksum = vld.NewError(
 		"invalid taxpayer checksum",
 		"This value has not the correct checksum.")
+	ErrInvalidPassportNumber = vld.NewError(
+		"invalid passport number",
+		"This value is not a valid passport series and number of the passport type.")
+	ErrInvalidVisaNumber = vld.NewError(
+		"invalid visa number",
+		"This value must consist of 5 to 20 capital latin letters and digits.")
+	ErrInvalidCurrencyCode = vld.NewError(
+		"invalid currency code",
+		"This value is not a valid currency code.")
 )
 
 func isNotDigit(c rune) bool {
@@ -32,66 +43,48 @@ func consistOnlyNumbersFormat() vld.StringFuncConstraint {
 }
 
 func hasCorrectInsuranceChecksum() vld.StringFuncConstraint {
-	return vld.OfStringBy(checksumInsurance).
+	return vld.OfStringBy(docnumber.SNILS).
 		WithError(ErrInvalidInsuranceChecksum).
 		WithMessage(ErrInvalidInsuranceChecksum.Message())
 }
 
-func checksumInsurance(s string) bool {
-	var sum int
-	for i := 0; i < len(s)-2; i++ {
-		sum += (9 - i) * int(s[i]-'0')
-	}
-	mod := sum % 101
-	if mod%10 != int(s[len(s)-1]-'0') {
-		return false
-	}
-	if (mod/10)%100 != int(s[len(s)-2]-'0') {
-		return false
-	}
-	return true
-}
-
 func hasCorrectTaxpayerChecksum() vld.StringFuncConstraint {
-	return vld.OfStringBy(checksumTaxpayer).
+	return vld.OfStringBy(docnumber.PersonalINN).
 		WithError(ErrInvalidTaxpayerChecksum).
 		WithMessage(ErrInvalidTaxpayerChecksum.Message())
 }
 
-func checksumTaxpayer(s string) bool {
-	switch len(s) {
-	case 10:
-		n10 := ((2*int(s[0]-'0') + 4*int(s[1]-'0') +
-			10*int(s[2]-'0') + 3*int(s[3]-'0') +
-			5*int(s[4]-'0') + 9*int(s[5]-'0') +
-			4*int(s[6]-'0') + 6*int(s[7]-'0') +
-			8*int(s[8]-'0')) % 11) % 10
-		if int(s[9]-'0') != n10 {
-			return false
-		}
-	case 12:
-		n11 := ((7*int(s[0]-'0') + 2*int(s[1]-'0') +
-			4*int(s[2]-'0') + 10*int(s[3]-'0') +
-			3*int(s[4]-'0') + 5*int(s[5]-'0') +
-			9*int(s[6]-'0') + 4*int(s[7]-'0') +
-			6*int(s[8]-'0') + 8*int(s[9]-'0')) % 11) % 10
-		if int(s[10]-'0') != n11 {
-			return false
-		}
-		n12 := ((3*int(s[0]-'0') + 7*int(s[1]-'0') +
-			2*int(s[2]-'0') + 4*int(s[3]-'0') +
-			10*int(s[4]-'0') + 3*int(s[5]-'0') +
-			5*int(s[6]-'0') + 9*int(s[7]-'0') +
-			4*int(s[8]-'0') + 6*int(s[9]-'0') +
-			8*n11) % 11) % 10
-		if int(s[11]-'0') != n12 {
-			return false
-		}
-	default:
-		return false
+// hasPassportNumberFormat checks the series and number shape of the passport type,
+// the unknown types are checked by the type property itself.
+func hasPassportNumberFormat(t PassportType) vld.StringFuncConstraint {
+	valid := func(string) bool { return true }
+	switch t {
+	case Internal:
+		valid = docnumber.InternalPassport
+	case External:
+		valid = docnumber.ExternalPassport
+	case Foreigners:
+		valid = docnumber.ForeignPassport
 	}
+	return vld.OfStringBy(valid).
+		WithError(ErrInvalidPassportNumber).
+		WithMessage(ErrInvalidPassportNumber.Message())
+}
+
+func hasVisaNumberFormat() vld.StringFuncConstraint {
+	return vld.OfStringBy(docnumber.Visa).
+		WithError(ErrInvalidVisaNumber).
+		WithMessage(ErrInvalidVisaNumber.Message())
+}
 
-	return true
+// isCurrencyCode checks the format of ISO 4217 alphabetic code only,
+// the code itself isn't looked up.
+func isCurrencyCode() vld.StringFuncConstraint {
+	return vld.OfStringBy(func(s string) bool {
+		return strings.IndexFunc(s, func(c rune) bool { return c < 'A' || c > 'Z' }) == -1
+	}).
+		WithError(ErrInvalidCurrencyCode).
+		WithMessage(ErrInvalidCurrencyCode.Message())
 }
 
 func ValidationErrorMessage(err error) string {
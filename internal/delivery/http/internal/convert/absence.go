@@ -0,0 +1,159 @@
+package convert
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+var absenceTypeTitles = map[model.AbsenceType]string{
+	model.AbsenceTypeSick:         "Больничный",
+	model.AbsenceTypeUnpaid:       "Отпуск без сохранения заработной платы",
+	model.AbsenceTypeBusinessTrip: "Командировка",
+	model.AbsenceTypeStudy:        "Учебный отпуск",
+}
+
+func FromAPIAddAbsenceRequest(req api.AddAbsenceJSONRequestBody) model.Absence {
+	a := model.Absence{
+		Type:      model.AbsenceType(req.Type),
+		DateBegin: req.DateFrom.Time,
+		DateEnd:   req.DateTo.Time,
+	}
+	if req.DocumentNumber != nil {
+		a.DocumentNumber = *req.DocumentNumber
+	}
+	if req.Comment != nil {
+		a.Comment = *req.Comment
+	}
+	return a
+}
+
+func FromAPIPutAbsenceRequest(absenceID uint64, req api.PutAbsenceJSONRequestBody) model.Absence {
+	a := model.Absence{
+		ID:        absenceID,
+		Type:      model.AbsenceType(req.Type),
+		DateBegin: req.DateFrom.Time,
+		DateEnd:   req.DateTo.Time,
+	}
+	if req.DocumentNumber != nil {
+		a.DocumentNumber = *req.DocumentNumber
+	}
+	if req.Comment != nil {
+		a.Comment = *req.Comment
+	}
+	return a
+}
+
+func ToAPIAbsence(a *model.Absence) api.Absence {
+	return api.Absence{
+		ID:             a.ID,
+		Type:           api.AbsenceType(a.Type),
+		DateFrom:       types.Date{Time: a.DateBegin},
+		DateTo:         types.Date{Time: a.DateEnd},
+		DocumentNumber: a.DocumentNumber,
+		Comment:        a.Comment,
+		HasScan:        a.HasScan,
+	}
+}
+
+func ToAPIListAbsences(as []model.Absence) api.ListAbsencesResponse {
+	res := make([]api.Absence, len(as))
+	for i := range as {
+		res[i] = ToAPIAbsence(&as[i])
+	}
+	return res
+}
+
+func ToAPIListAllAbsences(as []model.EmployeeAbsence) api.ListAllAbsencesResponse {
+	res := make([]api.EmployeeAbsence, len(as))
+	for i, a := range as {
+		res[i] = api.EmployeeAbsence{
+			ID:             a.ID,
+			UserID:         a.UserID,
+			LastName:       a.LastName,
+			FirstName:      a.FirstName,
+			MiddleName:     a.MiddleName,
+			Department:     a.Department,
+			Position:       a.Position,
+			Type:           api.AbsenceType(a.Type),
+			DateFrom:       types.Date{Time: a.DateBegin},
+			DateTo:         types.Date{Time: a.DateEnd},
+			DocumentNumber: a.DocumentNumber,
+			Comment:        a.Comment,
+			HasScan:        a.HasScan,
+		}
+	}
+	return res
+}
+
+// AbsencesTable returns the absences of the employees as a table for export.
+func AbsencesTable(as []model.EmployeeAbsence) [][]string {
+	table := make([][]string, 0, len(as)+1)
+	table = append(table, []string{
+		"Фамилия", "Имя", "Отчество", "Подразделение", "Должность",
+		"Вид отсутствия", "Дата начала", "Дата окончания", "Номер документа", "Комментарий",
+	})
+	for _, a := range as {
+		table = append(table, []string{
+			a.LastName,
+			a.FirstName,
+			a.MiddleName,
+			a.Department,
+			a.Position,
+			absenceTypeTitles[a.Type],
+			a.DateBegin.Format(time.DateOnly),
+			a.DateEnd.Format(time.DateOnly),
+			a.DocumentNumber,
+			a.Comment,
+		})
+	}
+	return table
+}
+
+func ToAPIAbsenceReport(ss []model.AbsenceSummary) api.AbsenceReportResponse {
+	res := make([]api.AbsenceReportItem, len(ss))
+	for i, s := range ss {
+		res[i] = api.AbsenceReportItem{
+			UserID:           s.UserID,
+			LastName:         s.LastName,
+			FirstName:        s.FirstName,
+			MiddleName:       s.MiddleName,
+			Department:       s.Department,
+			Position:         s.Position,
+			SickDays:         s.Days[model.AbsenceTypeSick],
+			UnpaidDays:       s.Days[model.AbsenceTypeUnpaid],
+			BusinessTripDays: s.Days[model.AbsenceTypeBusinessTrip],
+			StudyDays:        s.Days[model.AbsenceTypeStudy],
+			TotalDays:        s.Total(),
+		}
+	}
+	return res
+}
+
+// AbsenceReportTable returns the absence report as a table for export.
+func AbsenceReportTable(ss []model.AbsenceSummary) [][]string {
+	table := make([][]string, 0, len(ss)+1)
+	table = append(table, []string{
+		"Фамилия", "Имя", "Отчество", "Подразделение", "Должность",
+		"Больничный", "Без сохранения заработной платы", "Командировка", "Учебный отпуск", "Всего",
+	})
+	for _, s := range ss {
+		table = append(table, []string{
+			s.LastName,
+			s.FirstName,
+			s.MiddleName,
+			s.Department,
+			s.Position,
+			strconv.Itoa(s.Days[model.AbsenceTypeSick]),
+			strconv.Itoa(s.Days[model.AbsenceTypeUnpaid]),
+			strconv.Itoa(s.Days[model.AbsenceTypeBusinessTrip]),
+			strconv.Itoa(s.Days[model.AbsenceTypeStudy]),
+			strconv.Itoa(s.Total()),
+		})
+	}
+	return table
+}
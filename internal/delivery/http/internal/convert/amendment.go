@@ -0,0 +1,73 @@
+package convert
+
+import (
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+func ToAPIAmendment(a *model.Amendment) api.Amendment {
+	res := api.Amendment{
+		ID:            a.ID,
+		Number:        a.Number,
+		Date:          types.Date{Time: a.Date},
+		EffectiveFrom: types.Date{Time: a.EffectiveFrom},
+		WorkTypeID:    a.WorkTypeID,
+		PositionID:    a.PositionID,
+		Salary:        a.Salary,
+		Description:   a.Description,
+		HasScan:       a.HasScan,
+	}
+	if a.DateEnd != nil {
+		res.DateTo = &types.Date{Time: *a.DateEnd}
+	}
+	return res
+}
+
+func ToAPIListAmendments(as []model.Amendment) api.ListAmendmentsResponse {
+	res := make([]api.Amendment, len(as))
+	for i := 0; i < len(as); i++ {
+		res[i] = ToAPIAmendment(&as[i])
+	}
+	return res
+}
+
+func FromAPIAddAmendmentRequest(contractID uint64, req api.AddAmendmentJSONRequestBody) model.Amendment {
+	a := model.Amendment{
+		ContractID:    contractID,
+		Number:        req.Number,
+		Date:          req.Date.Time,
+		EffectiveFrom: req.EffectiveFrom.Time,
+		WorkTypeID:    req.WorkTypeID,
+		PositionID:    req.PositionID,
+		Salary:        req.Salary,
+	}
+	if req.DateTo != nil {
+		a.DateEnd = &req.DateTo.Time
+	}
+	if req.Description != nil {
+		a.Description = *req.Description
+	}
+	return a
+}
+
+func FromAPIPutAmendmentRequest(contractID, amendmentID uint64, req api.PutAmendmentJSONRequestBody) model.Amendment {
+	a := FromAPIAddAmendmentRequest(contractID, api.AddAmendmentRequest(req))
+	a.ID = amendmentID
+	return a
+}
+
+func ToAPIContractTerms(t *model.ContractTerms) api.ContractTerms {
+	res := api.ContractTerms{
+		Date:        types.Date{Time: t.Date},
+		WorkTypeID:  t.WorkTypeID,
+		PositionID:  t.PositionID,
+		Salary:      t.Salary,
+		AmendmentID: t.AmendmentID,
+	}
+	if t.DateEnd != nil {
+		res.DateTo = &types.Date{Time: *t.DateEnd}
+	}
+	return res
+}
@@ -0,0 +1,102 @@
+package convert
+
+import (
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/benefit/model"
+)
+
+func FromAPIAddBenefitRequest(req api.AddBenefitJSONRequestBody) model.Benefit {
+	return model.Benefit{
+		Title: req.Title,
+		Cost:  req.Cost,
+		Rules: model.Rules{
+			Grade:           req.Grade,
+			DepartmentID:    req.DepartmentID,
+			MinTenureMonths: req.MinTenureMonths,
+		},
+	}
+}
+
+func FromAPIPutBenefitRequest(benefitID uint64, req api.PutBenefitJSONRequestBody) model.Benefit {
+	return model.Benefit{
+		ID:    benefitID,
+		Title: req.Title,
+		Cost:  req.Cost,
+		Rules: model.Rules{
+			Grade:           req.Grade,
+			DepartmentID:    req.DepartmentID,
+			MinTenureMonths: req.MinTenureMonths,
+		},
+	}
+}
+
+func ToAPIBenefit(b *model.Benefit) api.Benefit {
+	return toAPIBenefit(*b)
+}
+
+func ToAPIListBenefits(bs []model.Benefit) api.ListBenefitsResponse {
+	res := make([]api.Benefit, len(bs))
+	for i := 0; i < len(bs); i++ {
+		res[i] = toAPIBenefit(bs[i])
+	}
+	return res
+}
+
+func toAPIBenefit(b model.Benefit) api.Benefit {
+	return api.Benefit{
+		ID:              b.ID,
+		Title:           b.Title,
+		Cost:            b.Cost,
+		Grade:           b.Rules.Grade,
+		DepartmentID:    b.Rules.DepartmentID,
+		MinTenureMonths: b.Rules.MinTenureMonths,
+	}
+}
+
+func FromAPIAddBenefitEnrolmentRequest(req api.AddBenefitEnrolmentJSONRequestBody) model.Enrolment {
+	return model.Enrolment{
+		BenefitID: req.BenefitID,
+		DateFrom:  req.DateFrom.Time,
+		DateTo:    req.DateTo.Time,
+	}
+}
+
+func FromAPIPutBenefitEnrolmentRequest(enrolmentID uint64, req api.PutBenefitEnrolmentJSONRequestBody) model.Enrolment {
+	return model.Enrolment{
+		ID:       enrolmentID,
+		DateFrom: req.DateFrom.Time,
+		DateTo:   req.DateTo.Time,
+	}
+}
+
+func ToAPIListBenefitEnrolments(es []model.Enrolment) api.ListBenefitEnrolmentsResponse {
+	res := make([]api.BenefitEnrolment, len(es))
+	for i, e := range es {
+		res[i] = api.BenefitEnrolment{
+			ID:        e.ID,
+			BenefitID: e.BenefitID,
+			Benefit:   e.Benefit,
+			Cost:      e.Cost,
+			DateFrom:  types.Date{Time: e.DateFrom},
+			DateTo:    types.Date{Time: e.DateTo},
+			Version:   e.Version,
+		}
+	}
+	return res
+}
+
+func ToAPIBenefitCostReport(dcs []model.DepartmentCost) api.BenefitCostReportResponse {
+	res := make([]api.BenefitCost, len(dcs))
+	for i, dc := range dcs {
+		res[i] = api.BenefitCost{
+			DepartmentID: dc.DepartmentID,
+			Department:   dc.Department,
+			Employees:    dc.Employees,
+			Enrolments:   dc.Enrolments,
+			Cost:         dc.Cost,
+		}
+	}
+	return res
+}
@@ -0,0 +1,43 @@
+package convert
+
+import (
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/calendar/model"
+)
+
+func ToAPIListCalendarDays(days []model.Day) api.ListCalendarDaysResponse {
+	res := make([]api.CalendarDay, len(days))
+	for i, d := range days {
+		title := d.Title
+		res[i] = api.CalendarDay{
+			Date:  types.Date{Time: d.Date},
+			Type:  api.CalendarDayType(d.Type),
+			Title: &title,
+		}
+	}
+	return res
+}
+
+func FromAPIPutCalendarDaysRequest(req api.PutCalendarDaysJSONRequestBody) []model.Day {
+	days := make([]model.Day, len(req.Days))
+	for i, d := range req.Days {
+		days[i] = model.Day{
+			Date: d.Date.Time,
+			Type: model.DayType(d.Type),
+		}
+		if d.Title != nil {
+			days[i].Title = *d.Title
+		}
+	}
+	return days
+}
+
+func ToAPICalendarDaysCount(c model.DaysCount) api.CalendarDaysCount {
+	return api.CalendarDaysCount{
+		CalendarDays: c.CalendarDays,
+		WorkingDays:  c.WorkingDays,
+		Holidays:     c.Holidays,
+	}
+}
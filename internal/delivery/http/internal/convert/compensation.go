@@ -0,0 +1,69 @@
+package convert
+
+import (
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/compensation/model"
+)
+
+func FromAPIAddCompensationRequest(req api.AddCompensationJSONRequestBody) model.Compensation {
+	return model.Compensation{
+		ContractID:        req.ContractID,
+		Salary:            req.Salary,
+		SalaryRate:        req.SalaryRate,
+		SocialSecurityTax: req.SocialSecurityTax,
+		IncomeTax:         req.IncomeTax,
+		Currency:          req.Currency,
+		DateBegin:         req.DateFrom.Time,
+	}
+}
+
+func FromAPIPutCompensationRequest(compensationID uint64, req api.PutCompensationJSONRequestBody) model.Compensation {
+	return model.Compensation{
+		ID:                compensationID,
+		Salary:            req.Salary,
+		SalaryRate:        req.SalaryRate,
+		SocialSecurityTax: req.SocialSecurityTax,
+		IncomeTax:         req.IncomeTax,
+		Currency:          req.Currency,
+		DateBegin:         req.DateFrom.Time,
+	}
+}
+
+func ToAPICompensation(c *model.Compensation) api.Compensation {
+	return toAPICompensation(*c)
+}
+
+func ToAPIListCompensations(cs []model.Compensation) api.ListCompensationsResponse {
+	res := make([]api.Compensation, len(cs))
+	for i := 0; i < len(cs); i++ {
+		res[i] = toAPICompensation(cs[i])
+	}
+	return res
+}
+
+func toAPICompensation(c model.Compensation) api.Compensation {
+	return api.Compensation{
+		ID:                c.ID,
+		ContractID:        c.ContractID,
+		Salary:            c.Salary,
+		SalaryRate:        c.SalaryRate,
+		SocialSecurityTax: c.SocialSecurityTax,
+		IncomeTax:         c.IncomeTax,
+		Currency:          c.Currency,
+		DateFrom:          types.Date{Time: c.DateBegin},
+	}
+}
+
+func ToAPIUserFinance(c *model.Compensation) *api.UserFinance {
+	if c == nil {
+		return nil
+	}
+	return &api.UserFinance{
+		Salary:            &c.Salary,
+		SocialSecurityTax: &c.SocialSecurityTax,
+		IncomeTax:         &c.IncomeTax,
+		Currency:          &c.Currency,
+	}
+}
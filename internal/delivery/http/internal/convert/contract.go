@@ -9,15 +9,20 @@ import (
 
 func FromAPIAddContractRequest(req api.AddContractJSONRequestBody) model.Contract {
 	mc := model.Contract{
-		Number:          req.Number,
 		WorkTypeID:      req.WorkTypeID,
 		ProbationPeriod: req.ProbationPeriod,
 		DateBegin:       req.DateFrom.Time,
 	}
 
+	if req.Number != nil {
+		mc.Number = *req.Number
+	}
 	if req.DateTo != nil {
 		mc.DateEnd = &req.DateTo.Time
 	}
+	if req.ExtraVacationDays != nil {
+		mc.ExtraVacationDays = *req.ExtraVacationDays
+	}
 	switch req.Type {
 	case api.Permanent:
 		mc.Type = model.ContractTypePermanent
@@ -39,6 +44,9 @@ func FromAPIPutContractRequest(contractID uint64, req api.PutContractJSONRequest
 	if req.DateTo != nil {
 		mc.DateEnd = &req.DateTo.Time
 	}
+	if req.ExtraVacationDays != nil {
+		mc.ExtraVacationDays = *req.ExtraVacationDays
+	}
 	switch req.Type {
 	case api.Permanent:
 		mc.Type = model.ContractTypePermanent
@@ -68,10 +76,19 @@ func toAPIContract(med model.Contract) api.Contract {
 		ProbationPeriod: med.ProbationPeriod,
 		DateFrom:        types.Date{Time: med.DateBegin},
 		HasScan:         &med.HasScan,
+		HasAmendments:   &med.HasAmendments,
+
+		ExtraVacationDays: &med.ExtraVacationDays,
 	}
 	if med.DateEnd != nil {
 		resp.DateTo = &types.Date{Time: *med.DateEnd}
 	}
+	if med.ProbationEnd != nil {
+		resp.ProbationEnd = &types.Date{Time: *med.ProbationEnd}
+	}
+	if med.ProbationResult != nil {
+		resp.ProbationResult = toAPIProbationResult(med.ProbationResult)
+	}
 	switch med.Type {
 	case model.ContractTypePermanent:
 		resp.Type = api.Permanent
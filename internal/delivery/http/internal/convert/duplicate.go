@@ -0,0 +1,21 @@
+package convert
+
+import (
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+func ToAPIDuplicate(d *model.Duplicate) api.Duplicate {
+	return api.Duplicate{
+		Reason:  api.DuplicateReason(d.Reason),
+		UserIDs: d.UserIDs,
+	}
+}
+
+func ToAPIListDuplicates(ds []model.Duplicate) api.ListDuplicatesResponse {
+	res := make([]api.Duplicate, len(ds))
+	for i := 0; i < len(ds); i++ {
+		res[i] = ToAPIDuplicate(&ds[i])
+	}
+	return res
+}
@@ -0,0 +1,70 @@
+package convert
+
+import (
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+func FromAPIAddExperienceRequest(req api.AddExperienceJSONRequestBody) model.Experience {
+	return model.Experience{
+		CompanyName: req.CompanyName,
+		Position:    req.Position,
+		Functional:  req.Functional,
+		Awards:      req.Awards,
+		DateFrom:    req.DateFrom.Time,
+		DateTo:      req.DateTo.Time,
+	}
+}
+
+func FromAPIPutExperienceRequest(experienceID uint64, req api.PutExperienceJSONRequestBody) model.Experience {
+	return model.Experience{
+		ID:          experienceID,
+		CompanyName: req.CompanyName,
+		Position:    req.Position,
+		Functional:  req.Functional,
+		Awards:      req.Awards,
+		DateFrom:    req.DateFrom.Time,
+		DateTo:      req.DateTo.Time,
+	}
+}
+
+func ToAPIGetExperienceResponse(mex *model.Experience) api.GetExperienceResponse {
+	return toAPIExperience(*mex)
+}
+
+func ToAPIListExperiences(exs []model.Experience) api.ListExperiencesResponse {
+	res := make([]api.Experience, len(exs))
+	for i := 0; i < len(exs); i++ {
+		res[i] = toAPIExperience(exs[i])
+	}
+	return res
+}
+
+func ToAPIExperienceLength(total, continuous model.Length) api.ExperienceLength {
+	return api.ExperienceLength{
+		Total:      toAPIWorkLength(total),
+		Continuous: toAPIWorkLength(continuous),
+	}
+}
+
+func toAPIExperience(mex model.Experience) api.Experience {
+	return api.Experience{
+		ID:          mex.ID,
+		CompanyName: mex.CompanyName,
+		Position:    mex.Position,
+		Functional:  mex.Functional,
+		Awards:      mex.Awards,
+		DateFrom:    types.Date{Time: mex.DateFrom},
+		DateTo:      types.Date{Time: mex.DateTo},
+	}
+}
+
+func toAPIWorkLength(l model.Length) api.WorkLength {
+	return api.WorkLength{
+		Years:  l.Years,
+		Months: l.Months,
+		Days:   l.Days,
+	}
+}
@@ -0,0 +1,76 @@
+package convert
+
+import (
+	"math"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/compensation/model"
+)
+
+func ToAPIListIndexations(is []model.Indexation) api.ListIndexationsResponse {
+	res := make([]api.Indexation, len(is))
+	for i, ix := range is {
+		res[i] = api.Indexation{
+			ID:           ix.ID,
+			RunID:        ix.RunID,
+			DateFrom:     types.Date{Time: ix.DateBegin},
+			Percents:     float64(ix.BasisPoints) / 100,
+			Currency:     ix.Currency,
+			SalaryBefore: ix.SalaryBefore,
+			SalaryAfter:  ix.SalaryAfter,
+		}
+	}
+	return res
+}
+
+func FromAPIIndexationRunRequest(req api.IndexationRunRequest) model.IndexationRun {
+	return model.IndexationRun{
+		DepartmentID: req.DepartmentID,
+		BasisPoints:  int(math.Round(req.Percents * 100)),
+		DateBegin:    req.DateFrom.Time,
+	}
+}
+
+func ToAPIIndexationPreview(items []model.IndexationItem) api.IndexationPreviewResponse {
+	res := make([]api.IndexationPreviewItem, len(items))
+	for i, item := range items {
+		res[i] = api.IndexationPreviewItem{
+			UserID:       item.UserID,
+			LastName:     item.LastName,
+			FirstName:    item.FirstName,
+			MiddleName:   item.MiddleName,
+			ContractID:   item.Current.ContractID,
+			Currency:     item.Current.Currency,
+			SalaryBefore: item.Current.Salary,
+			SalaryAfter:  item.Indexed.Salary,
+		}
+	}
+	return res
+}
+
+func ToAPIIndexationRun(r *model.IndexationRun) api.IndexationRun {
+	return toAPIIndexationRun(*r, time.Now())
+}
+
+func ToAPIListIndexationRuns(rs []model.IndexationRun) api.ListIndexationRunsResponse {
+	now := time.Now()
+	res := make([]api.IndexationRun, len(rs))
+	for i := 0; i < len(rs); i++ {
+		res[i] = toAPIIndexationRun(rs[i], now)
+	}
+	return res
+}
+
+func toAPIIndexationRun(r model.IndexationRun, now time.Time) api.IndexationRun {
+	return api.IndexationRun{
+		ID:           r.ID,
+		DepartmentID: r.DepartmentID,
+		Percents:     float64(r.BasisPoints) / 100,
+		DateFrom:     types.Date{Time: r.DateBegin},
+		Employees:    r.Employees,
+		Reversible:   r.Reversible(now),
+	}
+}
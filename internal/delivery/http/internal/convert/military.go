@@ -0,0 +1,133 @@
+package convert
+
+import (
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+func FromAPIMilitary(m *api.Military) *model.Military {
+	if m == nil {
+		return nil
+	}
+	mm := &model.Military{
+		Rank:         m.Rank,
+		Speciality:   m.Speciality,
+		Category:     m.Category,
+		Commissariat: m.Comissariat,
+	}
+	if m.Version != nil {
+		mm.Version = *m.Version
+	}
+	return mm
+}
+
+func ToAPIMilitary(m *model.Military) *api.Military {
+	if m == nil {
+		return nil
+	}
+	return &api.Military{
+		Category:    m.Category,
+		Comissariat: m.Commissariat,
+		HasScan:     &m.HasScan,
+		Rank:        m.Rank,
+		Speciality:  m.Speciality,
+		Version:     &m.Version,
+	}
+}
+
+func ToAPIListMilitaryLiable(mls []model.MilitaryLiable) api.ListMilitaryLiableResponse {
+	res := make([]api.MilitaryLiable, len(mls))
+	for i, ml := range mls {
+		res[i] = api.MilitaryLiable{
+			UserID:      ml.UserID,
+			LastName:    ml.LastName,
+			FirstName:   ml.FirstName,
+			MiddleName:  ml.MiddleName,
+			DateOfBirth: types.Date{Time: ml.DateOfBirth},
+			Department:  ml.Department,
+			Position:    ml.Position,
+			Rank:        ml.Military.Rank,
+			Speciality:  ml.Military.Speciality,
+			Category:    ml.Military.Category,
+			Comissariat: ml.Military.Commissariat,
+		}
+	}
+	return res
+}
+
+func ToAPIListMilitaryNotifications(mns []model.MilitaryNotification) api.ListMilitaryNotificationsResponse {
+	res := make([]api.MilitaryNotification, len(mns))
+	for i, mn := range mns {
+		res[i] = api.MilitaryNotification{
+			UserID:      mn.UserID,
+			LastName:    mn.LastName,
+			FirstName:   mn.FirstName,
+			MiddleName:  mn.MiddleName,
+			DateOfBirth: types.Date{Time: mn.DateOfBirth},
+			Department:  mn.Department,
+			Position:    mn.Position,
+			Rank:        mn.Military.Rank,
+			Speciality:  mn.Military.Speciality,
+			Category:    mn.Military.Category,
+			Comissariat: mn.Military.Commissariat,
+			Event:       api.MilitaryNotificationEvent(mn.Event),
+			Date:        types.Date{Time: mn.Date},
+		}
+	}
+	return res
+}
+
+var militaryTableHeader = []string{
+	"Фамилия", "Имя", "Отчество", "Дата рождения", "Подразделение", "Должность",
+	"Воинское звание", "ВУС", "Категория годности", "Военный комиссариат",
+}
+
+// MilitaryLiableTable returns the list of liable employees as a table for export.
+func MilitaryLiableTable(mls []model.MilitaryLiable) [][]string {
+	table := make([][]string, 0, len(mls)+1)
+	table = append(table, militaryTableHeader)
+	for _, ml := range mls {
+		table = append(table, militaryLiableRow(ml))
+	}
+	return table
+}
+
+var militaryEventTitles = map[model.MilitaryEvent]string{
+	model.MilitaryEventRegistered:      "Принят на воинский учёт",
+	model.MilitaryEventChanged:         "Изменение учётных данных",
+	model.MilitaryEventDeregistered:    "Снят с воинского учёта",
+	model.MilitaryEventPositionChanged: "Приём или перевод на должность",
+	model.MilitaryEventTerminated:      "Увольнение",
+}
+
+// MilitaryNotificationsTable returns the changes as a table for export.
+func MilitaryNotificationsTable(mns []model.MilitaryNotification) [][]string {
+	table := make([][]string, 0, len(mns)+1)
+	table = append(table, append([]string{"Дата", "Изменение"}, militaryTableHeader...))
+	for _, mn := range mns {
+		table = append(table, append([]string{
+			mn.Date.Format(time.DateOnly),
+			militaryEventTitles[mn.Event],
+		}, militaryLiableRow(mn.MilitaryLiable)...))
+	}
+	return table
+}
+
+func militaryLiableRow(ml model.MilitaryLiable) []string {
+	return []string{
+		ml.LastName,
+		ml.FirstName,
+		ml.MiddleName,
+		ml.DateOfBirth.Format(time.DateOnly),
+		ml.Department,
+		ml.Position,
+		ml.Military.Rank,
+		ml.Military.Speciality,
+		ml.Military.Category,
+		ml.Military.Commissariat,
+	}
+}
@@ -0,0 +1,116 @@
+package convert
+
+import (
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+func ToAPIOnboardingTemplate(t *model.OnboardingTemplate) api.OnboardingTemplate {
+	items := make([]api.OnboardingTemplateItem, len(t.Items))
+	for i, item := range t.Items {
+		var st *api.ScanType
+		if item.ScanType != nil {
+			v := api.ScanType(*item.ScanType)
+			st = &v
+		}
+		items[i] = api.OnboardingTemplateItem{
+			Title:    item.Title,
+			ScanType: st,
+			DueDays:  item.DueDays,
+		}
+	}
+	return api.OnboardingTemplate{
+		ID:           t.ID,
+		Title:        t.Title,
+		DepartmentID: t.DepartmentID,
+		PositionID:   t.PositionID,
+		Items:        items,
+	}
+}
+
+func ToAPIListOnboardingTemplates(ts []model.OnboardingTemplate) api.ListOnboardingTemplatesResponse {
+	res := make([]api.OnboardingTemplate, len(ts))
+	for i := 0; i < len(ts); i++ {
+		res[i] = ToAPIOnboardingTemplate(&ts[i])
+	}
+	return res
+}
+
+func FromAPIAddOnboardingTemplateRequest(req api.AddOnboardingTemplateJSONRequestBody) model.OnboardingTemplate {
+	return model.OnboardingTemplate{
+		Title:        req.Title,
+		DepartmentID: req.DepartmentID,
+		PositionID:   req.PositionID,
+		Items:        fromAPIOnboardingTemplateItems(req.Items),
+	}
+}
+
+func FromAPIPutOnboardingTemplateRequest(templateID uint64,
+	req api.PutOnboardingTemplateJSONRequestBody) model.OnboardingTemplate {
+	return model.OnboardingTemplate{
+		ID:           templateID,
+		Title:        req.Title,
+		DepartmentID: req.DepartmentID,
+		PositionID:   req.PositionID,
+		Items:        fromAPIOnboardingTemplateItems(req.Items),
+	}
+}
+
+func ToAPIListOnboardingItems(items []model.OnboardingItem) api.ListOnboardingItemsResponse {
+	res := make([]api.OnboardingItem, len(items))
+	for i := 0; i < len(items); i++ {
+		res[i] = toAPIOnboardingItem(items[i])
+	}
+	return res
+}
+
+func ToAPIListOverdueOnboarding(os []model.OverdueOnboarding) api.ListOverdueOnboardingResponse {
+	res := make([]api.OverdueOnboarding, len(os))
+	for i, o := range os {
+		res[i] = api.OverdueOnboarding{
+			UserID:     o.UserID,
+			LastName:   o.LastName,
+			FirstName:  o.FirstName,
+			MiddleName: o.MiddleName,
+			Department: o.Department,
+			Position:   o.Position,
+			Items:      ToAPIListOnboardingItems(o.Items),
+		}
+	}
+	return res
+}
+
+func fromAPIOnboardingTemplateItems(items []api.OnboardingTemplateItem) []model.OnboardingTemplateItem {
+	res := make([]model.OnboardingTemplateItem, len(items))
+	for i, item := range items {
+		var st *model.ScanType
+		if item.ScanType != nil {
+			v := model.ScanType(*item.ScanType)
+			st = &v
+		}
+		res[i] = model.OnboardingTemplateItem{
+			Title:    item.Title,
+			ScanType: st,
+			DueDays:  item.DueDays,
+		}
+	}
+	return res
+}
+
+func toAPIOnboardingItem(item model.OnboardingItem) api.OnboardingItem {
+	var st *api.ScanType
+	if item.ScanType != nil {
+		v := api.ScanType(*item.ScanType)
+		st = &v
+	}
+	return api.OnboardingItem{
+		ID:          item.ID,
+		Title:       item.Title,
+		ScanType:    st,
+		DueDate:     types.Date{Time: item.DueDate},
+		Completed:   item.CompletedAt != nil,
+		CompletedAt: item.CompletedAt,
+	}
+}
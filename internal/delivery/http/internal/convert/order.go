@@ -0,0 +1,69 @@
+package convert
+
+import (
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+func ToAPIOrder(o *model.Order) api.Order {
+	res := api.Order{
+		ID:            o.ID,
+		Type:          api.OrderType(o.Type),
+		Status:        api.OrderStatus(o.Status),
+		Title:         o.Title,
+		UserID:        o.UserID,
+		LastName:      o.LastName,
+		FirstName:     o.FirstName,
+		MiddleName:    o.MiddleName,
+		EffectiveDate: types.Date{Time: o.EffectiveDate},
+		ContractID:    o.ContractID,
+		VacationID:    o.VacationID,
+		HasScan:       o.HasScan,
+	}
+	if o.Number != "" {
+		res.Number = &o.Number
+	}
+	if o.Date != nil {
+		res.Date = &types.Date{Time: *o.Date}
+	}
+	return res
+}
+
+func ToAPIListOrders(os []model.Order) api.ListOrdersResponse {
+	res := make([]api.Order, len(os))
+	for i := 0; i < len(os); i++ {
+		res[i] = ToAPIOrder(&os[i])
+	}
+	return res
+}
+
+func FromAPIListOrdersParams(params api.ListOrdersParams) model.ListOrdersParams {
+	return model.ListOrdersParams{
+		Type:   (*model.OrderType)(params.Type),
+		Status: (*model.OrderStatus)(params.Status),
+		UserID: params.UserID,
+		Year:   params.Year,
+	}
+}
+
+func FromAPIAddOrderRequest(req api.AddOrderJSONRequestBody) model.Order {
+	o := model.Order{
+		Type:          model.OrderType(req.Type),
+		UserID:        req.UserID,
+		EffectiveDate: req.EffectiveDate.Time,
+		ContractID:    req.ContractID,
+		VacationID:    req.VacationID,
+	}
+	if req.Title != nil {
+		o.Title = *req.Title
+	}
+	return o
+}
+
+func FromAPIPutOrderRequest(orderID uint64, req api.PutOrderJSONRequestBody) model.Order {
+	o := FromAPIAddOrderRequest(api.AddOrderRequest(req))
+	o.ID = orderID
+	return o
+}
@@ -0,0 +1,42 @@
+package convert
+
+import (
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+func ToAPIPositionTrack(track []model.PositionTrackItem) api.PositionTrack {
+	res := make(api.PositionTrack, len(track))
+	for i, pt := range track {
+		res[i] = api.PositionTrackItem{
+			DateFrom:     types.Date{Time: pt.DateBegin},
+			DepartmentID: pt.DepartmentID,
+			PositionID:   pt.PositionID,
+		}
+		if pt.DateEnd != nil {
+			res[i].DateTo = &types.Date{Time: *pt.DateEnd}
+		}
+	}
+	return res
+}
+
+func ToAPIListPositionHolders(phs []model.PositionHolder) api.ListPositionHoldersResponse {
+	res := make(api.ListPositionHoldersResponse, len(phs))
+	for i, ph := range phs {
+		res[i] = api.PositionHolder{
+			DateFrom:     types.Date{Time: ph.DateBegin},
+			Department:   ph.Department,
+			DepartmentID: ph.DepartmentID,
+			FirstName:    ph.FirstName,
+			LastName:     ph.LastName,
+			MiddleName:   ph.MiddleName,
+			UserID:       ph.UserID,
+		}
+		if ph.DateEnd != nil {
+			res[i].DateTo = &types.Date{Time: *ph.DateEnd}
+		}
+	}
+	return res
+}
@@ -0,0 +1,57 @@
+package convert
+
+import (
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+func ToAPIListProbations(es []model.ProbationEmployee) api.ListProbationsResponse {
+	res := make([]api.ProbationEmployee, len(es))
+	for i, e := range es {
+		res[i] = api.ProbationEmployee{
+			UserID:         e.UserID,
+			LastName:       e.LastName,
+			FirstName:      e.FirstName,
+			MiddleName:     e.MiddleName,
+			Department:     e.Department,
+			Position:       e.Position,
+			ContractID:     e.Contract.ID,
+			ContractNumber: e.Contract.Number,
+			DateFrom:       types.Date{Time: e.Contract.DateBegin},
+			ProbationEnd:   types.Date{Time: *e.Contract.ProbationEnd},
+		}
+		if e.Contract.ProbationResult != nil {
+			o := api.ProbationOutcome(e.Contract.ProbationResult.Outcome)
+			res[i].Outcome = &o
+		}
+	}
+	return res
+}
+
+func FromAPIPutProbationResultRequest(req api.PutProbationResultJSONRequestBody) model.ProbationResult {
+	r := model.ProbationResult{
+		Outcome: model.ProbationOutcome(req.Outcome),
+	}
+	if req.ExtendedTo != nil {
+		r.ExtendedTo = &req.ExtendedTo.Time
+	}
+	if req.Comment != nil {
+		r.Comment = *req.Comment
+	}
+	return r
+}
+
+func toAPIProbationResult(r *model.ProbationResult) *api.ProbationResult {
+	res := &api.ProbationResult{
+		Outcome:   api.ProbationOutcome(r.Outcome),
+		Comment:   r.Comment,
+		DecidedAt: r.DecidedAt,
+		Version:   r.Version,
+	}
+	if r.ExtendedTo != nil {
+		res.ExtendedTo = &types.Date{Time: *r.ExtendedTo}
+	}
+	return res
+}
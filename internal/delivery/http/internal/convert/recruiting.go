@@ -0,0 +1,198 @@
+package convert
+
+import (
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	rmodel "github.com/Employee-s-file-cabinet/backend/internal/service/recruiting/model"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+func FromAPIAddVacancyRequest(req api.AddVacancyJSONRequestBody) rmodel.Vacancy {
+	v := rmodel.Vacancy{
+		Title:        req.Title,
+		PositionID:   req.PositionID,
+		DepartmentID: req.DepartmentID,
+		Status:       rmodel.VacancyStatusOpen,
+	}
+	if req.Description != nil {
+		v.Description = *req.Description
+	}
+	return v
+}
+
+func FromAPIPutVacancyRequest(vacancyID uint64, req api.PutVacancyJSONRequestBody) rmodel.Vacancy {
+	v := rmodel.Vacancy{
+		ID:           vacancyID,
+		Title:        req.Title,
+		PositionID:   req.PositionID,
+		DepartmentID: req.DepartmentID,
+		Status:       rmodel.VacancyStatus(req.Status),
+	}
+	if req.Description != nil {
+		v.Description = *req.Description
+	}
+	return v
+}
+
+func ToAPIVacancy(v *rmodel.Vacancy) api.Vacancy {
+	return api.Vacancy{
+		ID:           v.ID,
+		Title:        v.Title,
+		Description:  v.Description,
+		PositionID:   v.PositionID,
+		DepartmentID: v.DepartmentID,
+		Position:     v.Position,
+		Department:   v.Department,
+		Status:       api.VacancyStatus(v.Status),
+		CreatedAt:    v.CreatedAt,
+	}
+}
+
+func ToAPIListVacancies(vs []rmodel.Vacancy) api.ListVacanciesResponse {
+	res := make([]api.Vacancy, len(vs))
+	for i := range vs {
+		res[i] = ToAPIVacancy(&vs[i])
+	}
+	return res
+}
+
+func FromAPIListCandidatesParams(params api.ListCandidatesParams) rmodel.ListCandidatesParams {
+	return rmodel.ListCandidatesParams{
+		VacancyID: params.VacancyID,
+		Stage:     (*rmodel.Stage)(params.Stage),
+	}
+}
+
+func FromAPIAddCandidateRequest(req api.AddCandidateJSONRequestBody) rmodel.Candidate {
+	c := rmodel.Candidate{
+		VacancyID: req.VacancyID,
+		LastName:  req.LastName,
+		FirstName: req.FirstName,
+	}
+	if req.MiddleName != nil {
+		c.MiddleName = *req.MiddleName
+	}
+	if req.Email != nil {
+		c.Email = string(*req.Email)
+	}
+	if req.Phone != nil {
+		c.Phone = *req.Phone
+	}
+	if req.DateOfBirth != nil {
+		c.DateOfBirth = &req.DateOfBirth.Time
+	}
+	return c
+}
+
+func FromAPIPutCandidateRequest(candidateID uint64, req api.PutCandidateJSONRequestBody) rmodel.Candidate {
+	c := rmodel.Candidate{
+		ID:        candidateID,
+		VacancyID: req.VacancyID,
+		LastName:  req.LastName,
+		FirstName: req.FirstName,
+	}
+	if req.MiddleName != nil {
+		c.MiddleName = *req.MiddleName
+	}
+	if req.Email != nil {
+		c.Email = string(*req.Email)
+	}
+	if req.Phone != nil {
+		c.Phone = *req.Phone
+	}
+	if req.DateOfBirth != nil {
+		c.DateOfBirth = &req.DateOfBirth.Time
+	}
+	return c
+}
+
+func ToAPICandidate(c *rmodel.Candidate) api.Candidate {
+	res := api.Candidate{
+		ID:         c.ID,
+		VacancyID:  c.VacancyID,
+		LastName:   c.LastName,
+		FirstName:  c.FirstName,
+		MiddleName: c.MiddleName,
+		Email:      types.Email(c.Email),
+		Phone:      c.Phone,
+		Stage:      api.CandidateStage(c.Stage),
+		UserID:     c.UserID,
+		CreatedAt:  c.CreatedAt,
+	}
+	if c.DateOfBirth != nil {
+		res.DateOfBirth = &types.Date{Time: *c.DateOfBirth}
+	}
+	return res
+}
+
+func ToAPIListCandidates(cs []rmodel.Candidate) api.ListCandidatesResponse {
+	res := make([]api.Candidate, len(cs))
+	for i := range cs {
+		res[i] = ToAPICandidate(&cs[i])
+	}
+	return res
+}
+
+func ToAPIListCandidateNotes(ns []rmodel.Note) api.ListCandidateNotesResponse {
+	res := make([]api.CandidateNote, len(ns))
+	for i, n := range ns {
+		res[i] = api.CandidateNote{
+			ID:        n.ID,
+			AuthorID:  n.AuthorID,
+			Stage:     api.CandidateStage(n.Stage),
+			Text:      n.Text,
+			CreatedAt: n.CreatedAt,
+		}
+	}
+	return res
+}
+
+func ToAPIListCandidateCVs(cvs []rmodel.CV) api.ListCandidateCVsResponse {
+	res := make([]api.CandidateCV, len(cvs))
+	for i, cv := range cvs {
+		res[i] = api.CandidateCV{
+			ID:          cv.ID,
+			FileName:    cv.FileName,
+			ContentType: cv.ContentType,
+			Size:        cv.Size,
+			UploadedAt:  cv.UploadedAt,
+		}
+	}
+	return res
+}
+
+// FromAPIHireCandidateRequest converts the employee data absent in the candidate,
+// the rest is filled by the recruiting service.
+func FromAPIHireCandidateRequest(req api.HireCandidateJSONRequestBody) model.User {
+	user := model.User{
+		ShortUserInfo: model.ShortUserInfo{
+			Email: string(req.Email),
+		},
+		PlaceOfBirth:        req.PlaceOfBirth,
+		Grade:               req.Grade,
+		RegistrationAddress: req.RegistrationAddress,
+		ResidentialAddress:  req.ResidentialAddress,
+		Nationality:         req.Nationality,
+		Insurance:           model.Insurance{Number: req.Insurance.Number},
+		Taxpayer:            model.Taxpayer{Number: req.Taxpayer.Number},
+		Military:            FromAPIMilitary(req.Military),
+		WorkPermit:          FromAPIWorkPermit(req.WorkPermit),
+	}
+	if req.DateOfBirth != nil {
+		user.DateOfBirth = req.DateOfBirth.Time
+	}
+	switch req.Gender {
+	case api.Female:
+		user.Gender = model.GenderFemale
+	case api.Male:
+		user.Gender = model.GenderMale
+	}
+	if req.PhoneNumbers != nil {
+		user.PhoneNumbers = make(map[string]string, len(req.PhoneNumbers))
+		for k, v := range req.PhoneNumbers {
+			user.PhoneNumbers[k] = string(v)
+		}
+	}
+	return user
+}
@@ -0,0 +1,71 @@
+package convert
+
+import (
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+func FromAPIAddRelativeRequest(req api.AddRelativeJSONRequestBody) model.Relative {
+	r := model.Relative{
+		Type:        model.RelationType(req.Type),
+		LastName:    req.LastName,
+		FirstName:   req.FirstName,
+		DateOfBirth: req.DateOfBirth.Time,
+	}
+	if req.MiddleName != nil {
+		r.MiddleName = *req.MiddleName
+	}
+	if req.Disabled != nil {
+		r.Disabled = *req.Disabled
+	}
+	return r
+}
+
+func FromAPIPutRelativeRequest(relativeID uint64, req api.PutRelativeJSONRequestBody) model.Relative {
+	r := model.Relative{
+		ID:          relativeID,
+		Type:        model.RelationType(req.Type),
+		LastName:    req.LastName,
+		FirstName:   req.FirstName,
+		DateOfBirth: req.DateOfBirth.Time,
+	}
+	if req.MiddleName != nil {
+		r.MiddleName = *req.MiddleName
+	}
+	if req.Disabled != nil {
+		r.Disabled = *req.Disabled
+	}
+	return r
+}
+
+func ToAPIRelative(r *model.Relative) api.Relative {
+	return api.Relative{
+		ID:          r.ID,
+		Type:        api.RelationType(r.Type),
+		LastName:    r.LastName,
+		FirstName:   r.FirstName,
+		MiddleName:  r.MiddleName,
+		DateOfBirth: types.Date{Time: r.DateOfBirth},
+		Disabled:    r.Disabled,
+		HasScan:     r.HasScan,
+	}
+}
+
+func ToAPIListRelatives(rs []model.Relative) api.ListRelativesResponse {
+	res := make([]api.Relative, len(rs))
+	for i := range rs {
+		res[i] = ToAPIRelative(&rs[i])
+	}
+	return res
+}
+
+func ToAPIFamily(f model.Family) api.Family {
+	return api.Family{
+		ChildrenUnder14:         f.ChildrenUnder14,
+		ChildrenUnder18:         f.ChildrenUnder18,
+		DisabledChildrenUnder18: f.DisabledChildrenUnder18,
+		ExtraLeaveEligible:      f.ExtraLeaveEligible,
+	}
+}
@@ -0,0 +1,57 @@
+package convert
+
+import (
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+func ToAPINumberSequence(s *model.NumberSequence) api.NumberSequence {
+	var types []api.OrderType
+	if len(s.Types) > 0 {
+		types = make([]api.OrderType, len(s.Types))
+		for i, t := range s.Types {
+			types[i] = api.OrderType(t)
+		}
+	}
+	return api.NumberSequence{
+		ID:          s.ID,
+		Entity:      api.SequenceEntity(s.Entity),
+		Title:       s.Title,
+		Prefix:      s.Prefix,
+		Suffix:      s.Suffix,
+		WithYear:    s.WithYear,
+		Padding:     s.Padding,
+		YearlyReset: s.YearlyReset,
+		Types:       types,
+	}
+}
+
+func ToAPIListNumberSequences(ss []model.NumberSequence) api.ListNumberSequencesResponse {
+	res := make([]api.NumberSequence, len(ss))
+	for i := 0; i < len(ss); i++ {
+		res[i] = ToAPINumberSequence(&ss[i])
+	}
+	return res
+}
+
+func FromAPIPutNumberSequenceRequest(sequenceID uint64, req api.PutNumberSequenceJSONRequestBody) model.NumberSequence {
+	s := FromAPIAddNumberSequenceRequest(api.AddNumberSequenceRequest(req))
+	s.ID = sequenceID
+	return s
+}
+
+func FromAPIAddNumberSequenceRequest(req api.AddNumberSequenceJSONRequestBody) model.NumberSequence {
+	types := make([]model.OrderType, len(req.Types))
+	for i, t := range req.Types {
+		types[i] = model.OrderType(t)
+	}
+	return model.NumberSequence{
+		Title:       req.Title,
+		Prefix:      req.Prefix,
+		Suffix:      req.Suffix,
+		WithYear:    req.WithYear,
+		Padding:     req.Padding,
+		YearlyReset: req.YearlyReset,
+		Types:       types,
+	}
+}
@@ -0,0 +1,80 @@
+package convert
+
+import (
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/staffing/model"
+)
+
+func FromAPIAddStaffUnitRequest(req api.AddStaffUnitJSONRequestBody) model.StaffUnit {
+	return model.StaffUnit{
+		DepartmentID: req.DepartmentID,
+		PositionID:   req.PositionID,
+		Quantity:     req.Quantity,
+		Rate:         float64(req.Rate),
+		Grade:        req.Grade,
+		SalaryMin:    req.SalaryMin,
+		SalaryMax:    req.SalaryMax,
+		DateBegin:    req.DateFrom.Time,
+	}
+}
+
+func FromAPIPutStaffUnitRequest(req api.PutStaffUnitJSONRequestBody) model.StaffUnit {
+	return model.StaffUnit{
+		Quantity:  req.Quantity,
+		Rate:      float64(req.Rate),
+		Grade:     req.Grade,
+		SalaryMin: req.SalaryMin,
+		SalaryMax: req.SalaryMax,
+		DateBegin: req.DateFrom.Time,
+	}
+}
+
+func ToAPIStaffUnit(su *model.StaffUnit) api.StaffUnit {
+	return toAPIStaffUnit(*su)
+}
+
+func ToAPIListStaffUnits(sus []model.StaffUnit) api.ListStaffUnitsResponse {
+	res := make([]api.StaffUnit, len(sus))
+	for i := 0; i < len(sus); i++ {
+		res[i] = toAPIStaffUnit(sus[i])
+	}
+	return res
+}
+
+func toAPIStaffUnit(su model.StaffUnit) api.StaffUnit {
+	res := api.StaffUnit{
+		ID:           su.ID,
+		DepartmentID: su.DepartmentID,
+		Department:   su.Department,
+		PositionID:   su.PositionID,
+		Position:     su.Position,
+		Quantity:     su.Quantity,
+		Rate:         api.StaffUnitRate(su.Rate),
+		Grade:        su.Grade,
+		SalaryMin:    su.SalaryMin,
+		SalaryMax:    su.SalaryMax,
+		DateFrom:     types.Date{Time: su.DateBegin},
+	}
+	if su.DateEnd != nil {
+		res.DateTo = &types.Date{Time: *su.DateEnd}
+	}
+	return res
+}
+
+func ToAPIListStaffingVacancies(vs []model.Vacancy) api.ListStaffingVacanciesResponse {
+	res := make([]api.StaffingVacancy, len(vs))
+	for i, v := range vs {
+		res[i] = api.StaffingVacancy{
+			DepartmentID: v.DepartmentID,
+			Department:   v.Department,
+			PositionID:   v.PositionID,
+			Position:     v.Position,
+			Slots:        v.Slots,
+			Filled:       v.Filled,
+			Vacant:       v.Vacant(),
+		}
+	}
+	return res
+}
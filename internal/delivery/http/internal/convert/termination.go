@@ -0,0 +1,25 @@
+package convert
+
+import (
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+func FromAPITermination(req api.TerminateUserJSONRequestBody) model.Termination {
+	return model.Termination{
+		Date:   req.Date.Time,
+		Reason: model.TerminationReason(req.Reason),
+	}
+}
+
+func ToAPITermination(t *model.Termination) *api.Termination {
+	if t == nil {
+		return nil
+	}
+	return &api.Termination{
+		Date:   types.Date{Time: t.Date},
+		Reason: api.TerminationReason(t.Reason),
+	}
+}
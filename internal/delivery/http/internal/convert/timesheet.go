@@ -0,0 +1,106 @@
+package convert
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+var monthTitles = [...]string{
+	"январь", "февраль", "март", "апрель", "май", "июнь",
+	"июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь",
+}
+
+func FromAPIPutTimesheetDayRequest(userID uint64, date time.Time,
+	req api.PutTimesheetDayJSONRequestBody) model.TimesheetCorrection {
+	c := model.TimesheetCorrection{
+		UserID: userID,
+		Date:   date,
+		Code:   model.TimesheetCode(req.Code),
+		Note:   req.Note,
+	}
+	if req.Hours != nil {
+		c.Hours = *req.Hours
+	}
+	return c
+}
+
+func ToAPITimesheet(ts *model.Timesheet) api.Timesheet {
+	res := api.Timesheet{
+		DepartmentID: ts.DepartmentID,
+		Department:   ts.Department,
+		Year:         ts.Year,
+		Month:        int(ts.Month),
+		Rows:         make([]api.TimesheetRow, len(ts.Rows)),
+	}
+	for i, row := range ts.Rows {
+		days := make([]api.TimesheetDay, len(row.Days))
+		for j, d := range row.Days {
+			days[j] = api.TimesheetDay{
+				Date:      types.Date{Time: d.Date},
+				Code:      string(d.Code),
+				Hours:     d.Hours,
+				Corrected: d.Corrected,
+			}
+			if d.Corrected {
+				days[j].Note = &d.Note
+			}
+		}
+		res.Rows[i] = api.TimesheetRow{
+			UserID:      row.UserID,
+			LastName:    row.LastName,
+			FirstName:   row.FirstName,
+			MiddleName:  row.MiddleName,
+			Position:    row.Position,
+			Days:        days,
+			WorkedDays:  row.WorkedDays(),
+			WorkedHours: row.WorkedHours(),
+		}
+	}
+	return res
+}
+
+// TimesheetTitle returns the title of the printable timesheet.
+func TimesheetTitle(ts *model.Timesheet) string {
+	return "Табель учёта рабочего времени (форма Т-13): " + ts.Department + ", " +
+		monthTitles[ts.Month-1] + " " + strconv.Itoa(ts.Year)
+}
+
+// TimesheetTable returns the timesheet as a table for export, every employee takes
+// two rows as in form T-13: the codes of the days and the worked hours.
+func TimesheetTable(ts *model.Timesheet) [][]string {
+	_, last := model.TimesheetPeriod(ts.Year, ts.Month)
+	header := make([]string, 0, last.Day()+5)
+	header = append(header, "№", "ФИО", "Должность")
+	for d := 1; d <= last.Day(); d++ {
+		header = append(header, strconv.Itoa(d))
+	}
+	header = append(header, "Дней", "Часов")
+
+	table := make([][]string, 0, 2*len(ts.Rows)+1)
+	table = append(table, header)
+	for i, row := range ts.Rows {
+		codes := make([]string, 0, len(header))
+		hours := make([]string, 0, len(header))
+		name := strings.TrimSpace(strings.Join([]string{row.LastName, row.FirstName, row.MiddleName}, " "))
+		codes = append(codes, strconv.Itoa(i+1), name, row.Position)
+		hours = append(hours, "", "", "")
+		for _, d := range row.Days {
+			codes = append(codes, string(d.Code))
+			if d.Hours > 0 {
+				hours = append(hours, strconv.Itoa(d.Hours))
+			} else {
+				hours = append(hours, "")
+			}
+		}
+		codes = append(codes, strconv.Itoa(row.WorkedDays()), "")
+		hours = append(hours, "", strconv.Itoa(row.WorkedHours()))
+		table = append(table, codes, hours)
+	}
+	return table
+}
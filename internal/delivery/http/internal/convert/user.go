@@ -25,6 +25,8 @@ func FromAPIAddUserRequest(req api.AddUserJSONRequestBody) model.User {
 		Taxpayer:            model.Taxpayer{Number: req.Taxpayer.Number},
 		PositionID:          req.PositionID,
 		DepartmentID:        req.DepartmentID,
+		Military:            FromAPIMilitary(req.Military),
+		WorkPermit:          FromAPIWorkPermit(req.WorkPermit),
 	}
 	switch req.Gender {
 	case api.Female:
@@ -32,6 +34,12 @@ func FromAPIAddUserRequest(req api.AddUserJSONRequestBody) model.User {
 	case api.Male:
 		user.Gender = model.GenderMale
 	}
+	if req.PersonnelNumber != nil {
+		user.PersonnelNumber = *req.PersonnelNumber
+	}
+	if req.PositionDate != nil {
+		user.PositionDate = &req.PositionDate.Time
+	}
 	if req.PhoneNumbers != nil {
 		user.PhoneNumbers = make(map[string]string, len(req.PhoneNumbers))
 		for k, v := range req.PhoneNumbers {
@@ -60,6 +68,8 @@ func FromAPIPutUserRequest(userID uint64, req api.PutUserJSONRequestBody) model.
 		Taxpayer:            model.Taxpayer{Number: req.Taxpayer.Number},
 		PositionID:          req.PositionID,
 		DepartmentID:        req.DepartmentID,
+		Military:            FromAPIMilitary(req.Military),
+		WorkPermit:          FromAPIWorkPermit(req.WorkPermit),
 	}
 	switch req.Gender {
 	case api.Female:
@@ -67,6 +77,12 @@ func FromAPIPutUserRequest(userID uint64, req api.PutUserJSONRequestBody) model.
 	case api.Male:
 		user.Gender = model.GenderMale
 	}
+	if req.PersonnelNumber != nil {
+		user.PersonnelNumber = *req.PersonnelNumber
+	}
+	if req.PositionDate != nil {
+		user.PositionDate = &req.PositionDate.Time
+	}
 	if req.PhoneNumbers != nil {
 		user.PhoneNumbers = make(map[string]string, len(req.PhoneNumbers))
 		for k, v := range req.PhoneNumbers {
@@ -80,12 +96,13 @@ func ToAPIGetExpandedUserResponse(u *model.ExpandedUser) api.GetExpandedUserResp
 	var expUser api.GetExpandedUserResponse
 
 	expUser.GetUserResponse = ToAPIGetUserResponse(&u.User)
-	expUser.Military = nil
 	expUser.Educations = ToAPIListEducations(u.Educations)
 	expUser.Trainings = ToAPIListTrainings(u.Trainings)
 	expUser.Passports = ToAPIExpandedPassports(u.Passports)
 	expUser.Vacations = ToAPIListVacations(u.Vacations)
 	expUser.Contracts = ToAPIListContracts(u.Contracts)
+	expUser.Relatives = ToAPIListRelatives(u.Relatives)
+	expUser.Family = ToAPIFamily(u.Family)
 
 	return expUser
 }
@@ -99,11 +116,13 @@ func ToAPIGetUserResponse(u *model.User) api.GetUserResponse {
 		Gender:              "",
 		Grade:               u.Grade,
 		ID:                  u.ID,
+		PersonnelNumber:     u.PersonnelNumber,
 		LastName:            u.LastName,
 		MiddleName:          u.MiddleName,
 		Nationality:         u.Nationality,
 		PlaceOfBirth:        u.PlaceOfBirth,
 		PositionID:          u.PositionID,
+		PositionTrack:       ToAPIPositionTrack(u.PositionTrack),
 		RegistrationAddress: u.RegistrationAddress,
 		ResidentialAddress:  u.ResidentialAddress,
 		Insurance: api.Insurance{
@@ -114,13 +133,9 @@ func ToAPIGetUserResponse(u *model.User) api.GetUserResponse {
 			Number:  u.Taxpayer.Number,
 			HasScan: &u.Taxpayer.HasScan,
 		},
-		Military: &api.Military{
-			Category:    u.Military.Category,
-			Comissariat: u.Military.Commissariat,
-			HasScan:     &u.Military.HasScan,
-			Rank:        u.Military.Rank,
-			Speciality:  u.Military.Speciality,
-		},
+		Military:    ToAPIMilitary(u.Military),
+		WorkPermit:  ToAPIWorkPermit(u.WorkPermit),
+		Termination: ToAPITermination(u.Termination),
 		PersonalDataProcessing: api.PersonalDataProcessing{
 			HasScan: u.PersonalDataProcessing.HasScan,
 		},
@@ -150,13 +165,14 @@ func ToAPIListUsers(users []model.ShortUserInfo) []api.ListUsersItem {
 
 func toAPIListUser(u model.ShortUserInfo) api.ListUsersItem {
 	item := api.ListUsersItem{
-		ID:         u.ID,
-		FirstName:  u.FirstName,
-		LastName:   u.LastName,
-		MiddleName: u.MiddleName,
-		Email:      types.Email(u.Email),
-		Position:   u.Position,
-		Department: u.Department,
+		ID:          u.ID,
+		FirstName:   u.FirstName,
+		LastName:    u.LastName,
+		MiddleName:  u.MiddleName,
+		Email:       types.Email(u.Email),
+		Position:    u.Position,
+		Department:  u.Department,
+		Termination: ToAPITermination(u.Termination),
 	}
 	if u.PhoneNumbers != nil {
 		item.PhoneNumbers = make(map[string]api.PhoneNumber, len(u.PhoneNumbers))
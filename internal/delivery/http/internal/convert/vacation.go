@@ -1,6 +1,8 @@
 package convert
 
 import (
+	"strconv"
+
 	"github.com/oapi-codegen/runtime/types"
 
 	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
@@ -45,3 +47,118 @@ func toAPIVacation(med model.Vacation) api.Vacation {
 		DateTo:   types.Date{Time: med.DateEnd},
 	}
 }
+
+func ToAPIVacationBalance(b *model.VacationBalance) api.VacationBalance {
+	return api.VacationBalance{
+		AnnualDays: b.AnnualDays,
+		Accrued:    b.Accrued,
+		Used:       b.Used,
+		Balance:    b.Balance,
+	}
+}
+
+func ToAPIListVacationBalances(bs []model.EmployeeVacationBalance) api.ListVacationBalancesResponse {
+	res := make([]api.EmployeeVacationBalance, len(bs))
+	for i, b := range bs {
+		res[i] = api.EmployeeVacationBalance{
+			UserID:     b.UserID,
+			LastName:   b.LastName,
+			FirstName:  b.FirstName,
+			MiddleName: b.MiddleName,
+			Department: b.Department,
+			Position:   b.Position,
+			AnnualDays: b.AnnualDays,
+			Accrued:    b.Accrued,
+			Used:       b.Used,
+			Balance:    b.Balance,
+		}
+	}
+	return res
+}
+
+// VacationBalancesTable returns the vacation balances as a table for export.
+func VacationBalancesTable(bs []model.EmployeeVacationBalance) [][]string {
+	table := make([][]string, 0, len(bs)+1)
+	table = append(table, []string{
+		"Фамилия", "Имя", "Отчество", "Подразделение", "Должность",
+		"Дней отпуска в год", "Начислено", "Использовано", "Остаток",
+	})
+	for _, b := range bs {
+		table = append(table, []string{
+			b.LastName,
+			b.FirstName,
+			b.MiddleName,
+			b.Department,
+			b.Position,
+			strconv.FormatUint(uint64(b.AnnualDays), 10),
+			strconv.FormatFloat(b.Accrued, 'f', 2, 64),
+			strconv.FormatUint(uint64(b.Used), 10),
+			strconv.FormatFloat(b.Balance, 'f', 2, 64),
+		})
+	}
+	return table
+}
+
+func ToAPIListAllVacations(evs []model.EmployeeVacation) api.ListAllVacationsResponse {
+	res := make([]api.EmployeeVacation, len(evs))
+	for i, ev := range evs {
+		res[i] = api.EmployeeVacation{
+			ID:         ev.ID,
+			UserID:     ev.UserID,
+			LastName:   ev.LastName,
+			FirstName:  ev.FirstName,
+			MiddleName: ev.MiddleName,
+			Department: ev.Department,
+			Position:   ev.Position,
+			DateFrom:   types.Date{Time: ev.DateBegin},
+			DateTo:     types.Date{Time: ev.DateEnd},
+		}
+	}
+	return res
+}
+
+func ToAPIVacationSchedule(vs *model.VacationSchedule) api.VacationSchedule {
+	items := make([]api.VacationScheduleItem, len(vs.Items))
+	for i, it := range vs.Items {
+		items[i] = api.VacationScheduleItem{
+			ID:           it.ID,
+			UserID:       it.UserID,
+			LastName:     it.LastName,
+			FirstName:    it.FirstName,
+			MiddleName:   it.MiddleName,
+			DepartmentID: it.DepartmentID,
+			Department:   it.Department,
+			Position:     it.Position,
+			DateFrom:     types.Date{Time: it.DateBegin},
+			DateTo:       types.Date{Time: it.DateEnd},
+		}
+	}
+	return api.VacationSchedule{
+		Year:       vs.Year,
+		Status:     api.VacationScheduleStatus(vs.Status),
+		ApprovedAt: vs.ApprovedAt,
+		LockedAt:   vs.LockedAt,
+		Items:      items,
+	}
+}
+
+func FromAPIAddVacationScheduleItemRequest(req api.AddVacationScheduleItemJSONRequestBody) model.ScheduleItem {
+	return model.ScheduleItem{
+		Vacation: model.Vacation{
+			DateBegin: req.DateFrom.Time,
+			DateEnd:   req.DateTo.Time,
+		},
+		UserID: req.UserID,
+	}
+}
+
+func FromAPIPutVacationScheduleItemRequest(itemID uint64,
+	req api.PutVacationScheduleItemJSONRequestBody) model.ScheduleItem {
+	return model.ScheduleItem{
+		Vacation: model.Vacation{
+			ID:        itemID,
+			DateBegin: req.DateFrom.Time,
+			DateEnd:   req.DateTo.Time,
+		},
+	}
+}
@@ -0,0 +1,60 @@
+package convert
+
+import (
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+func FromAPIAddVacationRequestRequest(req api.AddVacationRequestJSONRequestBody) model.VacationRequest {
+	r := model.VacationRequest{
+		DateBegin: req.DateFrom.Time,
+		DateEnd:   req.DateTo.Time,
+	}
+	if req.Comment != nil {
+		r.Comment = *req.Comment
+	}
+	return r
+}
+
+func FromAPIPutVacationRequestRequest(requestID uint64, req api.PutVacationRequestJSONRequestBody) model.VacationRequest {
+	r := model.VacationRequest{
+		ID:        requestID,
+		DateBegin: req.DateFrom.Time,
+		DateEnd:   req.DateTo.Time,
+	}
+	if req.Comment != nil {
+		r.Comment = *req.Comment
+	}
+	return r
+}
+
+func ToAPIVacationRequest(r *model.VacationRequest) api.VacationRequest {
+	return api.VacationRequest{
+		ID:              r.ID,
+		UserID:          r.UserID,
+		LastName:        r.LastName,
+		FirstName:       r.FirstName,
+		MiddleName:      r.MiddleName,
+		DateFrom:        types.Date{Time: r.DateBegin},
+		DateTo:          types.Date{Time: r.DateEnd},
+		Comment:         r.Comment,
+		Status:          api.VacationRequestStatus(r.Status),
+		HeadID:          r.HeadID,
+		HeadApprovedAt:  r.HeadApprovedAt,
+		SubmittedAt:     r.SubmittedAt,
+		DecidedBy:       r.DecidedBy,
+		DecidedAt:       r.DecidedAt,
+		DecisionComment: r.DecisionComment,
+		VacationID:      r.VacationID,
+	}
+}
+
+func ToAPIListVacationRequests(rs []model.VacationRequest) api.ListVacationRequestsResponse {
+	res := make([]api.VacationRequest, len(rs))
+	for i := range rs {
+		res[i] = ToAPIVacationRequest(&rs[i])
+	}
+	return res
+}
@@ -0,0 +1,89 @@
+package convert
+
+import (
+	"github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+func FromAPIWorkPermit(wp *api.WorkPermit) *model.WorkPermit {
+	if wp == nil {
+		return nil
+	}
+	mwp := &model.WorkPermit{
+		Number:    wp.Number,
+		ValidFrom: wp.ValidFrom.Time,
+		ValidTo:   wp.ValidTo.Time,
+	}
+	if wp.Version != nil {
+		mwp.Version = *wp.Version
+	}
+	return mwp
+}
+
+func ToAPIWorkPermit(wp *model.WorkPermit) *api.WorkPermit {
+	if wp == nil {
+		return nil
+	}
+	return &api.WorkPermit{
+		HasScan:   &wp.HasScan,
+		Number:    wp.Number,
+		ValidFrom: types.Date{Time: wp.ValidFrom},
+		ValidTo:   types.Date{Time: wp.ValidTo},
+		Version:   &wp.Version,
+	}
+}
+
+func FromAPIAddWorkPermitRequest(req api.AddWorkPermitJSONRequestBody) model.WorkPermit {
+	return model.WorkPermit{
+		Number:    req.Number,
+		ValidFrom: req.ValidFrom.Time,
+		ValidTo:   req.ValidTo.Time,
+	}
+}
+
+func FromAPIPutWorkPermitRequest(workPermitID uint64, req api.PutWorkPermitJSONRequestBody) model.WorkPermit {
+	return model.WorkPermit{
+		ID:        workPermitID,
+		Number:    req.Number,
+		ValidFrom: req.ValidFrom.Time,
+		ValidTo:   req.ValidTo.Time,
+	}
+}
+
+func ToAPIGetWorkPermitResponse(wp *model.WorkPermit) api.GetWorkPermitResponse {
+	return toAPIWorkPermitRecord(*wp)
+}
+
+func ToAPIListWorkPermits(wps []model.WorkPermit) api.ListWorkPermitsResponse {
+	res := make([]api.WorkPermitRecord, len(wps))
+	for i := 0; i < len(wps); i++ {
+		res[i] = toAPIWorkPermitRecord(wps[i])
+	}
+	return res
+}
+
+func ToAPIWorkPermitCheck(uncovered []model.Period) api.WorkPermitCheck {
+	res := api.WorkPermitCheck{
+		Valid:     len(uncovered) == 0,
+		Uncovered: make([]api.Period, len(uncovered)),
+	}
+	for i, p := range uncovered {
+		res.Uncovered[i] = api.Period{
+			DateFrom: types.Date{Time: p.DateFrom},
+			DateTo:   types.Date{Time: p.DateTo},
+		}
+	}
+	return res
+}
+
+func toAPIWorkPermitRecord(wp model.WorkPermit) api.WorkPermitRecord {
+	return api.WorkPermitRecord{
+		ID:        wp.ID,
+		Number:    wp.Number,
+		ValidFrom: types.Date{Time: wp.ValidFrom},
+		ValidTo:   types.Date{Time: wp.ValidTo},
+		HasScan:   wp.HasScan,
+	}
+}
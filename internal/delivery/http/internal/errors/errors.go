@@ -56,6 +56,7 @@ func ResponseServiceError(w http.ResponseWriter, r *http.Request, err error) {
 		ResponseError(w, r,
 			http.StatusInternalServerError,
 			ErrInternalServerErrorMsg)
+		return
 	}
 	ResponseError(w, r,
 		serviceStatusToHTTPStatusCode(serviceErr),
@@ -88,6 +89,8 @@ func serviceStatusToHTTPStatusCode(err *service.Error) int {
 		return http.StatusUnauthorized
 	case service.ContentTooLarge:
 		return http.StatusRequestEntityTooLarge
+	case service.PreconditionFailed:
+		return http.StatusPreconditionFailed
 	default:
 		return http.StatusInternalServerError
 	}
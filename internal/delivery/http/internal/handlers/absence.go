@@ -0,0 +1,215 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+	umodel "github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.ListAbsencesResponse
+// @Router  /users/{user_id}/absences [get]
+func (h *handler) ListAbsences(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	as, err := h.userService.ListAbsences(ctx, userID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListAbsences(as)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.AddAbsenceJSONRequestBody true ""
+// @Failure 409  {object} api.Error "the absence overlaps a vacation or another absence"
+// @Router  /users/{user_id}/absences [post]
+func (h *handler) AddAbsence(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	var a api.AddAbsenceJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &a); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := a.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	id, err := h.userService.AddAbsence(ctx, userID, convert.FromAPIAddAbsenceRequest(a))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location",
+		api.BaseURL+"/users/"+strconv.FormatUint(userID, 10)+
+			"/absences/"+strconv.FormatUint(id, 10))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Failure 404 {object} api.Error "absence not found"
+// @Router  /users/{user_id}/absences/{absence_id} [delete]
+func (h *handler) DeleteAbsence(w http.ResponseWriter, r *http.Request, userID, absenceID uint64) {
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.userService.DeleteAbsence(r.Context(), userID, absenceID, version); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Produce application/json
+// @Success 200 {object} api.Absence
+// @Router  /users/{user_id}/absences/{absence_id} [get]
+func (h *handler) GetAbsence(w http.ResponseWriter, r *http.Request, userID, absenceID uint64) {
+	ctx := r.Context()
+
+	a, err := h.userService.GetAbsence(ctx, userID, absenceID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	setETag(w, a.Version)
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIAbsence(a)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutAbsenceJSONRequestBody true ""
+// @Failure 409  {object} api.Error "the absence overlaps a vacation or another absence"
+// @Router  /users/{user_id}/absences/{absence_id} [put]
+func (h *handler) PutAbsence(w http.ResponseWriter, r *http.Request, userID, absenceID uint64) {
+	ctx := r.Context()
+
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	var a api.PutAbsenceJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &a); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := a.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	ma := convert.FromAPIPutAbsenceRequest(absenceID, a)
+	ma.Version = version
+	err := h.userService.UpdateAbsence(ctx, userID, ma)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Produce application/json,text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Success 200 {object} api.ListAllAbsencesResponse
+// @Router  /absences [get]
+func (h *handler) ListAllAbsences(w http.ResponseWriter, r *http.Request, params api.ListAllAbsencesParams) {
+	ctx := r.Context()
+
+	if err := params.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	lp := umodel.ListAbsencesParams{
+		DepartmentID: params.DepartmentID,
+		DateFrom:     params.DateFrom.Time,
+		DateTo:       params.DateTo.Time,
+	}
+	if params.Type != nil {
+		t := umodel.AbsenceType(*params.Type)
+		lp.Type = &t
+	}
+	as, err := h.userService.ListAllAbsences(ctx, lp)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	switch exportFormat(params.Format) {
+	case api.Csv:
+		err = response.CSV(w, "absences", convert.AbsencesTable(as))
+	case api.Xlsx:
+		err = response.XLSX(w, "absences", convert.AbsencesTable(as))
+	default:
+		err = response.JSON(w, http.StatusOK, convert.ToAPIListAllAbsences(as))
+	}
+	if err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Produce application/json,text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Success 200 {object} api.AbsenceReportResponse
+// @Router  /absences/report [get]
+func (h *handler) GetAbsenceReport(w http.ResponseWriter, r *http.Request, params api.GetAbsenceReportParams) {
+	ctx := r.Context()
+
+	if err := params.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	ss, err := h.userService.AbsenceReport(ctx, umodel.ListAbsencesParams{
+		DepartmentID: params.DepartmentID,
+		DateFrom:     params.DateFrom.Time,
+		DateTo:       params.DateTo.Time,
+	})
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	switch exportFormat(params.Format) {
+	case api.Csv:
+		err = response.CSV(w, "absence_report", convert.AbsenceReportTable(ss))
+	case api.Xlsx:
+		err = response.XLSX(w, "absence_report", convert.AbsenceReportTable(ss))
+	default:
+		err = response.JSON(w, http.StatusOK, convert.ToAPIAbsenceReport(ss))
+	}
+	if err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
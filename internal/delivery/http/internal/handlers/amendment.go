@@ -0,0 +1,147 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.ListAmendmentsResponse
+// @Router  /users/{user_id}/contracts/{contract_id}/amendments [get]
+func (h *handler) ListAmendments(w http.ResponseWriter, r *http.Request, userID, contractID uint64) {
+	ctx := r.Context()
+
+	as, err := h.userService.ListAmendments(ctx, userID, contractID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListAmendments(as)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.AddAmendmentJSONRequestBody true ""
+// @Failure 409  {object} api.Error "the amendment number is already used, the work type or the position not found"
+// @Router  /users/{user_id}/contracts/{contract_id}/amendments [post]
+func (h *handler) AddAmendment(w http.ResponseWriter, r *http.Request, userID, contractID uint64) {
+	ctx := r.Context()
+
+	var a api.AddAmendmentJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &a); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := a.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	id, err := h.userService.AddAmendment(ctx, userID, convert.FromAPIAddAmendmentRequest(contractID, a))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location",
+		api.BaseURL+"/users/"+strconv.FormatUint(userID, 10)+
+			"/contracts/"+strconv.FormatUint(contractID, 10)+
+			"/amendments/"+strconv.FormatUint(id, 10))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Produce application/json
+// @Success 200 {object} api.Amendment
+// @Router  /users/{user_id}/contracts/{contract_id}/amendments/{amendment_id} [get]
+func (h *handler) GetAmendment(w http.ResponseWriter, r *http.Request, userID, contractID, amendmentID uint64) {
+	ctx := r.Context()
+
+	a, err := h.userService.GetAmendment(ctx, userID, contractID, amendmentID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	setETag(w, a.Version)
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIAmendment(a)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutAmendmentJSONRequestBody true ""
+// @Failure 409  {object} api.Error "the amendment number is already used, the work type or the position not found"
+// @Router  /users/{user_id}/contracts/{contract_id}/amendments/{amendment_id} [put]
+func (h *handler) PutAmendment(w http.ResponseWriter, r *http.Request, userID, contractID, amendmentID uint64) {
+	ctx := r.Context()
+
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	var a api.PutAmendmentJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &a); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := a.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	ma := convert.FromAPIPutAmendmentRequest(contractID, amendmentID, a)
+	ma.Version = version
+	err := h.userService.UpdateAmendment(ctx, userID, ma)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Produce application/json
+// @Success 200 {object} api.ContractTerms
+// @Router  /users/{user_id}/contracts/{contract_id}/terms [get]
+func (h *handler) GetContractTerms(w http.ResponseWriter, r *http.Request, userID, contractID uint64,
+	params api.GetContractTermsParams) {
+	ctx := r.Context()
+
+	date := time.Now()
+	if params.Date != nil {
+		date = params.Date.Time
+	}
+
+	t, err := h.userService.GetContractTerms(ctx, userID, contractID, date)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIContractTerms(t)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
@@ -0,0 +1,231 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.ListBenefitsResponse
+// @Router  /benefits [get]
+func (h *handler) ListBenefits(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	bs, err := h.benefitService.ListBenefits(ctx)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListBenefits(bs)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.AddBenefitJSONRequestBody true ""
+// @Failure 409  {object} api.Error "department not found"
+// @Router  /benefits [post]
+func (h *handler) AddBenefit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var b api.AddBenefitJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &b); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := b.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	id, err := h.benefitService.AddBenefit(ctx, convert.FromAPIAddBenefitRequest(b))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location",
+		api.BaseURL+"/benefits/"+strconv.FormatUint(id, 10))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Produce application/json
+// @Success 200 {object} api.BenefitCostReportResponse
+// @Router  /benefits/report [get]
+func (h *handler) BenefitCostReport(w http.ResponseWriter, r *http.Request, params api.BenefitCostReportParams) {
+	ctx := r.Context()
+
+	if err := params.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	dcs, err := h.benefitService.CostReport(ctx, params.DateFrom.Time, params.DateTo.Time, params.DepartmentID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIBenefitCostReport(dcs)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Produce application/json
+// @Success 200 {object} api.Benefit
+// @Router  /benefits/{benefit_id} [get]
+func (h *handler) GetBenefit(w http.ResponseWriter, r *http.Request, benefitID uint64) {
+	ctx := r.Context()
+
+	b, err := h.benefitService.GetBenefit(ctx, benefitID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIBenefit(b)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutBenefitJSONRequestBody true ""
+// @Router  /benefits/{benefit_id} [put]
+func (h *handler) PutBenefit(w http.ResponseWriter, r *http.Request, benefitID uint64) {
+	ctx := r.Context()
+
+	var b api.PutBenefitJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &b); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := b.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	if err := h.benefitService.UpdateBenefit(ctx, convert.FromAPIPutBenefitRequest(benefitID, b)); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Produce application/json
+// @Success 200 {object} api.ListBenefitEnrolmentsResponse
+// @Router  /users/{user_id}/benefits [get]
+func (h *handler) ListBenefitEnrolments(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	es, err := h.benefitService.ListEnrolments(ctx, userID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListBenefitEnrolments(es)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.AddBenefitEnrolmentJSONRequestBody true ""
+// @Failure 409  {object} api.Error "the employee is terminated, not eligible or already uses the benefit"
+// @Router  /users/{user_id}/benefits [post]
+func (h *handler) AddBenefitEnrolment(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	var e api.AddBenefitEnrolmentJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &e); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := e.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	if _, err := h.benefitService.AddEnrolment(ctx, userID, convert.FromAPIAddBenefitEnrolmentRequest(e)); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location",
+		api.BaseURL+"/users/"+strconv.FormatUint(userID, 10)+"/benefits")
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Failure 404 {object} api.Error "enrolment not found"
+// @Failure 409 {object} api.Error "the employee is terminated"
+// @Router  /users/{user_id}/benefits/{enrolment_id} [delete]
+func (h *handler) DeleteBenefitEnrolment(w http.ResponseWriter, r *http.Request, userID, enrolmentID uint64) {
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.benefitService.DeleteEnrolment(r.Context(), userID, enrolmentID, version); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutBenefitEnrolmentJSONRequestBody true ""
+// @Failure 409  {object} api.Error "the employee is terminated, not eligible on the new first day or the period overlaps"
+// @Router  /users/{user_id}/benefits/{enrolment_id} [put]
+func (h *handler) PutBenefitEnrolment(w http.ResponseWriter, r *http.Request, userID, enrolmentID uint64) {
+	ctx := r.Context()
+
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	var e api.PutBenefitEnrolmentJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &e); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := e.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	me := convert.FromAPIPutBenefitEnrolmentRequest(enrolmentID, e)
+	me.Version = version
+	err := h.benefitService.UpdateEnrolment(ctx, userID, me)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
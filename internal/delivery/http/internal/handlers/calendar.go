@@ -0,0 +1,136 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/calendar"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.CalendarDaysCount
+// @Router  /calendar/count [get]
+func (h *handler) CountCalendarDays(w http.ResponseWriter, r *http.Request, params api.CountCalendarDaysParams) {
+	ctx := r.Context()
+
+	if err := params.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	c, err := h.calendarService.Count(ctx, params.DateFrom.Time, params.DateTo.Time)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPICalendarDaysCount(c)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Produce application/json
+// @Success 200 {object} api.ListCalendarDaysResponse
+// @Failure 404 {object} api.Error "the calendar of the year is not loaded"
+// @Router  /calendar/{year} [get]
+func (h *handler) ListCalendarDays(w http.ResponseWriter, r *http.Request, year uint64) {
+	ctx := r.Context()
+
+	days, err := h.calendarService.ListDays(ctx, int(year))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListCalendarDays(days)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutCalendarDaysJSONRequestBody true ""
+// @Router  /calendar/{year} [put]
+func (h *handler) PutCalendarDays(w http.ResponseWriter, r *http.Request, year uint64) {
+	ctx := r.Context()
+
+	var req api.PutCalendarDaysJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &req); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := req.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	err := h.calendarService.SetDays(ctx, int(year), convert.FromAPIPutCalendarDaysRequest(req))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Accept  multipart/form-data
+// @Param   body body api.ImportCalendarMultipartRequestBody true ""
+// @Router  /calendar/{year}/import [post]
+func (h *handler) ImportCalendar(w http.ResponseWriter, r *http.Request, year uint64) {
+	ctx := r.Context()
+
+	err := r.ParseMultipartForm(calendar.MaxImportSize)
+	if err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if header.Size > calendar.MaxImportSize {
+		srverr.ResponseError(w, r, http.StatusBadRequest, errLimitRequestBodySizeMsg)
+		return
+	}
+
+	var format calendar.ImportFormat
+	switch strings.ToLower(filepath.Ext(header.Filename)) {
+	case ".xml":
+		format = calendar.ImportFormatXML
+	case ".csv":
+		format = calendar.ImportFormatCSV
+	default:
+		srverr.ResponseError(w, r, http.StatusBadRequest, "the file must be .xml or .csv")
+		return
+	}
+
+	fr := http.MaxBytesReader(w, file, calendar.MaxImportSize)
+	defer fr.Close()
+
+	if err := h.calendarService.Import(ctx, int(year), format, fr); err != nil {
+		if errors.Is(err, new(http.MaxBytesError)) {
+			srverr.ResponseError(w, r, http.StatusBadRequest, errLimitRequestBodySizeMsg)
+			return
+		}
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
@@ -0,0 +1,143 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.ListCompensationsResponse
+// @Failure 403 {object} api.Error "no compensations read permission"
+// @Router  /users/{user_id}/compensations [get]
+func (h *handler) ListCompensations(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	if !h.allowed(r, objCompensations, actRead) {
+		srverr.ResponseError(w, r, http.StatusForbidden, errNotAllowedMsg)
+		return
+	}
+
+	cs, err := h.compensationService.ListCompensations(ctx, userID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListCompensations(cs)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.AddCompensationJSONRequestBody true ""
+// @Failure 403  {object} api.Error "no compensations write permission"
+// @Failure 409  {object} api.Error "the user is terminated"
+// @Router  /users/{user_id}/compensations [post]
+func (h *handler) AddCompensation(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	if !h.allowed(r, objCompensations, actWrite) {
+		srverr.ResponseError(w, r, http.StatusForbidden, errNotAllowedMsg)
+		return
+	}
+
+	var c api.AddCompensationJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &c); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := c.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	id, err := h.compensationService.AddCompensation(ctx, userID, convert.FromAPIAddCompensationRequest(c))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location",
+		api.BaseURL+"/users/"+strconv.FormatUint(userID, 10)+
+			"/compensations/"+strconv.FormatUint(id, 10))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Produce application/json
+// @Success 200 {object} api.Compensation
+// @Failure 403 {object} api.Error "no compensations read permission"
+// @Router  /users/{user_id}/compensations/{compensation_id} [get]
+func (h *handler) GetCompensation(w http.ResponseWriter, r *http.Request, userID uint64, compensationID uint64) {
+	ctx := r.Context()
+
+	if !h.allowed(r, objCompensations, actRead) {
+		srverr.ResponseError(w, r, http.StatusForbidden, errNotAllowedMsg)
+		return
+	}
+
+	c, err := h.compensationService.GetCompensation(ctx, userID, compensationID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	setETag(w, c.Version)
+	if err := response.JSON(w, http.StatusOK, convert.ToAPICompensation(c)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutCompensationJSONRequestBody true ""
+// @Failure 403  {object} api.Error "no compensations write permission"
+// @Failure 409  {object} api.Error "the user is terminated"
+// @Router  /users/{user_id}/compensations/{compensation_id} [put]
+func (h *handler) PutCompensation(w http.ResponseWriter, r *http.Request, userID uint64, compensationID uint64) {
+	ctx := r.Context()
+
+	if !h.allowed(r, objCompensations, actWrite) {
+		srverr.ResponseError(w, r, http.StatusForbidden, errNotAllowedMsg)
+		return
+	}
+
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	var c api.PutCompensationJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &c); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := c.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	mc := convert.FromAPIPutCompensationRequest(compensationID, c)
+	mc.Version = version
+	err := h.compensationService.UpdateCompensation(ctx, userID, mc)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
@@ -80,6 +80,7 @@ func (h *handler) GetContract(w http.ResponseWriter, r *http.Request, userID uin
 		return
 	}
 
+	setETag(w, c.Version)
 	if err := response.JSON(w, http.StatusOK, convert.ToAPIGetContractResponse(c)); err != nil {
 		srverr.LogError(r, err, false)
 		srverr.ResponseError(w, r,
@@ -108,10 +109,16 @@ func (h *handler) PatchContract(w http.ResponseWriter, r *http.Request, userID u
 
 // @Accept  application/json
 // @Param   body body api.PutContractJSONRequestBody true ""
+// @Failure 409  {object} api.Error "the contract is amended"
 // @Router  /users/{user_id}/contracts/{contract_id} [put]
 func (h *handler) PutContract(w http.ResponseWriter, r *http.Request, userID uint64, contractID uint64) {
 	ctx := r.Context()
 
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
 	var c api.PutContractJSONRequestBody
 	if err := request.DecodeJSONStrict(w, r, &c); err != nil {
 		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
@@ -124,7 +131,9 @@ func (h *handler) PutContract(w http.ResponseWriter, r *http.Request, userID uin
 		return
 	}
 
-	err := h.userService.UpdateContract(ctx, userID, convert.FromAPIPutContractRequest(contractID, c))
+	mc := convert.FromAPIPutContractRequest(contractID, c)
+	mc.Version = version
+	err := h.userService.UpdateContract(ctx, userID, mc)
 	if err != nil {
 		srverr.ResponseServiceError(w, r, err)
 		return
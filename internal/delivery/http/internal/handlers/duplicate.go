@@ -0,0 +1,29 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.ListDuplicatesResponse
+// @Router  /duplicates [get]
+func (h *handler) ListDuplicates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ds, err := h.userService.ListDuplicates(ctx)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListDuplicates(ds)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
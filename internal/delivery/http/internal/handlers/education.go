@@ -80,6 +80,7 @@ func (h *handler) GetEducation(w http.ResponseWriter, r *http.Request, userID ui
 		return
 	}
 
+	setETag(w, ed.Version)
 	if err := response.JSON(w, http.StatusOK, convert.ToAPIGetEducationResponse(ed)); err != nil {
 		srverr.LogError(r, err, false)
 		srverr.ResponseError(w, r,
@@ -115,6 +116,11 @@ func (h *handler) PatchEducation(w http.ResponseWriter, r *http.Request, userID
 func (h *handler) PutEducation(w http.ResponseWriter, r *http.Request, userID, educationID uint64) {
 	ctx := r.Context()
 
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
 	var e api.PutEducationJSONRequestBody
 	if err := request.DecodeJSONStrict(w, r, &e); err != nil {
 		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
@@ -127,7 +133,9 @@ func (h *handler) PutEducation(w http.ResponseWriter, r *http.Request, userID, e
 		return
 	}
 
-	err := h.userService.UpdateEducation(ctx, userID, convert.FromAPIPutEducationRequest(educationID, e))
+	med := convert.FromAPIPutEducationRequest(educationID, e)
+	med.Version = version
+	err := h.userService.UpdateEducation(ctx, userID, med)
 	if err != nil {
 		srverr.ResponseServiceError(w, r, err)
 		return
@@ -0,0 +1,55 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	umodel "github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+// The updates and deletions of the documents of the employee require If-Match.
+// Not versioned on purpose:
+//   - DeleteScan: not implemented, the scans are never edited;
+//   - PatchOnboardingItem: the only changeable field of the item is set to the given value;
+//   - PutTimesheetDay, DeleteTimesheetDay: the correction is the whole state of the day
+//     replacing the previous one, the author of the correction is recorded.
+
+// setETag sets the ETag of the resource to be sent back in If-Match on updating.
+func setETag(w http.ResponseWriter, version int64) {
+	w.Header().Set("ETag", strconv.Quote(strconv.FormatInt(version, 10)))
+}
+
+// ifMatch returns the version of the resource from the If-Match header
+// (request.AnyVersion for "*"), it writes the error response
+// if the header is missing, malformed or can't match the resource.
+func ifMatch(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	version, err := request.IfMatch(r)
+	if err != nil {
+		responseIfMatchError(w, r, err)
+		return 0, false
+	}
+	return version, true
+}
+
+// ifMatchOrNew is ifMatch of the resource that is created or replaced by PUT:
+// the resource is created with If-None-Match: * (umodel.NewVersion), it's replaced by If-Match.
+func ifMatchOrNew(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	if r.Header.Get("If-None-Match") == "*" {
+		return umodel.NewVersion, true
+	}
+	return ifMatch(w, r)
+}
+
+func responseIfMatchError(w http.ResponseWriter, r *http.Request, err error) {
+	status := http.StatusPreconditionFailed
+	switch {
+	case errors.Is(err, request.ErrNoIfMatch):
+		status = http.StatusPreconditionRequired
+	case errors.Is(err, request.ErrMalformedIfMatch):
+		status = http.StatusBadRequest
+	}
+	srverr.ResponseError(w, r, status, err.Error())
+}
@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIfMatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		header      string
+		wantOK      bool
+		wantVersion int64
+		wantStatus  int
+	}{
+		{name: "missing", wantStatus: http.StatusPreconditionRequired},
+		{name: "malformed", header: "3", wantStatus: http.StatusBadRequest},
+		{name: "weak", header: `W/"3"`, wantStatus: http.StatusPreconditionFailed},
+		{name: "strong", header: `"3"`, wantOK: true, wantVersion: 3},
+		{name: "any", header: "*", wantOK: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPut, "/users/1", nil)
+			if tt.header != "" {
+				r.Header.Set("If-Match", tt.header)
+			}
+			w := httptest.NewRecorder()
+
+			version, ok := ifMatch(w, r)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantVersion, version)
+			if !tt.wantOK {
+				assert.Equal(t, tt.wantStatus, w.Code)
+			}
+		})
+	}
+}
+
+func TestSetETag(t *testing.T) {
+	w := httptest.NewRecorder()
+	setETag(w, 7)
+	assert.Equal(t, `"7"`, w.Header().Get("ETag"))
+}
@@ -0,0 +1,152 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.ListExperiencesResponse
+// @Router  /users/{user_id}/experiences [get]
+func (h *handler) ListExperiences(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	exs, err := h.userService.ListExperiences(ctx, userID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListExperiences(exs)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.AddExperienceJSONRequestBody true ""
+// @Failure 409  {object} api.Error "user not found"
+// @Router  /users/{user_id}/experiences [post]
+func (h *handler) AddExperience(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	var ex api.AddExperienceJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &ex); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := ex.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	id, err := h.userService.AddExperience(ctx, userID, convert.FromAPIAddExperienceRequest(ex))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location",
+		api.BaseURL+"/users/"+strconv.FormatUint(userID, 10)+
+			"/experiences/"+strconv.FormatUint(id, 10))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Produce application/json
+// @Success 200 {object} api.ExperienceLength
+// @Router  /users/{user_id}/experiences/length [get]
+func (h *handler) GetExperienceLength(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	total, continuous, err := h.userService.GetExperienceLength(ctx, userID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIExperienceLength(total, continuous)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Failure 404 {object} api.Error "experience not found"
+// @Router  /users/{user_id}/experiences/{experience_id} [delete]
+func (h *handler) DeleteExperience(w http.ResponseWriter, r *http.Request, userID, experienceID uint64) {
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.userService.DeleteExperience(r.Context(), userID, experienceID, version); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Produce application/json
+// @Success 200 {object} api.GetExperienceResponse
+// @Router  /users/{user_id}/experiences/{experience_id} [get]
+func (h *handler) GetExperience(w http.ResponseWriter, r *http.Request, userID, experienceID uint64) {
+	ctx := r.Context()
+
+	ex, err := h.userService.GetExperience(ctx, userID, experienceID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	setETag(w, ex.Version)
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIGetExperienceResponse(ex)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutExperienceJSONRequestBody true ""
+// @Router  /users/{user_id}/experiences/{experience_id} [put]
+func (h *handler) PutExperience(w http.ResponseWriter, r *http.Request, userID, experienceID uint64) {
+	ctx := r.Context()
+
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	var ex api.PutExperienceJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &ex); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := ex.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	mex := convert.FromAPIPutExperienceRequest(experienceID, ex)
+	mex.Version = version
+	err := h.userService.UpdateExperience(ctx, userID, mex)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
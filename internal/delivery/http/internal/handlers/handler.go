@@ -3,6 +3,8 @@ package handlers
 import (
 	"log/slog"
 
+	"github.com/casbin/casbin/v2"
+
 	"github.com/Employee-s-file-cabinet/backend/internal/config/env"
 	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
 )
@@ -13,13 +15,25 @@ type handler struct {
 	userService             UserService
 	authService             AuthService
 	passwordRecoveryService PasswordRecoveryService
+	staffingService         StaffingService
+	compensationService     CompensationService
+	benefitService          BenefitService
+	calendarService         CalendarService
+	recruitingService       RecruitingService
+	enforcer                *casbin.SyncedEnforcer
 	envType                 env.Type
 	logger                  *slog.Logger
 }
 
-func New(envType env.Type, userService UserService,
+func New(envType env.Type, enforcer *casbin.SyncedEnforcer,
+	userService UserService,
 	authService AuthService,
 	passwordRecoveryService PasswordRecoveryService,
+	staffingService StaffingService,
+	compensationService CompensationService,
+	benefitService BenefitService,
+	calendarService CalendarService,
+	recruitingService RecruitingService,
 	logger *slog.Logger) *handler {
 	return &handler{
 		envType:                 envType,
@@ -27,5 +41,11 @@ func New(envType env.Type, userService UserService,
 		userService:             userService,
 		authService:             authService,
 		passwordRecoveryService: passwordRecoveryService,
+		staffingService:         staffingService,
+		compensationService:     compensationService,
+		benefitService:          benefitService,
+		calendarService:         calendarService,
+		recruitingService:       recruitingService,
+		enforcer:                enforcer,
 	}
 }
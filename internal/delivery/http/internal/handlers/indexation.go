@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.ListIndexationsResponse
+// @Failure 403 {object} api.Error "no compensations read permission"
+// @Router  /users/{user_id}/indexations [get]
+func (h *handler) ListIndexations(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	if !h.allowed(r, objCompensations, actRead) {
+		srverr.ResponseError(w, r, http.StatusForbidden, errNotAllowedMsg)
+		return
+	}
+
+	is, err := h.compensationService.ListIndexations(ctx, userID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListIndexations(is)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Produce application/json
+// @Success 200 {object} api.ListIndexationRunsResponse
+// @Failure 403 {object} api.Error "no compensations read permission"
+// @Router  /indexations [get]
+func (h *handler) ListIndexationRuns(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if !h.allowed(r, objCompensations, actRead) {
+		srverr.ResponseError(w, r, http.StatusForbidden, errNotAllowedMsg)
+		return
+	}
+
+	runs, err := h.compensationService.ListIndexationRuns(ctx)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListIndexationRuns(runs)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.AddIndexationRunJSONRequestBody true ""
+// @Failure 403  {object} api.Error "no compensations write permission"
+// @Router  /indexations [post]
+func (h *handler) AddIndexationRun(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if !h.allowed(r, objCompensations, actWrite) {
+		srverr.ResponseError(w, r, http.StatusForbidden, errNotAllowedMsg)
+		return
+	}
+
+	var run api.AddIndexationRunJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &run); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := run.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	id, err := h.compensationService.AddIndexationRun(ctx, convert.FromAPIIndexationRunRequest(run))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location",
+		api.BaseURL+"/indexations/"+strconv.FormatUint(id, 10))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Accept  application/json
+// @Produce application/json
+// @Param   body body api.PreviewIndexationRunJSONRequestBody true ""
+// @Success 200  {object} api.IndexationPreviewResponse
+// @Failure 403  {object} api.Error "no compensations read permission"
+// @Router  /indexations/preview [post]
+func (h *handler) PreviewIndexationRun(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	if !h.allowed(r, objCompensations, actRead) {
+		srverr.ResponseError(w, r, http.StatusForbidden, errNotAllowedMsg)
+		return
+	}
+
+	var run api.PreviewIndexationRunJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &run); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := run.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	items, err := h.compensationService.PreviewIndexationRun(ctx, convert.FromAPIIndexationRunRequest(run))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIIndexationPreview(items)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Failure 403 {object} api.Error "no compensations write permission"
+// @Failure 409 {object} api.Error "the indexation is already in effect"
+// @Router  /indexations/{run_id} [delete]
+func (h *handler) RevertIndexationRun(w http.ResponseWriter, r *http.Request, runID uint64) {
+	ctx := r.Context()
+
+	if !h.allowed(r, objCompensations, actWrite) {
+		srverr.ResponseError(w, r, http.StatusForbidden, errNotAllowedMsg)
+		return
+	}
+
+	if err := h.compensationService.RevertIndexationRun(ctx, runID); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Produce application/json
+// @Success 200 {object} api.IndexationRun
+// @Failure 403 {object} api.Error "no compensations read permission"
+// @Router  /indexations/{run_id} [get]
+func (h *handler) GetIndexationRun(w http.ResponseWriter, r *http.Request, runID uint64) {
+	ctx := r.Context()
+
+	if !h.allowed(r, objCompensations, actRead) {
+		srverr.ResponseError(w, r, http.StatusForbidden, errNotAllowedMsg)
+		return
+	}
+
+	run, err := h.compensationService.GetIndexationRun(ctx, runID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIIndexationRun(run)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
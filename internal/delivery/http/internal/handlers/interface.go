@@ -2,11 +2,18 @@ package handlers
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/casbin/casbin/v2"
 
 	"github.com/Employee-s-file-cabinet/backend/internal/service/auth/model/token"
+	bmodel "github.com/Employee-s-file-cabinet/backend/internal/service/benefit/model"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/calendar"
+	calmodel "github.com/Employee-s-file-cabinet/backend/internal/service/calendar/model"
+	cmodel "github.com/Employee-s-file-cabinet/backend/internal/service/compensation/model"
+	rmodel "github.com/Employee-s-file-cabinet/backend/internal/service/recruiting/model"
+	smodel "github.com/Employee-s-file-cabinet/backend/internal/service/staffing/model"
 	umodel "github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
 )
 
@@ -14,10 +21,18 @@ type UserService interface {
 	ListShortUserInfo(ctx context.Context, params umodel.ListUsersParams) (users []umodel.ShortUserInfo, totalCount int, err error)
 	Get(ctx context.Context, userID uint64) (*umodel.User, error)
 	GetExpanded(ctx context.Context, userID uint64) (*umodel.ExpandedUser, error)
-	Add(ctx context.Context, u umodel.User) (uint64, error)
-	Update(ctx context.Context, user umodel.User) error
+	Add(ctx context.Context, u umodel.User, force bool) (uint64, []string, error)
+	Update(ctx context.Context, user umodel.User, force bool) ([]string, error)
+	Terminate(ctx context.Context, userID uint64, t umodel.Termination) error
 	DownloadPhoto(ctx context.Context, userID uint64, hash string) (f umodel.File, closeFn func() error, err error)
 	UploadPhoto(ctx context.Context, userID uint64, f umodel.File) error
+	ListPositionHolders(ctx context.Context, positionID uint64, date *time.Time) ([]umodel.PositionHolder, error)
+
+	GetMilitary(ctx context.Context, userID uint64) (*umodel.Military, error)
+	SetMilitary(ctx context.Context, userID uint64, m umodel.Military) error
+	DeleteMilitary(ctx context.Context, userID uint64, version int64) error
+	ListMilitaryLiable(ctx context.Context, params umodel.ListMilitaryParams) ([]umodel.MilitaryLiable, error)
+	ListMilitaryNotifications(ctx context.Context, from, to time.Time) ([]umodel.MilitaryNotification, error)
 
 	ListEducations(ctx context.Context, userID uint64) ([]umodel.Education, error)
 	GetEducation(ctx context.Context, userID, educationID uint64) (*umodel.Education, error)
@@ -29,11 +44,34 @@ type UserService interface {
 	AddTraining(ctx context.Context, userID uint64, ed umodel.Training) (uint64, error)
 	UpdateTraining(ctx context.Context, userID uint64, tr umodel.Training) error
 
+	GetExperience(ctx context.Context, userID, experienceID uint64) (*umodel.Experience, error)
+	ListExperiences(ctx context.Context, userID uint64) ([]umodel.Experience, error)
+	AddExperience(ctx context.Context, userID uint64, ex umodel.Experience) (uint64, error)
+	UpdateExperience(ctx context.Context, userID uint64, ex umodel.Experience) error
+	DeleteExperience(ctx context.Context, userID, experienceID uint64, version int64) error
+	GetExperienceLength(ctx context.Context, userID uint64) (total, continuous umodel.Length, err error)
+
 	GetPassport(ctx context.Context, userID, passportID uint64) (*umodel.Passport, error)
 	ListPassports(ctx context.Context, userID uint64) ([]umodel.Passport, error)
 	AddPassport(ctx context.Context, userID uint64, ed umodel.Passport) (uint64, error)
 	UpdatePassport(ctx context.Context, userID uint64, p umodel.Passport) error
 
+	ListWorkPermits(ctx context.Context, userID uint64) ([]umodel.WorkPermit, error)
+	GetWorkPermit(ctx context.Context, userID, workPermitID uint64) (*umodel.WorkPermit, error)
+	AddWorkPermit(ctx context.Context, userID uint64, wp umodel.WorkPermit) (uint64, error)
+	UpdateWorkPermit(ctx context.Context, userID uint64, wp umodel.WorkPermit) error
+	DeleteWorkPermit(ctx context.Context, userID, workPermitID uint64, version int64) error
+	CheckWorkPermits(ctx context.Context, userID uint64) ([]umodel.Period, error)
+
+	ListOnboardingTemplates(ctx context.Context) ([]umodel.OnboardingTemplate, error)
+	GetOnboardingTemplate(ctx context.Context, templateID uint64) (*umodel.OnboardingTemplate, error)
+	AddOnboardingTemplate(ctx context.Context, t umodel.OnboardingTemplate) (uint64, error)
+	UpdateOnboardingTemplate(ctx context.Context, t umodel.OnboardingTemplate) error
+	DeleteOnboardingTemplate(ctx context.Context, templateID uint64) error
+	ListOnboardingItems(ctx context.Context, userID uint64) ([]umodel.OnboardingItem, error)
+	SetOnboardingItemCompleted(ctx context.Context, userID, itemID uint64, completed bool) error
+	ListOverdueOnboarding(ctx context.Context, departmentID *uint64) ([]umodel.OverdueOnboarding, error)
+
 	GetVisa(ctx context.Context, userID, passportID, visaID uint64) (*umodel.Visa, error)
 	ListVisas(ctx context.Context, userID, passportID uint64) ([]umodel.Visa, error)
 	AddVisa(ctx context.Context, userID, passportID uint64, mv umodel.Visa) (uint64, error)
@@ -41,8 +79,43 @@ type UserService interface {
 
 	GetVacation(ctx context.Context, userID, vacationID uint64) (*umodel.Vacation, error)
 	ListVacations(ctx context.Context, userID uint64) ([]umodel.Vacation, error)
-	AddVacation(ctx context.Context, userID uint64, v umodel.Vacation) (uint64, error)
-	UpdateVacation(ctx context.Context, userID uint64, v umodel.Vacation) error
+	AddVacation(ctx context.Context, userID uint64, v umodel.Vacation, force bool) (uint64, []string, error)
+	UpdateVacation(ctx context.Context, userID uint64, v umodel.Vacation, force bool) ([]string, error)
+	GetVacationBalance(ctx context.Context, userID uint64) (*umodel.VacationBalance, error)
+	ListVacationBalances(ctx context.Context, departmentID *uint64) ([]umodel.EmployeeVacationBalance, error)
+	ListAllVacations(ctx context.Context, params umodel.ListVacationsParams) ([]umodel.EmployeeVacation, error)
+
+	GetVacationSchedule(ctx context.Context, year int, departmentID *uint64) (*umodel.VacationSchedule, error)
+	AddVacationScheduleItem(ctx context.Context, year int, item umodel.ScheduleItem) (uint64, []string, error)
+	UpdateVacationScheduleItem(ctx context.Context, year int, item umodel.ScheduleItem) ([]string, error)
+	DeleteVacationScheduleItem(ctx context.Context, year int, itemID uint64) error
+	SetVacationScheduleStatus(ctx context.Context, year int, status umodel.ScheduleStatus) error
+
+	GetVacationRequest(ctx context.Context, a umodel.Actor, requestID uint64) (*umodel.VacationRequest, error)
+	ListVacationRequests(ctx context.Context, a umodel.Actor) ([]umodel.VacationRequest, error)
+	ListIncomingVacationRequests(ctx context.Context, a umodel.Actor) ([]umodel.VacationRequest, error)
+	AddVacationRequest(ctx context.Context, a umodel.Actor, r umodel.VacationRequest) (uint64, error)
+	UpdateVacationRequest(ctx context.Context, a umodel.Actor, r umodel.VacationRequest) error
+	ChangeVacationRequest(ctx context.Context,
+		a umodel.Actor, requestID uint64, action umodel.RequestAction, comment string, force bool) ([]string, error)
+
+	ListAbsences(ctx context.Context, userID uint64) ([]umodel.Absence, error)
+	GetAbsence(ctx context.Context, userID, absenceID uint64) (*umodel.Absence, error)
+	AddAbsence(ctx context.Context, userID uint64, a umodel.Absence) (uint64, error)
+	UpdateAbsence(ctx context.Context, userID uint64, a umodel.Absence) error
+	DeleteAbsence(ctx context.Context, userID, absenceID uint64, version int64) error
+	ListAllAbsences(ctx context.Context, params umodel.ListAbsencesParams) ([]umodel.EmployeeAbsence, error)
+	AbsenceReport(ctx context.Context, params umodel.ListAbsencesParams) ([]umodel.AbsenceSummary, error)
+
+	ListRelatives(ctx context.Context, userID uint64) ([]umodel.Relative, error)
+	GetRelative(ctx context.Context, userID, relativeID uint64) (*umodel.Relative, error)
+	AddRelative(ctx context.Context, userID uint64, r umodel.Relative) (uint64, error)
+	UpdateRelative(ctx context.Context, userID uint64, r umodel.Relative) error
+	DeleteRelative(ctx context.Context, userID, relativeID uint64, version int64) error
+
+	GetTimesheet(ctx context.Context, departmentID uint64, year int, month time.Month) (*umodel.Timesheet, error)
+	SetTimesheetCorrection(ctx context.Context, year int, month time.Month, c umodel.TimesheetCorrection) error
+	DeleteTimesheetCorrection(ctx context.Context, year int, month time.Month, userID uint64, date time.Time) error
 
 	GetScan(ctx context.Context, userID, scanID uint64) (*umodel.Scan, error)
 	ListScans(ctx context.Context, userID uint64) ([]umodel.Scan, error)
@@ -52,13 +125,33 @@ type UserService interface {
 	ListContracts(ctx context.Context, userID uint64) ([]umodel.Contract, error)
 	AddContract(ctx context.Context, userID uint64, ed umodel.Contract) (uint64, error)
 	UpdateContract(ctx context.Context, userID uint64, c umodel.Contract) error
+	ListProbationEnds(ctx context.Context, days uint) ([]umodel.ProbationEmployee, error)
+	SetProbationResult(ctx context.Context, userID, contractID uint64, r umodel.ProbationResult) error
+	ListAmendments(ctx context.Context, userID, contractID uint64) ([]umodel.Amendment, error)
+	GetAmendment(ctx context.Context, userID, contractID, amendmentID uint64) (*umodel.Amendment, error)
+	AddAmendment(ctx context.Context, userID uint64, a umodel.Amendment) (uint64, error)
+	UpdateAmendment(ctx context.Context, userID uint64, a umodel.Amendment) error
+
+	ListOrders(ctx context.Context, params umodel.ListOrdersParams) ([]umodel.Order, error)
+	GetOrder(ctx context.Context, orderID uint64) (*umodel.Order, error)
+	AddOrder(ctx context.Context, o umodel.Order) (uint64, error)
+	UpdateOrder(ctx context.Context, o umodel.Order) error
+	ChangeOrder(ctx context.Context, orderID uint64, action umodel.OrderAction, date *time.Time) error
+
+	ListNumberSequences(ctx context.Context) ([]umodel.NumberSequence, error)
+	AddNumberSequence(ctx context.Context, seq umodel.NumberSequence) (uint64, error)
+	UpdateNumberSequence(ctx context.Context, seq umodel.NumberSequence) error
+
+	ListDuplicates(ctx context.Context) ([]umodel.Duplicate, error)
+
+	GetContractTerms(ctx context.Context, userID, contractID uint64, date time.Time) (*umodel.ContractTerms, error)
 }
 
 type AuthService interface {
 	Login(ctx context.Context, login, password string) (string, string, error)
 	Expires() time.Time
 	Payload(token, sign string) (*token.Payload, error)
-	PolicyEnforcer() (*casbin.Enforcer, error)
+	PolicyEnforcer() (*casbin.SyncedEnforcer, error)
 }
 
 type PasswordRecoveryService interface {
@@ -66,3 +159,67 @@ type PasswordRecoveryService interface {
 	ChangePassword(ctx context.Context, key, newPassword string) error
 	Check(ctx context.Context, key string) error
 }
+
+type StaffingService interface {
+	ListStaffUnits(ctx context.Context, date time.Time, departmentID *uint64) ([]smodel.StaffUnit, error)
+	GetStaffUnit(ctx context.Context, unitID uint64) (*smodel.StaffUnit, error)
+	AddStaffUnit(ctx context.Context, su smodel.StaffUnit) (uint64, error)
+	ChangeStaffUnit(ctx context.Context, unitID uint64, su smodel.StaffUnit) (uint64, error)
+	ListVacancies(ctx context.Context, date time.Time, departmentID *uint64) ([]smodel.Vacancy, error)
+}
+
+type CompensationService interface {
+	ListCompensations(ctx context.Context, userID uint64) ([]cmodel.Compensation, error)
+	GetCompensation(ctx context.Context, userID, compensationID uint64) (*cmodel.Compensation, error)
+	GetCurrentCompensation(ctx context.Context, userID uint64) (*cmodel.Compensation, error)
+	AddCompensation(ctx context.Context, userID uint64, c cmodel.Compensation) (uint64, error)
+	UpdateCompensation(ctx context.Context, userID uint64, c cmodel.Compensation) error
+
+	ListIndexations(ctx context.Context, userID uint64) ([]cmodel.Indexation, error)
+	PreviewIndexationRun(ctx context.Context, run cmodel.IndexationRun) ([]cmodel.IndexationItem, error)
+	AddIndexationRun(ctx context.Context, run cmodel.IndexationRun) (uint64, error)
+	ListIndexationRuns(ctx context.Context) ([]cmodel.IndexationRun, error)
+	GetIndexationRun(ctx context.Context, runID uint64) (*cmodel.IndexationRun, error)
+	RevertIndexationRun(ctx context.Context, runID uint64) error
+}
+
+type BenefitService interface {
+	ListBenefits(ctx context.Context) ([]bmodel.Benefit, error)
+	GetBenefit(ctx context.Context, benefitID uint64) (*bmodel.Benefit, error)
+	AddBenefit(ctx context.Context, b bmodel.Benefit) (uint64, error)
+	UpdateBenefit(ctx context.Context, b bmodel.Benefit) error
+
+	ListEnrolments(ctx context.Context, userID uint64) ([]bmodel.Enrolment, error)
+	AddEnrolment(ctx context.Context, userID uint64, e bmodel.Enrolment) (uint64, error)
+	UpdateEnrolment(ctx context.Context, userID uint64, e bmodel.Enrolment) error
+	DeleteEnrolment(ctx context.Context, userID, enrolmentID uint64, version int64) error
+	CostReport(ctx context.Context, from, to time.Time, departmentID *uint64) ([]bmodel.DepartmentCost, error)
+}
+
+type CalendarService interface {
+	ListDays(ctx context.Context, year int) ([]calmodel.Day, error)
+	SetDays(ctx context.Context, year int, days []calmodel.Day) error
+	Import(ctx context.Context, year int, format calendar.ImportFormat, r io.Reader) error
+	Count(ctx context.Context, from, to time.Time) (calmodel.DaysCount, error)
+}
+
+type RecruitingService interface {
+	ListVacancies(ctx context.Context, status *rmodel.VacancyStatus) ([]rmodel.Vacancy, error)
+	GetVacancy(ctx context.Context, vacancyID uint64) (*rmodel.Vacancy, error)
+	AddVacancy(ctx context.Context, v rmodel.Vacancy) (uint64, error)
+	UpdateVacancy(ctx context.Context, v rmodel.Vacancy) error
+
+	ListCandidates(ctx context.Context, params rmodel.ListCandidatesParams) ([]rmodel.Candidate, error)
+	GetCandidate(ctx context.Context, candidateID uint64) (*rmodel.Candidate, error)
+	AddCandidate(ctx context.Context, c rmodel.Candidate) (uint64, error)
+	UpdateCandidate(ctx context.Context, c rmodel.Candidate) error
+	MoveCandidate(ctx context.Context, candidateID uint64, stage rmodel.Stage) error
+	Hire(ctx context.Context, candidateID uint64, u umodel.User, force bool) (uint64, []string, error)
+
+	ListNotes(ctx context.Context, candidateID uint64) ([]rmodel.Note, error)
+	AddNote(ctx context.Context, candidateID uint64, n rmodel.Note) (uint64, error)
+
+	ListCVs(ctx context.Context, candidateID uint64) ([]rmodel.CV, error)
+	UploadCV(ctx context.Context, candidateID uint64, cv rmodel.CV, f rmodel.File) (uint64, error)
+	DownloadCV(ctx context.Context, candidateID, cvID uint64) (*rmodel.CV, rmodel.File, func() error, error)
+}
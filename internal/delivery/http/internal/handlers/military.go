@@ -0,0 +1,161 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+	umodel "github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.Military
+// @Failure 404 {object} api.Error "not liable for military service"
+// @Router  /users/{user_id}/military [get]
+func (h *handler) GetMilitary(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	m, err := h.userService.GetMilitary(ctx, userID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	setETag(w, m.Version)
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIMilitary(m)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutMilitaryJSONRequestBody true ""
+// @Router  /users/{user_id}/military [put]
+func (h *handler) PutMilitary(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	version, ok := ifMatchOrNew(w, r)
+	if !ok {
+		return
+	}
+
+	var m api.PutMilitaryJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &m); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := m.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	mm := convert.FromAPIMilitary(&m)
+	mm.Version = version
+	if err := h.userService.SetMilitary(ctx, userID, *mm); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Router /users/{user_id}/military [delete]
+func (h *handler) DeleteMilitary(w http.ResponseWriter, r *http.Request, userID uint64) {
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.userService.DeleteMilitary(r.Context(), userID, version); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Produce application/json,text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Success 200 {object} api.ListMilitaryLiableResponse
+// @Router  /military/liable [get]
+func (h *handler) ListMilitaryLiable(w http.ResponseWriter, r *http.Request, params api.ListMilitaryLiableParams) {
+	ctx := r.Context()
+
+	if err := params.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	mls, err := h.userService.ListMilitaryLiable(ctx, umodel.ListMilitaryParams{
+		Category:          params.Category,
+		Rank:              params.Rank,
+		IncludeTerminated: params.IncludeTerminated != nil && *params.IncludeTerminated,
+	})
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	switch exportFormat(params.Format) {
+	case api.Csv:
+		err = response.CSV(w, "military_liable", convert.MilitaryLiableTable(mls))
+	case api.Xlsx:
+		err = response.XLSX(w, "military_liable", convert.MilitaryLiableTable(mls))
+	default:
+		err = response.JSON(w, http.StatusOK, convert.ToAPIListMilitaryLiable(mls))
+	}
+	if err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Produce application/json,text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Success 200 {object} api.ListMilitaryNotificationsResponse
+// @Router  /military/notifications [get]
+func (h *handler) ListMilitaryNotifications(w http.ResponseWriter, r *http.Request,
+	params api.ListMilitaryNotificationsParams) {
+	ctx := r.Context()
+
+	if err := params.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	mns, err := h.userService.ListMilitaryNotifications(ctx, params.DateFrom.Time, params.DateTo.Time)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	switch exportFormat(params.Format) {
+	case api.Csv:
+		err = response.CSV(w, "military_notifications", convert.MilitaryNotificationsTable(mns))
+	case api.Xlsx:
+		err = response.XLSX(w, "military_notifications", convert.MilitaryNotificationsTable(mns))
+	default:
+		err = response.JSON(w, http.StatusOK, convert.ToAPIListMilitaryNotifications(mns))
+	}
+	if err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// exportFormat returns the requested format of the report or JSON.
+func exportFormat(format *api.ExportFormat) api.ExportFormat {
+	if format != nil {
+		return *format
+	}
+	return api.Json
+}
@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.ListOverdueOnboardingResponse
+// @Router  /onboarding/overdue [get]
+func (h *handler) ListOverdueOnboarding(w http.ResponseWriter, r *http.Request, params api.ListOverdueOnboardingParams) {
+	ctx := r.Context()
+
+	os, err := h.userService.ListOverdueOnboarding(ctx, params.DepartmentID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListOverdueOnboarding(os)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Produce application/json
+// @Success 200 {object} api.ListOnboardingTemplatesResponse
+// @Router  /onboarding/templates [get]
+func (h *handler) ListOnboardingTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ts, err := h.userService.ListOnboardingTemplates(ctx)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListOnboardingTemplates(ts)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.AddOnboardingTemplateJSONRequestBody true ""
+// @Failure 409  {object} api.Error "department or position not found"
+// @Router  /onboarding/templates [post]
+func (h *handler) AddOnboardingTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var t api.AddOnboardingTemplateJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &t); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := t.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	id, err := h.userService.AddOnboardingTemplate(ctx, convert.FromAPIAddOnboardingTemplateRequest(t))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location", api.BaseURL+"/onboarding/templates/"+strconv.FormatUint(id, 10))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Failure 404 {object} api.Error "onboarding template not found"
+// @Router  /onboarding/templates/{template_id} [delete]
+func (h *handler) DeleteOnboardingTemplate(w http.ResponseWriter, r *http.Request, templateID uint64) {
+	if err := h.userService.DeleteOnboardingTemplate(r.Context(), templateID); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Produce application/json
+// @Success 200 {object} api.OnboardingTemplate
+// @Router  /onboarding/templates/{template_id} [get]
+func (h *handler) GetOnboardingTemplate(w http.ResponseWriter, r *http.Request, templateID uint64) {
+	ctx := r.Context()
+
+	t, err := h.userService.GetOnboardingTemplate(ctx, templateID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIOnboardingTemplate(t)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutOnboardingTemplateJSONRequestBody true ""
+// @Router  /onboarding/templates/{template_id} [put]
+func (h *handler) PutOnboardingTemplate(w http.ResponseWriter, r *http.Request, templateID uint64) {
+	ctx := r.Context()
+
+	var t api.PutOnboardingTemplateJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &t); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := t.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	err := h.userService.UpdateOnboardingTemplate(ctx, convert.FromAPIPutOnboardingTemplateRequest(templateID, t))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Produce application/json
+// @Success 200 {object} api.ListOnboardingItemsResponse
+// @Router  /users/{user_id}/onboarding [get]
+func (h *handler) ListOnboardingItems(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	items, err := h.userService.ListOnboardingItems(ctx, userID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListOnboardingItems(items)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PatchOnboardingItemJSONRequestBody true ""
+// @Failure 409  {object} api.Error "the item is completed by uploading the scan"
+// @Router  /users/{user_id}/onboarding/{item_id} [patch]
+func (h *handler) PatchOnboardingItem(w http.ResponseWriter, r *http.Request, userID, itemID uint64) {
+	ctx := r.Context()
+
+	var req api.PatchOnboardingItemJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &req); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := h.userService.SetOnboardingItemCompleted(ctx, userID, itemID, req.Completed); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
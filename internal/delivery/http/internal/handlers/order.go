@@ -0,0 +1,145 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+	umodel "github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.ListOrdersResponse
+// @Router  /orders [get]
+func (h *handler) ListOrders(w http.ResponseWriter, r *http.Request, params api.ListOrdersParams) {
+	ctx := r.Context()
+
+	if err := params.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	os, err := h.userService.ListOrders(ctx, convert.FromAPIListOrdersParams(params))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListOrders(os)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.AddOrderJSONRequestBody true ""
+// @Failure 409  {object} api.Error "the user, the contract or the vacation not found"
+// @Router  /orders [post]
+func (h *handler) AddOrder(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var o api.AddOrderJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &o); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := o.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	id, err := h.userService.AddOrder(ctx, convert.FromAPIAddOrderRequest(o))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location", api.BaseURL+"/orders/"+strconv.FormatUint(id, 10))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Produce application/json
+// @Success 200 {object} api.Order
+// @Router  /orders/{order_id} [get]
+func (h *handler) GetOrder(w http.ResponseWriter, r *http.Request, orderID uint64) {
+	ctx := r.Context()
+
+	o, err := h.userService.GetOrder(ctx, orderID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIOrder(o)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutOrderJSONRequestBody true ""
+// @Failure 409  {object} api.Error "the order is not a draft, the user, the contract or the vacation not found"
+// @Router  /orders/{order_id} [put]
+func (h *handler) PutOrder(w http.ResponseWriter, r *http.Request, orderID uint64) {
+	ctx := r.Context()
+
+	var o api.PutOrderJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &o); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := o.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	if err := h.userService.UpdateOrder(ctx, convert.FromAPIPutOrderRequest(orderID, o)); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PatchOrderJSONRequestBody true ""
+// @Failure 409  {object} api.Error "the order is not a draft"
+// @Router  /orders/{order_id} [patch]
+func (h *handler) PatchOrder(w http.ResponseWriter, r *http.Request, orderID uint64) {
+	ctx := r.Context()
+
+	var p api.PatchOrderJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &p); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := p.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	var date *time.Time
+	if p.Date != nil {
+		date = &p.Date.Time
+	}
+	if err := h.userService.ChangeOrder(ctx, orderID, umodel.OrderAction(p.Action), date); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
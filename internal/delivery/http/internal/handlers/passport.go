@@ -87,6 +87,7 @@ func (h *handler) GetPassport(w http.ResponseWriter, r *http.Request, userID, pa
 		return
 	}
 
+	setETag(w, p.Version)
 	if err := response.JSON(w, http.StatusOK, convert.ToAPIGetPassportResponse(p)); err != nil {
 		srverr.LogError(r, err, false)
 		srverr.ResponseError(w, r,
@@ -119,6 +120,11 @@ func (h *handler) PatchPassport(w http.ResponseWriter, r *http.Request, userID u
 func (h *handler) PutPassport(w http.ResponseWriter, r *http.Request, userID, passportID uint64) {
 	ctx := r.Context()
 
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
 	var p api.PutPassportJSONRequestBody
 	if err := request.DecodeJSONStrict(w, r, &p); err != nil {
 		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
@@ -131,7 +137,9 @@ func (h *handler) PutPassport(w http.ResponseWriter, r *http.Request, userID, pa
 		return
 	}
 
-	err := h.userService.UpdatePassport(ctx, userID, convert.FromAPIPutPassportRequest(passportID, p))
+	mp := convert.FromAPIPutPassportRequest(passportID, p)
+	mp.Version = version
+	err := h.userService.UpdatePassport(ctx, userID, mp)
 	if err != nil {
 		srverr.ResponseServiceError(w, r, err)
 		return
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/middleware"
+	umodel "github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+// Dedicated permissions: casbin objects and actions that aren't REST resources.
+// They guard data which is a part of resources available for the role.
+const (
+	objCompensations    = "compensations"
+	objVacationRequests = "vacation_requests"
+
+	actRead   = "read"
+	actWrite  = "write"
+	actDecide = "decide"
+)
+
+const errNotAllowedMsg = "user is not allowed to access"
+
+// allowed reports whether the requesting user has the dedicated permission.
+func (h *handler) allowed(r *http.Request, obj, act string) bool {
+	payload, ok := middleware.Payload(r.Context())
+	if !ok {
+		return false
+	}
+	ok, err := h.enforcer.Enforce(payload.Data.UserID, obj, act)
+	if err != nil {
+		srverr.LogError(r, err, false)
+		return false
+	}
+	return ok
+}
+
+// actor returns the requesting user acting on vacation requests.
+func (h *handler) actor(r *http.Request) (umodel.Actor, bool) {
+	payload, ok := middleware.Payload(r.Context())
+	if !ok {
+		return umodel.Actor{}, false
+	}
+	userID, err := strconv.ParseUint(payload.Data.UserID, 10, 64)
+	if err != nil {
+		return umodel.Actor{}, false
+	}
+	return umodel.Actor{
+		UserID: userID,
+		HR:     h.allowed(r, objVacationRequests, actDecide),
+	}, true
+}
@@ -0,0 +1,36 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.ListPositionHoldersResponse
+// @Router  /positions/{position_id}/holders [get]
+func (h *handler) ListPositionHolders(w http.ResponseWriter, r *http.Request, positionID uint64, params api.ListPositionHoldersParams) {
+	ctx := r.Context()
+
+	var date *time.Time
+	if params.Date != nil {
+		date = &params.Date.Time
+	}
+
+	phs, err := h.userService.ListPositionHolders(ctx, positionID, date)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListPositionHolders(phs)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
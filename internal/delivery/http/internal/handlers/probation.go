@@ -0,0 +1,80 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+)
+
+// defaultProbationDays is the period of ending probations listed by default.
+const defaultProbationDays = 30
+
+// @Produce application/json
+// @Success 200 {object} api.ListProbationsResponse
+// @Router  /probations [get]
+func (h *handler) ListProbations(w http.ResponseWriter, r *http.Request, params api.ListProbationsParams) {
+	ctx := r.Context()
+
+	if err := params.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	days := uint(defaultProbationDays)
+	if params.Days != nil {
+		days = *params.Days
+	}
+
+	es, err := h.userService.ListProbationEnds(ctx, days)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListProbations(es)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutProbationResultJSONRequestBody true ""
+// @Failure 409  {object} api.Error "the contract has no probation period"
+// @Router  /users/{user_id}/contracts/{contract_id}/probation [put]
+func (h *handler) PutProbationResult(w http.ResponseWriter, r *http.Request, userID uint64, contractID uint64) {
+	ctx := r.Context()
+
+	version, ok := ifMatchOrNew(w, r)
+	if !ok {
+		return
+	}
+
+	var req api.PutProbationResultJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &req); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := req.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	pr := convert.FromAPIPutProbationResultRequest(req)
+	pr.Version = version
+	err := h.userService.SetProbationResult(ctx, userID, contractID, pr)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
@@ -0,0 +1,424 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/recruiting"
+	rmodel "github.com/Employee-s-file-cabinet/backend/internal/service/recruiting/model"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.ListVacanciesResponse
+// @Router  /vacancies [get]
+func (h *handler) ListVacancies(w http.ResponseWriter, r *http.Request, params api.ListVacanciesParams) {
+	ctx := r.Context()
+
+	if err := params.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	vs, err := h.recruitingService.ListVacancies(ctx, (*rmodel.VacancyStatus)(params.Status))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListVacancies(vs)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.AddVacancyJSONRequestBody true ""
+// @Router  /vacancies [post]
+func (h *handler) AddVacancy(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var v api.AddVacancyJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &v); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := v.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	id, err := h.recruitingService.AddVacancy(ctx, convert.FromAPIAddVacancyRequest(v))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location",
+		api.BaseURL+"/vacancies/"+strconv.FormatUint(id, 10))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Produce application/json
+// @Success 200 {object} api.Vacancy
+// @Router  /vacancies/{vacancy_id} [get]
+func (h *handler) GetVacancy(w http.ResponseWriter, r *http.Request, vacancyID uint64) {
+	ctx := r.Context()
+
+	v, err := h.recruitingService.GetVacancy(ctx, vacancyID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIVacancy(v)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutVacancyJSONRequestBody true ""
+// @Router  /vacancies/{vacancy_id} [put]
+func (h *handler) PutVacancy(w http.ResponseWriter, r *http.Request, vacancyID uint64) {
+	ctx := r.Context()
+
+	var v api.PutVacancyJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &v); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := v.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	if err := h.recruitingService.UpdateVacancy(ctx, convert.FromAPIPutVacancyRequest(vacancyID, v)); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Produce application/json
+// @Success 200 {object} api.ListCandidatesResponse
+// @Router  /candidates [get]
+func (h *handler) ListCandidates(w http.ResponseWriter, r *http.Request, params api.ListCandidatesParams) {
+	ctx := r.Context()
+
+	if err := params.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	cs, err := h.recruitingService.ListCandidates(ctx, convert.FromAPIListCandidatesParams(params))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListCandidates(cs)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.AddCandidateJSONRequestBody true ""
+// @Router  /candidates [post]
+func (h *handler) AddCandidate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var c api.AddCandidateJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &c); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := c.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	id, err := h.recruitingService.AddCandidate(ctx, convert.FromAPIAddCandidateRequest(c))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location",
+		api.BaseURL+"/candidates/"+strconv.FormatUint(id, 10))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Produce application/json
+// @Success 200 {object} api.Candidate
+// @Router  /candidates/{candidate_id} [get]
+func (h *handler) GetCandidate(w http.ResponseWriter, r *http.Request, candidateID uint64) {
+	ctx := r.Context()
+
+	c, err := h.recruitingService.GetCandidate(ctx, candidateID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPICandidate(c)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutCandidateJSONRequestBody true ""
+// @Router  /candidates/{candidate_id} [put]
+func (h *handler) PutCandidate(w http.ResponseWriter, r *http.Request, candidateID uint64) {
+	ctx := r.Context()
+
+	var c api.PutCandidateJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &c); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := c.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	err := h.recruitingService.UpdateCandidate(ctx, convert.FromAPIPutCandidateRequest(candidateID, c))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutCandidateStageJSONRequestBody true ""
+// @Failure 409 {object} api.Error "the candidate is hired"
+// @Router  /candidates/{candidate_id}/stage [put]
+func (h *handler) PutCandidateStage(w http.ResponseWriter, r *http.Request, candidateID uint64) {
+	ctx := r.Context()
+
+	var s api.PutCandidateStageJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &s); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := s.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	if err := h.recruitingService.MoveCandidate(ctx, candidateID, rmodel.Stage(s.Stage)); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.HireCandidateJSONRequestBody true ""
+// @Success 201 {object} api.Warnings "the warnings if there are any"
+// @Failure 409 {object} api.Error "the candidate has not received the offer"
+// @Router  /candidates/{candidate_id}/hire [post]
+func (h *handler) HireCandidate(w http.ResponseWriter, r *http.Request, candidateID uint64, params api.HireCandidateParams) {
+	ctx := r.Context()
+
+	var req api.HireCandidateJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &req); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := req.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	id, warnings, err := h.recruitingService.Hire(ctx, candidateID, convert.FromAPIHireCandidateRequest(req),
+		params.Force != nil && *params.Force)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location",
+		api.BaseURL+"/users/"+strconv.FormatUint(id, 10))
+	writeWarnings(w, r, http.StatusCreated, warnings)
+}
+
+// @Produce application/json
+// @Success 200 {object} api.ListCandidateNotesResponse
+// @Router  /candidates/{candidate_id}/notes [get]
+func (h *handler) ListCandidateNotes(w http.ResponseWriter, r *http.Request, candidateID uint64) {
+	ctx := r.Context()
+
+	ns, err := h.recruitingService.ListNotes(ctx, candidateID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListCandidateNotes(ns)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.AddCandidateNoteJSONRequestBody true ""
+// @Router  /candidates/{candidate_id}/notes [post]
+func (h *handler) AddCandidateNote(w http.ResponseWriter, r *http.Request, candidateID uint64) {
+	ctx := r.Context()
+
+	a, ok := h.actor(r)
+	if !ok {
+		srverr.ResponseError(w, r, http.StatusForbidden, errNotAllowedMsg)
+		return
+	}
+
+	var n api.AddCandidateNoteJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &n); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := n.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	if _, err := h.recruitingService.AddNote(ctx, candidateID, rmodel.Note{
+		AuthorID: a.UserID,
+		Text:     n.Text,
+	}); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location",
+		api.BaseURL+"/candidates/"+strconv.FormatUint(candidateID, 10)+"/notes")
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Produce application/json
+// @Success 200 {object} api.ListCandidateCVsResponse
+// @Router  /candidates/{candidate_id}/cvs [get]
+func (h *handler) ListCandidateCVs(w http.ResponseWriter, r *http.Request, candidateID uint64) {
+	ctx := r.Context()
+
+	cvs, err := h.recruitingService.ListCVs(ctx, candidateID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListCandidateCVs(cvs)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  multipart/form-data
+// @Param   body body api.UploadCandidateCVMultipartRequestBody true ""
+// @Router  /candidates/{candidate_id}/cvs [post]
+func (h *handler) UploadCandidateCV(w http.ResponseWriter, r *http.Request, candidateID uint64) {
+	ctx := r.Context()
+
+	err := r.ParseMultipartForm(32 << 20) // maxMemory 32MB
+	if err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if header.Size > recruiting.MaxCVSize {
+		srverr.ResponseError(w, r, http.StatusBadRequest, errLimitRequestBodySizeMsg)
+		return
+	}
+
+	fr := http.MaxBytesReader(w, file, recruiting.MaxCVSize)
+	defer fr.Close()
+
+	id, err := h.recruitingService.UploadCV(ctx, candidateID,
+		rmodel.CV{FileName: header.Filename},
+		rmodel.File{
+			Reader:      fr,
+			Size:        header.Size,
+			ContentType: header.Header.Get("Content-Type"),
+		})
+	if err != nil {
+		if errors.Is(err, new(http.MaxBytesError)) {
+			srverr.ResponseError(w, r, http.StatusBadRequest, errLimitRequestBodySizeMsg)
+			return
+		}
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location",
+		api.BaseURL+"/candidates/"+strconv.FormatUint(candidateID, 10)+
+			"/cvs/"+strconv.FormatUint(id, 10))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Produce application/octet-stream
+// @Router  /candidates/{candidate_id}/cvs/{cv_id} [get]
+func (h *handler) DownloadCandidateCV(w http.ResponseWriter, r *http.Request, candidateID, cvID uint64) {
+	ctx := r.Context()
+
+	cv, f, closeFn, err := h.recruitingService.DownloadCV(ctx, candidateID, cvID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+	defer closeFn()
+
+	w.Header().Set("Content-Type", f.ContentType)
+	w.Header().Set("Content-Disposition",
+		mime.FormatMediaType("attachment", map[string]string{"filename": cv.FileName}))
+	if _, err := io.Copy(w, f); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+		return
+	}
+}
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.ListRelativesResponse
+// @Router  /users/{user_id}/relatives [get]
+func (h *handler) ListRelatives(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	rs, err := h.userService.ListRelatives(ctx, userID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListRelatives(rs)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.AddRelativeJSONRequestBody true ""
+// @Router  /users/{user_id}/relatives [post]
+func (h *handler) AddRelative(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	var rel api.AddRelativeJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &rel); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := rel.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	id, err := h.userService.AddRelative(ctx, userID, convert.FromAPIAddRelativeRequest(rel))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location",
+		api.BaseURL+"/users/"+strconv.FormatUint(userID, 10)+
+			"/relatives/"+strconv.FormatUint(id, 10))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Failure 404 {object} api.Error "relative not found"
+// @Router  /users/{user_id}/relatives/{relative_id} [delete]
+func (h *handler) DeleteRelative(w http.ResponseWriter, r *http.Request, userID, relativeID uint64) {
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.userService.DeleteRelative(r.Context(), userID, relativeID, version); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Produce application/json
+// @Success 200 {object} api.Relative
+// @Router  /users/{user_id}/relatives/{relative_id} [get]
+func (h *handler) GetRelative(w http.ResponseWriter, r *http.Request, userID, relativeID uint64) {
+	ctx := r.Context()
+
+	rel, err := h.userService.GetRelative(ctx, userID, relativeID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	setETag(w, rel.Version)
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIRelative(rel)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutRelativeJSONRequestBody true ""
+// @Router  /users/{user_id}/relatives/{relative_id} [put]
+func (h *handler) PutRelative(w http.ResponseWriter, r *http.Request, userID, relativeID uint64) {
+	ctx := r.Context()
+
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	var rel api.PutRelativeJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &rel); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := rel.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	mr := convert.FromAPIPutRelativeRequest(relativeID, rel)
+	mr.Version = version
+	err := h.userService.UpdateRelative(ctx, userID, mr)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
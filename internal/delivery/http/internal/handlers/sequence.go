@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.ListNumberSequencesResponse
+// @Router  /number-sequences [get]
+func (h *handler) ListNumberSequences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ss, err := h.userService.ListNumberSequences(ctx)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListNumberSequences(ss)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.AddNumberSequenceJSONRequestBody true ""
+// @Router  /number-sequences [post]
+func (h *handler) AddNumberSequence(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var s api.AddNumberSequenceJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &s); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := s.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	if _, err := h.userService.AddNumberSequence(ctx, convert.FromAPIAddNumberSequenceRequest(s)); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location", api.BaseURL+"/number-sequences")
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Accept  application/json
+// @Param   body body api.PutNumberSequenceJSONRequestBody true ""
+// @Failure 409  {object} api.Error "the order types are given for the sequence of other numbers"
+// @Router  /number-sequences/{sequence_id} [put]
+func (h *handler) PutNumberSequence(w http.ResponseWriter, r *http.Request, sequenceID uint64) {
+	ctx := r.Context()
+
+	var s api.PutNumberSequenceJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &s); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := s.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	err := h.userService.UpdateNumberSequence(ctx, convert.FromAPIPutNumberSequenceRequest(sequenceID, s))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
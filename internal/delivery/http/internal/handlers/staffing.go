@@ -0,0 +1,142 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/muonsoft/validation/validator"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.ListStaffUnitsResponse
+// @Router  /staffing [get]
+func (h *handler) ListStaffUnits(w http.ResponseWriter, r *http.Request, params api.ListStaffUnitsParams) {
+	ctx := r.Context()
+
+	sus, err := h.staffingService.ListStaffUnits(ctx, staffingDate(params.Date), params.DepartmentID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListStaffUnits(sus)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.AddStaffUnitJSONRequestBody true ""
+// @Router  /staffing [post]
+func (h *handler) AddStaffUnit(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var su api.AddStaffUnitJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &su); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := su.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	id, err := h.staffingService.AddStaffUnit(ctx, convert.FromAPIAddStaffUnitRequest(su))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location",
+		api.BaseURL+"/staffing/"+strconv.FormatUint(id, 10))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Produce application/json
+// @Success 200 {object} api.ListStaffingVacanciesResponse
+// @Router  /staffing/vacancies [get]
+func (h *handler) ListStaffingVacancies(w http.ResponseWriter, r *http.Request, params api.ListStaffingVacanciesParams) {
+	ctx := r.Context()
+
+	vs, err := h.staffingService.ListVacancies(ctx, staffingDate(params.Date), params.DepartmentID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListStaffingVacancies(vs)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Produce application/json
+// @Success 200 {object} api.StaffUnit
+// @Router  /staffing/{unit_id} [get]
+func (h *handler) GetStaffUnit(w http.ResponseWriter, r *http.Request, unitID uint64) {
+	ctx := r.Context()
+
+	su, err := h.staffingService.GetStaffUnit(ctx, unitID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIStaffUnit(su)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutStaffUnitJSONRequestBody true ""
+// @Router  /staffing/{unit_id} [put]
+func (h *handler) PutStaffUnit(w http.ResponseWriter, r *http.Request, unitID uint64) {
+	ctx := r.Context()
+
+	var su api.PutStaffUnitJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &su); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := su.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	id, err := h.staffingService.ChangeStaffUnit(ctx, unitID, convert.FromAPIPutStaffUnitRequest(su))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location",
+		api.BaseURL+"/staffing/"+strconv.FormatUint(id, 10))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// staffingDate returns the requested date of the staffing table or today.
+func staffingDate(date *openapi_types.Date) time.Time {
+	if date != nil {
+		return date.Time
+	}
+	return time.Now()
+}
@@ -0,0 +1,44 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+)
+
+// @Accept  application/json
+// @Param   body body api.TerminateUserJSONRequestBody true ""
+// @Failure 400  {object} api.Error "the last working day is in the future or before the current contract or position"
+// @Failure 409  {object} api.Error "the user is already terminated"
+// @Router  /users/{user_id}/termination [post]
+func (h *handler) TerminateUser(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	var t api.TerminateUserJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &t); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := t.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	if err := h.userService.Terminate(ctx, userID, convert.FromAPITermination(t)); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	// the grouping policy of the user was removed with the termination,
+	// the synced enforcer reloads it without racing the authorization of other requests
+	if err := h.enforcer.LoadPolicy(); err != nil {
+		srverr.LogError(r, err, false)
+	}
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+)
+
+// @Produce application/json,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet,application/pdf
+// @Success 200 {object} api.Timesheet
+// @Router  /timesheets/{year}/{month} [get]
+func (h *handler) GetTimesheet(w http.ResponseWriter, r *http.Request,
+	year, month uint64, params api.GetTimesheetParams) {
+	ctx := r.Context()
+
+	if err := params.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	ts, err := h.userService.GetTimesheet(ctx, params.DepartmentID, int(year), time.Month(month))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	format := api.TimesheetFormatJson
+	if params.Format != nil {
+		format = *params.Format
+	}
+	switch format {
+	case api.TimesheetFormatXlsx:
+		err = response.XLSX(w, "timesheet", convert.TimesheetTable(ts))
+	case api.TimesheetFormatPdf:
+		err = response.PDF(w, "timesheet", convert.TimesheetTitle(ts), convert.TimesheetTable(ts))
+	default:
+		err = response.JSON(w, http.StatusOK, convert.ToAPITimesheet(ts))
+	}
+	if err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutTimesheetDayJSONRequestBody true ""
+// @Router  /timesheets/{year}/{month}/employees/{user_id}/days/{day} [put]
+func (h *handler) PutTimesheetDay(w http.ResponseWriter, r *http.Request, year, month, userID, day uint64) {
+	ctx := r.Context()
+
+	a, ok := h.actor(r)
+	if !ok {
+		srverr.ResponseError(w, r, http.StatusForbidden, errNotAllowedMsg)
+		return
+	}
+
+	var req api.PutTimesheetDayJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &req); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := req.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	c := convert.FromAPIPutTimesheetDayRequest(userID, timesheetDate(year, month, day), req)
+	c.CorrectedBy = a.UserID
+	if err := h.userService.SetTimesheetCorrection(ctx, int(year), time.Month(month), c); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Router /timesheets/{year}/{month}/employees/{user_id}/days/{day} [delete]
+func (h *handler) DeleteTimesheetDay(w http.ResponseWriter, r *http.Request, year, month, userID, day uint64) {
+	ctx := r.Context()
+
+	err := h.userService.DeleteTimesheetCorrection(ctx,
+		int(year), time.Month(month), userID, timesheetDate(year, month, day))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// timesheetDate returns the date of the day of the month, the day out of the month
+// is normalized to another month and is rejected by the service.
+func timesheetDate(year, month, day uint64) time.Time {
+	return time.Date(int(year), time.Month(month), int(day), 0, 0, 0, 0, time.UTC)
+}
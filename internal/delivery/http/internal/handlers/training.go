@@ -81,6 +81,7 @@ func (h *handler) GetTraining(w http.ResponseWriter, r *http.Request, userID uin
 		return
 	}
 
+	setETag(w, tr.Version)
 	if err := response.JSON(w, http.StatusOK, convert.ToAPIGetTrainingResponse(tr)); err != nil {
 		srverr.LogError(r, err, false)
 		srverr.ResponseError(w, r,
@@ -116,6 +117,11 @@ func (h *handler) PatchTraining(w http.ResponseWriter, r *http.Request, userID u
 func (h *handler) PutTraining(w http.ResponseWriter, r *http.Request, userID, trainingID uint64) {
 	ctx := r.Context()
 
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
 	var tr api.PutTrainingJSONRequestBody
 	if err := request.DecodeJSONStrict(w, r, &tr); err != nil {
 		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
@@ -128,7 +134,9 @@ func (h *handler) PutTraining(w http.ResponseWriter, r *http.Request, userID, tr
 		return
 	}
 
-	err := h.userService.UpdateTraining(ctx, userID, convert.FromAPIPutTrainingRequest(trainingID, tr))
+	mtr := convert.FromAPIPutTrainingRequest(trainingID, tr)
+	mtr.Version = version
+	err := h.userService.UpdateTraining(ctx, userID, mtr)
 	if err != nil {
 		srverr.ResponseServiceError(w, r, err)
 		return
@@ -37,6 +37,9 @@ func (h *handler) ListUsers(w http.ResponseWriter, r *http.Request, params api.L
 	if params.Query != nil {
 		opts = append(opts, model.WithQuery(*params.Query))
 	}
+	if params.IncludeTerminated != nil {
+		opts = append(opts, model.WithTerminated(*params.IncludeTerminated))
+	}
 	if params.SortBy != nil {
 		switch *params.SortBy {
 		case api.ListUsersParamsSortByAlphabet:
@@ -75,8 +78,9 @@ func (h *handler) ListUsers(w http.ResponseWriter, r *http.Request, params api.L
 
 // @Accept  application/json
 // @Param   body body api.AddUserJSONRequestBody true ""
+// @Success 201 {object} api.Warnings "the warnings if there are any"
 // @Router  /users [post]
-func (h *handler) AddUser(w http.ResponseWriter, r *http.Request) {
+func (h *handler) AddUser(w http.ResponseWriter, r *http.Request, params api.AddUserParams) {
 	ctx := r.Context()
 
 	var u api.AddUserJSONRequestBody
@@ -91,7 +95,8 @@ func (h *handler) AddUser(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	id, err := h.userService.Add(ctx, convert.FromAPIAddUserRequest(u))
+	id, warnings, err := h.userService.Add(ctx, convert.FromAPIAddUserRequest(u),
+		params.Force != nil && *params.Force)
 	if err != nil {
 		srverr.ResponseServiceError(w, r, err)
 		return
@@ -99,7 +104,7 @@ func (h *handler) AddUser(w http.ResponseWriter, r *http.Request) {
 
 	w.Header().Set("Location",
 		api.BaseURL+"/users/"+strconv.FormatUint(id, 10))
-	w.WriteHeader(http.StatusCreated)
+	writeWarnings(w, r, http.StatusCreated, warnings)
 }
 
 // @Produce application/json
@@ -122,6 +127,7 @@ func (h *handler) getUser(w http.ResponseWriter, r *http.Request, userID uint64)
 		return
 	}
 
+	setETag(w, u.Version)
 	if err := response.JSON(w, http.StatusOK, convert.ToAPIGetUserResponse(u)); err != nil {
 		srverr.LogError(r, err, false)
 		srverr.ResponseError(w, r,
@@ -138,8 +144,20 @@ func (h *handler) getExpandedUser(w http.ResponseWriter, r *http.Request, userID
 		srverr.ResponseServiceError(w, r, err)
 		return
 	}
+	resp := convert.ToAPIGetExpandedUserResponse(u)
+
+	// compensation figures are shown only to users with the dedicated permission
+	if h.allowed(r, objCompensations, actRead) {
+		c, err := h.compensationService.GetCurrentCompensation(ctx, userID)
+		if err != nil {
+			srverr.ResponseServiceError(w, r, err)
+			return
+		}
+		resp.Finance = convert.ToAPIUserFinance(c)
+	}
 
-	if err := response.JSON(w, http.StatusOK, convert.ToAPIGetExpandedUserResponse(u)); err != nil {
+	setETag(w, u.Version)
+	if err := response.JSON(w, http.StatusOK, resp); err != nil {
 		srverr.LogError(r, err, false)
 		srverr.ResponseError(w, r,
 			http.StatusInternalServerError,
@@ -167,10 +185,16 @@ func (h *handler) PatchUser(w http.ResponseWriter, r *http.Request, userID uint6
 
 // @Accept  application/json
 // @Param   body body api.PutUserJSONRequestBody true ""
+// @Success 200 {object} api.Warnings "the warnings if there are any"
 // @Router  /users/{user_id} [put]
-func (h *handler) PutUser(w http.ResponseWriter, r *http.Request, userID uint64) {
+func (h *handler) PutUser(w http.ResponseWriter, r *http.Request, userID uint64, params api.PutUserParams) {
 	ctx := r.Context()
 
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
 	var u api.PutUserJSONRequestBody
 	if err := request.DecodeJSONStrict(w, r, &u); err != nil {
 		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
@@ -183,9 +207,13 @@ func (h *handler) PutUser(w http.ResponseWriter, r *http.Request, userID uint64)
 		return
 	}
 
-	err := h.userService.Update(ctx, convert.FromAPIPutUserRequest(userID, u))
+	mu := convert.FromAPIPutUserRequest(userID, u)
+	mu.Version = version
+	warnings, err := h.userService.Update(ctx, mu, params.Force != nil && *params.Force)
 	if err != nil {
 		srverr.ResponseServiceError(w, r, err)
 		return
 	}
+
+	writeWarnings(w, r, http.StatusOK, warnings)
 }
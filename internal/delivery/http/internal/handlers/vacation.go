@@ -11,6 +11,7 @@ import (
 	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
 	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
 	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+	umodel "github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
 )
 
 // @Produce application/json
@@ -35,9 +36,10 @@ func (h *handler) ListVacations(w http.ResponseWriter, r *http.Request, userID u
 
 // @Accept application/json
 // @Param   body body api.AddVacationJSONRequestBody true ""
-// @Failure 409  {object} api.Error "vacation already exists"
+// @Success 201  {object} api.Warnings
+// @Failure 409  {object} api.Error "the vacation exceeds the balance"
 // @Router  /users/{user_id}/vacations [post]
-func (h *handler) AddVacation(w http.ResponseWriter, r *http.Request, userID uint64) {
+func (h *handler) AddVacation(w http.ResponseWriter, r *http.Request, userID uint64, params api.AddVacationParams) {
 	ctx := r.Context()
 
 	var v api.AddVacationJSONRequestBody
@@ -52,7 +54,8 @@ func (h *handler) AddVacation(w http.ResponseWriter, r *http.Request, userID uin
 		return
 	}
 
-	id, err := h.userService.AddVacation(ctx, userID, convert.FromAPIAddVacationRequest(v))
+	id, warnings, err := h.userService.AddVacation(ctx, userID, convert.FromAPIAddVacationRequest(v),
+		params.Force != nil && *params.Force)
 	if err != nil {
 		srverr.ResponseServiceError(w, r, err)
 		return
@@ -61,7 +64,7 @@ func (h *handler) AddVacation(w http.ResponseWriter, r *http.Request, userID uin
 	w.Header().Set("Location",
 		api.BaseURL+"/users/"+strconv.FormatUint(userID, 10)+
 			"/vacations/"+strconv.FormatUint(id, 10))
-	w.WriteHeader(http.StatusCreated)
+	writeWarnings(w, r, http.StatusCreated, warnings)
 }
 
 // @Router /users/{user_id}/vacations/{vacation_id} [delete]
@@ -81,6 +84,7 @@ func (h *handler) GetVacation(w http.ResponseWriter, r *http.Request, userID uin
 		return
 	}
 
+	setETag(w, v.Version)
 	if err := response.JSON(w, http.StatusOK, convert.ToAPIGetVacationResponse(v)); err != nil {
 		srverr.LogError(r, err, false)
 		srverr.ResponseError(w, r,
@@ -109,10 +113,18 @@ func (h *handler) PatchVacation(w http.ResponseWriter, r *http.Request, userID u
 
 // @Accept  application/json
 // @Param   body body api.PutVacationJSONRequestBody true ""
+// @Success 200  {object} api.Warnings
+// @Failure 409  {object} api.Error "the vacation exceeds the balance"
 // @Router  /users/{user_id}/vacations/{vacation_id} [put]
-func (h *handler) PutVacation(w http.ResponseWriter, r *http.Request, userID, vacationID uint64) {
+func (h *handler) PutVacation(w http.ResponseWriter, r *http.Request,
+	userID, vacationID uint64, params api.PutVacationParams) {
 	ctx := r.Context()
 
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
 	var v api.PutVacationJSONRequestBody
 	if err := request.DecodeJSONStrict(w, r, &v); err != nil {
 		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
@@ -125,9 +137,91 @@ func (h *handler) PutVacation(w http.ResponseWriter, r *http.Request, userID, va
 		return
 	}
 
-	err := h.userService.UpdateVacation(ctx, userID, convert.FromAPIPutVacationRequest(vacationID, v))
+	mv := convert.FromAPIPutVacationRequest(vacationID, v)
+	mv.Version = version
+	warnings, err := h.userService.UpdateVacation(ctx, userID, mv, params.Force != nil && *params.Force)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	writeWarnings(w, r, http.StatusOK, warnings)
+}
+
+// @Produce application/json
+// @Success 200 {object} api.VacationBalance
+// @Router  /users/{user_id}/vacations/balance [get]
+func (h *handler) GetVacationBalance(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	b, err := h.userService.GetVacationBalance(ctx, userID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIVacationBalance(b)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Produce application/json,text/csv,application/vnd.openxmlformats-officedocument.spreadsheetml.sheet
+// @Success 200 {object} api.ListVacationBalancesResponse
+// @Router  /vacations/balances [get]
+func (h *handler) ListVacationBalances(w http.ResponseWriter, r *http.Request, params api.ListVacationBalancesParams) {
+	ctx := r.Context()
+
+	bs, err := h.userService.ListVacationBalances(ctx, params.DepartmentID)
 	if err != nil {
 		srverr.ResponseServiceError(w, r, err)
 		return
 	}
+
+	switch exportFormat(params.Format) {
+	case api.Csv:
+		err = response.CSV(w, "vacation_balances", convert.VacationBalancesTable(bs))
+	case api.Xlsx:
+		err = response.XLSX(w, "vacation_balances", convert.VacationBalancesTable(bs))
+	default:
+		err = response.JSON(w, http.StatusOK, convert.ToAPIListVacationBalances(bs))
+	}
+	if err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Produce application/json
+// @Success 200 {object} api.ListAllVacationsResponse
+// @Router  /vacations [get]
+func (h *handler) ListAllVacations(w http.ResponseWriter, r *http.Request, params api.ListAllVacationsParams) {
+	ctx := r.Context()
+
+	if err := params.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	vs, err := h.userService.ListAllVacations(ctx, umodel.ListVacationsParams{
+		DepartmentID: params.DepartmentID,
+		DateFrom:     params.DateFrom.Time,
+		DateTo:       params.DateTo.Time,
+	})
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListAllVacations(vs)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
 }
@@ -0,0 +1,202 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+	umodel "github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.ListVacationRequestsResponse
+// @Router  /vacation-requests [get]
+func (h *handler) ListVacationRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	a, ok := h.actor(r)
+	if !ok {
+		srverr.ResponseError(w, r, http.StatusForbidden, errNotAllowedMsg)
+		return
+	}
+
+	rs, err := h.userService.ListVacationRequests(ctx, a)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListVacationRequests(rs)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Produce application/json
+// @Success 200 {object} api.ListVacationRequestsResponse
+// @Router  /vacation-requests/incoming [get]
+func (h *handler) ListIncomingVacationRequests(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	a, ok := h.actor(r)
+	if !ok {
+		srverr.ResponseError(w, r, http.StatusForbidden, errNotAllowedMsg)
+		return
+	}
+
+	rs, err := h.userService.ListIncomingVacationRequests(ctx, a)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListVacationRequests(rs)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.AddVacationRequestJSONRequestBody true ""
+// @Failure 409  {object} api.Error "the request overlaps another vacation or request"
+// @Router  /vacation-requests [post]
+func (h *handler) AddVacationRequest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	a, ok := h.actor(r)
+	if !ok {
+		srverr.ResponseError(w, r, http.StatusForbidden, errNotAllowedMsg)
+		return
+	}
+
+	var req api.AddVacationRequestJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &req); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := req.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	id, err := h.userService.AddVacationRequest(ctx, a, convert.FromAPIAddVacationRequestRequest(req))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location",
+		api.BaseURL+"/vacation-requests/"+strconv.FormatUint(id, 10))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Produce application/json
+// @Success 200 {object} api.VacationRequest
+// @Router  /vacation-requests/{request_id} [get]
+func (h *handler) GetVacationRequest(w http.ResponseWriter, r *http.Request, requestID uint64) {
+	ctx := r.Context()
+
+	a, ok := h.actor(r)
+	if !ok {
+		srverr.ResponseError(w, r, http.StatusForbidden, errNotAllowedMsg)
+		return
+	}
+
+	vr, err := h.userService.GetVacationRequest(ctx, a, requestID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIVacationRequest(vr)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutVacationRequestJSONRequestBody true ""
+// @Failure 409  {object} api.Error "the request is not draft or overlaps another vacation or request"
+// @Router  /vacation-requests/{request_id} [put]
+func (h *handler) PutVacationRequest(w http.ResponseWriter, r *http.Request, requestID uint64) {
+	ctx := r.Context()
+
+	a, ok := h.actor(r)
+	if !ok {
+		srverr.ResponseError(w, r, http.StatusForbidden, errNotAllowedMsg)
+		return
+	}
+
+	var req api.PutVacationRequestJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &req); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := req.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	err := h.userService.UpdateVacationRequest(ctx, a, convert.FromAPIPutVacationRequestRequest(requestID, req))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PatchVacationRequestJSONRequestBody true ""
+// @Success 200  {object} api.Warnings
+// @Failure 409  {object} api.Error "the action is not allowed for the request status or the approval exceeds the balance"
+// @Router  /vacation-requests/{request_id} [patch]
+func (h *handler) PatchVacationRequest(w http.ResponseWriter, r *http.Request,
+	requestID uint64, params api.PatchVacationRequestParams) {
+	ctx := r.Context()
+
+	a, ok := h.actor(r)
+	if !ok {
+		srverr.ResponseError(w, r, http.StatusForbidden, errNotAllowedMsg)
+		return
+	}
+
+	var req api.PatchVacationRequestJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &req); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := req.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	var comment string
+	if req.Comment != nil {
+		comment = *req.Comment
+	}
+	warnings, err := h.userService.ChangeVacationRequest(ctx, a, requestID, umodel.RequestAction(req.Action), comment,
+		params.Force != nil && *params.Force)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	writeWarnings(w, r, http.StatusOK, warnings)
+}
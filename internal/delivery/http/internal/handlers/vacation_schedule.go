@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+	umodel "github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.VacationSchedule
+// @Router  /vacations/schedules/{year} [get]
+func (h *handler) GetVacationSchedule(w http.ResponseWriter, r *http.Request,
+	year uint64, params api.GetVacationScheduleParams) {
+	ctx := r.Context()
+
+	vs, err := h.userService.GetVacationSchedule(ctx, int(year), params.DepartmentID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIVacationSchedule(vs)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PatchVacationScheduleJSONRequestBody true ""
+// @Failure 409  {object} api.Error "the status can't be changed"
+// @Router  /vacations/schedules/{year} [patch]
+func (h *handler) PatchVacationSchedule(w http.ResponseWriter, r *http.Request, year uint64) {
+	ctx := r.Context()
+
+	var req api.PatchVacationScheduleJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &req); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := req.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	err := h.userService.SetVacationScheduleStatus(ctx, int(year), umodel.ScheduleStatus(req.Status))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.AddVacationScheduleItemJSONRequestBody true ""
+// @Success 201  {object} api.Warnings
+// @Failure 409  {object} api.Error "the schedule is not draft or the vacation overlaps"
+// @Router  /vacations/schedules/{year}/items [post]
+func (h *handler) AddVacationScheduleItem(w http.ResponseWriter, r *http.Request, year uint64) {
+	ctx := r.Context()
+
+	var req api.AddVacationScheduleItemJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &req); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := req.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	_, warnings, err := h.userService.AddVacationScheduleItem(ctx, int(year),
+		convert.FromAPIAddVacationScheduleItemRequest(req))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location",
+		api.BaseURL+"/vacations/schedules/"+strconv.FormatUint(year, 10))
+	writeWarnings(w, r, http.StatusCreated, warnings)
+}
+
+// @Accept  application/json
+// @Param   body body api.PutVacationScheduleItemJSONRequestBody true ""
+// @Success 200  {object} api.Warnings
+// @Failure 409  {object} api.Error "the schedule is not draft or the vacation overlaps"
+// @Router  /vacations/schedules/{year}/items/{item_id} [put]
+func (h *handler) PutVacationScheduleItem(w http.ResponseWriter, r *http.Request, year uint64, itemID uint64) {
+	ctx := r.Context()
+
+	var req api.PutVacationScheduleItemJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &req); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := req.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	warnings, err := h.userService.UpdateVacationScheduleItem(ctx, int(year),
+		convert.FromAPIPutVacationScheduleItemRequest(itemID, req))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	writeWarnings(w, r, http.StatusOK, warnings)
+}
+
+// @Failure 409 {object} api.Error "the schedule is not draft"
+// @Router  /vacations/schedules/{year}/items/{item_id} [delete]
+func (h *handler) DeleteVacationScheduleItem(w http.ResponseWriter, r *http.Request, year uint64, itemID uint64) {
+	ctx := r.Context()
+
+	if err := h.userService.DeleteVacationScheduleItem(ctx, int(year), itemID); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
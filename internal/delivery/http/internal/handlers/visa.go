@@ -81,6 +81,7 @@ func (h *handler) GetVisa(w http.ResponseWriter, r *http.Request, userID uint64,
 		return
 	}
 
+	setETag(w, v.Version)
 	if err := response.JSON(w, http.StatusOK, convert.ToAPIGetVisaResponse(v)); err != nil {
 		srverr.LogError(r, err, false)
 		srverr.ResponseError(w, r,
@@ -113,6 +114,11 @@ func (h *handler) PatchVisa(w http.ResponseWriter, r *http.Request, userID, pass
 func (h *handler) PutVisa(w http.ResponseWriter, r *http.Request, userID, passportID, visaID uint64) {
 	ctx := r.Context()
 
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
 	var v api.PutVisaJSONRequestBody
 	if err := request.DecodeJSONStrict(w, r, &v); err != nil {
 		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
@@ -125,7 +131,9 @@ func (h *handler) PutVisa(w http.ResponseWriter, r *http.Request, userID, passpo
 		return
 	}
 
-	err := h.userService.UpdateVisa(ctx, userID, passportID, convert.FromAPIPutVisaRequest(visaID, v))
+	mv := convert.FromAPIPutVisaRequest(visaID, v)
+	mv.Version = version
+	err := h.userService.UpdateVisa(ctx, userID, passportID, mv)
 	if err != nil {
 		srverr.ResponseServiceError(w, r, err)
 		return
@@ -0,0 +1,24 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+)
+
+// writeWarnings writes the status and the warnings of the done operation,
+// the body is empty if there are no warnings.
+func writeWarnings(w http.ResponseWriter, r *http.Request, status int, warnings []string) {
+	if len(warnings) == 0 {
+		w.WriteHeader(status)
+		return
+	}
+	if err := response.JSON(w, status, api.Warnings{Warnings: warnings}); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
@@ -0,0 +1,153 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/muonsoft/validation/validator"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/api"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/convert"
+	srverr "github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/errors"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/request"
+	"github.com/Employee-s-file-cabinet/backend/internal/delivery/http/internal/response"
+)
+
+// @Produce application/json
+// @Success 200 {object} api.ListWorkPermitsResponse
+// @Failure 403 {object} api.Error "work permits are disabled"
+// @Router  /users/{user_id}/work_permits [get]
+func (h *handler) ListWorkPermits(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	wps, err := h.userService.ListWorkPermits(ctx, userID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIListWorkPermits(wps)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.AddWorkPermitJSONRequestBody true ""
+// @Failure 409  {object} api.Error "user not found"
+// @Router  /users/{user_id}/work_permits [post]
+func (h *handler) AddWorkPermit(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	var wp api.AddWorkPermitJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &wp); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := wp.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	id, err := h.userService.AddWorkPermit(ctx, userID, convert.FromAPIAddWorkPermitRequest(wp))
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Location",
+		api.BaseURL+"/users/"+strconv.FormatUint(userID, 10)+
+			"/work_permits/"+strconv.FormatUint(id, 10))
+	w.WriteHeader(http.StatusCreated)
+}
+
+// @Produce application/json
+// @Success 200 {object} api.WorkPermitCheck
+// @Router  /users/{user_id}/work_permits/check [get]
+func (h *handler) CheckWorkPermits(w http.ResponseWriter, r *http.Request, userID uint64) {
+	ctx := r.Context()
+
+	uncovered, err := h.userService.CheckWorkPermits(ctx, userID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIWorkPermitCheck(uncovered)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Failure 404 {object} api.Error "work permit not found"
+// @Router  /users/{user_id}/work_permits/{work_permit_id} [delete]
+func (h *handler) DeleteWorkPermit(w http.ResponseWriter, r *http.Request, userID, workPermitID uint64) {
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.userService.DeleteWorkPermit(r.Context(), userID, workPermitID, version); err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
+
+// @Produce application/json
+// @Success 200 {object} api.GetWorkPermitResponse
+// @Router  /users/{user_id}/work_permits/{work_permit_id} [get]
+func (h *handler) GetWorkPermit(w http.ResponseWriter, r *http.Request, userID, workPermitID uint64) {
+	ctx := r.Context()
+
+	wp, err := h.userService.GetWorkPermit(ctx, userID, workPermitID)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+
+	setETag(w, wp.Version)
+	if err := response.JSON(w, http.StatusOK, convert.ToAPIGetWorkPermitResponse(wp)); err != nil {
+		srverr.LogError(r, err, false)
+		srverr.ResponseError(w, r,
+			http.StatusInternalServerError,
+			srverr.ErrInternalServerErrorMsg)
+	}
+}
+
+// @Accept  application/json
+// @Param   body body api.PutWorkPermitJSONRequestBody true ""
+// @Router  /users/{user_id}/work_permits/{work_permit_id} [put]
+func (h *handler) PutWorkPermit(w http.ResponseWriter, r *http.Request, userID, workPermitID uint64) {
+	ctx := r.Context()
+
+	version, ok := ifMatch(w, r)
+	if !ok {
+		return
+	}
+
+	var wp api.PutWorkPermitJSONRequestBody
+	if err := request.DecodeJSONStrict(w, r, &wp); err != nil {
+		srverr.ResponseError(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := wp.Validate(ctx, validator.Instance()); err != nil {
+		msg := api.ValidationErrorMessage(err)
+		srverr.ResponseError(w, r, http.StatusBadRequest, msg)
+		return
+	}
+
+	mwp := convert.FromAPIPutWorkPermitRequest(workPermitID, wp)
+	mwp.Version = version
+	err := h.userService.UpdateWorkPermit(ctx, userID, mwp)
+	if err != nil {
+		srverr.ResponseServiceError(w, r, err)
+		return
+	}
+}
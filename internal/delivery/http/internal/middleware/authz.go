@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -16,12 +17,23 @@ type TokenManager interface {
 	Payload(token, sign string) (*token.Payload, error)
 }
 
+type payloadContextKey struct{}
+
+// Payload returns the token payload of the authorized request.
+func Payload(ctx context.Context) (*token.Payload, bool) {
+	p, ok := ctx.Value(payloadContextKey{}).(*token.Payload)
+	return p, ok
+}
+
 type Authorizer struct {
 	TokenManager TokenManager
-	Enforcer     *casbin.Enforcer
+	Enforcer     *casbin.SyncedEnforcer
 }
 
-func (a *Authorizer) AuthorizeMiddleware(next http.Handler) http.Handler {
+// IdentifyMiddleware puts the token payload of the requesting user into the context
+// of the operations requiring authorization. A request without a valid token stays
+// unidentified: the handlers checking dedicated permissions reject it.
+func (a *Authorizer) IdentifyMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Context().Value(api.BearerAuthScopes) == nil {
 			next.ServeHTTP(w, r)
@@ -29,21 +41,35 @@ func (a *Authorizer) AuthorizeMiddleware(next http.Handler) http.Handler {
 		}
 		token, err := cookie.GetToken(r)
 		if err != nil {
-			srverrors.ResponseError(w, r,
-				http.StatusForbidden,
-				http.ErrNoCookie.Error())
+			next.ServeHTTP(w, r)
 			return
 		}
 		sign, err := cookie.GetSignature(r)
 		if err != nil {
-			srverrors.ResponseError(w, r,
-				http.StatusForbidden,
-				http.ErrNoCookie.Error())
+			next.ServeHTTP(w, r)
 			return
 		}
-
 		payload, err := a.TokenManager.Payload(token, sign)
 		if err != nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := context.WithValue(r.Context(), payloadContextKey{}, payload)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// AuthorizeMiddleware checks the route policy of the user identified by IdentifyMiddleware
+// for the operations requiring authorization.
+func (a *Authorizer) AuthorizeMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Context().Value(api.BearerAuthScopes) == nil {
+			next.ServeHTTP(w, r)
+			return
+		}
+		payload, ok := Payload(r.Context())
+		if !ok {
 			srverrors.ResponseError(w, r,
 				http.StatusUnauthorized,
 				"access token is missing or invalid")
@@ -61,5 +87,6 @@ func (a *Authorizer) AuthorizeMiddleware(next http.Handler) http.Handler {
 				"user is not allowed to access")
 			return
 		}
+		next.ServeHTTP(w, r)
 	})
 }
@@ -0,0 +1,66 @@
+package request
+
+import (
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrNoIfMatch        = errors.New("the If-Match header with the ETag of the resource is required")
+	ErrInvalidIfMatch   = errors.New("the If-Match header does not match the ETag of the resource")
+	ErrMalformedIfMatch = errors.New("the If-Match header is malformed")
+)
+
+// AnyVersion is the version of If-Match: *, it matches any version of the existing resource.
+const AnyVersion int64 = 0
+
+// IfMatch returns the version of the resource from the If-Match header (RFC 9110, 13.1.1).
+// The header is "*" (AnyVersion) or the list of ETags compared by the strong comparison,
+// so the weak ETags never match and are skipped. Only one version can be current,
+// the list of several strong ETags is matched by none of them.
+func IfMatch(r *http.Request) (int64, error) {
+	h := strings.TrimSpace(strings.Join(r.Header.Values("If-Match"), ","))
+	if h == "" {
+		return 0, ErrNoIfMatch
+	}
+	if h == "*" {
+		return AnyVersion, nil
+	}
+
+	var versions []int64
+	for _, tag := range strings.Split(h, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		weak := strings.HasPrefix(tag, "W/")
+		version, ok := parseETag(strings.TrimPrefix(tag, "W/"))
+		if !ok {
+			return 0, ErrMalformedIfMatch
+		}
+		if !weak && version != 0 && !slices.Contains(versions, version) {
+			versions = append(versions, version)
+		}
+	}
+	if len(versions) != 1 {
+		return 0, ErrInvalidIfMatch
+	}
+	return versions[0], nil
+}
+
+// parseETag returns the version of the quoted ETag set by the server,
+// 0 for the well-formed ETag that isn't set by the server and never matches.
+func parseETag(tag string) (int64, bool) {
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' ||
+		strings.ContainsAny(tag[1:len(tag)-1], "\"\\") {
+		return 0, false
+	}
+	version, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64)
+	if err != nil || version <= 0 {
+		return 0, true
+	}
+	return version, true
+}
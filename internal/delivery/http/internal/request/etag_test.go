@@ -0,0 +1,46 @@
+package request
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIfMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		headers []string
+		want    int64
+		wantErr error
+	}{
+		{name: "missing", wantErr: ErrNoIfMatch},
+		{name: "strong", headers: []string{`"3"`}, want: 3},
+		{name: "any", headers: []string{"*"}, want: AnyVersion},
+		{name: "weak never matches", headers: []string{`W/"3"`}, wantErr: ErrInvalidIfMatch},
+		{name: "weak is skipped in the list", headers: []string{`W/"2", "3"`}, want: 3},
+		{name: "several header lines", headers: []string{`W/"2"`, `"3"`}, want: 3},
+		{name: "same version twice", headers: []string{`"3", "3"`}, want: 3},
+		{name: "several versions", headers: []string{`"2", "3"`}, wantErr: ErrInvalidIfMatch},
+		{name: "not our ETag", headers: []string{`"abc"`}, wantErr: ErrInvalidIfMatch},
+		{name: "zero version", headers: []string{`"0"`}, wantErr: ErrInvalidIfMatch},
+		{name: "unquoted", headers: []string{"3"}, wantErr: ErrMalformedIfMatch},
+		{name: "unterminated", headers: []string{`"3`}, wantErr: ErrMalformedIfMatch},
+		{name: "any in the list", headers: []string{`*, "3"`}, wantErr: ErrMalformedIfMatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("PUT", "/users/1", nil)
+			for _, h := range tt.headers {
+				r.Header.Add("If-Match", h)
+			}
+			version, err := IfMatch(r)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, version)
+		})
+	}
+}
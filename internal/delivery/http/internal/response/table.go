@@ -0,0 +1,70 @@
+package response
+
+import (
+	"bytes"
+	"encoding/csv"
+	"mime"
+	"net/http"
+
+	"github.com/xuri/excelize/v2"
+
+	"github.com/Employee-s-file-cabinet/backend/pkg/pdf"
+)
+
+const xlsxContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+
+// utf8BOM makes spreadsheet applications detect the encoding of a CSV file.
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// CSV writes the table as a CSV attachment, the first row is a header.
+func CSV(w http.ResponseWriter, filename string, table [][]string) error {
+	var buf bytes.Buffer
+	buf.Write(utf8BOM)
+	cw := csv.NewWriter(&buf)
+	if err := cw.WriteAll(table); err != nil {
+		return err
+	}
+	return attachment(w, "text/csv; charset=utf-8", filename+".csv", buf.Bytes())
+}
+
+// XLSX writes the table as an XLSX attachment, the first row is a header.
+func XLSX(w http.ResponseWriter, filename string, table [][]string) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	sheet := f.GetSheetName(f.GetActiveSheetIndex())
+	for i, row := range table {
+		cell, err := excelize.CoordinatesToCellName(1, i+1)
+		if err != nil {
+			return err
+		}
+		if err := f.SetSheetRow(sheet, cell, &row); err != nil {
+			return err
+		}
+	}
+
+	buf, err := f.WriteToBuffer()
+	if err != nil {
+		return err
+	}
+	return attachment(w, xlsxContentType, filename+".xlsx", buf.Bytes())
+}
+
+func attachment(w http.ResponseWriter, contentType, filename string, data []byte) error {
+	w.Header().Set("Content-Type", contentType)
+	w.Header().Set("Content-Disposition",
+		mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	w.WriteHeader(http.StatusOK)
+	_, err := w.Write(data)
+	return err
+}
+
+// PDF writes the table as a printable PDF attachment with the title,
+// the first row is a header repeated on every page.
+func PDF(w http.ResponseWriter, filename, title string, table [][]string) error {
+	var buf bytes.Buffer
+	if err := pdf.Table(&buf, title, table); err != nil {
+		return err
+	}
+	return attachment(w, "application/pdf", filename+".pdf", buf.Bytes())
+}
@@ -37,6 +37,11 @@ func New(cfg Config, envType env.Type,
 	userService handlers.UserService,
 	authService handlers.AuthService,
 	passwordRecoveryService handlers.PasswordRecoveryService,
+	staffingService handlers.StaffingService,
+	compensationService handlers.CompensationService,
+	benefitService handlers.BenefitService,
+	calendarService handlers.CalendarService,
+	recruitingService handlers.RecruitingService,
 	logger *slog.Logger) (*server, error) {
 	logger = logger.With(slog.String("from", "http-server"))
 
@@ -53,8 +58,6 @@ func New(cfg Config, envType env.Type,
 		logger:     logger,
 	}
 
-	handler := handlers.New(envType, userService, authService, passwordRecoveryService, logger)
-
 	mux := chi.NewRouter()
 	mux.NotFound(srverr.NotFound)
 	mux.MethodNotAllowed(srverr.MethodNotAllowed)
@@ -90,11 +93,17 @@ func New(cfg Config, envType env.Type,
 		TokenManager: authService,
 		Enforcer:     e,
 	}
-	mux.Use(authz.AuthorizeMiddleware)
+
+	handler := handlers.New(envType, e,
+		userService, authService, passwordRecoveryService, staffingService, compensationService, benefitService, calendarService, recruitingService, logger)
 
 	srv.Handler = api.HandlerWithOptions(handler, api.ChiServerOptions{
 		BaseURL:    api.BaseURL,
 		BaseRouter: mux,
+		// the middlewares are applied after the route is matched, so they know whether
+		// the operation requires authorization: every bearerAuth route needs a policy.
+		// The last one in the list runs first.
+		Middlewares: []api.MiddlewareFunc{authz.AuthorizeMiddleware, authz.IdentifyMiddleware},
 		ErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
 			logger.Debug("request error", slog.Attr{
 				Key:   "error",
@@ -4,6 +4,6 @@ import (
 	"github.com/casbin/casbin/v2"
 )
 
-func (s *service) PolicyEnforcer() (*casbin.Enforcer, error) {
-	return casbin.NewEnforcer("policy_models/rest.conf", s.authRepository.PolicyAdapter())
+func (s *service) PolicyEnforcer() (*casbin.SyncedEnforcer, error) {
+	return casbin.NewSyncedEnforcer("policy_models/rest.conf", s.authRepository.PolicyAdapter())
 }
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyModel(t *testing.T) {
+	e, err := casbin.NewEnforcer("../../../policy_models/rest.conf")
+	require.NoError(t, err)
+
+	_, err = e.AddPolicies([][]string{
+		{"4", "/users/{user_id}", "GET"},
+		{"4", "/vacation-requests/*", "*"},
+		{"2", "/users/*", "*"},
+	})
+	require.NoError(t, err)
+	_, err = e.AddGroupingPolicies([][]string{{"5", "4"}, {"7", "2"}})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		sub  string
+		obj  string
+		act  string
+		want bool
+	}{
+		{name: "employee reads own card", sub: "5", obj: "/users/5", act: "GET", want: true},
+		{name: "employee reads another card", sub: "5", obj: "/users/6", act: "GET"},
+		{name: "employee changes own card", sub: "5", obj: "/users/5", act: "PATCH"},
+		{name: "employee reads own nested resource", sub: "5", obj: "/users/5/compensations", act: "GET"},
+		{name: "employee requests vacation", sub: "5", obj: "/vacation-requests/1", act: "POST", want: true},
+		{name: "hr reads any card", sub: "7", obj: "/users/6", act: "GET", want: true},
+		{name: "hr changes any card", sub: "7", obj: "/users/6", act: "PATCH", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := e.Enforce(tt.sub, tt.obj, tt.act)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package benefit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/benefit/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *service) ListBenefits(ctx context.Context) ([]model.Benefit, error) {
+	const op = "benefit service: list benefits"
+
+	bs, err := s.benefitRepository.ListBenefits(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return bs, nil
+}
+
+func (s *service) GetBenefit(ctx context.Context, benefitID uint64) (*model.Benefit, error) {
+	const op = "benefit service: get benefit"
+
+	b, err := s.benefitRepository.GetBenefit(ctx, benefitID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "benefit not found")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return b, nil
+}
+
+func (s *service) AddBenefit(ctx context.Context, b model.Benefit) (uint64, error) {
+	const op = "benefit service: add benefit"
+
+	id, err := s.benefitRepository.AddBenefit(ctx, b)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrConflict) {
+			return 0, serr.NewError(serr.Conflict, "not added: department problem")
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (s *service) UpdateBenefit(ctx context.Context, b model.Benefit) error {
+	const op = "benefit service: update benefit"
+
+	err := s.benefitRepository.UpdateBenefit(ctx, b)
+	if err != nil {
+		switch {
+		case errors.Is(err, repoerr.ErrConflict):
+			return serr.NewError(serr.Conflict, "not updated: department problem")
+		case errors.Is(err, repoerr.ErrRecordNotAffected):
+			return serr.NewError(serr.NotFound, "benefit not found")
+		default:
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package benefit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/benefit/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *service) ListEnrolments(ctx context.Context, userID uint64) ([]model.Enrolment, error) {
+	const op = "benefit service: list enrolments"
+
+	es, err := s.benefitRepository.ListEnrolments(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return es, nil
+}
+
+// AddEnrolment enrols the employee in the benefit if the employee meets
+// the eligibility rules on the first day of the enrolment.
+func (s *service) AddEnrolment(ctx context.Context, userID uint64, e model.Enrolment) (uint64, error) {
+	const op = "benefit service: add enrolment"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.checkEligible(ctx, "not added", userID, e.BenefitID, e.DateFrom); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := s.benefitRepository.AddEnrolment(ctx, userID, e)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrConflict) {
+			return 0, serr.NewError(serr.Conflict, "not added: the employee already uses the benefit in the period")
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+// UpdateEnrolment changes the period of the enrolment if the employee meets
+// the eligibility rules on the new first day of the enrolment.
+func (s *service) UpdateEnrolment(ctx context.Context, userID uint64, e model.Enrolment) error {
+	const op = "benefit service: update enrolment"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	cur, err := s.benefitRepository.GetEnrolment(ctx, userID, e.ID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return serr.NewError(serr.NotFound, "enrolment not found")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.checkEligible(ctx, "not updated", userID, cur.BenefitID, e.DateFrom); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.benefitRepository.UpdateEnrolment(ctx, userID, e)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrVersionMismatch) {
+			return serr.NewError(serr.PreconditionFailed, "not updated: the enrolment is changed by another user")
+		}
+		if errors.Is(err, repoerr.ErrRecordNotAffected) {
+			return serr.NewError(serr.Conflict, "not updated: user/enrolment problem or overlapping period")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// checkEligible returns an error if the employee doesn't meet
+// the eligibility rules of the benefit on the date.
+func (s *service) checkEligible(ctx context.Context, action string, userID, benefitID uint64, date time.Time) error {
+	emp, err := s.benefitRepository.GetEmployee(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return serr.NewError(serr.Conflict, action+": user not found")
+		}
+		return err
+	}
+
+	b, err := s.benefitRepository.GetBenefit(ctx, benefitID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return serr.NewError(serr.Conflict, action+": benefit not found")
+		}
+		return err
+	}
+
+	if !b.Rules.Eligible(*emp, date) {
+		return serr.NewError(serr.Conflict, action+": the employee is not eligible for the benefit")
+	}
+	return nil
+}
+
+// checkNotTerminated returns an error if the user is a former employee.
+func (s *service) checkNotTerminated(ctx context.Context, userID uint64) error {
+	terminated, err := s.benefitRepository.IsTerminated(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if terminated {
+		return serr.NewError(serr.Conflict, "the user is terminated, the data is read-only")
+	}
+	return nil
+}
+
+func (s *service) DeleteEnrolment(ctx context.Context, userID, enrolmentID uint64, version int64) error {
+	const op = "benefit service: delete enrolment"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := s.benefitRepository.DeleteEnrolment(ctx, userID, enrolmentID, version)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrVersionMismatch) {
+			return serr.NewError(serr.PreconditionFailed, "not deleted: the enrolment is changed by another user")
+		}
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return serr.NewError(serr.NotFound, "enrolment not found")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// CostReport returns benefit costs per department within the period.
+func (s *service) CostReport(ctx context.Context,
+	from, to time.Time, departmentID *uint64) ([]model.DepartmentCost, error) {
+	const op = "benefit service: cost report"
+
+	if to.Before(from) {
+		return nil, serr.NewError(serr.InvalidArgument, "the period end is earlier than its beginning")
+	}
+
+	es, err := s.benefitRepository.ListEnrolmentsInPeriod(ctx, from, to, departmentID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return model.CostReport(es, from, to), nil
+}
@@ -0,0 +1,24 @@
+package benefit
+
+import (
+	"context"
+	"time"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/benefit/model"
+)
+
+type benefitRepository interface {
+	ListBenefits(ctx context.Context) ([]model.Benefit, error)
+	GetBenefit(ctx context.Context, benefitID uint64) (*model.Benefit, error)
+	AddBenefit(ctx context.Context, b model.Benefit) (uint64, error)
+	UpdateBenefit(ctx context.Context, b model.Benefit) error
+
+	GetEmployee(ctx context.Context, userID uint64) (*model.Employee, error)
+	IsTerminated(ctx context.Context, userID uint64) (bool, error)
+	ListEnrolments(ctx context.Context, userID uint64) ([]model.Enrolment, error)
+	GetEnrolment(ctx context.Context, userID, enrolmentID uint64) (*model.Enrolment, error)
+	ListEnrolmentsInPeriod(ctx context.Context, from, to time.Time, departmentID *uint64) ([]model.Enrolment, error)
+	AddEnrolment(ctx context.Context, userID uint64, e model.Enrolment) (uint64, error)
+	UpdateEnrolment(ctx context.Context, userID uint64, e model.Enrolment) error
+	DeleteEnrolment(ctx context.Context, userID, enrolmentID uint64, version int64) error
+}
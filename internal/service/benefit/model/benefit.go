@@ -0,0 +1,52 @@
+package model
+
+import "time"
+
+// Benefit represents a benefit of the catalogue.
+// Cost is a monthly cost of the benefit per employee, in their minor unit form.
+type Benefit struct {
+	ID    uint64
+	Title string
+	Cost  int64
+	Rules Rules
+}
+
+// Rules represents eligibility rules of the benefit,
+// an empty rule means no restriction.
+type Rules struct {
+	Grade           *string
+	DepartmentID    *uint64
+	MinTenureMonths int
+}
+
+// Employee represents the employee data checked by the eligibility rules.
+// EmployedSince is nil if the employee has no contracts.
+type Employee struct {
+	Grade         string
+	DepartmentID  uint64
+	EmployedSince *time.Time
+}
+
+// Eligible reports whether the employee is eligible for the benefit on the date.
+func (r Rules) Eligible(e Employee, date time.Time) bool {
+	if r.Grade != nil && *r.Grade != e.Grade {
+		return false
+	}
+	if r.DepartmentID != nil && *r.DepartmentID != e.DepartmentID {
+		return false
+	}
+	if r.MinTenureMonths > 0 {
+		if e.EmployedSince == nil {
+			return false
+		}
+		if truncateDate(e.EmployedSince.AddDate(0, r.MinTenureMonths, 0)).After(truncateDate(date)) {
+			return false
+		}
+	}
+	return true
+}
+
+func truncateDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,93 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(s string) time.Time {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestRules_Eligible(t *testing.T) {
+	grade := "A"
+	departmentID := uint64(2)
+	since := date("2023-01-15")
+	employee := Employee{Grade: "A", DepartmentID: 2, EmployedSince: &since}
+
+	tests := []struct {
+		name     string
+		rules    Rules
+		employee Employee
+		date     time.Time
+		want     bool
+	}{
+		{
+			name:     "no rules",
+			employee: Employee{Grade: "B", DepartmentID: 1},
+			date:     date("2024-01-01"),
+			want:     true,
+		},
+		{
+			name:     "all rules are met",
+			rules:    Rules{Grade: &grade, DepartmentID: &departmentID, MinTenureMonths: 12},
+			employee: employee,
+			date:     date("2024-01-15"),
+			want:     true,
+		},
+		{
+			name:     "another grade",
+			rules:    Rules{Grade: &grade},
+			employee: Employee{Grade: "B", DepartmentID: 2},
+			date:     date("2024-01-15"),
+		},
+		{
+			name:     "another department",
+			rules:    Rules{DepartmentID: &departmentID},
+			employee: Employee{Grade: "A", DepartmentID: 3},
+			date:     date("2024-01-15"),
+		},
+		{
+			name:     "tenure is too short",
+			rules:    Rules{MinTenureMonths: 12},
+			employee: employee,
+			date:     date("2024-01-14"),
+		},
+		{
+			name:     "no contracts",
+			rules:    Rules{MinTenureMonths: 1},
+			employee: Employee{Grade: "A", DepartmentID: 2},
+			date:     date("2024-01-14"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.rules.Eligible(tt.employee, tt.date))
+		})
+	}
+}
+
+func TestCostReport(t *testing.T) {
+	enrolments := []Enrolment{
+		{UserID: 1, Cost: 3000, DepartmentID: 2, Department: "Бухгалтерия",
+			DateFrom: date("2023-12-01"), DateTo: date("2024-12-31")},
+		{UserID: 1, Cost: 600, DepartmentID: 2, Department: "Бухгалтерия",
+			DateFrom: date("2024-01-21"), DateTo: date("2024-01-25")},
+		{UserID: 2, Cost: 3000, DepartmentID: 1, Department: "Управление",
+			DateFrom: date("2024-01-01"), DateTo: date("2024-01-10")},
+		{UserID: 3, Cost: 3000, DepartmentID: 1, Department: "Управление",
+			DateFrom: date("2024-02-01"), DateTo: date("2024-02-10")},
+	}
+
+	got := CostReport(enrolments, date("2024-01-01"), date("2024-01-30"))
+	assert.Equal(t, []DepartmentCost{
+		{DepartmentID: 2, Department: "Бухгалтерия", Employees: 1, Enrolments: 2, Cost: 3100},
+		{DepartmentID: 1, Department: "Управление", Employees: 1, Enrolments: 1, Cost: 1000},
+	}, got)
+}
@@ -0,0 +1,79 @@
+package model
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// Enrolment represents the use of the benefit by the employee, both dates are inclusive.
+type Enrolment struct {
+	ID           uint64
+	UserID       uint64
+	BenefitID    uint64
+	Benefit      string
+	Cost         int64
+	DepartmentID uint64
+	Department   string
+	DateFrom     time.Time
+	DateTo       time.Time
+	Version      int64
+}
+
+// CostIn returns the cost of the enrolment within the period.
+// The monthly cost is prorated by days, a month is 30 days.
+func (e Enrolment) CostIn(from, to time.Time) int64 {
+	begin, end := truncateDate(e.DateFrom), truncateDate(e.DateTo)
+	if f := truncateDate(from); f.After(begin) {
+		begin = f
+	}
+	if t := truncateDate(to); t.Before(end) {
+		end = t
+	}
+	if end.Before(begin) {
+		return 0
+	}
+	days := int64(end.Sub(begin).Hours()/24) + 1
+	return e.Cost * days / 30
+}
+
+// DepartmentCost represents benefit costs of the department within a period.
+type DepartmentCost struct {
+	DepartmentID uint64
+	Department   string
+	Employees    int
+	Enrolments   int
+	Cost         int64
+}
+
+// CostReport groups costs of the enrolments within the period by departments.
+// Departments are ordered by title.
+func CostReport(enrolments []Enrolment, from, to time.Time) []DepartmentCost {
+	costs := make(map[uint64]*DepartmentCost)
+	employees := make(map[uint64]map[uint64]struct{})
+	for _, e := range enrolments {
+		if truncateDate(e.DateTo).Before(truncateDate(from)) ||
+			truncateDate(e.DateFrom).After(truncateDate(to)) {
+			continue
+		}
+		dc, ok := costs[e.DepartmentID]
+		if !ok {
+			dc = &DepartmentCost{DepartmentID: e.DepartmentID, Department: e.Department}
+			costs[e.DepartmentID] = dc
+			employees[e.DepartmentID] = make(map[uint64]struct{})
+		}
+		dc.Enrolments++
+		dc.Cost += e.CostIn(from, to)
+		employees[e.DepartmentID][e.UserID] = struct{}{}
+	}
+
+	report := make([]DepartmentCost, 0, len(costs))
+	for id, dc := range costs {
+		dc.Employees = len(employees[id])
+		report = append(report, *dc)
+	}
+	slices.SortFunc(report, func(a, b DepartmentCost) int {
+		return cmp.Compare(a.Department, b.Department)
+	})
+	return report
+}
@@ -0,0 +1,111 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/benefit/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+const benefitColumns = `id, title, cost, grade, department_id, min_tenure_months`
+
+func (s *storage) ListBenefits(ctx context.Context) ([]model.Benefit, error) {
+	const op = "postgresql benefit storage: list benefits"
+
+	rows, err := s.Query(ctx, `SELECT `+benefitColumns+` FROM benefits ORDER BY title, id`)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	bs, err := pgx.CollectRows[benefit](rows, pgx.RowToStructByNameLax[benefit])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	benefits := make([]model.Benefit, len(bs))
+	for i, b := range bs {
+		benefits[i] = convertBenefitToModelBenefit(b)
+	}
+	return benefits, nil
+}
+
+func (s *storage) GetBenefit(ctx context.Context, benefitID uint64) (*model.Benefit, error) {
+	const op = "postgresql benefit storage: get benefit"
+
+	rows, err := s.Query(ctx, `SELECT `+benefitColumns+` FROM benefits WHERE id = @id`,
+		pgx.NamedArgs{"id": benefitID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	b, err := pgx.CollectExactlyOneRow[benefit](rows, pgx.RowToStructByNameLax[benefit])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	mb := convertBenefitToModelBenefit(b)
+	return &mb, nil
+}
+
+func (s *storage) AddBenefit(ctx context.Context, b model.Benefit) (uint64, error) {
+	const op = "postgresql benefit storage: add benefit"
+
+	row := s.QueryRow(ctx, `INSERT INTO benefits
+		(title, cost, grade, department_id, min_tenure_months)
+		VALUES (@title, @cost, @grade, @department_id, @min_tenure_months)
+		RETURNING id`,
+		pgx.NamedArgs{
+			"title":             b.Title,
+			"cost":              b.Cost,
+			"grade":             b.Rules.Grade,
+			"department_id":     b.Rules.DepartmentID,
+			"min_tenure_months": b.Rules.MinTenureMonths,
+		})
+
+	if err := row.Scan(&b.ID); err != nil {
+		if strings.Contains(err.Error(), "23") && // Integrity Constraint Violation
+			strings.Contains(err.Error(), "department_id") {
+			return 0, fmt.Errorf("the department does not exist: %w", repoerr.ErrConflict)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return b.ID, nil
+}
+
+func (s *storage) UpdateBenefit(ctx context.Context, b model.Benefit) error {
+	const op = "postgresql benefit storage: update benefit"
+
+	tag, err := s.Exec(ctx, `UPDATE benefits
+		SET title = @title, cost = @cost, grade = @grade,
+		department_id = @department_id, min_tenure_months = @min_tenure_months
+		WHERE id = @id`,
+		pgx.NamedArgs{
+			"id":                b.ID,
+			"title":             b.Title,
+			"cost":              b.Cost,
+			"grade":             b.Rules.Grade,
+			"department_id":     b.Rules.DepartmentID,
+			"min_tenure_months": b.Rules.MinTenureMonths,
+		})
+	if err != nil {
+		if strings.Contains(err.Error(), "23") && // Integrity Constraint Violation
+			strings.Contains(err.Error(), "department_id") {
+			return fmt.Errorf("the department does not exist: %w", repoerr.ErrConflict)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 { // it's ok for pgx
+		return repoerr.ErrRecordNotAffected
+	}
+	return nil
+}
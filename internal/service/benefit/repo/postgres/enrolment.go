@@ -0,0 +1,230 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/benefit/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+const enrolmentQuery = `SELECT
+benefit_uses.id AS id, benefit_uses.user_id AS user_id,
+benefit_uses.benefit_id AS benefit_id, benefits.title AS benefit, benefits.cost AS cost,
+users.department_id AS department_id, departments.title AS department,
+benefit_uses.date_begin AS date_begin, benefit_uses.date_end AS date_end, benefit_uses.version AS version
+FROM benefit_uses
+JOIN benefits ON benefit_uses.benefit_id = benefits.id
+JOIN users ON benefit_uses.user_id = users.id
+JOIN departments ON users.department_id = departments.id`
+
+func (s *storage) GetEmployee(ctx context.Context, userID uint64) (*model.Employee, error) {
+	const op = "postgresql benefit storage: get employee"
+
+	rows, err := s.Query(ctx, `SELECT grade, department_id,
+		(SELECT MIN(date_begin) FROM contracts WHERE contracts.user_id = users.id) AS employed_since
+		FROM users
+		WHERE id = @user_id`,
+		pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	e, err := pgx.CollectExactlyOneRow[employee](rows, pgx.RowToStructByNameLax[employee])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	me := convertEmployeeToModelEmployee(e)
+	return &me, nil
+}
+
+func (s *storage) ListEnrolments(ctx context.Context, userID uint64) ([]model.Enrolment, error) {
+	const op = "postgresql benefit storage: list enrolments"
+
+	rows, err := s.Query(ctx, enrolmentQuery+`
+		WHERE benefit_uses.user_id = @user_id
+		ORDER BY benefit_uses.date_begin`,
+		pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	return collectEnrolments(rows, op)
+}
+
+func (s *storage) GetEnrolment(ctx context.Context, userID, enrolmentID uint64) (*model.Enrolment, error) {
+	const op = "postgresql benefit storage: get enrolment"
+
+	rows, err := s.Query(ctx, enrolmentQuery+`
+		WHERE benefit_uses.id = @id AND benefit_uses.user_id = @user_id`,
+		pgx.NamedArgs{
+			"id":      enrolmentID,
+			"user_id": userID,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	e, err := pgx.CollectExactlyOneRow[enrolment](rows, pgx.RowToStructByNameLax[enrolment])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	me := convertEnrolmentToModelEnrolment(e)
+	return &me, nil
+}
+
+func (s *storage) ListEnrolmentsInPeriod(ctx context.Context,
+	from, to time.Time, departmentID *uint64) ([]model.Enrolment, error) {
+	const op = "postgresql benefit storage: list enrolments in period"
+
+	rows, err := s.Query(ctx, enrolmentQuery+`
+		WHERE benefit_uses.date_begin <= @date_to AND benefit_uses.date_end >= @date_from AND
+		(@department_id::bigint IS NULL OR users.department_id = @department_id)`,
+		pgx.NamedArgs{
+			"date_from":     from,
+			"date_to":       to,
+			"department_id": departmentID,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	return collectEnrolments(rows, op)
+}
+
+func collectEnrolments(rows pgx.Rows, op string) ([]model.Enrolment, error) {
+	es, err := pgx.CollectRows[enrolment](rows, pgx.RowToStructByNameLax[enrolment])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	enrolments := make([]model.Enrolment, len(es))
+	for i, e := range es {
+		enrolments[i] = convertEnrolmentToModelEnrolment(e)
+	}
+	return enrolments, nil
+}
+
+// AddEnrolment adds the enrolment unless the user already uses the benefit
+// within the period, in this case it returns repoerr.ErrConflict.
+func (s *storage) AddEnrolment(ctx context.Context, userID uint64, e model.Enrolment) (uint64, error) {
+	const op = "postgresql benefit storage: add enrolment"
+
+	row := s.QueryRow(ctx, `INSERT INTO benefit_uses
+		(user_id, benefit_id, date_begin, date_end)
+		SELECT @user_id::bigint, @benefit_id::bigint, @date_begin::date, @date_end::date
+		WHERE NOT EXISTS (SELECT 1 FROM benefit_uses
+			WHERE user_id = @user_id AND benefit_id = @benefit_id AND
+			date_begin <= @date_end AND date_end >= @date_begin)
+		RETURNING id`,
+		pgx.NamedArgs{
+			"user_id":    userID,
+			"benefit_id": e.BenefitID,
+			"date_begin": e.DateFrom,
+			"date_end":   e.DateTo,
+		})
+
+	if err := row.Scan(&e.ID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, fmt.Errorf("the enrolment overlaps an existing one: %w", repoerr.ErrConflict)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return e.ID, nil
+}
+
+// UpdateEnrolment changes the period of the enrolment of the version (of any version if it's 0).
+func (s *storage) UpdateEnrolment(ctx context.Context, userID uint64, e model.Enrolment) error {
+	const op = "postgresql benefit storage: update enrolment"
+
+	args := pgx.NamedArgs{
+		"id":         e.ID,
+		"user_id":    userID,
+		"date_begin": e.DateFrom,
+		"date_end":   e.DateTo,
+		"version":    e.Version,
+	}
+	tag, err := s.Exec(ctx, `UPDATE benefit_uses
+		SET date_begin = @date_begin, date_end = @date_end
+		WHERE id = @id AND user_id = @user_id AND (@version::bigint = 0 OR version = @version) AND
+		NOT EXISTS (SELECT 1 FROM benefit_uses AS other
+			WHERE other.user_id = benefit_uses.user_id AND other.benefit_id = benefit_uses.benefit_id AND
+			other.id <> benefit_uses.id AND
+			other.date_begin <= @date_end AND other.date_end >= @date_begin)`, args)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 { // it's ok for pgx
+		return s.enrolmentNotAffected(ctx, args, repoerr.ErrRecordNotAffected)
+	}
+	return nil
+}
+
+// DeleteEnrolment deletes the enrolment of the version (of any version if it's 0).
+func (s *storage) DeleteEnrolment(ctx context.Context, userID, enrolmentID uint64, version int64) error {
+	const op = "postgresql benefit storage: delete enrolment"
+
+	args := pgx.NamedArgs{
+		"id":      enrolmentID,
+		"user_id": userID,
+		"version": version,
+	}
+	tag, err := s.Exec(ctx, `DELETE FROM benefit_uses
+		WHERE id = @id AND user_id = @user_id AND (@version::bigint = 0 OR version = @version)`, args)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return s.enrolmentNotAffected(ctx, args, repoerr.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// enrolmentNotAffected tells the stale version of the enrolment from the other reasons
+// the update or deletion affected nothing: it returns ErrVersionMismatch if the enrolment
+// of another version exists, otherwise the other error.
+func (s *storage) enrolmentNotAffected(ctx context.Context, args pgx.NamedArgs, other error) error {
+	var stale bool
+	err := s.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM benefit_uses
+		WHERE id = @id AND user_id = @user_id AND NOT (@version::bigint = 0 OR version = @version))`,
+		args).Scan(&stale)
+	if err != nil {
+		return err
+	}
+	if stale {
+		return repoerr.ErrVersionMismatch
+	}
+	return other
+}
+
+func (s *storage) IsTerminated(ctx context.Context, userID uint64) (bool, error) {
+	const op = "postgresql benefit storage: is terminated"
+
+	var terminated bool
+	err := s.QueryRow(ctx,
+		"SELECT terminated_at IS NOT NULL FROM users WHERE id = @user_id",
+		pgx.NamedArgs{"user_id": userID}).Scan(&terminated)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return terminated, nil
+}
@@ -0,0 +1,56 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/benefit/model"
+)
+
+type benefit struct {
+	ID              uint64  `db:"id"`
+	Title           string  `db:"title"`
+	Cost            int64   `db:"cost"`
+	Grade           *string `db:"grade"`
+	DepartmentID    *uint64 `db:"department_id"`
+	MinTenureMonths int     `db:"min_tenure_months"`
+}
+
+func convertBenefitToModelBenefit(b benefit) model.Benefit {
+	return model.Benefit{
+		ID:    b.ID,
+		Title: b.Title,
+		Cost:  b.Cost,
+		Rules: model.Rules{
+			Grade:           b.Grade,
+			DepartmentID:    b.DepartmentID,
+			MinTenureMonths: b.MinTenureMonths,
+		},
+	}
+}
+
+type employee struct {
+	Grade         string     `db:"grade"`
+	DepartmentID  uint64     `db:"department_id"`
+	EmployedSince *time.Time `db:"employed_since"`
+}
+
+func convertEmployeeToModelEmployee(e employee) model.Employee {
+	return model.Employee(e)
+}
+
+type enrolment struct {
+	ID           uint64    `db:"id"`
+	UserID       uint64    `db:"user_id"`
+	BenefitID    uint64    `db:"benefit_id"`
+	Benefit      string    `db:"benefit"`
+	Cost         int64     `db:"cost"`
+	DepartmentID uint64    `db:"department_id"`
+	Department   string    `db:"department"`
+	DateFrom     time.Time `db:"date_begin"`
+	DateTo       time.Time `db:"date_end"`
+	Version      int64     `db:"version"`
+}
+
+func convertEnrolmentToModelEnrolment(e enrolment) model.Enrolment {
+	return model.Enrolment(e)
+}
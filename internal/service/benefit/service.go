@@ -0,0 +1,11 @@
+package benefit
+
+type service struct {
+	benefitRepository benefitRepository
+}
+
+func NewService(benefitRepository benefitRepository) *service {
+	return &service{
+		benefitRepository: benefitRepository,
+	}
+}
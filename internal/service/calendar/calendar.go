@@ -0,0 +1,128 @@
+package calendar
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/calendar/model"
+)
+
+// MaxImportSize is the maximum size of the imported production calendar file.
+const MaxImportSize = 1 << 20 // bytes
+
+// ImportFormat is the format of the imported production calendar file.
+type ImportFormat string
+
+const (
+	ImportFormatXML ImportFormat = "xml" // xmlcalendar.ru
+	ImportFormatCSV ImportFormat = "csv" // date,type,title
+)
+
+// Calendar returns the production calendar of the period including the years of the dates.
+func (s *service) Calendar(ctx context.Context, from, to time.Time) (*model.Calendar, error) {
+	const op = "calendar service: calendar"
+
+	if to.Before(from) {
+		from, to = to, from
+	}
+	from = time.Date(from.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	to = time.Date(to.Year(), time.December, 31, 0, 0, 0, 0, time.UTC)
+
+	years, err := s.calendarRepository.ListYears(ctx, from.Year(), to.Year())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	days, err := s.calendarRepository.ListDays(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return model.New(years, days), nil
+}
+
+// Count returns the number of calendar and working days from the date from to the date to inclusive.
+func (s *service) Count(ctx context.Context, from, to time.Time) (model.DaysCount, error) {
+	const op = "calendar service: count"
+
+	c, err := s.Calendar(ctx, from, to)
+	if err != nil {
+		return model.DaysCount{}, fmt.Errorf("%s: %w", op, err)
+	}
+	return c.Count(from, to), nil
+}
+
+// ListDays returns the days of the year differing from the five-day working week.
+func (s *service) ListDays(ctx context.Context, year int) ([]model.Day, error) {
+	const op = "calendar service: list days"
+
+	years, err := s.calendarRepository.ListYears(ctx, year, year)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(years) == 0 {
+		return nil, serr.NewError(serr.NotFound, "the production calendar of the year is not loaded")
+	}
+
+	days, err := s.calendarRepository.ListDays(ctx,
+		time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(year, time.December, 31, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return days, nil
+}
+
+// SetDays replaces the days of the year.
+func (s *service) SetDays(ctx context.Context, year int, days []model.Day) error {
+	const op = "calendar service: set days"
+
+	dates := make(map[time.Time]struct{}, len(days))
+	for _, d := range days {
+		if d.Date.Year() != year {
+			return serr.NewError(serr.InvalidArgument,
+				fmt.Sprintf("the day %s is not in the year %d", d.Date.Format(time.DateOnly), year))
+		}
+		if _, ok := dates[d.Date]; ok {
+			return serr.NewError(serr.InvalidArgument,
+				fmt.Sprintf("the day %s is duplicated", d.Date.Format(time.DateOnly)))
+		}
+		dates[d.Date] = struct{}{}
+	}
+
+	if err := s.calendarRepository.SetYear(ctx, year, days); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Import replaces the days of the year with the days from the file.
+func (s *service) Import(ctx context.Context, year int, format ImportFormat, r io.Reader) error {
+	const op = "calendar service: import"
+
+	var (
+		days []model.Day
+		err  error
+	)
+	switch format {
+	case ImportFormatXML:
+		var fileYear int
+		fileYear, days, err = model.ParseXML(r)
+		if err == nil && fileYear != year {
+			err = fmt.Errorf("the file is the calendar of the year %d", fileYear)
+		}
+	case ImportFormatCSV:
+		days, err = model.ParseCSV(r)
+	default:
+		err = fmt.Errorf("unsupported format %q", format)
+	}
+	if err != nil {
+		return serr.NewError(serr.InvalidArgument, err.Error())
+	}
+
+	if err := s.SetDays(ctx, year, days); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
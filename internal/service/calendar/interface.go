@@ -0,0 +1,14 @@
+package calendar
+
+import (
+	"context"
+	"time"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/calendar/model"
+)
+
+type calendarRepository interface {
+	ListYears(ctx context.Context, from, to int) ([]int, error)
+	ListDays(ctx context.Context, from, to time.Time) ([]model.Day, error)
+	SetYear(ctx context.Context, year int, days []model.Day) error
+}
@@ -0,0 +1,161 @@
+package model
+
+import (
+	"time"
+)
+
+// DayType is the type of the day differing from the five-day working week.
+type DayType string
+
+const (
+	DayTypeHoliday   DayType = "holiday"   // non-working public holiday (article 112 of the Labour Code)
+	DayTypeDayOff    DayType = "day_off"   // non-working day moved from a weekend
+	DayTypeWorking   DayType = "working"   // working weekend day
+	DayTypeShortened DayType = "shortened" // working day shortened by one hour before a holiday
+)
+
+// Day is a day of the production calendar.
+type Day struct {
+	Date  time.Time
+	Type  DayType
+	Title string
+}
+
+// DaysCount is the number of days in the period.
+type DaysCount struct {
+	CalendarDays int // all the days excluding public holidays
+	WorkingDays  int
+	Holidays     int // public holidays
+}
+
+// publicHolidays are non-working public holidays (article 112 of the Labour Code),
+// they are used for the years without the production calendar.
+var publicHolidays = map[time.Month][]int{
+	time.January:  {1, 2, 3, 4, 5, 6, 7, 8},
+	time.February: {23},
+	time.March:    {8},
+	time.May:      {1, 9},
+	time.June:     {12},
+	time.November: {4},
+}
+
+// Calendar is the production calendar: exceptions from the five-day working week.
+// The years not loaded to the calendar are the five-day working week with public holidays,
+// the days off moved from the weekends are not known for them.
+type Calendar struct {
+	days  map[time.Time]Day
+	years map[int]struct{}
+}
+
+// New returns the calendar of the days of the years.
+func New(years []int, days []Day) *Calendar {
+	c := &Calendar{
+		days:  make(map[time.Time]Day, len(days)),
+		years: make(map[int]struct{}, len(years)),
+	}
+	for _, y := range years {
+		c.years[y] = struct{}{}
+	}
+	for _, d := range days {
+		d.Date = truncateDate(d.Date)
+		c.days[d.Date] = d
+	}
+	return c
+}
+
+// Day returns the day of the calendar.
+func (c *Calendar) Day(date time.Time) Day {
+	date = truncateDate(date)
+	if d, ok := c.days[date]; ok {
+		return d
+	}
+	if _, ok := c.years[date.Year()]; !ok && isPublicHoliday(date) {
+		return Day{Date: date, Type: DayTypeHoliday}
+	}
+	return Day{Date: date}
+}
+
+// IsHoliday reports whether the date is a non-working public holiday.
+func (c *Calendar) IsHoliday(date time.Time) bool {
+	return c.Day(date).Type == DayTypeHoliday
+}
+
+// IsWorkingDay reports whether the date is a working day.
+func (c *Calendar) IsWorkingDay(date time.Time) bool {
+	switch c.Day(date).Type {
+	case DayTypeHoliday, DayTypeDayOff:
+		return false
+	case DayTypeWorking, DayTypeShortened:
+		return true
+	default:
+		wd := date.Weekday()
+		return wd != time.Saturday && wd != time.Sunday
+	}
+}
+
+// Count returns the number of days from begin to end inclusive.
+func (c *Calendar) Count(begin, end time.Time) DaysCount {
+	var dc DaysCount
+	end = truncateDate(end)
+	for d := truncateDate(begin); !d.After(end); d = d.AddDate(0, 0, 1) {
+		switch {
+		case c.IsHoliday(d):
+			dc.Holidays++
+		case c.IsWorkingDay(d):
+			dc.WorkingDays++
+			dc.CalendarDays++
+		default:
+			dc.CalendarDays++
+		}
+	}
+	return dc
+}
+
+// CalendarDays returns the number of days from begin to end inclusive excluding public holidays,
+// as vacations are counted (article 120 of the Labour Code).
+func (c *Calendar) CalendarDays(begin, end time.Time) int {
+	return c.Count(begin, end).CalendarDays
+}
+
+// WorkingDays returns the number of working days from begin to end inclusive.
+func (c *Calendar) WorkingDays(begin, end time.Time) int {
+	return c.Count(begin, end).WorkingDays
+}
+
+// WorkingHours returns the norm of working hours of the day for the 40-hour working week:
+// 8 hours, 7 hours for a day shortened before a holiday, 0 for a non-working day.
+func (c *Calendar) WorkingHours(date time.Time) int {
+	switch {
+	case !c.IsWorkingDay(date):
+		return 0
+	case c.Day(date).Type == DayTypeShortened:
+		return 7
+	default:
+		return 8
+	}
+}
+
+// NextWorkingDay returns the date if it's a working day or the next working day
+// (a period ending on a non-working day ends on the next working day, article 14 of the Labour Code).
+func (c *Calendar) NextWorkingDay(date time.Time) time.Time {
+	date = truncateDate(date)
+	for !c.IsWorkingDay(date) {
+		date = date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+func isPublicHoliday(date time.Time) bool {
+	_, m, d := date.Date()
+	for _, hd := range publicHolidays[m] {
+		if hd == d {
+			return true
+		}
+	}
+	return false
+}
+
+func truncateDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,94 @@
+package model
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(s string) time.Time {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+const calendar2024 = `<?xml version="1.0" encoding="UTF-8"?>
+<calendar year="2024" lang="ru" date="2023.09.25" country="ru">
+	<holidays>
+		<holiday id="1" title="Новогодние каникулы"/>
+		<holiday id="4" title="Международный женский день"/>
+	</holidays>
+	<days>
+		<day d="01.01" t="1" h="1"/>
+		<day d="01.02" t="1" h="1"/>
+		<day d="03.07" t="2"/>
+		<day d="03.08" t="1" h="4"/>
+		<day d="04.27" t="3"/>
+		<day d="04.29" t="1" f="04.27"/>
+	</days>
+</calendar>`
+
+func TestParseXML(t *testing.T) {
+	year, days, err := ParseXML(strings.NewReader(calendar2024))
+	require.NoError(t, err)
+	assert.Equal(t, 2024, year)
+	assert.Equal(t, []Day{
+		{Date: date("2024-01-01"), Type: DayTypeHoliday, Title: "Новогодние каникулы"},
+		{Date: date("2024-01-02"), Type: DayTypeHoliday, Title: "Новогодние каникулы"},
+		{Date: date("2024-03-07"), Type: DayTypeShortened},
+		{Date: date("2024-03-08"), Type: DayTypeHoliday, Title: "Международный женский день"},
+		{Date: date("2024-04-27"), Type: DayTypeWorking},
+		{Date: date("2024-04-29"), Type: DayTypeDayOff, Title: "перенос с 04.27"},
+	}, days)
+
+	_, _, err = ParseXML(strings.NewReader(`<calendar><days><day d="01.01" t="1"/></days></calendar>`))
+	assert.Error(t, err)
+}
+
+func TestParseCSV(t *testing.T) {
+	days, err := ParseCSV(strings.NewReader("date,type,title\n" +
+		"2024-03-08,holiday,Международный женский день\n" +
+		"2024-04-27,working\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []Day{
+		{Date: date("2024-03-08"), Type: DayTypeHoliday, Title: "Международный женский день"},
+		{Date: date("2024-04-27"), Type: DayTypeWorking},
+	}, days)
+
+	_, err = ParseCSV(strings.NewReader("2024-03-08,weekend\n"))
+	assert.Error(t, err)
+	_, err = ParseCSV(strings.NewReader("08.03.2024,holiday\n"))
+	assert.Error(t, err)
+}
+
+func TestCalendar(t *testing.T) {
+	_, days, err := ParseXML(strings.NewReader(calendar2024))
+	require.NoError(t, err)
+	c := New([]int{2024}, days)
+
+	// 2024-04-27 is a working Saturday, 2024-04-29 is a day off moved from it
+	assert.True(t, c.IsWorkingDay(date("2024-04-27")))
+	assert.False(t, c.IsWorkingDay(date("2024-04-29")))
+	assert.False(t, c.IsHoliday(date("2024-04-29")))
+	assert.Equal(t, date("2024-04-30"), c.NextWorkingDay(date("2024-04-28")))
+
+	// 2024-03-04 - 2024-03-10: 8 March is a holiday, 7 March is shortened
+	assert.Equal(t, DaysCount{CalendarDays: 6, WorkingDays: 4, Holidays: 1},
+		c.Count(date("2024-03-04"), date("2024-03-10")))
+	assert.Equal(t, 7, c.WorkingHours(date("2024-03-07")))
+	assert.Equal(t, 0, c.WorkingHours(date("2024-03-08")))
+	assert.Equal(t, 8, c.WorkingHours(date("2024-04-27")))
+
+	// the year is loaded, so fixed public holidays are not used
+	assert.False(t, c.IsHoliday(date("2024-05-09")))
+	// the year is not loaded
+	assert.True(t, c.IsHoliday(date("2025-05-09")))
+	assert.Equal(t, 6, c.CalendarDays(date("2025-01-01"), date("2025-01-14")))
+	assert.Equal(t, 13, c.CalendarDays(date("2025-03-03"), date("2025-03-16")))
+	assert.Equal(t, 10, c.WorkingDays(date("2025-03-03"), date("2025-03-16")))
+}
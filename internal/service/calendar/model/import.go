@@ -0,0 +1,113 @@
+package model
+
+import (
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// xmlCalendar is the production calendar in the format of xmlcalendar.ru.
+type xmlCalendar struct {
+	Year     int `xml:"year,attr"`
+	Holidays []struct {
+		ID    string `xml:"id,attr"`
+		Title string `xml:"title,attr"`
+	} `xml:"holidays>holiday"`
+	Days []struct {
+		Date    string `xml:"d,attr"` // MM.DD
+		Type    int    `xml:"t,attr"` // 1 - day off, 2 - shortened, 3 - working weekend day
+		Holiday string `xml:"h,attr"` // holiday ID
+		From    string `xml:"f,attr"` // MM.DD the day is moved from
+	} `xml:"days>day"`
+}
+
+// ParseXML parses the production calendar of the year in the format of xmlcalendar.ru.
+func ParseXML(r io.Reader) (year int, days []Day, err error) {
+	var xc xmlCalendar
+	if err := xml.NewDecoder(r).Decode(&xc); err != nil {
+		return 0, nil, fmt.Errorf("invalid XML: %w", err)
+	}
+	if xc.Year == 0 {
+		return 0, nil, errors.New("invalid XML: no year of the calendar")
+	}
+
+	holidays := make(map[string]string, len(xc.Holidays))
+	for _, h := range xc.Holidays {
+		holidays[h.ID] = h.Title
+	}
+
+	days = make([]Day, 0, len(xc.Days))
+	for _, xd := range xc.Days {
+		date, err := time.Parse("2006.01.02", fmt.Sprintf("%d.%s", xc.Year, xd.Date))
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid date %q: %w", xd.Date, err)
+		}
+
+		d := Day{Date: date}
+		switch xd.Type {
+		case 1:
+			if xd.Holiday != "" {
+				d.Type = DayTypeHoliday
+				d.Title = holidays[xd.Holiday]
+			} else {
+				d.Type = DayTypeDayOff
+			}
+		case 2:
+			d.Type = DayTypeShortened
+		case 3:
+			d.Type = DayTypeWorking
+		default:
+			return 0, nil, fmt.Errorf("invalid type %d of the day %q", xd.Type, xd.Date)
+		}
+		if d.Title == "" && xd.From != "" {
+			d.Title = "перенос с " + xd.From
+		}
+		days = append(days, d)
+	}
+	return xc.Year, days, nil
+}
+
+// ParseCSV parses the days of the production calendar from the lines "date,type,title",
+// the date is YYYY-MM-DD and the type is one of the day types. The header line is optional.
+func ParseCSV(r io.Reader) ([]Day, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	var days []Day
+	for line := 1; ; line++ {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV: %w", err)
+		}
+		if line == 1 && strings.EqualFold(rec[0], "date") {
+			continue
+		}
+		if len(rec) < 2 {
+			return nil, fmt.Errorf("line %d: date and type are required", line)
+		}
+
+		date, err := time.Parse(time.DateOnly, rec[0])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid date %q", line, rec[0])
+		}
+		d := Day{Date: date, Type: DayType(rec[1])}
+		switch d.Type {
+		case DayTypeHoliday, DayTypeDayOff, DayTypeWorking, DayTypeShortened:
+		default:
+			return nil, fmt.Errorf("line %d: invalid type %q", line, rec[1])
+		}
+		if len(rec) > 2 {
+			d.Title = rec[2]
+		}
+		days = append(days, d)
+	}
+	return days, nil
+}
@@ -0,0 +1,97 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/calendar/model"
+)
+
+// ListYears returns the years from the year from to the year to loaded to the calendar.
+func (s *storage) ListYears(ctx context.Context, from, to int) ([]int, error) {
+	const op = "postgresql calendar storage: list years"
+
+	rows, err := s.Query(ctx, `SELECT year FROM calendar_years
+		WHERE year BETWEEN @from AND @to
+		ORDER BY year`,
+		pgx.NamedArgs{
+			"from": from,
+			"to":   to,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	years, err := pgx.CollectRows[int](rows, pgx.RowTo[int])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return years, nil
+}
+
+func (s *storage) ListDays(ctx context.Context, from, to time.Time) ([]model.Day, error) {
+	const op = "postgresql calendar storage: list days"
+
+	rows, err := s.Query(ctx, `SELECT date, type, title FROM calendar_days
+		WHERE date BETWEEN @from AND @to
+		ORDER BY date`,
+		pgx.NamedArgs{
+			"from": from,
+			"to":   to,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	ds, err := pgx.CollectRows[day](rows, pgx.RowToStructByNameLax[day])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	days := make([]model.Day, len(ds))
+	for i, d := range ds {
+		days[i] = convertDayToModelDay(d)
+	}
+	return days, nil
+}
+
+// SetYear replaces the days of the year.
+func (s *storage) SetYear(ctx context.Context, year int, days []model.Day) error {
+	const op = "postgresql calendar storage: set year"
+
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	if _, err := tx.Exec(ctx, `INSERT INTO calendar_years (year) VALUES (@year)
+		ON CONFLICT (year) DO UPDATE SET updated_at = now()`,
+		pgx.NamedArgs{"year": year}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM calendar_days
+		WHERE date_part('year', date) = @year`,
+		pgx.NamedArgs{"year": year}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, d := range days {
+		if _, err := tx.Exec(ctx, `INSERT INTO calendar_days (date, type, title)
+			VALUES (@date, @type, @title)`,
+			pgx.NamedArgs{
+				"date":  d.Date,
+				"type":  string(d.Type),
+				"title": d.Title,
+			}); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/calendar/model"
+)
+
+type day struct {
+	Date  time.Time `db:"date"`
+	Type  string    `db:"type"`
+	Title string    `db:"title"`
+}
+
+func convertDayToModelDay(d day) model.Day {
+	return model.Day{
+		Date:  d.Date,
+		Type:  model.DayType(d.Type),
+		Title: d.Title,
+	}
+}
@@ -0,0 +1,11 @@
+package calendar
+
+type service struct {
+	calendarRepository calendarRepository
+}
+
+func NewService(calendarRepository calendarRepository) *service {
+	return &service{
+		calendarRepository: calendarRepository,
+	}
+}
@@ -0,0 +1,98 @@
+package compensation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/compensation/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *service) ListCompensations(ctx context.Context, userID uint64) ([]model.Compensation, error) {
+	const op = "compensation service: list compensations"
+
+	cs, err := s.compensationRepository.ListCompensations(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return cs, nil
+}
+
+func (s *service) GetCompensation(ctx context.Context, userID, compensationID uint64) (*model.Compensation, error) {
+	const op = "compensation service: get compensation"
+
+	c, err := s.compensationRepository.GetCompensation(ctx, userID, compensationID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "compensation not found")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return c, nil
+}
+
+// GetCurrentCompensation returns the compensation effective today
+// under the user's current contract or nil if there is no such one.
+func (s *service) GetCurrentCompensation(ctx context.Context, userID uint64) (*model.Compensation, error) {
+	const op = "compensation service: get current compensation"
+
+	c, err := s.compensationRepository.GetCurrentCompensation(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return c, nil
+}
+
+func (s *service) AddCompensation(ctx context.Context, userID uint64, c model.Compensation) (uint64, error) {
+	const op = "compensation service: add compensation"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := s.compensationRepository.AddCompensation(ctx, userID, c)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return 0, serr.NewError(serr.Conflict, "not added: user/contract problem")
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (s *service) UpdateCompensation(ctx context.Context, userID uint64, c model.Compensation) error {
+	const op = "compensation service: update compensation"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := s.compensationRepository.UpdateCompensation(ctx, userID, c)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrVersionMismatch) {
+			return serr.NewError(serr.PreconditionFailed, "not updated: the compensation is changed by another user")
+		}
+		if errors.Is(err, repoerr.ErrRecordNotAffected) {
+			return serr.NewError(serr.Conflict, "not updated: user/compensation problem")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// checkNotTerminated returns an error if the user is a former employee.
+func (s *service) checkNotTerminated(ctx context.Context, userID uint64) error {
+	terminated, err := s.compensationRepository.IsTerminated(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if terminated {
+		return serr.NewError(serr.Conflict, "the user is terminated, the data is read-only")
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package compensation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/compensation/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *service) ListIndexations(ctx context.Context, userID uint64) ([]model.Indexation, error) {
+	const op = "compensation service: list indexations"
+
+	is, err := s.indexationRepository.ListIndexations(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return is, nil
+}
+
+// PreviewIndexationRun returns the employees affected by the run
+// with their current and indexed compensations. Nothing is written.
+func (s *service) PreviewIndexationRun(ctx context.Context, run model.IndexationRun) ([]model.IndexationItem, error) {
+	const op = "compensation service: preview indexation run"
+
+	items, err := s.indexationRepository.ListIndexationBase(ctx, run.DepartmentID, run.DateBegin)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	for i := range items {
+		items[i].Indexed = items[i].Current.Indexed(run.BasisPoints, run.DateBegin)
+	}
+	return items, nil
+}
+
+// AddIndexationRun indexes compensations of the employees as previewed by PreviewIndexationRun.
+// The base is read and the run is written in one transaction,
+// so the compensations changed meanwhile aren't overwritten by the stale ones.
+func (s *service) AddIndexationRun(ctx context.Context, run model.IndexationRun) (uint64, error) {
+	const op = "compensation service: add indexation run"
+
+	var id uint64
+	err := s.indexationRepository.WithTx(ctx, func(ctx context.Context) error {
+		items, err := s.PreviewIndexationRun(ctx, run)
+		if err != nil {
+			return err
+		}
+		if len(items) == 0 {
+			return serr.NewError(serr.InvalidArgument, "no employees to index")
+		}
+
+		id, err = s.indexationRepository.AddIndexationRun(ctx, run, items)
+		if err != nil {
+			if errors.Is(err, repoerr.ErrConflict) {
+				return serr.NewError(serr.Conflict, "not added: department not found")
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (s *service) ListIndexationRuns(ctx context.Context) ([]model.IndexationRun, error) {
+	const op = "compensation service: list indexation runs"
+
+	runs, err := s.indexationRepository.ListIndexationRuns(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return runs, nil
+}
+
+func (s *service) GetIndexationRun(ctx context.Context, runID uint64) (*model.IndexationRun, error) {
+	const op = "compensation service: get indexation run"
+
+	run, err := s.indexationRepository.GetIndexationRun(ctx, runID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "indexation run not found")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return run, nil
+}
+
+// RevertIndexationRun deletes the run with all its results.
+// It's possible only until the run comes into effect.
+func (s *service) RevertIndexationRun(ctx context.Context, runID uint64) error {
+	const op = "compensation service: revert indexation run"
+
+	run, err := s.GetIndexationRun(ctx, runID)
+	if err != nil {
+		return err
+	}
+	if !run.Reversible(time.Now()) {
+		return serr.NewError(serr.Conflict, "not reverted: the indexation is already in effect")
+	}
+
+	err = s.indexationRepository.DeleteIndexationRun(ctx, runID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotAffected) {
+			return serr.NewError(serr.Conflict, "not reverted: the indexation is already in effect")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
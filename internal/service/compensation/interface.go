@@ -0,0 +1,29 @@
+package compensation
+
+import (
+	"context"
+	"time"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/compensation/model"
+)
+
+type compensationRepository interface {
+	ListCompensations(ctx context.Context, userID uint64) ([]model.Compensation, error)
+	GetCompensation(ctx context.Context, userID, compensationID uint64) (*model.Compensation, error)
+	GetCurrentCompensation(ctx context.Context, userID uint64) (*model.Compensation, error)
+	AddCompensation(ctx context.Context, userID uint64, c model.Compensation) (uint64, error)
+	UpdateCompensation(ctx context.Context, userID uint64, c model.Compensation) error
+	IsTerminated(ctx context.Context, userID uint64) (bool, error)
+}
+
+type indexationRepository interface {
+	// WithTx runs fn in a transaction, the repository called with the context of fn joins it.
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
+
+	ListIndexations(ctx context.Context, userID uint64) ([]model.Indexation, error)
+	ListIndexationBase(ctx context.Context, departmentID *uint64, date time.Time) ([]model.IndexationItem, error)
+	ListIndexationRuns(ctx context.Context) ([]model.IndexationRun, error)
+	GetIndexationRun(ctx context.Context, runID uint64) (*model.IndexationRun, error)
+	AddIndexationRun(ctx context.Context, run model.IndexationRun, items []model.IndexationItem) (uint64, error)
+	DeleteIndexationRun(ctx context.Context, runID uint64) error
+}
@@ -0,0 +1,18 @@
+package model
+
+import "time"
+
+// Compensation represents the employee's pay under the contract
+// effective from DateBegin until the next record of the contract.
+// Amounts are per month in minor units of the currency.
+type Compensation struct {
+	ID                uint64
+	ContractID        uint64
+	Salary            int64
+	SalaryRate        *float64
+	SocialSecurityTax int64
+	IncomeTax         int64
+	Currency          string
+	DateBegin         time.Time
+	Version           int64
+}
@@ -0,0 +1,63 @@
+package model
+
+import "time"
+
+// Indexation represents a raise of the employee's salary.
+// RunID is set if the indexation was a part of a bulk indexation run.
+type Indexation struct {
+	ID           uint64
+	RunID        *uint64
+	DateBegin    time.Time
+	BasisPoints  int // hundredths of a percent, 450 is 4.5%
+	Currency     string
+	SalaryBefore int64
+	SalaryAfter  int64
+}
+
+// IndexationRun represents a bulk indexation of the department
+// or the whole company (DepartmentID is nil).
+type IndexationRun struct {
+	ID           uint64
+	DepartmentID *uint64
+	BasisPoints  int // hundredths of a percent, 450 is 4.5%
+	DateBegin    time.Time
+	Employees    int
+}
+
+// Reversible reports whether the run can be reverted on the date:
+// it's possible until the indexation comes into effect.
+func (r IndexationRun) Reversible(date time.Time) bool {
+	y1, m1, d1 := date.Date()
+	y2, m2, d2 := r.DateBegin.Date()
+	return time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC).
+		Before(time.Date(y2, m2, d2, 0, 0, 0, 0, time.UTC))
+}
+
+// IndexationItem represents the employee's compensation affected by the indexation run.
+type IndexationItem struct {
+	UserID     uint64
+	LastName   string
+	FirstName  string
+	MiddleName string
+	Current    Compensation
+	Indexed    Compensation
+}
+
+// Indexed returns a new compensation raised by basis points (hundredths of a percent) from the date.
+// All amounts are raised in the same proportion and rounded half away from zero.
+func (c Compensation) Indexed(basisPoints int, date time.Time) Compensation {
+	c.ID = 0
+	c.DateBegin = date
+	c.Salary = index(c.Salary, basisPoints)
+	c.SocialSecurityTax = index(c.SocialSecurityTax, basisPoints)
+	c.IncomeTax = index(c.IncomeTax, basisPoints)
+	return c
+}
+
+func index(amount int64, basisPoints int) int64 {
+	n := amount * int64(10000+basisPoints)
+	if n < 0 {
+		return (n - 5000) / 10000
+	}
+	return (n + 5000) / 10000
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompensation_Indexed(t *testing.T) {
+	date := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	c := Compensation{
+		ID:                1,
+		ContractID:        2,
+		Salary:            10000050,
+		SocialSecurityTax: 3000015,
+		IncomeTax:         1300007,
+		Currency:          "RUB",
+		DateBegin:         time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	got := c.Indexed(500, date)
+
+	assert.Equal(t, Compensation{
+		ContractID:        2,
+		Salary:            10500053,
+		SocialSecurityTax: 3150016,
+		IncomeTax:         1365007,
+		Currency:          "RUB",
+		DateBegin:         date,
+	}, got)
+	assert.Equal(t, uint64(1), c.ID, "the source compensation must not change")
+}
+
+func TestCompensation_Indexed_fraction(t *testing.T) {
+	date := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	c := Compensation{Salary: 10000000, SocialSecurityTax: 3000015, IncomeTax: -1300007}
+
+	got := c.Indexed(450, date)
+
+	assert.Equal(t, int64(10450000), got.Salary)
+	assert.Equal(t, int64(3135016), got.SocialSecurityTax)
+	assert.Equal(t, int64(-1358507), got.IncomeTax)
+}
+
+func TestIndexationRun_Reversible(t *testing.T) {
+	r := IndexationRun{DateBegin: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)}
+
+	assert.True(t, r.Reversible(time.Date(2024, 2, 29, 23, 0, 0, 0, time.UTC)))
+	assert.False(t, r.Reversible(time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)))
+	assert.False(t, r.Reversible(time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,180 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/compensation/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+const compensationColumns = `id, contract_id, salary, salary_rate, social_security_tax, income_tax,
+currency, date_begin, version`
+
+func (s *storage) ListCompensations(ctx context.Context, userID uint64) ([]model.Compensation, error) {
+	const op = "postgresql compensation storage: list compensations"
+
+	rows, err := s.Query(ctx, `SELECT `+compensationColumns+`
+		FROM finances
+		WHERE user_id = @user_id
+		ORDER BY contract_id, date_begin`,
+		pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	cs, err := pgx.CollectRows[compensation](rows, pgx.RowToStructByNameLax[compensation])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	compensations := make([]model.Compensation, len(cs))
+	for i, c := range cs {
+		compensations[i] = convertCompensationToModelCompensation(c)
+	}
+	return compensations, nil
+}
+
+func (s *storage) GetCompensation(ctx context.Context, userID, compensationID uint64) (*model.Compensation, error) {
+	const op = "postgresql compensation storage: get compensation"
+
+	rows, err := s.Query(ctx, `SELECT `+compensationColumns+`
+		FROM finances
+		WHERE id = @id AND user_id = @user_id`,
+		pgx.NamedArgs{
+			"id":      compensationID,
+			"user_id": userID,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	c, err := pgx.CollectExactlyOneRow[compensation](rows, pgx.RowToStructByNameLax[compensation])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	mc := convertCompensationToModelCompensation(c)
+	return &mc, nil
+}
+
+func (s *storage) GetCurrentCompensation(ctx context.Context, userID uint64) (*model.Compensation, error) {
+	const op = "postgresql compensation storage: get current compensation"
+
+	rows, err := s.Query(ctx, `SELECT `+compensationColumns+`
+		FROM finances
+		WHERE user_id = @user_id AND date_begin <= CURRENT_DATE AND contract_id IN
+			(SELECT id FROM contracts
+			WHERE user_id = @user_id AND date_begin <= CURRENT_DATE AND 
+			(date_end IS NULL OR date_end >= CURRENT_DATE))
+		ORDER BY date_begin DESC, id DESC
+		LIMIT 1`,
+		pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	c, err := pgx.CollectExactlyOneRow[compensation](rows, pgx.RowToStructByNameLax[compensation])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	mc := convertCompensationToModelCompensation(c)
+	return &mc, nil
+}
+
+// AddCompensation adds the compensation only if the contract belongs to the user,
+// otherwise repoerr.ErrRecordNotFound is returned.
+func (s *storage) AddCompensation(ctx context.Context, userID uint64, mc model.Compensation) (uint64, error) {
+	const op = "postgresql compensation storage: add compensation"
+
+	row := s.QueryRow(ctx, `INSERT INTO finances
+		(user_id, contract_id, salary, salary_rate, social_security_tax, income_tax, currency, date_begin)
+		SELECT user_id, id, @salary, @salary_rate, @social_security_tax, @income_tax, @currency, @date_begin
+		FROM contracts
+		WHERE id = @contract_id AND user_id = @user_id
+		RETURNING id`,
+		pgx.NamedArgs{
+			"user_id":             userID,
+			"contract_id":         mc.ContractID,
+			"salary":              mc.Salary,
+			"salary_rate":         mc.SalaryRate,
+			"social_security_tax": mc.SocialSecurityTax,
+			"income_tax":          mc.IncomeTax,
+			"currency":            mc.Currency,
+			"date_begin":          mc.DateBegin,
+		})
+	if err := row.Scan(&mc.ID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repoerr.ErrRecordNotFound
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return mc.ID, nil
+}
+
+func (s *storage) UpdateCompensation(ctx context.Context, userID uint64, mc model.Compensation) error {
+	const op = "postgresql compensation storage: update compensation"
+
+	args := pgx.NamedArgs{
+		"id":                  mc.ID,
+		"user_id":             userID,
+		"salary":              mc.Salary,
+		"salary_rate":         mc.SalaryRate,
+		"social_security_tax": mc.SocialSecurityTax,
+		"income_tax":          mc.IncomeTax,
+		"currency":            mc.Currency,
+		"date_begin":          mc.DateBegin,
+		"version":             mc.Version,
+	}
+	tag, err := s.Exec(ctx, `UPDATE finances
+		SET salary = @salary, salary_rate = @salary_rate, social_security_tax = @social_security_tax,
+		income_tax = @income_tax, currency = @currency, date_begin = @date_begin
+		WHERE id = @id AND user_id = @user_id AND (@version::bigint = 0 OR version = @version)`, args)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 { // it's ok for pgx
+		// the stale version is told from the absent compensation
+		var exists bool
+		err := s.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM finances WHERE id = @id AND user_id = @user_id)`,
+			args).Scan(&exists)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if exists {
+			return repoerr.ErrVersionMismatch
+		}
+		return repoerr.ErrRecordNotAffected
+	}
+	return nil
+}
+
+func (s *storage) IsTerminated(ctx context.Context, userID uint64) (bool, error) {
+	const op = "postgresql compensation storage: is terminated"
+
+	var terminated bool
+	err := s.QueryRow(ctx,
+		"SELECT terminated_at IS NOT NULL FROM users WHERE id = @user_id",
+		pgx.NamedArgs{"user_id": userID}).Scan(&terminated)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return terminated, nil
+}
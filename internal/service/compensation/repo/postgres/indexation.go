@@ -0,0 +1,262 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/compensation/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *storage) ListIndexations(ctx context.Context, userID uint64) ([]model.Indexation, error) {
+	const op = "postgresql compensation storage: list indexations"
+
+	rows, err := s.Query(ctx, `SELECT 
+		id, run_id, date_begin, basis_points, currency, salary_before, salary_after
+		FROM indexations
+		WHERE user_id = @user_id
+		ORDER BY date_begin`,
+		pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	is, err := pgx.CollectRows[indexation](rows, pgx.RowToStructByNameLax[indexation])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	indexations := make([]model.Indexation, len(is))
+	for i, ix := range is {
+		indexations[i] = convertIndexationToModelIndexation(ix)
+	}
+	return indexations, nil
+}
+
+// ListIndexationBase returns compensations effective on the date
+// under the contracts effective on the date of the current department employees
+// (of all employees if departmentID is nil), the former employees are not indexed.
+// The compensations are locked, so in a transaction they aren't changed until it ends.
+func (s *storage) ListIndexationBase(ctx context.Context, departmentID *uint64, date time.Time) ([]model.IndexationItem, error) {
+	const op = "postgresql compensation storage: list indexation base"
+
+	rows, err := s.Query(ctx, `SELECT
+		finances.id AS id, finances.contract_id AS contract_id, 
+		salary, salary_rate, social_security_tax, income_tax, currency, finances.date_begin AS date_begin,
+		finances.user_id AS user_id, users.lastname AS last_name, users.firstname AS first_name, 
+		users.middlename AS middle_name
+		FROM finances
+		JOIN users ON finances.user_id = users.id
+		WHERE finances.id IN (SELECT DISTINCT ON (finances.user_id) finances.id
+			FROM finances
+			JOIN contracts ON finances.contract_id = contracts.id
+			JOIN users ON finances.user_id = users.id
+			WHERE users.terminated_at IS NULL AND finances.date_begin <= @date AND
+			contracts.date_begin <= @date AND (contracts.date_end IS NULL OR contracts.date_end >= @date) AND
+			(@department_id::bigint IS NULL OR users.department_id = @department_id)
+			ORDER BY finances.user_id, finances.date_begin DESC, finances.id DESC)
+		ORDER BY finances.user_id
+		FOR UPDATE OF finances`,
+		pgx.NamedArgs{
+			"date":          date,
+			"department_id": departmentID,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	bs, err := pgx.CollectRows[indexationBase](rows, pgx.RowToStructByNameLax[indexationBase])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	items := make([]model.IndexationItem, len(bs))
+	for i, b := range bs {
+		items[i] = model.IndexationItem{
+			UserID:     b.UserID,
+			LastName:   b.LastName,
+			FirstName:  b.FirstName,
+			MiddleName: b.MiddleName,
+			Current:    convertCompensationToModelCompensation(b.compensation),
+		}
+	}
+	return items, nil
+}
+
+const indexationRunColumns = `indexation_runs.id AS id, department_id, indexation_runs.basis_points AS basis_points, 
+indexation_runs.date_begin AS date_begin,
+(SELECT COUNT(*) FROM indexations WHERE indexations.run_id = indexation_runs.id) AS employees`
+
+func (s *storage) ListIndexationRuns(ctx context.Context) ([]model.IndexationRun, error) {
+	const op = "postgresql compensation storage: list indexation runs"
+
+	rows, err := s.Query(ctx, `SELECT `+indexationRunColumns+`
+		FROM indexation_runs
+		ORDER BY date_begin DESC, id DESC`)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	rs, err := pgx.CollectRows[indexationRun](rows, pgx.RowToStructByNameLax[indexationRun])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	runs := make([]model.IndexationRun, len(rs))
+	for i, r := range rs {
+		runs[i] = convertIndexationRunToModelIndexationRun(r)
+	}
+	return runs, nil
+}
+
+func (s *storage) GetIndexationRun(ctx context.Context, runID uint64) (*model.IndexationRun, error) {
+	const op = "postgresql compensation storage: get indexation run"
+
+	rows, err := s.Query(ctx, `SELECT `+indexationRunColumns+`
+		FROM indexation_runs
+		WHERE id = @id`,
+		pgx.NamedArgs{"id": runID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	r, err := pgx.CollectExactlyOneRow[indexationRun](rows, pgx.RowToStructByNameLax[indexationRun])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	mr := convertIndexationRunToModelIndexationRun(r)
+	return &mr, nil
+}
+
+// AddIndexationRun writes the run, new compensations and indexations of the employees
+// in one transaction.
+func (s *storage) AddIndexationRun(ctx context.Context, run model.IndexationRun, items []model.IndexationItem) (uint64, error) {
+	const op = "postgresql compensation storage: add indexation run"
+
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	err = tx.QueryRow(ctx, `INSERT INTO indexation_runs (department_id, basis_points, date_begin)
+		VALUES (@department_id, @basis_points, @date_begin)
+		RETURNING id`,
+		pgx.NamedArgs{
+			"department_id": run.DepartmentID,
+			"basis_points":  run.BasisPoints,
+			"date_begin":    run.DateBegin,
+		}).Scan(&run.ID)
+	if err != nil {
+		if strings.Contains(err.Error(), "23") && // Integrity Constraint Violation
+			strings.Contains(err.Error(), "department_id") {
+			return 0, fmt.Errorf("the department does not exist: %w", repoerr.ErrConflict)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	for _, item := range items {
+		var financeID uint64
+		err := tx.QueryRow(ctx, `INSERT INTO finances
+			(user_id, contract_id, salary, salary_rate, social_security_tax, income_tax, currency, date_begin)
+			VALUES (@user_id, @contract_id, @salary, @salary_rate, @social_security_tax, @income_tax, @currency, @date_begin)
+			RETURNING id`,
+			pgx.NamedArgs{
+				"user_id":             item.UserID,
+				"contract_id":         item.Indexed.ContractID,
+				"salary":              item.Indexed.Salary,
+				"salary_rate":         item.Indexed.SalaryRate,
+				"social_security_tax": item.Indexed.SocialSecurityTax,
+				"income_tax":          item.Indexed.IncomeTax,
+				"currency":            item.Indexed.Currency,
+				"date_begin":          item.Indexed.DateBegin,
+			}).Scan(&financeID)
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+
+		_, err = tx.Exec(ctx, `INSERT INTO indexations
+			(user_id, date_begin, basis_points, currency, salary_before, salary_after, run_id, finance_id)
+			VALUES (@user_id, @date_begin, @basis_points, @currency, @salary_before, @salary_after, @run_id, @finance_id)`,
+			pgx.NamedArgs{
+				"user_id":       item.UserID,
+				"date_begin":    run.DateBegin,
+				"basis_points":  run.BasisPoints,
+				"currency":      item.Current.Currency,
+				"salary_before": item.Current.Salary,
+				"salary_after":  item.Indexed.Salary,
+				"run_id":        run.ID,
+				"finance_id":    financeID,
+			})
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return run.ID, nil
+}
+
+// DeleteIndexationRun deletes the run with its indexations and compensations
+// if the run hasn't come into effect yet, otherwise repoerr.ErrRecordNotAffected is returned.
+func (s *storage) DeleteIndexationRun(ctx context.Context, runID uint64) error {
+	const op = "postgresql compensation storage: delete indexation run"
+
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	tag, err := tx.Exec(ctx, `SELECT id FROM indexation_runs 
+		WHERE id = @id AND date_begin > CURRENT_DATE 
+		FOR UPDATE`,
+		pgx.NamedArgs{"id": runID})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repoerr.ErrRecordNotAffected
+	}
+
+	rows, err := tx.Query(ctx, `DELETE FROM indexations WHERE run_id = @id RETURNING finance_id`,
+		pgx.NamedArgs{"id": runID})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	financeIDs, err := pgx.CollectRows(rows, pgx.RowTo[uint64])
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	_, err = tx.Exec(ctx, `DELETE FROM finances WHERE id = ANY(@ids)`,
+		pgx.NamedArgs{"ids": financeIDs})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	_, err = tx.Exec(ctx, `DELETE FROM indexation_runs WHERE id = @id`,
+		pgx.NamedArgs{"id": runID})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
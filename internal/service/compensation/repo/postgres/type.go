@@ -0,0 +1,57 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/compensation/model"
+)
+
+type compensation struct {
+	ID                uint64    `db:"id"`
+	ContractID        uint64    `db:"contract_id"`
+	Salary            int64     `db:"salary"`
+	SalaryRate        *float64  `db:"salary_rate"`
+	SocialSecurityTax int64     `db:"social_security_tax"`
+	IncomeTax         int64     `db:"income_tax"`
+	Currency          string    `db:"currency"`
+	DateBegin         time.Time `db:"date_begin"`
+	Version           int64     `db:"version"`
+}
+
+func convertCompensationToModelCompensation(c compensation) model.Compensation {
+	return model.Compensation(c)
+}
+
+type indexation struct {
+	ID           uint64    `db:"id"`
+	RunID        *uint64   `db:"run_id"`
+	DateBegin    time.Time `db:"date_begin"`
+	BasisPoints  int       `db:"basis_points"`
+	Currency     string    `db:"currency"`
+	SalaryBefore int64     `db:"salary_before"`
+	SalaryAfter  int64     `db:"salary_after"`
+}
+
+func convertIndexationToModelIndexation(i indexation) model.Indexation {
+	return model.Indexation(i)
+}
+
+type indexationRun struct {
+	ID           uint64    `db:"id"`
+	DepartmentID *uint64   `db:"department_id"`
+	BasisPoints  int       `db:"basis_points"`
+	DateBegin    time.Time `db:"date_begin"`
+	Employees    int       `db:"employees"`
+}
+
+func convertIndexationRunToModelIndexationRun(r indexationRun) model.IndexationRun {
+	return model.IndexationRun(r)
+}
+
+type indexationBase struct {
+	compensation
+	UserID     uint64 `db:"user_id"`
+	LastName   string `db:"last_name"`
+	FirstName  string `db:"first_name"`
+	MiddleName string `db:"middle_name"`
+}
@@ -0,0 +1,14 @@
+package compensation
+
+type service struct {
+	compensationRepository compensationRepository
+	indexationRepository   indexationRepository
+}
+
+func NewService(compensationRepository compensationRepository,
+	indexationRepository indexationRepository) *service {
+	return &service{
+		compensationRepository: compensationRepository,
+		indexationRepository:   indexationRepository,
+	}
+}
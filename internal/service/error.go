@@ -11,6 +11,7 @@ const (
 	PermissionDenied
 	Unauthenticated
 	ContentTooLarge
+	PreconditionFailed
 )
 
 type Error struct {
@@ -0,0 +1,122 @@
+package recruiting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/recruiting/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *service) ListCandidates(ctx context.Context, params model.ListCandidatesParams) ([]model.Candidate, error) {
+	const op = "recruiting service: list candidates"
+
+	cs, err := s.recruitingRepository.ListCandidates(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return cs, nil
+}
+
+func (s *service) GetCandidate(ctx context.Context, candidateID uint64) (*model.Candidate, error) {
+	const op = "recruiting service: get candidate"
+
+	c, err := s.recruitingRepository.GetCandidate(ctx, candidateID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "candidate not found")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return c, nil
+}
+
+// AddCandidate adds the candidate to the vacancy at the new stage.
+func (s *service) AddCandidate(ctx context.Context, c model.Candidate) (uint64, error) {
+	const op = "recruiting service: add candidate"
+
+	c.Stage = model.StageNew
+	id, err := s.recruitingRepository.AddCandidate(ctx, c)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrConflict) {
+			return 0, serr.NewError(serr.Conflict, "not added: vacancy problem")
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (s *service) UpdateCandidate(ctx context.Context, c model.Candidate) error {
+	const op = "recruiting service: update candidate"
+
+	err := s.recruitingRepository.UpdateCandidate(ctx, c)
+	if err != nil {
+		switch {
+		case errors.Is(err, repoerr.ErrConflict):
+			return serr.NewError(serr.Conflict, "not updated: vacancy problem")
+		case errors.Is(err, repoerr.ErrRecordNotAffected):
+			return serr.NewError(serr.NotFound, "candidate not found")
+		default:
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return nil
+}
+
+// MoveCandidate moves the candidate to the stage of the pipeline.
+// The hired stage is set only by Hire.
+func (s *service) MoveCandidate(ctx context.Context, candidateID uint64, stage model.Stage) error {
+	const op = "recruiting service: move candidate"
+
+	c, err := s.GetCandidate(ctx, candidateID)
+	if err != nil {
+		return err
+	}
+	if !c.CanMoveTo(stage) {
+		return serr.NewError(serr.Conflict, "not updated: the candidate is hired or the stage is set by the hire action")
+	}
+
+	if err := s.recruitingRepository.SetCandidateStage(ctx, candidateID, stage); err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotAffected) {
+			return serr.NewError(serr.Conflict, "not updated: the candidate is hired")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *service) ListNotes(ctx context.Context, candidateID uint64) ([]model.Note, error) {
+	const op = "recruiting service: list notes"
+
+	if _, err := s.GetCandidate(ctx, candidateID); err != nil {
+		return nil, err
+	}
+
+	ns, err := s.recruitingRepository.ListNotes(ctx, candidateID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return ns, nil
+}
+
+// AddNote adds the note of the author, the note is bound to the current stage of the candidate.
+func (s *service) AddNote(ctx context.Context, candidateID uint64, n model.Note) (uint64, error) {
+	const op = "recruiting service: add note"
+
+	c, err := s.GetCandidate(ctx, candidateID)
+	if err != nil {
+		return 0, err
+	}
+	n.Stage = c.Stage
+
+	id, err := s.recruitingRepository.AddNote(ctx, candidateID, n)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrConflict) {
+			return 0, serr.NewError(serr.NotFound, "candidate not found")
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
@@ -0,0 +1,95 @@
+package recruiting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/repo/s3"
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/recruiting/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+// Prefix=candidate-{candidate_id}; Name=cv-{cv_id}
+// objectName in s3: candidate-{candidate_id}_cv-{cv_id}
+// Ex.: candidate-3_cv-1
+func cvObject(candidateID, cvID uint64) (prefix, name string) {
+	return fmt.Sprintf("candidate-%d", candidateID), fmt.Sprintf("cv-%d", cvID)
+}
+
+func (s *service) ListCVs(ctx context.Context, candidateID uint64) ([]model.CV, error) {
+	const op = "recruiting service: list cvs"
+
+	if _, err := s.GetCandidate(ctx, candidateID); err != nil {
+		return nil, err
+	}
+
+	cvs, err := s.recruitingRepository.ListCVs(ctx, candidateID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return cvs, nil
+}
+
+func (s *service) UploadCV(ctx context.Context, candidateID uint64, cv model.CV, f model.File) (uint64, error) {
+	const op = "recruiting service: upload cv"
+
+	if f.Size > MaxCVSize {
+		return 0, serr.NewError(serr.ContentTooLarge, "cv file size too large")
+	}
+
+	cv.ContentType = f.ContentType
+	cv.Size = f.Size
+	id, err := s.recruitingRepository.AddCV(ctx, candidateID, cv)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrConflict) {
+			return 0, serr.NewError(serr.NotFound, "candidate not found")
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	prefix, name := cvObject(candidateID, id)
+	if err := s.fileRepository.Upload(ctx, s3.File{
+		Prefix:      prefix,
+		Name:        name,
+		Reader:      f.Reader,
+		Size:        f.Size,
+		ContentType: f.ContentType,
+	}); err != nil {
+		// the record without the file is useless
+		if err := s.recruitingRepository.DeleteCV(ctx, candidateID, id); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+// DownloadCV returns the CV record and its content, closeFn must be called after reading.
+func (s *service) DownloadCV(ctx context.Context, candidateID, cvID uint64) (*model.CV, model.File, func() error, error) {
+	const op = "recruiting service: download cv"
+
+	cv, err := s.recruitingRepository.GetCV(ctx, candidateID, cvID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, model.File{}, nil, serr.NewError(serr.NotFound, "candidate or cv file not found")
+		}
+		return nil, model.File{}, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	prefix, name := cvObject(candidateID, cvID)
+	f, closeFn, err := s.fileRepository.Download(ctx, prefix, name, "")
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, model.File{}, nil, serr.NewError(serr.NotFound, "cv file not found")
+		}
+		return nil, model.File{}, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return cv, model.File{
+		Reader:      f.Reader,
+		ContentType: f.ContentType,
+		Size:        f.Size,
+	}, closeFn, nil
+}
@@ -0,0 +1,121 @@
+package recruiting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	umodel "github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+// candidatePhone is the key of the candidate's phone in the phone numbers of the employee.
+const candidatePhone = "mobile"
+
+// Hire creates the employee from the candidate who received the offer.
+// The names and the phone are taken from the candidate, the position and the department
+// from the vacancy, the rest of the user data is u. force and the warnings
+// have the same meaning as in adding the user.
+// The candidate is locked, the employee is added and the candidate is marked as hired
+// in one transaction. Then the CVs are copied to the scans of the employee,
+// a failed copy doesn't cancel the hiring.
+func (s *service) Hire(ctx context.Context, candidateID uint64, u umodel.User, force bool) (uint64, []string, error) {
+	const op = "recruiting service: hire candidate"
+
+	var (
+		userID   uint64
+		warnings []string
+	)
+	err := s.recruitingRepository.WithTx(ctx, func(ctx context.Context) error {
+		c, err := s.recruitingRepository.LockCandidate(ctx, candidateID)
+		if err != nil {
+			if errors.Is(err, repoerr.ErrRecordNotFound) {
+				return serr.NewError(serr.NotFound, "candidate not found")
+			}
+			return err
+		}
+		if !c.CanBeHired() {
+			return serr.NewError(serr.Conflict, "not hired: the candidate has not received the offer")
+		}
+		v, err := s.GetVacancy(ctx, c.VacancyID)
+		if err != nil {
+			return err
+		}
+
+		u.LastName, u.FirstName, u.MiddleName = c.LastName, c.FirstName, c.MiddleName
+		if c.Phone != "" {
+			if u.PhoneNumbers == nil {
+				u.PhoneNumbers = make(map[string]string, 1)
+			}
+			if _, ok := u.PhoneNumbers[candidatePhone]; !ok {
+				u.PhoneNumbers[candidatePhone] = c.Phone
+			}
+		}
+		if c.DateOfBirth != nil && u.DateOfBirth.IsZero() {
+			u.DateOfBirth = *c.DateOfBirth
+		}
+		if u.DateOfBirth.IsZero() {
+			return serr.NewError(serr.InvalidArgument, "the date of birth is required")
+		}
+		u.PositionID, u.DepartmentID = v.PositionID, v.DepartmentID
+
+		userID, warnings, err = s.userService.Add(ctx, u, force)
+		if err != nil {
+			return err
+		}
+
+		if err := s.recruitingRepository.SetCandidateHired(ctx, candidateID, userID); err != nil {
+			if errors.Is(err, repoerr.ErrRecordNotAffected) {
+				return serr.NewError(serr.Conflict, "not hired: the candidate stage has been changed")
+			}
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		var serviceErr *serr.Error
+		if errors.As(err, &serviceErr) {
+			return 0, nil, err
+		}
+		return 0, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.copyCVs(ctx, candidateID, userID); err != nil {
+		s.logger.Warn("recruiting service: copy candidate CVs",
+			slog.Uint64("candidate_id", candidateID),
+			slog.Uint64("user_id", userID),
+			slog.String("error", err.Error()))
+	}
+	return userID, warnings, nil
+}
+
+// copyCVs uploads the CVs of the candidate to the scans of the employee.
+func (s *service) copyCVs(ctx context.Context, candidateID, userID uint64) error {
+	cvs, err := s.recruitingRepository.ListCVs(ctx, candidateID)
+	if err != nil {
+		return err
+	}
+
+	for _, cv := range cvs {
+		prefix, name := cvObject(candidateID, cv.ID)
+		f, closeFn, err := s.fileRepository.Download(ctx, prefix, name, "")
+		if err != nil {
+			return err
+		}
+		_, err = s.userService.UploadScan(ctx, userID, umodel.Scan{
+			Type:        umodel.ScanTypeOther,
+			Description: "Резюме кандидата: " + cv.FileName,
+		}, umodel.File{
+			Reader:      f.Reader,
+			ContentType: f.ContentType,
+			Size:        f.Size,
+		})
+		closeFn() //nolint:errcheck
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
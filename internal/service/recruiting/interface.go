@@ -0,0 +1,46 @@
+package recruiting
+
+import (
+	"context"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/repo/s3"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/recruiting/model"
+	umodel "github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+type recruitingRepository interface {
+	// WithTx runs fn in a transaction, the repositories called with the context of fn join it.
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
+
+	ListVacancies(ctx context.Context, status *model.VacancyStatus) ([]model.Vacancy, error)
+	GetVacancy(ctx context.Context, vacancyID uint64) (*model.Vacancy, error)
+	AddVacancy(ctx context.Context, v model.Vacancy) (uint64, error)
+	UpdateVacancy(ctx context.Context, v model.Vacancy) error
+
+	ListCandidates(ctx context.Context, params model.ListCandidatesParams) ([]model.Candidate, error)
+	GetCandidate(ctx context.Context, candidateID uint64) (*model.Candidate, error)
+	AddCandidate(ctx context.Context, c model.Candidate) (uint64, error)
+	UpdateCandidate(ctx context.Context, c model.Candidate) error
+	SetCandidateStage(ctx context.Context, candidateID uint64, stage model.Stage) error
+	LockCandidate(ctx context.Context, candidateID uint64) (*model.Candidate, error)
+	SetCandidateHired(ctx context.Context, candidateID, userID uint64) error
+
+	ListNotes(ctx context.Context, candidateID uint64) ([]model.Note, error)
+	AddNote(ctx context.Context, candidateID uint64, n model.Note) (uint64, error)
+
+	ListCVs(ctx context.Context, candidateID uint64) ([]model.CV, error)
+	GetCV(ctx context.Context, candidateID, cvID uint64) (*model.CV, error)
+	AddCV(ctx context.Context, candidateID uint64, c model.CV) (uint64, error)
+	DeleteCV(ctx context.Context, candidateID, cvID uint64) error
+}
+
+type s3FileRepository interface {
+	Upload(context.Context, s3.File) error
+	Download(ctx context.Context, prefix, name, etag string) (file s3.File, closeFn func() error, err error)
+}
+
+// userService creates the employee from the hired candidate.
+type userService interface {
+	Add(ctx context.Context, u umodel.User, force bool) (uint64, []string, error)
+	UploadScan(ctx context.Context, userID uint64, ms umodel.Scan, f umodel.File) (uint64, error)
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"io"
+	"time"
+)
+
+// Stage is a stage of the candidate in the recruiting pipeline.
+type Stage string
+
+const (
+	StageNew       Stage = "new"
+	StageScreening Stage = "screening"
+	StageInterview Stage = "interview"
+	StageOffer     Stage = "offer"
+	StageHired     Stage = "hired"
+	StageRejected  Stage = "rejected"
+)
+
+// Candidate represents an applicant for the vacancy. The contacts are the personal ones,
+// UserID is set when the candidate is hired.
+type Candidate struct {
+	ID          uint64
+	VacancyID   uint64
+	LastName    string
+	FirstName   string
+	MiddleName  string
+	Email       string
+	Phone       string
+	DateOfBirth *time.Time
+	Stage       Stage
+	UserID      *uint64
+	CreatedAt   time.Time
+}
+
+// CanMoveTo reports whether the candidate can be moved to the stage.
+// The hired candidate stays hired and the hired stage is set only by the hire action.
+func (c Candidate) CanMoveTo(stage Stage) bool {
+	return c.Stage != StageHired && stage != StageHired
+}
+
+// CanBeHired reports whether the candidate is ready to be hired,
+// only the candidate who received the offer is.
+func (c Candidate) CanBeHired() bool {
+	return c.Stage == StageOffer
+}
+
+// ListCandidatesParams filters the candidates, a nil filter means no restriction.
+type ListCandidatesParams struct {
+	VacancyID *uint64
+	Stage     *Stage
+}
+
+// Note is a note on the candidate, e.g. the interview feedback,
+// Stage is the stage of the candidate when the note was written.
+type Note struct {
+	ID        uint64
+	AuthorID  uint64
+	Stage     Stage
+	Text      string
+	CreatedAt time.Time
+}
+
+// CV is a file of the candidate's resume stored in the file storage.
+type CV struct {
+	ID          uint64
+	FileName    string
+	ContentType string
+	Size        int64
+	UploadedAt  time.Time
+}
+
+// File is the content of the CV.
+type File struct {
+	io.Reader
+	ContentType string
+	Size        int64
+}
@@ -0,0 +1,28 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCandidate_CanMoveTo(t *testing.T) {
+	tests := []struct {
+		name  string
+		from  Stage
+		to    Stage
+		allow bool
+	}{
+		{name: "forward", from: StageNew, to: StageInterview, allow: true},
+		{name: "back", from: StageOffer, to: StageScreening, allow: true},
+		{name: "reject", from: StageInterview, to: StageRejected, allow: true},
+		{name: "reconsider", from: StageRejected, to: StageInterview, allow: true},
+		{name: "hire by stage change", from: StageOffer, to: StageHired, allow: false},
+		{name: "hired is final", from: StageHired, to: StageRejected, allow: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.allow, Candidate{Stage: tt.from}.CanMoveTo(tt.to))
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package model
+
+import "time"
+
+type VacancyStatus string
+
+const (
+	VacancyStatusOpen   VacancyStatus = "open"
+	VacancyStatusClosed VacancyStatus = "closed"
+)
+
+// Vacancy represents an opening for the position of the department.
+// Position and Department are the titles filled on reading.
+type Vacancy struct {
+	ID           uint64
+	Title        string
+	Description  string
+	PositionID   uint64
+	DepartmentID uint64
+	Position     string
+	Department   string
+	Status       VacancyStatus
+	CreatedAt    time.Time
+}
@@ -0,0 +1,187 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/recruiting/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+const candidateColumns = `id, vacancy_id, lastname, firstname, middlename, email, phone,
+date_of_birth, stage, user_id, created_at`
+
+func (s *storage) ListCandidates(ctx context.Context, params model.ListCandidatesParams) ([]model.Candidate, error) {
+	const op = "postgresql recruiting storage: list candidates"
+
+	rows, err := s.Query(ctx, `SELECT `+candidateColumns+` FROM candidates
+		WHERE (@vacancy_id::bigint IS NULL OR vacancy_id = @vacancy_id)
+		AND (@stage::varchar IS NULL OR stage = @stage)
+		ORDER BY created_at DESC, id`,
+		pgx.NamedArgs{
+			"vacancy_id": params.VacancyID,
+			"stage":      params.Stage,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	cs, err := pgx.CollectRows[candidate](rows, pgx.RowToStructByNameLax[candidate])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	candidates := make([]model.Candidate, len(cs))
+	for i, c := range cs {
+		candidates[i] = convertCandidateToModelCandidate(c)
+	}
+	return candidates, nil
+}
+
+func (s *storage) GetCandidate(ctx context.Context, candidateID uint64) (*model.Candidate, error) {
+	const op = "postgresql recruiting storage: get candidate"
+
+	rows, err := s.Query(ctx, `SELECT `+candidateColumns+` FROM candidates WHERE id = @id`,
+		pgx.NamedArgs{"id": candidateID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	c, err := pgx.CollectExactlyOneRow[candidate](rows, pgx.RowToStructByNameLax[candidate])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	mc := convertCandidateToModelCandidate(c)
+	return &mc, nil
+}
+
+func (s *storage) AddCandidate(ctx context.Context, c model.Candidate) (uint64, error) {
+	const op = "postgresql recruiting storage: add candidate"
+
+	row := s.QueryRow(ctx, `INSERT INTO candidates
+		(vacancy_id, lastname, firstname, middlename, email, phone, date_of_birth, stage)
+		VALUES (@vacancy_id, @lastname, @firstname, @middlename, @email, @phone, @date_of_birth, @stage)
+		RETURNING id`,
+		pgx.NamedArgs{
+			"vacancy_id":    c.VacancyID,
+			"lastname":      c.LastName,
+			"firstname":     c.FirstName,
+			"middlename":    c.MiddleName,
+			"email":         c.Email,
+			"phone":         c.Phone,
+			"date_of_birth": c.DateOfBirth,
+			"stage":         c.Stage,
+		})
+
+	if err := row.Scan(&c.ID); err != nil {
+		if strings.Contains(err.Error(), "23") && // Integrity Constraint Violation
+			strings.Contains(err.Error(), "vacancy_id") {
+			return 0, fmt.Errorf("the vacancy does not exist: %w", repoerr.ErrConflict)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return c.ID, nil
+}
+
+// UpdateCandidate updates the personal data and the vacancy of the candidate,
+// the stage is changed by SetCandidateStage.
+func (s *storage) UpdateCandidate(ctx context.Context, c model.Candidate) error {
+	const op = "postgresql recruiting storage: update candidate"
+
+	tag, err := s.Exec(ctx, `UPDATE candidates
+		SET vacancy_id = @vacancy_id, lastname = @lastname, firstname = @firstname,
+		middlename = @middlename, email = @email, phone = @phone, date_of_birth = @date_of_birth
+		WHERE id = @id`,
+		pgx.NamedArgs{
+			"id":            c.ID,
+			"vacancy_id":    c.VacancyID,
+			"lastname":      c.LastName,
+			"firstname":     c.FirstName,
+			"middlename":    c.MiddleName,
+			"email":         c.Email,
+			"phone":         c.Phone,
+			"date_of_birth": c.DateOfBirth,
+		})
+	if err != nil {
+		if strings.Contains(err.Error(), "23") && // Integrity Constraint Violation
+			strings.Contains(err.Error(), "vacancy_id") {
+			return fmt.Errorf("the vacancy does not exist: %w", repoerr.ErrConflict)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 { // it's ok for pgx
+		return repoerr.ErrRecordNotAffected
+	}
+	return nil
+}
+
+// SetCandidateStage moves the candidate to the stage unless the candidate is hired.
+func (s *storage) SetCandidateStage(ctx context.Context, candidateID uint64, stage model.Stage) error {
+	const op = "postgresql recruiting storage: set candidate stage"
+
+	tag, err := s.Exec(ctx, `UPDATE candidates SET stage = @stage
+		WHERE id = @id AND stage <> 'hired'`,
+		pgx.NamedArgs{
+			"id":    candidateID,
+			"stage": stage,
+		})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 { // it's ok for pgx
+		return repoerr.ErrRecordNotAffected
+	}
+	return nil
+}
+
+// LockCandidate gets the candidate and locks it till the end of the transaction of ctx.
+func (s *storage) LockCandidate(ctx context.Context, candidateID uint64) (*model.Candidate, error) {
+	const op = "postgresql recruiting storage: lock candidate"
+
+	rows, err := s.Query(ctx, `SELECT `+candidateColumns+` FROM candidates WHERE id = @id FOR UPDATE`,
+		pgx.NamedArgs{"id": candidateID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	c, err := pgx.CollectExactlyOneRow[candidate](rows, pgx.RowToStructByNameLax[candidate])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	mc := convertCandidateToModelCandidate(c)
+	return &mc, nil
+}
+
+// SetCandidateHired links the candidate who received the offer to the employee.
+func (s *storage) SetCandidateHired(ctx context.Context, candidateID, userID uint64) error {
+	const op = "postgresql recruiting storage: set candidate hired"
+
+	tag, err := s.Exec(ctx, `UPDATE candidates SET stage = 'hired', user_id = @user_id
+		WHERE id = @id AND stage = 'offer'`,
+		pgx.NamedArgs{
+			"id":      candidateID,
+			"user_id": userID,
+		})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 { // it's ok for pgx
+		return repoerr.ErrRecordNotAffected
+	}
+	return nil
+}
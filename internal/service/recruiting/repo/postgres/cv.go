@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/recruiting/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+const cvColumns = `id, file_name, content_type, size, uploaded_at`
+
+func (s *storage) ListCVs(ctx context.Context, candidateID uint64) ([]model.CV, error) {
+	const op = "postgresql recruiting storage: list cvs"
+
+	rows, err := s.Query(ctx, `SELECT `+cvColumns+` FROM candidate_cvs
+		WHERE candidate_id = @candidate_id
+		ORDER BY uploaded_at, id`,
+		pgx.NamedArgs{"candidate_id": candidateID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	cs, err := pgx.CollectRows[cv](rows, pgx.RowToStructByNameLax[cv])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cvs := make([]model.CV, len(cs))
+	for i, c := range cs {
+		cvs[i] = convertCVToModelCV(c)
+	}
+	return cvs, nil
+}
+
+func (s *storage) GetCV(ctx context.Context, candidateID, cvID uint64) (*model.CV, error) {
+	const op = "postgresql recruiting storage: get cv"
+
+	rows, err := s.Query(ctx, `SELECT `+cvColumns+` FROM candidate_cvs
+		WHERE id = @id AND candidate_id = @candidate_id`,
+		pgx.NamedArgs{
+			"id":           cvID,
+			"candidate_id": candidateID,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	c, err := pgx.CollectExactlyOneRow[cv](rows, pgx.RowToStructByNameLax[cv])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	mc := convertCVToModelCV(c)
+	return &mc, nil
+}
+
+func (s *storage) AddCV(ctx context.Context, candidateID uint64, c model.CV) (uint64, error) {
+	const op = "postgresql recruiting storage: add cv"
+
+	row := s.QueryRow(ctx, `INSERT INTO candidate_cvs
+		(candidate_id, file_name, content_type, size)
+		VALUES (@candidate_id, @file_name, @content_type, @size)
+		RETURNING id`,
+		pgx.NamedArgs{
+			"candidate_id": candidateID,
+			"file_name":    c.FileName,
+			"content_type": c.ContentType,
+			"size":         c.Size,
+		})
+
+	if err := row.Scan(&c.ID); err != nil {
+		if strings.Contains(err.Error(), "23") && // Integrity Constraint Violation
+			strings.Contains(err.Error(), "candidate_id") {
+			return 0, fmt.Errorf("the candidate does not exist: %w", repoerr.ErrConflict)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return c.ID, nil
+}
+
+func (s *storage) DeleteCV(ctx context.Context, candidateID, cvID uint64) error {
+	const op = "postgresql recruiting storage: delete cv"
+
+	tag, err := s.Exec(ctx, `DELETE FROM candidate_cvs WHERE id = @id AND candidate_id = @candidate_id`,
+		pgx.NamedArgs{
+			"id":           cvID,
+			"candidate_id": candidateID,
+		})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repoerr.ErrRecordNotFound
+	}
+	return nil
+}
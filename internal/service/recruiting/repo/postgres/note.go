@@ -0,0 +1,61 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/recruiting/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *storage) ListNotes(ctx context.Context, candidateID uint64) ([]model.Note, error) {
+	const op = "postgresql recruiting storage: list notes"
+
+	rows, err := s.Query(ctx, `SELECT id, author_id, stage, text, created_at
+		FROM candidate_notes
+		WHERE candidate_id = @candidate_id
+		ORDER BY created_at, id`,
+		pgx.NamedArgs{"candidate_id": candidateID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	ns, err := pgx.CollectRows[note](rows, pgx.RowToStructByNameLax[note])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	notes := make([]model.Note, len(ns))
+	for i, n := range ns {
+		notes[i] = convertNoteToModelNote(n)
+	}
+	return notes, nil
+}
+
+func (s *storage) AddNote(ctx context.Context, candidateID uint64, n model.Note) (uint64, error) {
+	const op = "postgresql recruiting storage: add note"
+
+	row := s.QueryRow(ctx, `INSERT INTO candidate_notes
+		(candidate_id, author_id, stage, text)
+		VALUES (@candidate_id, @author_id, @stage, @text)
+		RETURNING id`,
+		pgx.NamedArgs{
+			"candidate_id": candidateID,
+			"author_id":    n.AuthorID,
+			"stage":        n.Stage,
+			"text":         n.Text,
+		})
+
+	if err := row.Scan(&n.ID); err != nil {
+		if strings.Contains(err.Error(), "23") && // Integrity Constraint Violation
+			strings.Contains(err.Error(), "candidate_id") {
+			return 0, fmt.Errorf("the candidate does not exist: %w", repoerr.ErrConflict)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return n.ID, nil
+}
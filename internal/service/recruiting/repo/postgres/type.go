@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/recruiting/model"
+)
+
+type vacancy struct {
+	ID           uint64    `db:"id"`
+	Title        string    `db:"title"`
+	Description  string    `db:"description"`
+	PositionID   uint64    `db:"position_id"`
+	DepartmentID uint64    `db:"department_id"`
+	Position     string    `db:"position"`
+	Department   string    `db:"department"`
+	Status       string    `db:"status"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+func convertVacancyToModelVacancy(v vacancy) model.Vacancy {
+	return model.Vacancy{
+		ID:           v.ID,
+		Title:        v.Title,
+		Description:  v.Description,
+		PositionID:   v.PositionID,
+		DepartmentID: v.DepartmentID,
+		Position:     v.Position,
+		Department:   v.Department,
+		Status:       model.VacancyStatus(v.Status),
+		CreatedAt:    v.CreatedAt,
+	}
+}
+
+type candidate struct {
+	ID          uint64     `db:"id"`
+	VacancyID   uint64     `db:"vacancy_id"`
+	LastName    string     `db:"lastname"`
+	FirstName   string     `db:"firstname"`
+	MiddleName  string     `db:"middlename"`
+	Email       string     `db:"email"`
+	Phone       string     `db:"phone"`
+	DateOfBirth *time.Time `db:"date_of_birth"`
+	Stage       string     `db:"stage"`
+	UserID      *uint64    `db:"user_id"`
+	CreatedAt   time.Time  `db:"created_at"`
+}
+
+func convertCandidateToModelCandidate(c candidate) model.Candidate {
+	return model.Candidate{
+		ID:          c.ID,
+		VacancyID:   c.VacancyID,
+		LastName:    c.LastName,
+		FirstName:   c.FirstName,
+		MiddleName:  c.MiddleName,
+		Email:       c.Email,
+		Phone:       c.Phone,
+		DateOfBirth: c.DateOfBirth,
+		Stage:       model.Stage(c.Stage),
+		UserID:      c.UserID,
+		CreatedAt:   c.CreatedAt,
+	}
+}
+
+type note struct {
+	ID        uint64    `db:"id"`
+	AuthorID  uint64    `db:"author_id"`
+	Stage     string    `db:"stage"`
+	Text      string    `db:"text"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func convertNoteToModelNote(n note) model.Note {
+	return model.Note{
+		ID:        n.ID,
+		AuthorID:  n.AuthorID,
+		Stage:     model.Stage(n.Stage),
+		Text:      n.Text,
+		CreatedAt: n.CreatedAt,
+	}
+}
+
+type cv struct {
+	ID          uint64    `db:"id"`
+	FileName    string    `db:"file_name"`
+	ContentType string    `db:"content_type"`
+	Size        int64     `db:"size"`
+	UploadedAt  time.Time `db:"uploaded_at"`
+}
+
+func convertCVToModelCV(c cv) model.CV {
+	return model.CV{
+		ID:          c.ID,
+		FileName:    c.FileName,
+		ContentType: c.ContentType,
+		Size:        c.Size,
+		UploadedAt:  c.UploadedAt,
+	}
+}
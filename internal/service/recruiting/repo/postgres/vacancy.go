@@ -0,0 +1,117 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/recruiting/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+const vacancyQuery = `SELECT vacancies.id AS id, vacancies.title AS title, vacancies.description AS description,
+position_id, department_id, positions.title AS position, departments.title AS department,
+status, vacancies.created_at AS created_at
+FROM vacancies
+JOIN positions ON vacancies.position_id = positions.id
+JOIN departments ON vacancies.department_id = departments.id`
+
+func (s *storage) ListVacancies(ctx context.Context, status *model.VacancyStatus) ([]model.Vacancy, error) {
+	const op = "postgresql recruiting storage: list vacancies"
+
+	rows, err := s.Query(ctx, vacancyQuery+`
+		WHERE @status::varchar IS NULL OR status = @status
+		ORDER BY vacancies.created_at DESC, vacancies.id`,
+		pgx.NamedArgs{"status": status})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	vs, err := pgx.CollectRows[vacancy](rows, pgx.RowToStructByNameLax[vacancy])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	vacancies := make([]model.Vacancy, len(vs))
+	for i, v := range vs {
+		vacancies[i] = convertVacancyToModelVacancy(v)
+	}
+	return vacancies, nil
+}
+
+func (s *storage) GetVacancy(ctx context.Context, vacancyID uint64) (*model.Vacancy, error) {
+	const op = "postgresql recruiting storage: get vacancy"
+
+	rows, err := s.Query(ctx, vacancyQuery+` WHERE vacancies.id = @id`,
+		pgx.NamedArgs{"id": vacancyID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	v, err := pgx.CollectExactlyOneRow[vacancy](rows, pgx.RowToStructByNameLax[vacancy])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	mv := convertVacancyToModelVacancy(v)
+	return &mv, nil
+}
+
+func (s *storage) AddVacancy(ctx context.Context, v model.Vacancy) (uint64, error) {
+	const op = "postgresql recruiting storage: add vacancy"
+
+	row := s.QueryRow(ctx, `INSERT INTO vacancies
+		(title, description, position_id, department_id, status)
+		VALUES (@title, @description, @position_id, @department_id, @status)
+		RETURNING id`,
+		pgx.NamedArgs{
+			"title":         v.Title,
+			"description":   v.Description,
+			"position_id":   v.PositionID,
+			"department_id": v.DepartmentID,
+			"status":        v.Status,
+		})
+
+	if err := row.Scan(&v.ID); err != nil {
+		if strings.Contains(err.Error(), "23") { // Integrity Constraint Violation
+			return 0, fmt.Errorf("the department or the position does not exist: %w", repoerr.ErrConflict)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return v.ID, nil
+}
+
+func (s *storage) UpdateVacancy(ctx context.Context, v model.Vacancy) error {
+	const op = "postgresql recruiting storage: update vacancy"
+
+	tag, err := s.Exec(ctx, `UPDATE vacancies
+		SET title = @title, description = @description, position_id = @position_id,
+		department_id = @department_id, status = @status
+		WHERE id = @id`,
+		pgx.NamedArgs{
+			"id":            v.ID,
+			"title":         v.Title,
+			"description":   v.Description,
+			"position_id":   v.PositionID,
+			"department_id": v.DepartmentID,
+			"status":        v.Status,
+		})
+	if err != nil {
+		if strings.Contains(err.Error(), "23") { // Integrity Constraint Violation
+			return fmt.Errorf("the department or the position does not exist: %w", repoerr.ErrConflict)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 { // it's ok for pgx
+		return repoerr.ErrRecordNotAffected
+	}
+	return nil
+}
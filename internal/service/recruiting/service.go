@@ -0,0 +1,25 @@
+package recruiting
+
+import "log/slog"
+
+// MaxCVSize is the maximum size of the candidate's CV file.
+const MaxCVSize = 20 << 20 // 20 MB
+
+type service struct {
+	recruitingRepository recruitingRepository
+	fileRepository       s3FileRepository
+	userService          userService
+	logger               *slog.Logger
+}
+
+func NewService(recruitingRepository recruitingRepository,
+	fileRepository s3FileRepository,
+	userService userService,
+	logger *slog.Logger) *service {
+	return &service{
+		recruitingRepository: recruitingRepository,
+		fileRepository:       fileRepository,
+		userService:          userService,
+		logger:               logger,
+	}
+}
@@ -0,0 +1,64 @@
+package recruiting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/recruiting/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *service) ListVacancies(ctx context.Context, status *model.VacancyStatus) ([]model.Vacancy, error) {
+	const op = "recruiting service: list vacancies"
+
+	vs, err := s.recruitingRepository.ListVacancies(ctx, status)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return vs, nil
+}
+
+func (s *service) GetVacancy(ctx context.Context, vacancyID uint64) (*model.Vacancy, error) {
+	const op = "recruiting service: get vacancy"
+
+	v, err := s.recruitingRepository.GetVacancy(ctx, vacancyID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "vacancy not found")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return v, nil
+}
+
+func (s *service) AddVacancy(ctx context.Context, v model.Vacancy) (uint64, error) {
+	const op = "recruiting service: add vacancy"
+
+	id, err := s.recruitingRepository.AddVacancy(ctx, v)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrConflict) {
+			return 0, serr.NewError(serr.Conflict, "not added: department or position problem")
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (s *service) UpdateVacancy(ctx context.Context, v model.Vacancy) error {
+	const op = "recruiting service: update vacancy"
+
+	err := s.recruitingRepository.UpdateVacancy(ctx, v)
+	if err != nil {
+		switch {
+		case errors.Is(err, repoerr.ErrConflict):
+			return serr.NewError(serr.Conflict, "not updated: department or position problem")
+		case errors.Is(err, repoerr.ErrRecordNotAffected):
+			return serr.NewError(serr.NotFound, "vacancy not found")
+		default:
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return nil
+}
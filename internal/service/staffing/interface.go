@@ -0,0 +1,17 @@
+package staffing
+
+import (
+	"context"
+	"time"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/staffing/model"
+)
+
+type staffingRepository interface {
+	ListStaffUnits(ctx context.Context, date time.Time, departmentID *uint64) ([]model.StaffUnit, error)
+	GetStaffUnit(ctx context.Context, unitID uint64) (*model.StaffUnit, error)
+	AddStaffUnit(ctx context.Context, su model.StaffUnit) (uint64, error)
+	ChangeStaffUnit(ctx context.Context, unitID uint64, su model.StaffUnit) (uint64, error)
+	ListVacancies(ctx context.Context, date time.Time, departmentID *uint64) ([]model.Vacancy, error)
+	CountFreeSlots(ctx context.Context, departmentID, positionID, exceptUserID uint64) (float64, error)
+}
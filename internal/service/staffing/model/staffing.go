@@ -0,0 +1,42 @@
+package model
+
+import "time"
+
+// StaffUnit represents a line of the staffing table:
+// planned slots for the position in the department.
+// Changing of the staff unit creates a new version of it,
+// so every version is valid only within its dates.
+type StaffUnit struct {
+	ID           uint64
+	DepartmentID uint64
+	Department   string
+	PositionID   uint64
+	Position     string
+	Quantity     uint
+	Rate         float64
+	Grade        string
+	SalaryMin    uint64
+	SalaryMax    uint64
+	DateBegin    time.Time
+	DateEnd      *time.Time
+}
+
+// Vacancy represents planned and filled slots for the position in the department.
+// The slots are counted by rates: a slot of 0.5 rate is a half of the slot,
+// an employee holds the slot by the rate of the compensation.
+type Vacancy struct {
+	DepartmentID uint64
+	Department   string
+	PositionID   uint64
+	Position     string
+	Slots        float64
+	Filled       float64
+}
+
+// Vacant returns the free slots.
+func (v Vacancy) Vacant() float64 {
+	if v.Filled >= v.Slots {
+		return 0
+	}
+	return v.Slots - v.Filled
+}
@@ -0,0 +1,26 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVacancy_Vacant(t *testing.T) {
+	tests := []struct {
+		name   string
+		slots  float64
+		filled float64
+		want   float64
+	}{
+		{name: "free", slots: 2, filled: 1, want: 1},
+		{name: "half a slot is free", slots: 1.5, filled: 1, want: 0.5},
+		{name: "two half rates hold a slot", slots: 1, filled: 0.5 + 0.5, want: 0},
+		{name: "overfilled", slots: 1, filled: 1.5, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Vacancy{Slots: tt.slots, Filled: tt.filled}.Vacant())
+		})
+	}
+}
@@ -0,0 +1,238 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/staffing/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+const staffUnitColumns = `staff_units.id AS id, 
+staff_units.department_id AS department_id, departments.title AS department,
+staff_units.position_id AS position_id, positions.title AS position,
+quantity, rate, grade, salary_min, salary_max, date_begin, date_end`
+
+// holders are the position_history rows of the employees holding the position
+// in the department on @date, joined with their users rows.
+const holders = `position_history
+	JOIN users ON position_history.user_id = users.id
+	WHERE position_history.date_begin <= @date AND
+	(position_history.date_end IS NULL OR position_history.date_end >= @date) AND
+	(users.terminated_at IS NULL OR users.terminated_at >= @date)`
+
+// holderRate is the rate held by the employee of the users row on @date:
+// the salary rate of the compensation effective on the date under the contract
+// effective on the date, a full rate if it isn't set.
+const holderRate = `COALESCE((SELECT finances.salary_rate::numeric FROM finances
+	JOIN contracts ON finances.contract_id = contracts.id
+	WHERE finances.user_id = users.id AND finances.date_begin <= @date AND
+	contracts.date_begin <= @date AND (contracts.date_end IS NULL OR contracts.date_end >= @date)
+	ORDER BY finances.date_begin DESC, finances.id DESC LIMIT 1), 1)`
+
+func (s *storage) ListStaffUnits(ctx context.Context, date time.Time, departmentID *uint64) ([]model.StaffUnit, error) {
+	const op = "postgresql staffing storage: list staff units"
+
+	rows, err := s.Query(ctx, `SELECT `+staffUnitColumns+`
+		FROM staff_units
+		JOIN departments ON staff_units.department_id = departments.id
+		JOIN positions ON staff_units.position_id = positions.id
+		WHERE date_begin <= @date AND (date_end IS NULL OR date_end >= @date) AND
+		(@department_id::bigint IS NULL OR staff_units.department_id = @department_id)
+		ORDER BY department, position`,
+		pgx.NamedArgs{
+			"date":          date,
+			"department_id": departmentID,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	sus, err := pgx.CollectRows[staffUnit](rows, pgx.RowToStructByNameLax[staffUnit])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	units := make([]model.StaffUnit, len(sus))
+	for i, su := range sus {
+		units[i] = convertStaffUnitToModelStaffUnit(su)
+	}
+	return units, nil
+}
+
+func (s *storage) GetStaffUnit(ctx context.Context, unitID uint64) (*model.StaffUnit, error) {
+	const op = "postgresql staffing storage: get staff unit"
+
+	rows, err := s.Query(ctx, `SELECT `+staffUnitColumns+`
+		FROM staff_units
+		JOIN departments ON staff_units.department_id = departments.id
+		JOIN positions ON staff_units.position_id = positions.id
+		WHERE staff_units.id = @id`,
+		pgx.NamedArgs{"id": unitID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	su, err := pgx.CollectExactlyOneRow[staffUnit](rows, pgx.RowToStructByNameLax[staffUnit])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	msu := convertStaffUnitToModelStaffUnit(su)
+	return &msu, nil
+}
+
+const insertStaffUnitQuery = `INSERT INTO staff_units
+	(department_id, position_id, quantity, rate, grade, salary_min, salary_max, date_begin, date_end)
+	VALUES 
+	(@department_id, @position_id, @quantity, @rate, @grade, @salary_min, @salary_max, @date_begin, @date_end)
+	RETURNING id`
+
+func staffUnitArgs(su model.StaffUnit) pgx.NamedArgs {
+	return pgx.NamedArgs{
+		"department_id": su.DepartmentID,
+		"position_id":   su.PositionID,
+		"quantity":      su.Quantity,
+		"rate":          su.Rate,
+		"grade":         su.Grade,
+		"salary_min":    su.SalaryMin,
+		"salary_max":    su.SalaryMax,
+		"date_begin":    su.DateBegin,
+		"date_end":      su.DateEnd,
+	}
+}
+
+func (s *storage) AddStaffUnit(ctx context.Context, su model.StaffUnit) (uint64, error) {
+	const op = "postgresql staffing storage: add staff unit"
+
+	row := s.QueryRow(ctx, insertStaffUnitQuery, staffUnitArgs(su))
+	if err := row.Scan(&su.ID); err != nil {
+		if strings.Contains(err.Error(), "23") && // Integrity Constraint Violation
+			(strings.Contains(err.Error(), "department_id") ||
+				strings.Contains(err.Error(), "position_id")) {
+			return 0, fmt.Errorf("the department or position does not exist: %w", repoerr.ErrConflict)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return su.ID, nil
+}
+
+// ChangeStaffUnit closes the staff unit on the day before su.DateBegin
+// and adds the new version of it in the same transaction.
+func (s *storage) ChangeStaffUnit(ctx context.Context, unitID uint64, su model.StaffUnit) (uint64, error) {
+	const op = "postgresql staffing storage: change staff unit"
+
+	tx, err := s.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	var dateEnd *time.Time
+	err = tx.QueryRow(ctx, `UPDATE staff_units AS su
+		SET date_end = @date_begin::date - 1
+		FROM (SELECT id, date_end FROM staff_units WHERE id = @id FOR UPDATE) AS old
+		WHERE su.id = old.id AND su.date_begin < @date_begin
+		RETURNING old.date_end`,
+		pgx.NamedArgs{
+			"id":         unitID,
+			"date_begin": su.DateBegin,
+		}).Scan(&dateEnd)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repoerr.ErrRecordNotAffected
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// the new version is valid as long as the old one
+	su.DateEnd = dateEnd
+	if err := tx.QueryRow(ctx, insertStaffUnitQuery, staffUnitArgs(su)).Scan(&su.ID); err != nil {
+		if strings.Contains(err.Error(), "23") && // Integrity Constraint Violation
+			(strings.Contains(err.Error(), "department_id") ||
+				strings.Contains(err.Error(), "position_id")) {
+			return 0, fmt.Errorf("the department or position does not exist: %w", repoerr.ErrConflict)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return su.ID, nil
+}
+
+func (s *storage) ListVacancies(ctx context.Context, date time.Time, departmentID *uint64) ([]model.Vacancy, error) {
+	const op = "postgresql staffing storage: list vacancies"
+
+	rows, err := s.Query(ctx, `SELECT 
+		planned.department_id AS department_id, departments.title AS department,
+		planned.position_id AS position_id, positions.title AS position,
+		planned.slots::float8 AS slots,
+		(SELECT COALESCE(SUM(`+holderRate+`), 0) FROM `+holders+` AND
+			position_history.department_id = planned.department_id AND
+			position_history.position_id = planned.position_id)::float8 AS filled
+		FROM (SELECT department_id, position_id, SUM(quantity * rate) AS slots
+			FROM staff_units
+			WHERE date_begin <= @date AND (date_end IS NULL OR date_end >= @date) AND
+			(@department_id::bigint IS NULL OR department_id = @department_id)
+			GROUP BY department_id, position_id) AS planned
+		JOIN departments ON planned.department_id = departments.id
+		JOIN positions ON planned.position_id = positions.id
+		ORDER BY department, position`,
+		pgx.NamedArgs{
+			"date":          date,
+			"department_id": departmentID,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	vs, err := pgx.CollectRows[vacancy](rows, pgx.RowToStructByNameLax[vacancy])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	vacancies := make([]model.Vacancy, len(vs))
+	for i, v := range vs {
+		vacancies[i] = convertVacancyToModelVacancy(v)
+	}
+	return vacancies, nil
+}
+
+// CountFreeSlots returns the free rates of the position in the department today:
+// the planned slots multiplied by their rates minus the rates held by the employees.
+func (s *storage) CountFreeSlots(ctx context.Context, departmentID, positionID, exceptUserID uint64) (float64, error) {
+	const op = "postgresql staffing storage: count free slots"
+
+	var free float64
+	err := s.QueryRow(ctx, `SELECT (
+		(SELECT COALESCE(SUM(quantity * rate), 0) FROM staff_units
+			WHERE department_id = @department_id AND position_id = @position_id AND
+			date_begin <= @date AND (date_end IS NULL OR date_end >= @date)) -
+		(SELECT COALESCE(SUM(`+holderRate+`), 0) FROM `+holders+` AND
+			position_history.department_id = @department_id AND position_history.position_id = @position_id AND
+			users.id <> @user_id))::float8`,
+		pgx.NamedArgs{
+			"department_id": departmentID,
+			"position_id":   positionID,
+			"user_id":       exceptUserID,
+			"date":          time.Now(),
+		}).Scan(&free)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return free, nil
+}
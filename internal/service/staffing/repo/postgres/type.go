@@ -0,0 +1,39 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/staffing/model"
+)
+
+type staffUnit struct {
+	ID           uint64     `db:"id"`
+	DepartmentID uint64     `db:"department_id"`
+	Department   string     `db:"department"`
+	PositionID   uint64     `db:"position_id"`
+	Position     string     `db:"position"`
+	Quantity     uint       `db:"quantity"`
+	Rate         float64    `db:"rate"`
+	Grade        string     `db:"grade"`
+	SalaryMin    uint64     `db:"salary_min"`
+	SalaryMax    uint64     `db:"salary_max"`
+	DateBegin    time.Time  `db:"date_begin"`
+	DateEnd      *time.Time `db:"date_end"`
+}
+
+func convertStaffUnitToModelStaffUnit(su staffUnit) model.StaffUnit {
+	return model.StaffUnit(su)
+}
+
+type vacancy struct {
+	DepartmentID uint64  `db:"department_id"`
+	Department   string  `db:"department"`
+	PositionID   uint64  `db:"position_id"`
+	Position     string  `db:"position"`
+	Slots        float64 `db:"slots"`
+	Filled       float64 `db:"filled"`
+}
+
+func convertVacancyToModelVacancy(v vacancy) model.Vacancy {
+	return model.Vacancy(v)
+}
@@ -0,0 +1,11 @@
+package staffing
+
+type service struct {
+	staffingRepository staffingRepository
+}
+
+func NewService(staffingRepository staffingRepository) *service {
+	return &service{
+		staffingRepository: staffingRepository,
+	}
+}
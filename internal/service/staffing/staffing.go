@@ -0,0 +1,106 @@
+package staffing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/staffing/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *service) ListStaffUnits(ctx context.Context, date time.Time, departmentID *uint64) ([]model.StaffUnit, error) {
+	const op = "staffing service: list staff units"
+
+	sus, err := s.staffingRepository.ListStaffUnits(ctx, date, departmentID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return sus, nil
+}
+
+func (s *service) GetStaffUnit(ctx context.Context, unitID uint64) (*model.StaffUnit, error) {
+	const op = "staffing service: get staff unit"
+
+	su, err := s.staffingRepository.GetStaffUnit(ctx, unitID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "staff unit not found")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return su, nil
+}
+
+func (s *service) AddStaffUnit(ctx context.Context, su model.StaffUnit) (uint64, error) {
+	const op = "staffing service: add staff unit"
+
+	id, err := s.staffingRepository.AddStaffUnit(ctx, su)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrConflict) {
+			return 0, serr.NewError(serr.Conflict, "not added: department or position not found")
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+// ChangeStaffUnit closes the staff unit version on the day before su.DateBegin
+// and creates a new version of the staff unit effective from su.DateBegin.
+func (s *service) ChangeStaffUnit(ctx context.Context, unitID uint64, su model.StaffUnit) (uint64, error) {
+	const op = "staffing service: change staff unit"
+
+	cur, err := s.staffingRepository.GetStaffUnit(ctx, unitID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return 0, serr.NewError(serr.NotFound, "staff unit not found")
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if !su.DateBegin.After(cur.DateBegin) {
+		return 0, serr.NewError(serr.InvalidArgument,
+			"the new version must be effective after the current version begins")
+	}
+	if cur.DateEnd != nil && su.DateBegin.After(*cur.DateEnd) {
+		return 0, serr.NewError(serr.InvalidArgument,
+			"the staff unit version is already closed at this date")
+	}
+
+	// the staff unit stays in the same department and position
+	su.DepartmentID, su.PositionID = cur.DepartmentID, cur.PositionID
+
+	id, err := s.staffingRepository.ChangeStaffUnit(ctx, unitID, su)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotAffected) {
+			return 0, serr.NewError(serr.Conflict, "not changed: staff unit problem")
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+// ListVacancies returns planned and filled slots on the date.
+func (s *service) ListVacancies(ctx context.Context, date time.Time, departmentID *uint64) ([]model.Vacancy, error) {
+	const op = "staffing service: list vacancies"
+
+	vs, err := s.staffingRepository.ListVacancies(ctx, date, departmentID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return vs, nil
+}
+
+// HasVacancy reports whether the staffing table has a free slot for the position
+// in the department today. The user with exceptUserID isn't counted as holding the slot,
+// so the user's current position can be checked as well.
+func (s *service) HasVacancy(ctx context.Context, departmentID, positionID, exceptUserID uint64) (bool, error) {
+	const op = "staffing service: has vacancy"
+
+	free, err := s.staffingRepository.CountFreeSlots(ctx, departmentID, positionID, exceptUserID)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return free > 0, nil
+}
@@ -0,0 +1,151 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *service) ListAbsences(ctx context.Context, userID uint64) ([]model.Absence, error) {
+	const op = "user service: list absences"
+
+	as, err := s.userRepository.ListAbsences(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return as, nil
+}
+
+func (s *service) GetAbsence(ctx context.Context, userID, absenceID uint64) (*model.Absence, error) {
+	const op = "user service: get absence"
+
+	a, err := s.userRepository.GetAbsence(ctx, userID, absenceID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "absence not found")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return a, nil
+}
+
+// AddAbsence adds the absence to the employee. The absence overlapping
+// a vacation or another absence of the employee is rejected.
+func (s *service) AddAbsence(ctx context.Context, userID uint64, a model.Absence) (uint64, error) {
+	const op = "user service: add absence"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.checkAbsenceOverlap(ctx, "not added", userID, a); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := s.userRepository.AddAbsence(ctx, userID, a)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrConflict) {
+			return 0, serr.NewError(serr.Conflict, "not added: user problem")
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (s *service) UpdateAbsence(ctx context.Context, userID uint64, a model.Absence) error {
+	const op = "user service: update absence"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.checkAbsenceOverlap(ctx, "not updated", userID, a); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := s.userRepository.UpdateAbsence(ctx, userID, a)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrVersionMismatch) {
+			return serr.NewError(serr.PreconditionFailed, "not updated: the absence is changed by another user")
+		}
+		if errors.Is(err, repoerr.ErrRecordNotAffected) {
+			return serr.NewError(serr.Conflict, "not updated: user/absence problem")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *service) DeleteAbsence(ctx context.Context, userID, absenceID uint64, version int64) error {
+	const op = "user service: delete absence"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := s.userRepository.DeleteAbsence(ctx, userID, absenceID, version)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrVersionMismatch) {
+			return serr.NewError(serr.PreconditionFailed, "not deleted: the absence is changed by another user")
+		}
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return serr.NewError(serr.NotFound, "absence not found")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// ListAllAbsences returns the absences of all employees having common days with the period.
+func (s *service) ListAllAbsences(ctx context.Context,
+	params model.ListAbsencesParams) ([]model.EmployeeAbsence, error) {
+	const op = "user service: list all absences"
+
+	as, err := s.userRepository.ListAllAbsences(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return as, nil
+}
+
+// AbsenceReport returns the number of days of the employee absences by type within the period.
+func (s *service) AbsenceReport(ctx context.Context,
+	params model.ListAbsencesParams) ([]model.AbsenceSummary, error) {
+	const op = "user service: absence report"
+
+	as, err := s.userRepository.ListAllAbsences(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return model.SummarizeAbsences(as, params.DateFrom, params.DateTo), nil
+}
+
+// checkAbsenceOverlap returns an error if the absence overlaps
+// a vacation or another absence of the employee.
+func (s *service) checkAbsenceOverlap(ctx context.Context, action string, userID uint64, a model.Absence) error {
+	vcs, err := s.userRepository.ListVacations(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if v, ok := model.FindOverlap(vcs, model.Vacation{DateBegin: a.DateBegin, DateEnd: a.DateEnd}); ok {
+		return serr.NewError(serr.Conflict,
+			fmt.Sprintf("%s: the absence overlaps the vacation from %s to %s", action,
+				v.DateBegin.Format(time.DateOnly), v.DateEnd.Format(time.DateOnly)))
+	}
+
+	as, err := s.userRepository.ListAbsences(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if o, ok := model.FindAbsenceOverlap(as, a); ok {
+		return serr.NewError(serr.Conflict,
+			fmt.Sprintf("%s: the absence overlaps the %s absence from %s to %s", action, o.Type,
+				o.DateBegin.Format(time.DateOnly), o.DateEnd.Format(time.DateOnly)))
+	}
+	return nil
+}
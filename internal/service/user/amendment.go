@@ -0,0 +1,150 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *service) ListAmendments(ctx context.Context, userID, contractID uint64) ([]model.Amendment, error) {
+	const op = "user service: list amendments"
+
+	if _, err := s.GetContract(ctx, userID, contractID); err != nil {
+		return nil, err
+	}
+
+	as, err := s.userRepository.ListAmendments(ctx, userID, contractID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return as, nil
+}
+
+func (s *service) GetAmendment(ctx context.Context, userID, contractID, amendmentID uint64) (*model.Amendment, error) {
+	const op = "user service: get amendment"
+
+	a, err := s.userRepository.GetAmendment(ctx, userID, contractID, amendmentID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "amendment not found")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return a, nil
+}
+
+// AddAmendment adds the amendment to the contract. After that the contract
+// can be changed only by amendments.
+func (s *service) AddAmendment(ctx context.Context, userID uint64, a model.Amendment) (uint64, error) {
+	const op = "user service: add amendment"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.checkAmendment(ctx, userID, a); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := s.userRepository.AddAmendment(ctx, userID, a)
+	if err != nil {
+		switch {
+		case errors.Is(err, repoerr.ErrRecordNotFound):
+			return 0, serr.NewError(serr.NotFound, "contract not found")
+		case errors.Is(err, repoerr.ErrConflict):
+			return 0, serr.NewError(serr.Conflict, fmt.Sprintf("not added: %s", err))
+		default:
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return id, nil
+}
+
+func (s *service) UpdateAmendment(ctx context.Context, userID uint64, a model.Amendment) error {
+	const op = "user service: update amendment"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.checkAmendment(ctx, userID, a); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := s.userRepository.UpdateAmendment(ctx, userID, a)
+	if err != nil {
+		switch {
+		case errors.Is(err, repoerr.ErrVersionMismatch):
+			return serr.NewError(serr.PreconditionFailed, "not updated: the amendment is changed by another user")
+		case errors.Is(err, repoerr.ErrRecordNotAffected):
+			return serr.NewError(serr.Conflict, "not updated: user/contract/amendment problem")
+		case errors.Is(err, repoerr.ErrConflict):
+			return serr.NewError(serr.Conflict, fmt.Sprintf("not updated: %s", err))
+		default:
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return nil
+}
+
+// GetContractTerms returns the terms of the contract in effect on the date.
+func (s *service) GetContractTerms(ctx context.Context, userID, contractID uint64,
+	date time.Time) (*model.ContractTerms, error) {
+	const op = "user service: get contract terms"
+
+	c, err := s.GetContract(ctx, userID, contractID)
+	if err != nil {
+		return nil, err
+	}
+	if date.Before(c.DateBegin) {
+		return nil, serr.NewError(serr.InvalidArgument, "the contract is not concluded by the date")
+	}
+
+	as, err := s.userRepository.ListAmendments(ctx, userID, contractID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	terms := c.EffectiveTerms(as, date)
+	return &terms, nil
+}
+
+// checkAmendment checks the amendment changes the terms of the contract
+// while it is in effect.
+func (s *service) checkAmendment(ctx context.Context, userID uint64, a model.Amendment) error {
+	if !a.ChangesTerms() && a.Description == "" {
+		return serr.NewError(serr.InvalidArgument, "the amendment changes no terms of the contract")
+	}
+
+	c, err := s.GetContract(ctx, userID, a.ContractID)
+	if err != nil {
+		return err
+	}
+	if a.EffectiveFrom.Before(c.DateBegin) {
+		return serr.NewError(serr.InvalidArgument, "the amendment is effective before the contract")
+	}
+	if a.DateEnd != nil && c.Type == model.ContractTypePermanent {
+		return serr.NewError(serr.InvalidArgument, "the permanent contract has no end date")
+	}
+
+	as, err := s.userRepository.ListAmendments(ctx, userID, a.ContractID)
+	if err != nil {
+		return err
+	}
+	others := make([]model.Amendment, 0, len(as))
+	for _, o := range as {
+		if o.ID != a.ID {
+			others = append(others, o)
+		}
+	}
+	if terms := c.EffectiveTerms(others, a.EffectiveFrom); terms.DateEnd != nil &&
+		a.EffectiveFrom.After(*terms.DateEnd) {
+		return serr.NewError(serr.InvalidArgument, "the amendment is effective after the contract ends")
+	}
+	return nil
+}
@@ -0,0 +1,19 @@
+package user
+
+type Config struct {
+	// StrictStaffing forbids to add users to positions
+	// that have no free slots in the staffing table, otherwise it's a warning.
+	StrictStaffing bool `env:"STRICT_STAFFING" env-default:"false"`
+	// ForeignCitizens enables work permits of foreign employees.
+	ForeignCitizens bool `env:"FOREIGN_CITIZENS" env-default:"false"`
+	// VacationDays is the base annual paid leave days,
+	// additional days are set for positions and contracts.
+	VacationDays uint `env:"VACATION_DAYS" env-default:"28"`
+	// MaxDepartmentAbsent is the number of employees of a department
+	// allowed to be on vacation at once, 0 means no limit.
+	// Exceeding it is a warning.
+	MaxDepartmentAbsent uint `env:"MAX_DEPARTMENT_ABSENT" env-default:"0"`
+	// ProbationNoticeDays is the number of days before the probation end
+	// to remind HR and the head of the department of it.
+	ProbationNoticeDays uint `env:"PROBATION_NOTICE_DAYS" env-default:"14"`
+}
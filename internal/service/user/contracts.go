@@ -20,7 +20,12 @@ func (s *service) GetContract(ctx context.Context, userID, contractID uint64) (*
 		}
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-	return tr, nil
+
+	ctrs := []model.Contract{*tr}
+	if err := s.setProbationEnds(ctx, ctrs); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return &ctrs[0], nil
 }
 
 func (s *service) ListContracts(ctx context.Context, userID uint64) ([]model.Contract, error) {
@@ -32,17 +37,45 @@ func (s *service) ListContracts(ctx context.Context, userID uint64) ([]model.Con
 			return nil, fmt.Errorf("%s: %w", op, err)
 		}
 	}
+
+	if err := s.setProbationEnds(ctx, ctrs); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 	return ctrs, nil
 }
 
+// AddContract adds the contract of the user. The contract gets the next number
+// of the contract sequence if the number is empty.
 func (s *service) AddContract(ctx context.Context, userID uint64, c model.Contract) (uint64, error) {
 	const op = "user service: add contract"
 
-	id, err := s.userRepository.AddContract(ctx, userID, c)
-	if err != nil {
-		if errors.Is(err, repoerr.ErrRecordNotFound) {
-			return 0, serr.NewError(serr.Conflict, "not added: user problem")
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var id uint64
+	err := s.userRepository.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		id, err = s.userRepository.AddContract(ctx, userID, c)
+		if err != nil {
+			switch {
+			case errors.Is(err, repoerr.ErrRecordNotFound):
+				return serr.NewError(serr.Conflict, "not added: user problem")
+			case errors.Is(err, repoerr.ErrRecordAlreadyExist):
+				return serr.NewError(serr.AlreadyExists, "not added: the contract number is already used")
+			default:
+				return err
+			}
 		}
+
+		return s.addDraftOrder(ctx, model.Order{
+			Type:          model.OrderTypeHiring,
+			UserID:        userID,
+			EffectiveDate: c.DateBegin,
+			ContractID:    &id,
+		})
+	})
+	if err != nil {
 		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 	return id, nil
@@ -51,14 +84,61 @@ func (s *service) AddContract(ctx context.Context, userID uint64, c model.Contra
 func (s *service) UpdateContract(ctx context.Context, userID uint64, c model.Contract) error {
 	const op = "user service: update contract"
 
-	err := s.userRepository.UpdateContract(ctx, userID, c)
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	// the original terms of the amended contract are kept for the history
+	cur, err := s.userRepository.GetContract(ctx, userID, c.ID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return serr.NewError(serr.NotFound, "contract not found")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if cur.HasAmendments {
+		return serr.NewError(serr.Conflict, "not updated: the contract is amended, add an amendment instead")
+	}
+
+	err = s.userRepository.UpdateContract(ctx, userID, c)
 	if err != nil {
 		switch {
+		case errors.Is(err, repoerr.ErrVersionMismatch):
+			return serr.NewError(serr.PreconditionFailed, "not updated: the contract is changed by another user")
 		case errors.Is(err, repoerr.ErrRecordNotAffected):
 			return serr.NewError(serr.Conflict, "not updated: user/contract problem")
+		case errors.Is(err, repoerr.ErrRecordAlreadyExist):
+			return serr.NewError(serr.AlreadyExists, "not updated: the contract number is already used")
 		default:
 			return fmt.Errorf("%s: %w", op, err)
 		}
 	}
 	return nil
 }
+
+// setProbationEnds sets the last days of the probation periods of the contracts.
+func (s *service) setProbationEnds(ctx context.Context, contracts []model.Contract) error {
+	if len(contracts) == 0 {
+		return nil
+	}
+
+	from, to := contracts[0].DateBegin, contracts[0].DateBegin
+	for _, c := range contracts {
+		if c.DateBegin.Before(from) {
+			from = c.DateBegin
+		}
+		if c.DateBegin.After(to) {
+			to = c.DateBegin
+		}
+	}
+	// the probation period is not longer than 6 months, the next year is enough
+	cal, err := s.calendar.Calendar(ctx, from, to.AddDate(1, 0, 0))
+	if err != nil {
+		return err
+	}
+
+	for i := range contracts {
+		contracts[i].SetProbationEnd(cal)
+	}
+	return nil
+}
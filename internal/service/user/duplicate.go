@@ -0,0 +1,110 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+// ListDuplicates returns the groups of the users which are probably the same person:
+// with the same identifier, or with similar names and the same date of birth.
+func (s *service) ListDuplicates(ctx context.Context) ([]model.Duplicate, error) {
+	const op = "user service: list duplicates"
+
+	ds, err := s.userRepository.ListDuplicates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	persons, err := s.userRepository.ListPersonsSharingBirthDate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	// the persons are ordered by the date of birth
+	for start := 0; start < len(persons); {
+		end := start + 1
+		for end < len(persons) && persons[end].DateOfBirth.Equal(persons[start].DateOfBirth) {
+			end++
+		}
+		for _, ids := range model.GroupSimilar(persons[start:end]) {
+			ds = append(ds, model.Duplicate{Reason: model.DuplicateReasonName, UserIDs: ids})
+		}
+		start = end
+	}
+	return ds, nil
+}
+
+// checkDuplicates returns an error if the user has the same insurance or taxpayer number
+// as a current employee. The same numbers of a former employee (it may be rehiring)
+// and the similar names with the same date of birth are the warnings ignored by force.
+func (s *service) checkDuplicates(ctx context.Context, u model.User, force bool) error {
+	matches, err := s.userRepository.FindDuplicates(ctx, u)
+	if err != nil {
+		return err
+	}
+
+	var warnings []uint64
+	for _, m := range matches {
+		if !m.Terminated {
+			return serr.NewError(serr.AlreadyExists,
+				fmt.Sprintf("%s.number: the number is already used by the user %d", m.Reason, m.UserID))
+		}
+		warnings = append(warnings, m.UserID)
+	}
+	if force {
+		return nil
+	}
+
+	persons, err := s.userRepository.ListPersonsBornOn(ctx, u.DateOfBirth, u.ID)
+	if err != nil {
+		return err
+	}
+	checked := model.Person{
+		LastName:    u.LastName,
+		FirstName:   u.FirstName,
+		DateOfBirth: u.DateOfBirth,
+	}
+	for _, p := range persons {
+		if checked.SimilarTo(p) {
+			warnings = append(warnings, p.ID)
+		}
+	}
+	return duplicatesWarning(warnings)
+}
+
+// checkPassportDuplicates returns an error if a current employee has the passport
+// of the same type and number. The passports of the former employees are skipped,
+// the rehired employee has the same passport.
+func (s *service) checkPassportDuplicates(ctx context.Context, userID uint64, p model.Passport) error {
+	matches, err := s.userRepository.FindPassportDuplicates(ctx, userID, p)
+	if err != nil {
+		return err
+	}
+	for _, m := range matches {
+		if !m.Terminated {
+			return serr.NewError(serr.AlreadyExists,
+				fmt.Sprintf("number: the passport is already added to the user %d", m.UserID))
+		}
+	}
+	return nil
+}
+
+func duplicatesWarning(userIDs []uint64) error {
+	if len(userIDs) == 0 {
+		return nil
+	}
+	slices.Sort(userIDs)
+	userIDs = slices.Compact(userIDs)
+
+	ids := make([]string, len(userIDs))
+	for i, id := range userIDs {
+		ids[i] = strconv.FormatUint(id, 10)
+	}
+	return serr.NewError(serr.Conflict,
+		"probable duplicates of the users "+strings.Join(ids, ", ")+" (use force to ignore)")
+}
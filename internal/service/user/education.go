@@ -36,6 +36,10 @@ func (s *service) ListEducations(ctx context.Context, userID uint64) ([]model.Ed
 func (s *service) AddEducation(ctx context.Context, userID uint64, ed model.Education) (uint64, error) {
 	const op = "user service: add education"
 
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
 	id, err := s.userRepository.AddEducation(ctx, userID, ed)
 	if err != nil {
 		if errors.Is(err, repoerr.ErrConflict) {
@@ -49,9 +53,15 @@ func (s *service) AddEducation(ctx context.Context, userID uint64, ed model.Educ
 func (s *service) UpdateEducation(ctx context.Context, userID uint64, ed model.Education) error {
 	const op = "user service: update education"
 
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	err := s.userRepository.UpdateEducation(ctx, userID, ed)
 	if err != nil {
 		switch {
+		case errors.Is(err, repoerr.ErrVersionMismatch):
+			return serr.NewError(serr.PreconditionFailed, "not updated: the education is changed by another user")
 		case errors.Is(err, repoerr.ErrRecordNotAffected):
 			return serr.NewError(serr.Conflict, "not updated: user/education problem")
 		default:
@@ -0,0 +1,134 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *service) GetExperience(ctx context.Context, userID, experienceID uint64) (*model.Experience, error) {
+	const op = "user service: get experience"
+
+	ex, err := s.userRepository.GetExperience(ctx, userID, experienceID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "experience not found")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return ex, nil
+}
+
+func (s *service) ListExperiences(ctx context.Context, userID uint64) ([]model.Experience, error) {
+	const op = "user service: list experiences"
+
+	exs, err := s.userRepository.ListExperiences(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return exs, nil
+}
+
+func (s *service) AddExperience(ctx context.Context, userID uint64, ex model.Experience) (uint64, error) {
+	const op = "user service: add experience"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := s.userRepository.AddExperience(ctx, userID, ex)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrConflict) {
+			return 0, serr.NewError(serr.Conflict, "not added: user problem")
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (s *service) UpdateExperience(ctx context.Context, userID uint64, ex model.Experience) error {
+	const op = "user service: update experience"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := s.userRepository.UpdateExperience(ctx, userID, ex)
+	if err != nil {
+		switch {
+		case errors.Is(err, repoerr.ErrVersionMismatch):
+			return serr.NewError(serr.PreconditionFailed, "not updated: the experience is changed by another user")
+		case errors.Is(err, repoerr.ErrRecordNotAffected):
+			return serr.NewError(serr.Conflict, "not updated: user/experience problem")
+		default:
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return nil
+}
+
+func (s *service) DeleteExperience(ctx context.Context, userID, experienceID uint64, version int64) error {
+	const op = "user service: delete experience"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := s.userRepository.DeleteExperience(ctx, userID, experienceID, version)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrVersionMismatch) {
+			return serr.NewError(serr.PreconditionFailed, "not deleted: the experience is changed by another user")
+		}
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return serr.NewError(serr.NotFound, "experience not found")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// GetExperienceLength returns the total and the continuous length of work experience
+// of the employee, taking into account previous work and contracts in the company.
+// An open-ended contract is counted up to today.
+func (s *service) GetExperienceLength(ctx context.Context, userID uint64) (total, continuous model.Length, err error) {
+	const op = "user service: get experience length"
+
+	if exist, err := s.userRepository.Exist(ctx, userID); err != nil {
+		return model.Length{}, model.Length{}, fmt.Errorf("%s: %w", op, err)
+	} else if !exist {
+		return model.Length{}, model.Length{}, serr.NewError(serr.NotFound, "user not found")
+	}
+
+	exs, err := s.userRepository.ListExperiences(ctx, userID)
+	if err != nil {
+		return model.Length{}, model.Length{}, fmt.Errorf("%s: %w", op, err)
+	}
+	cs, err := s.userRepository.ListContracts(ctx, userID)
+	if err != nil {
+		return model.Length{}, model.Length{}, fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := time.Now()
+	periods := make([]model.Period, 0, len(exs)+len(cs))
+	for _, ex := range exs {
+		periods = append(periods, model.Period{DateFrom: ex.DateFrom, DateTo: ex.DateTo})
+	}
+	for _, c := range cs {
+		if c.DateBegin.After(now) {
+			continue
+		}
+		dateTo := now
+		if c.DateEnd != nil && c.DateEnd.Before(now) {
+			dateTo = *c.DateEnd
+		}
+		periods = append(periods, model.Period{DateFrom: c.DateBegin, DateTo: dateTo})
+	}
+
+	total, continuous = model.ExperienceLength(periods)
+	return total, continuous, nil
+}
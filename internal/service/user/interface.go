@@ -2,18 +2,35 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/Employee-s-file-cabinet/backend/internal/repo/s3"
+	cmodel "github.com/Employee-s-file-cabinet/backend/internal/service/calendar/model"
 	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
 )
 
 type userRepository interface {
+	// WithTx runs fn in a transaction, the repository called with the context of fn joins it.
+	WithTx(ctx context.Context, fn func(ctx context.Context) error) error
+
 	Exist(ctx context.Context, userID uint64) (bool, error)
 	ListShortUserInfo(ctx context.Context, pms model.ListUsersParams) ([]model.ShortUserInfo, int, error)
 	Get(ctx context.Context, userID uint64) (*model.User, error)
 	GetExpandedUser(ctx context.Context, userID uint64) (*model.ExpandedUser, error)
 	Add(ctx context.Context, user model.User) (uint64, error)
 	Update(ctx context.Context, user model.User) error
+	GetPosition(ctx context.Context, userID uint64) (departmentID, positionID uint64, err error)
+	IsTerminated(ctx context.Context, userID uint64) (bool, error)
+	Terminate(ctx context.Context, userID uint64, t model.Termination) error
+
+	GetMilitary(ctx context.Context, userID uint64) (*model.Military, error)
+	SetMilitary(ctx context.Context, userID uint64, m model.Military) error
+	DeleteMilitary(ctx context.Context, userID uint64, version int64) error
+	ListMilitaryLiable(ctx context.Context, params model.ListMilitaryParams) ([]model.MilitaryLiable, error)
+	ListMilitaryNotifications(ctx context.Context, from, to time.Time) ([]model.MilitaryNotification, error)
+
+	ListPositionTrack(ctx context.Context, userID uint64) ([]model.PositionTrackItem, error)
+	ListPositionHolders(ctx context.Context, positionID uint64, date *time.Time) ([]model.PositionHolder, error)
 
 	GetEducation(ctx context.Context, userID, educationID uint64) (*model.Education, error)
 	ListEducations(ctx context.Context, userID uint64) ([]model.Education, error)
@@ -25,11 +42,33 @@ type userRepository interface {
 	AddTraining(ctx context.Context, userID uint64, tr model.Training) (uint64, error)
 	UpdateTraining(ctx context.Context, userID uint64, tr model.Training) error
 
+	ListExperiences(ctx context.Context, userID uint64) ([]model.Experience, error)
+	GetExperience(ctx context.Context, userID, experienceID uint64) (*model.Experience, error)
+	AddExperience(ctx context.Context, userID uint64, ex model.Experience) (uint64, error)
+	UpdateExperience(ctx context.Context, userID uint64, ex model.Experience) error
+	DeleteExperience(ctx context.Context, userID, experienceID uint64, version int64) error
+
 	ListPassports(ctx context.Context, userID uint64) ([]model.Passport, error)
 	GetPassport(ctx context.Context, userID, passportID uint64) (*model.Passport, error)
 	AddPassport(ctx context.Context, userID uint64, p model.Passport) (uint64, error)
 	UpdatePassport(ctx context.Context, userID uint64, p model.Passport) error
 
+	ListWorkPermits(ctx context.Context, userID uint64) ([]model.WorkPermit, error)
+	GetWorkPermit(ctx context.Context, userID, workPermitID uint64) (*model.WorkPermit, error)
+	AddWorkPermit(ctx context.Context, userID uint64, wp model.WorkPermit) (uint64, error)
+	UpdateWorkPermit(ctx context.Context, userID uint64, wp model.WorkPermit) error
+	DeleteWorkPermit(ctx context.Context, userID, workPermitID uint64, version int64) error
+
+	ListOnboardingTemplates(ctx context.Context) ([]model.OnboardingTemplate, error)
+	GetOnboardingTemplate(ctx context.Context, templateID uint64) (*model.OnboardingTemplate, error)
+	AddOnboardingTemplate(ctx context.Context, t model.OnboardingTemplate) (uint64, error)
+	UpdateOnboardingTemplate(ctx context.Context, t model.OnboardingTemplate) error
+	DeleteOnboardingTemplate(ctx context.Context, templateID uint64) error
+	ListOnboardingItems(ctx context.Context, userID uint64) ([]model.OnboardingItem, error)
+	GetOnboardingItem(ctx context.Context, userID, itemID uint64) (*model.OnboardingItem, error)
+	SetOnboardingItemCompleted(ctx context.Context, userID, itemID uint64, completed bool) error
+	ListOverdueOnboarding(ctx context.Context, date time.Time, departmentID *uint64) ([]model.EmployeeOnboardingItem, error)
+
 	ListVisas(ctx context.Context, userID, passportID uint64) ([]model.Visa, error)
 	GetVisa(ctx context.Context, userID, passportID, visaID uint64) (*model.Visa, error)
 	AddVisa(ctx context.Context, userID, passportID uint64, mv model.Visa) (uint64, error)
@@ -39,6 +78,45 @@ type userRepository interface {
 	ListVacations(ctx context.Context, userID uint64) ([]model.Vacation, error)
 	AddVacation(ctx context.Context, userID uint64, v model.Vacation) (uint64, error)
 	UpdateVacation(ctx context.Context, userID uint64, v model.Vacation) error
+	ListAllVacations(ctx context.Context, params model.ListVacationsParams) ([]model.EmployeeVacation, error)
+	ListDepartmentVacations(ctx context.Context, userID uint64, from, to time.Time) ([]model.Vacation, error)
+
+	GetVacationSchedule(ctx context.Context, year int, departmentID *uint64) (*model.VacationSchedule, error)
+	AddVacationScheduleItem(ctx context.Context, year int, item model.ScheduleItem) (uint64, error)
+	UpdateVacationScheduleItem(ctx context.Context, year int, item model.ScheduleItem) error
+	DeleteVacationScheduleItem(ctx context.Context, year int, itemID uint64) error
+	SetVacationScheduleStatus(ctx context.Context, year int, status model.ScheduleStatus) error
+
+	GetVacationRequest(ctx context.Context, requestID uint64) (*model.VacationRequest, error)
+	ListUserVacationRequests(ctx context.Context, userID uint64) ([]model.VacationRequest, error)
+	ListPendingVacationRequests(ctx context.Context, headID uint64, hr bool) ([]model.VacationRequest, error)
+	AddVacationRequest(ctx context.Context, r model.VacationRequest) (uint64, error)
+	UpdateVacationRequest(ctx context.Context, r model.VacationRequest) error
+	SetVacationRequestStatus(ctx context.Context, r model.VacationRequest, from model.RequestStatus) error
+	ApproveVacationRequest(ctx context.Context, r model.VacationRequest) (uint64, error)
+	GetHead(ctx context.Context, userID uint64) (*model.Recipient, error)
+	GetRecipient(ctx context.Context, userID uint64) (*model.Recipient, error)
+	ListHRRecipients(ctx context.Context) ([]model.Recipient, error)
+	GetEntitlement(ctx context.Context, userID uint64) (*model.EmployeeEntitlement, error)
+	ListEntitlements(ctx context.Context, departmentID *uint64) ([]model.EmployeeEntitlement, error)
+
+	ListAbsences(ctx context.Context, userID uint64) ([]model.Absence, error)
+	GetAbsence(ctx context.Context, userID, absenceID uint64) (*model.Absence, error)
+	AddAbsence(ctx context.Context, userID uint64, a model.Absence) (uint64, error)
+	UpdateAbsence(ctx context.Context, userID uint64, a model.Absence) error
+	DeleteAbsence(ctx context.Context, userID, absenceID uint64, version int64) error
+	ListAllAbsences(ctx context.Context, params model.ListAbsencesParams) ([]model.EmployeeAbsence, error)
+
+	ListRelatives(ctx context.Context, userID uint64) ([]model.Relative, error)
+	GetRelative(ctx context.Context, userID, relativeID uint64) (*model.Relative, error)
+	AddRelative(ctx context.Context, userID uint64, r model.Relative) (uint64, error)
+	UpdateRelative(ctx context.Context, userID uint64, r model.Relative) error
+	DeleteRelative(ctx context.Context, userID, relativeID uint64, version int64) error
+
+	GetDepartmentTitle(ctx context.Context, departmentID uint64) (string, error)
+	ListTimesheetEmployees(ctx context.Context, departmentID uint64, from, to time.Time) ([]model.TimesheetEmployee, error)
+	SetTimesheetCorrection(ctx context.Context, c model.TimesheetCorrection) error
+	DeleteTimesheetCorrection(ctx context.Context, userID uint64, date time.Time) error
 
 	GetScan(ctx context.Context, userID, scanID uint64) (*model.Scan, error)
 	ListScans(ctx context.Context, userID uint64) ([]model.Scan, error)
@@ -48,6 +126,32 @@ type userRepository interface {
 	GetContract(ctx context.Context, userID, contractID uint64) (*model.Contract, error)
 	AddContract(ctx context.Context, userID uint64, tr model.Contract) (uint64, error)
 	UpdateContract(ctx context.Context, userID uint64, mc model.Contract) error
+
+	ListProbationEmployees(ctx context.Context) ([]model.ProbationEmployee, error)
+	SetProbationResult(ctx context.Context, userID, contractID uint64, r model.ProbationResult) error
+	SetProbationReminded(ctx context.Context, contractID uint64, date time.Time) error
+
+	ListAmendments(ctx context.Context, userID, contractID uint64) ([]model.Amendment, error)
+	GetAmendment(ctx context.Context, userID, contractID, amendmentID uint64) (*model.Amendment, error)
+	AddAmendment(ctx context.Context, userID uint64, a model.Amendment) (uint64, error)
+	UpdateAmendment(ctx context.Context, userID uint64, a model.Amendment) error
+
+	ListOrders(ctx context.Context, params model.ListOrdersParams) ([]model.Order, error)
+	GetOrder(ctx context.Context, orderID uint64) (*model.Order, error)
+	AddOrder(ctx context.Context, o model.Order) (uint64, error)
+	UpdateOrder(ctx context.Context, o model.Order) error
+	SignOrder(ctx context.Context, orderID uint64, date time.Time) (string, error)
+	CancelOrder(ctx context.Context, orderID uint64) error
+
+	ListNumberSequences(ctx context.Context) ([]model.NumberSequence, error)
+	AddNumberSequence(ctx context.Context, seq model.NumberSequence) (uint64, error)
+	UpdateNumberSequence(ctx context.Context, seq model.NumberSequence) error
+
+	FindDuplicates(ctx context.Context, u model.User) ([]model.DuplicateMatch, error)
+	FindPassportDuplicates(ctx context.Context, userID uint64, mp model.Passport) ([]model.DuplicateMatch, error)
+	ListPersonsBornOn(ctx context.Context, date time.Time, exceptUserID uint64) ([]model.Person, error)
+	ListPersonsSharingBirthDate(ctx context.Context) ([]model.Person, error)
+	ListDuplicates(ctx context.Context) ([]model.Duplicate, error)
 }
 
 type s3FileRepository interface {
@@ -55,3 +159,15 @@ type s3FileRepository interface {
 	Download(ctx context.Context, prefix, name, etag string) (file s3.File, closeFn func() error, err error)
 	PresignedURL(ctx context.Context, prefix, name string) (string, error)
 }
+
+type staffingChecker interface {
+	HasVacancy(ctx context.Context, departmentID, positionID, exceptUserID uint64) (bool, error)
+}
+
+type productionCalendar interface {
+	Calendar(ctx context.Context, from, to time.Time) (*cmodel.Calendar, error)
+}
+
+type notificationDeliverer interface {
+	SendMessage(recipient, subject, msg string) error
+}
@@ -0,0 +1,92 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *service) GetMilitary(ctx context.Context, userID uint64) (*model.Military, error) {
+	const op = "user service: get military"
+
+	m, err := s.userRepository.GetMilitary(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "military registration not found (not liable)")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return m, nil
+}
+
+// SetMilitary creates the military registration record of the user if m.Version is model.NewVersion,
+// otherwise it replaces the record of the version (of any version if it's 0).
+func (s *service) SetMilitary(ctx context.Context, userID uint64, m model.Military) error {
+	const op = "user service: set military"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.userRepository.SetMilitary(ctx, userID, m); err != nil {
+		if errors.Is(err, repoerr.ErrVersionMismatch) {
+			return serr.NewError(serr.PreconditionFailed, "not updated: the military registration is changed by another user")
+		}
+		if errors.Is(err, repoerr.ErrConflict) {
+			return serr.NewError(serr.Conflict, "not updated: user not found")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// DeleteMilitary removes the military registration record,
+// the user becomes not liable for military service.
+func (s *service) DeleteMilitary(ctx context.Context, userID uint64, version int64) error {
+	const op = "user service: delete military"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.userRepository.DeleteMilitary(ctx, userID, version); err != nil {
+		if errors.Is(err, repoerr.ErrVersionMismatch) {
+			return serr.NewError(serr.PreconditionFailed, "not deleted: the military registration is changed by another user")
+		}
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return serr.NewError(serr.NotFound, "military registration not found")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *service) ListMilitaryLiable(ctx context.Context, params model.ListMilitaryParams) ([]model.MilitaryLiable, error) {
+	const op = "user service: list military liable"
+
+	mls, err := s.userRepository.ListMilitaryLiable(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return mls, nil
+}
+
+func (s *service) ListMilitaryNotifications(ctx context.Context,
+	from, to time.Time) ([]model.MilitaryNotification, error) {
+	const op = "user service: list military notifications"
+
+	if to.Before(from) {
+		return nil, serr.NewError(serr.InvalidArgument, "the period end is earlier than its beginning")
+	}
+
+	mns, err := s.userRepository.ListMilitaryNotifications(ctx, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return mns, nil
+}
@@ -0,0 +1,126 @@
+package model
+
+import "time"
+
+type AbsenceType string
+
+const (
+	AbsenceTypeSick         AbsenceType = "sick"
+	AbsenceTypeUnpaid       AbsenceType = "unpaid"
+	AbsenceTypeBusinessTrip AbsenceType = "business_trip"
+	AbsenceTypeStudy        AbsenceType = "study"
+)
+
+// Absence is an absence of the employee other than a vacation, both dates are inclusive.
+// The document confirming the absence (e.g. the sick-leave certificate) is kept
+// by its number and the scan of the absence type.
+type Absence struct {
+	ID             uint64
+	Type           AbsenceType
+	DateBegin      time.Time
+	DateEnd        time.Time
+	DocumentNumber string
+	Comment        string
+	HasScan        bool
+	Version        int64
+}
+
+// Overlaps reports whether the absence has common days with the vacation.
+func (a Absence) Overlaps(v Vacation) bool {
+	return Vacation{DateBegin: a.DateBegin, DateEnd: a.DateEnd}.Overlaps(v)
+}
+
+// DaysIn returns the number of days of the absence within the period.
+func (a Absence) DaysIn(from, to time.Time) int {
+	begin, end := truncateDate(a.DateBegin), truncateDate(a.DateEnd)
+	if from = truncateDate(from); begin.Before(from) {
+		begin = from
+	}
+	if to = truncateDate(to); end.After(to) {
+		end = to
+	}
+	if begin.After(end) {
+		return 0
+	}
+	return int(end.Sub(begin).Hours()/24) + 1
+}
+
+// FindAbsenceOverlap returns the first of the absences overlapping a,
+// the absence with the ID of a is skipped.
+func FindAbsenceOverlap(absences []Absence, a Absence) (Absence, bool) {
+	v := Vacation{DateBegin: a.DateBegin, DateEnd: a.DateEnd}
+	for _, o := range absences {
+		if o.ID != 0 && o.ID == a.ID {
+			continue
+		}
+		if o.Overlaps(v) {
+			return o, true
+		}
+	}
+	return Absence{}, false
+}
+
+// EmployeeAbsence is an absence with the employee data.
+type EmployeeAbsence struct {
+	Absence
+	UserID     uint64
+	LastName   string
+	FirstName  string
+	MiddleName string
+	Department string
+	Position   string
+}
+
+// ListAbsencesParams filters the absences of all employees:
+// the absences having common days with the period are returned.
+type ListAbsencesParams struct {
+	DepartmentID *uint64
+	Type         *AbsenceType
+	DateFrom     time.Time
+	DateTo       time.Time
+}
+
+// AbsenceSummary is the number of days of the employee absences by type within the period.
+type AbsenceSummary struct {
+	UserID     uint64
+	LastName   string
+	FirstName  string
+	MiddleName string
+	Department string
+	Position   string
+	Days       map[AbsenceType]int
+}
+
+// Total returns the number of days of all the absences.
+func (s AbsenceSummary) Total() int {
+	var total int
+	for _, d := range s.Days {
+		total += d
+	}
+	return total
+}
+
+// SummarizeAbsences counts the days of the absences within the period by the employees,
+// the employees are kept in the order of their first absence.
+func SummarizeAbsences(absences []EmployeeAbsence, from, to time.Time) []AbsenceSummary {
+	var res []AbsenceSummary
+	idx := make(map[uint64]int)
+	for _, a := range absences {
+		i, ok := idx[a.UserID]
+		if !ok {
+			i = len(res)
+			idx[a.UserID] = i
+			res = append(res, AbsenceSummary{
+				UserID:     a.UserID,
+				LastName:   a.LastName,
+				FirstName:  a.FirstName,
+				MiddleName: a.MiddleName,
+				Department: a.Department,
+				Position:   a.Position,
+				Days:       make(map[AbsenceType]int),
+			})
+		}
+		res[i].Days[a.Type] += a.DaysIn(from, to)
+	}
+	return res
+}
@@ -0,0 +1,26 @@
+package model
+
+import "testing"
+
+func TestSummarizeAbsences(t *testing.T) {
+	as := []EmployeeAbsence{
+		{UserID: 1, Absence: Absence{Type: AbsenceTypeSick, DateBegin: date("2024-01-29"), DateEnd: date("2024-02-02")}},
+		{UserID: 2, Absence: Absence{Type: AbsenceTypeStudy, DateBegin: date("2024-02-05"), DateEnd: date("2024-02-09")}},
+		{UserID: 1, Absence: Absence{Type: AbsenceTypeSick, DateBegin: date("2024-02-12"), DateEnd: date("2024-02-13")}},
+		{UserID: 1, Absence: Absence{Type: AbsenceTypeBusinessTrip, DateBegin: date("2024-02-26"), DateEnd: date("2024-03-05")}},
+	}
+
+	got := SummarizeAbsences(as, date("2024-02-01"), date("2024-02-29"))
+	if len(got) != 2 || got[0].UserID != 1 || got[1].UserID != 2 {
+		t.Fatalf("SummarizeAbsences() = %v, want the employees 1, 2", got)
+	}
+	if d := got[0].Days[AbsenceTypeSick]; d != 4 {
+		t.Errorf("sick days = %d, want 4", d)
+	}
+	if d := got[0].Days[AbsenceTypeBusinessTrip]; d != 4 {
+		t.Errorf("business trip days = %d, want 4", d)
+	}
+	if total := got[1].Total(); total != 5 {
+		t.Errorf("Total() = %d, want 5", total)
+	}
+}
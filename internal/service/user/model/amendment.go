@@ -0,0 +1,87 @@
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+// Amendment is the supplementary agreement to the contract. It changes the set terms
+// from EffectiveFrom, the terms which are nil are kept.
+type Amendment struct {
+	ID         uint64
+	ContractID uint64
+	Number     string
+	// Date is the day the amendment is signed.
+	Date          time.Time
+	EffectiveFrom time.Time
+	WorkTypeID    *uint64
+	PositionID    *uint64
+	Salary        *int64
+	// DateEnd is the new last day of the contract.
+	DateEnd     *time.Time
+	Description string
+	HasScan     bool
+	Version     int64
+}
+
+// ChangesTerms reports whether the amendment changes any of the contract terms.
+func (a Amendment) ChangesTerms() bool {
+	return a.WorkTypeID != nil || a.PositionID != nil || a.Salary != nil || a.DateEnd != nil
+}
+
+// ContractTerms are the terms of the contract in effect on the date.
+type ContractTerms struct {
+	Date       time.Time
+	WorkTypeID uint64
+	DateEnd    *time.Time
+	// PositionID and Salary are set only if they are changed by the amendments,
+	// otherwise they are as at the conclusion of the contract.
+	PositionID *uint64
+	Salary     *int64
+	// AmendmentID is the last amendment in effect, nil for the original terms.
+	AmendmentID *uint64
+}
+
+// EffectiveTerms returns the terms of the contract on the date. The amendments effective
+// by the date are applied in order of their effective dates, then of their signing dates.
+func (c Contract) EffectiveTerms(amendments []Amendment, date time.Time) ContractTerms {
+	date = truncateDate(date)
+	terms := ContractTerms{
+		Date:       date,
+		WorkTypeID: c.WorkTypeID,
+		DateEnd:    c.DateEnd,
+	}
+
+	as := make([]Amendment, len(amendments))
+	copy(as, amendments)
+	sort.SliceStable(as, func(i, j int) bool {
+		if !as[i].EffectiveFrom.Equal(as[j].EffectiveFrom) {
+			return as[i].EffectiveFrom.Before(as[j].EffectiveFrom)
+		}
+		if !as[i].Date.Equal(as[j].Date) {
+			return as[i].Date.Before(as[j].Date)
+		}
+		return as[i].ID < as[j].ID
+	})
+
+	for _, a := range as {
+		if a.EffectiveFrom.After(date) {
+			break
+		}
+		if a.WorkTypeID != nil {
+			terms.WorkTypeID = *a.WorkTypeID
+		}
+		if a.PositionID != nil {
+			terms.PositionID = a.PositionID
+		}
+		if a.Salary != nil {
+			terms.Salary = a.Salary
+		}
+		if a.DateEnd != nil {
+			terms.DateEnd = a.DateEnd
+		}
+		id := a.ID
+		terms.AmendmentID = &id
+	}
+	return terms
+}
@@ -0,0 +1,50 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContract_EffectiveTerms(t *testing.T) {
+	ptr := func(v uint64) *uint64 { return &v }
+	salary := func(v int64) *int64 { return &v }
+	end := func(s string) *time.Time { d := date(s); return &d }
+
+	c := Contract{WorkTypeID: 1, DateBegin: date("2023-01-10"), DateEnd: end("2024-01-09")}
+	amendments := []Amendment{
+		{ID: 3, EffectiveFrom: date("2023-09-01"), Date: date("2023-08-20"), PositionID: ptr(4), Salary: salary(120000)},
+		{ID: 1, EffectiveFrom: date("2023-03-01"), Date: date("2023-02-20"), Salary: salary(100000)},
+		{ID: 2, EffectiveFrom: date("2023-03-01"), Date: date("2023-02-25"), WorkTypeID: ptr(2), DateEnd: end("2024-12-31")},
+	}
+
+	tests := []struct {
+		name string
+		date time.Time
+		want ContractTerms
+	}{
+		{
+			name: "original terms",
+			date: date("2023-02-28"),
+			want: ContractTerms{Date: date("2023-02-28"), WorkTypeID: 1, DateEnd: end("2024-01-09")},
+		},
+		{
+			name: "two amendments effective from the same day",
+			date: date("2023-03-01"),
+			want: ContractTerms{Date: date("2023-03-01"), WorkTypeID: 2, DateEnd: end("2024-12-31"),
+				Salary: salary(100000), AmendmentID: ptr(2)},
+		},
+		{
+			name: "later amendment overrides the salary",
+			date: date("2023-09-01").Add(15 * time.Hour),
+			want: ContractTerms{Date: date("2023-09-01"), WorkTypeID: 2, DateEnd: end("2024-12-31"),
+				PositionID: ptr(4), Salary: salary(120000), AmendmentID: ptr(3)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, c.EffectiveTerms(amendments, tt.date))
+		})
+	}
+}
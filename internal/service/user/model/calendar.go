@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// Calendar is the production calendar.
+type Calendar interface {
+	// CalendarDays returns the number of days from begin to end inclusive excluding public holidays.
+	CalendarDays(begin, end time.Time) int
+	// NextWorkingDay returns the date if it's a working day or the next working day.
+	NextWorkingDay(date time.Time) time.Time
+	// IsHoliday reports whether the date is a non-working public holiday.
+	IsHoliday(date time.Time) bool
+	// WorkingHours returns the norm of working hours of the day, 0 for a non-working day.
+	WorkingHours(date time.Time) int
+}
@@ -12,6 +12,32 @@ type Contract struct {
 	ProbationPeriod *uint
 	DateBegin       time.Time
 	DateEnd         *time.Time
+	// ExtraVacationDays is the additional annual paid leave days of the contract.
+	ExtraVacationDays uint
+	// ProbationEnd is the last day of the probation period (nil without probation).
+	ProbationEnd *time.Time
+	// ProbationResult is the outcome of the probation, nil until it is decided.
+	ProbationResult *ProbationResult
+	// HasAmendments reports whether the contract is amended, the amended contract is read-only.
+	HasAmendments bool
+	Version       int64
+}
+
+// SetProbationEnd sets the last day of the probation period of ProbationPeriod months.
+// The period ending on a non-working day ends on the next working day (article 14 of the Labour Code).
+// The extended probation ends on the day set by the result.
+func (c *Contract) SetProbationEnd(cal Calendar) {
+	if c.ProbationPeriod == nil || *c.ProbationPeriod == 0 {
+		c.ProbationEnd = nil
+		return
+	}
+	if r := c.ProbationResult; r != nil && r.Outcome == ProbationExtended && r.ExtendedTo != nil {
+		end := *r.ExtendedTo
+		c.ProbationEnd = &end
+		return
+	}
+	end := cal.NextWorkingDay(c.DateBegin.AddDate(0, int(*c.ProbationPeriod), -1))
+	c.ProbationEnd = &end
 }
 
 type contractType string
@@ -0,0 +1,123 @@
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// DuplicateReason is why the users are considered to be the same person.
+type DuplicateReason string
+
+const (
+	DuplicateReasonInsurance DuplicateReason = "insurance"
+	DuplicateReasonName      DuplicateReason = "name" // similar names and the same date of birth
+	DuplicateReasonPassport  DuplicateReason = "passport"
+	DuplicateReasonTaxpayer  DuplicateReason = "taxpayer"
+)
+
+// DuplicateMatch is the other user with the same identifier as the checked one.
+type DuplicateMatch struct {
+	UserID     uint64
+	Reason     DuplicateReason
+	Terminated bool
+}
+
+// Duplicate is the group of the users which are probably the same person.
+type Duplicate struct {
+	Reason  DuplicateReason
+	UserIDs []uint64
+}
+
+// Person is the user's name and date of birth compared to find the duplicates,
+// the middle name is ignored as it's often omitted.
+type Person struct {
+	ID          uint64
+	LastName    string
+	FirstName   string
+	DateOfBirth time.Time
+}
+
+// SimilarTo reports whether the persons are born on the same date and their names
+// differ by a typo. The last and first names swapped by mistake are similar too.
+func (p Person) SimilarTo(o Person) bool {
+	if !p.DateOfBirth.Equal(o.DateOfBirth) {
+		return false
+	}
+	last, first := normalizeName(p.LastName), normalizeName(p.FirstName)
+	oLast, oFirst := normalizeName(o.LastName), normalizeName(o.FirstName)
+	return similarNames(last, oLast) && similarNames(first, oFirst) ||
+		similarNames(last, oFirst) && similarNames(first, oLast)
+}
+
+// GroupSimilar groups the persons similar to each other directly or through
+// the other persons of the group, the persons without similar ones are skipped.
+func GroupSimilar(persons []Person) [][]uint64 {
+	root := make([]int, len(persons))
+	var find func(i int) int
+	find = func(i int) int {
+		if root[i] != i {
+			root[i] = find(root[i])
+		}
+		return root[i]
+	}
+	for i := range persons {
+		root[i] = i
+		for j := 0; j < i; j++ {
+			if persons[i].SimilarTo(persons[j]) {
+				root[find(i)] = find(j)
+			}
+		}
+	}
+
+	var groups [][]uint64
+	index := make(map[int]int) // root to the group index
+	for i := range persons {
+		r := find(i)
+		if r == i {
+			continue
+		}
+		k, ok := index[r]
+		if !ok {
+			k = len(groups)
+			index[r] = k
+			groups = append(groups, []uint64{persons[r].ID})
+		}
+		groups[k] = append(groups[k], persons[i].ID)
+	}
+	return groups
+}
+
+func normalizeName(s string) string {
+	s = strings.ToLower(strings.TrimSpace(s))
+	s = strings.ReplaceAll(s, "ё", "е")
+	return strings.NewReplacer(" ", "", "-", "").Replace(s)
+}
+
+// similarNames allows one typo in the short names and two typos in the long ones.
+func similarNames(a, b string) bool {
+	allowed := 1
+	if len([]rune(a)) > 6 {
+		allowed = 2
+	}
+	return levenshtein([]rune(a), []rune(b)) <= allowed
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,32 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPerson_SimilarTo(t *testing.T) {
+	born := time.Date(1990, time.May, 15, 0, 0, 0, 0, time.UTC)
+	p := Person{LastName: "Фёдоров", FirstName: "Алексей", DateOfBirth: born}
+
+	assert.True(t, p.SimilarTo(Person{LastName: "Федоров", FirstName: "Алексей", DateOfBirth: born}))
+	assert.True(t, p.SimilarTo(Person{LastName: "Фёдорова", FirstName: "Алексей", DateOfBirth: born}))
+	assert.True(t, p.SimilarTo(Person{LastName: "Алексей", FirstName: "Федоров", DateOfBirth: born}))
+	assert.False(t, p.SimilarTo(Person{LastName: "Федоров", FirstName: "Алексей", DateOfBirth: born.AddDate(0, 0, 1)}))
+	assert.False(t, p.SimilarTo(Person{LastName: "Петров", FirstName: "Алексей", DateOfBirth: born}))
+	assert.False(t, p.SimilarTo(Person{LastName: "Федоров", FirstName: "Андрей", DateOfBirth: born}))
+}
+
+func TestGroupSimilar(t *testing.T) {
+	born := time.Date(1990, time.May, 15, 0, 0, 0, 0, time.UTC)
+	groups := GroupSimilar([]Person{
+		{ID: 1, LastName: "Иванов", FirstName: "Иван", DateOfBirth: born},
+		{ID: 2, LastName: "Петров", FirstName: "Пётр", DateOfBirth: born},
+		{ID: 3, LastName: "Иванова", FirstName: "Иван", DateOfBirth: born},
+		{ID: 4, LastName: "Петров", FirstName: "Петр", DateOfBirth: born},
+		{ID: 5, LastName: "Сидоров", FirstName: "Иван", DateOfBirth: born},
+	})
+	assert.Equal(t, [][]uint64{{1, 3}, {2, 4}}, groups)
+}
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"math"
+	"time"
+)
+
+// EntitlementPeriod is a period of employment (a contract) giving
+// the annual paid leave: the base days plus ExtraDays.
+type EntitlementPeriod struct {
+	DateBegin time.Time
+	DateEnd   *time.Time
+	ExtraDays uint
+}
+
+// VacationBalance represents the annual paid leave of the employee.
+type VacationBalance struct {
+	AnnualDays uint    // days per year of the current (last) contract
+	Accrued    float64 // days accrued since the employment
+	Used       uint    // days of the vacations, public holidays excluded
+	Balance    float64 // days left
+}
+
+// EmployeeEntitlement is the data to calculate the vacation balance of the employee.
+type EmployeeEntitlement struct {
+	UserID     uint64
+	LastName   string
+	FirstName  string
+	MiddleName string
+	Department string
+	Position   string
+	Periods    []EntitlementPeriod
+	Vacations  []Vacation
+}
+
+// EmployeeVacationBalance is the vacation balance with the employee data.
+type EmployeeVacationBalance struct {
+	UserID     uint64
+	LastName   string
+	FirstName  string
+	MiddleName string
+	Department string
+	Position   string
+	VacationBalance
+}
+
+// CalculateVacationBalance returns the vacation balance on the date.
+// The leave is accrued pro rata for months worked in each period:
+// the rest of 15 days and more is rounded up to a full month, the rest less than 15 days is dropped.
+// All the vacations are used days, including planned ones, public holidays are not counted.
+func CalculateVacationBalance(baseDays uint, periods []EntitlementPeriod,
+	vacations []Vacation, cal Calendar, date time.Time) VacationBalance {
+	var b VacationBalance
+
+	date = truncateDate(date)
+	var last time.Time
+	for _, p := range periods {
+		annual := baseDays + p.ExtraDays
+		if !p.DateBegin.Before(last) {
+			last = p.DateBegin
+			b.AnnualDays = annual
+		}
+
+		end := date
+		if p.DateEnd != nil && p.DateEnd.Before(end) {
+			end = truncateDate(*p.DateEnd)
+		}
+		b.Accrued += float64(annual) * float64(workedMonths(p.DateBegin, end)) / 12
+	}
+	b.Accrued = roundDays(b.Accrued)
+
+	for _, v := range vacations {
+		b.Used += uint(cal.CalendarDays(v.DateBegin, v.DateEnd))
+	}
+	b.Balance = roundDays(b.Accrued - float64(b.Used))
+	return b
+}
+
+// workedMonths returns the number of months from begin to end inclusive
+// rounding the rest of 15 days and more up to a full month.
+func workedMonths(begin, end time.Time) int {
+	begin, end = truncateDate(begin), truncateDate(end).AddDate(0, 0, 1)
+	if !begin.Before(end) {
+		return 0
+	}
+
+	months := 0
+	for !begin.AddDate(0, months+1, 0).After(end) {
+		months++
+	}
+	if rest := end.Sub(begin.AddDate(0, months, 0)).Hours() / 24; rest >= 15 {
+		months++
+	}
+	return months
+}
+
+func roundDays(days float64) float64 {
+	return math.Round(days*100) / 100
+}
@@ -0,0 +1,85 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	cmodel "github.com/Employee-s-file-cabinet/backend/internal/service/calendar/model"
+)
+
+func TestCalculateVacationBalance(t *testing.T) {
+	cal := cmodel.New(nil, nil)
+	contractEnd := date("2023-06-30")
+	tests := []struct {
+		name      string
+		periods   []EntitlementPeriod
+		vacations []Vacation
+		date      time.Time
+		want      VacationBalance
+	}{
+		{
+			name:    "full year",
+			periods: []EntitlementPeriod{{DateBegin: date("2023-01-01")}},
+			date:    date("2023-12-31"),
+			want:    VacationBalance{AnnualDays: 28, Accrued: 28, Balance: 28},
+		},
+		{
+			name:    "rest of 15 days is a full month",
+			periods: []EntitlementPeriod{{DateBegin: date("2023-01-01")}},
+			date:    date("2023-02-15"),
+			want:    VacationBalance{AnnualDays: 28, Accrued: 4.67, Balance: 4.67},
+		},
+		{
+			name:    "rest of 14 days is dropped",
+			periods: []EntitlementPeriod{{DateBegin: date("2023-01-01")}},
+			date:    date("2023-02-14"),
+			want:    VacationBalance{AnnualDays: 28, Accrued: 2.33, Balance: 2.33},
+		},
+		{
+			name: "closed contract and extra days",
+			periods: []EntitlementPeriod{
+				{DateBegin: date("2023-01-01"), DateEnd: &contractEnd},
+				{DateBegin: date("2023-07-01"), ExtraDays: 8},
+			},
+			vacations: []Vacation{{DateBegin: date("2023-08-01"), DateEnd: date("2023-08-14")}},
+			date:      date("2023-12-31"),
+			want:      VacationBalance{AnnualDays: 36, Accrued: 32, Used: 14, Balance: 18},
+		},
+		{
+			name:      "not started",
+			periods:   []EntitlementPeriod{{DateBegin: date("2024-01-01")}},
+			vacations: []Vacation{{DateBegin: date("2024-03-04"), DateEnd: date("2024-03-10")}},
+			date:      date("2023-12-31"),
+			want:      VacationBalance{AnnualDays: 28, Used: 6, Balance: -6},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CalculateVacationBalance(28, tt.periods, tt.vacations, cal, tt.date))
+		})
+	}
+}
+
+func TestContract_SetProbationEnd(t *testing.T) {
+	cal := cmodel.New([]int{2024}, []cmodel.Day{
+		{Date: date("2024-04-29"), Type: cmodel.DayTypeDayOff},
+		{Date: date("2024-04-30"), Type: cmodel.DayTypeDayOff},
+		{Date: date("2024-05-01"), Type: cmodel.DayTypeHoliday},
+	})
+	three, none := uint(3), uint(0)
+
+	c := Contract{DateBegin: date("2024-01-15"), ProbationPeriod: &three}
+	c.SetProbationEnd(cal)
+	assert.Equal(t, date("2024-04-15"), *c.ProbationEnd)
+
+	// 2024-04-27 is Saturday (not a working one in this calendar), 2024-04-28 - 2024-05-01 are non-working days
+	c = Contract{DateBegin: date("2024-01-28"), ProbationPeriod: &three}
+	c.SetProbationEnd(cal)
+	assert.Equal(t, date("2024-05-02"), *c.ProbationEnd)
+
+	c = Contract{DateBegin: date("2024-01-28"), ProbationPeriod: &none}
+	c.SetProbationEnd(cal)
+	assert.Nil(t, c.ProbationEnd)
+}
@@ -0,0 +1,90 @@
+package model
+
+import (
+	"slices"
+	"time"
+)
+
+// Experience represents previous work of the employee in another company.
+type Experience struct {
+	ID          uint64
+	CompanyName string
+	Position    string
+	Functional  string
+	Awards      string
+	DateFrom    time.Time
+	DateTo      time.Time
+	Version     int64
+}
+
+// Period represents a period of work, both dates are inclusive.
+type Period struct {
+	DateFrom time.Time
+	DateTo   time.Time
+}
+
+func (p Period) days() int {
+	return int(truncateDate(p.DateTo).Sub(truncateDate(p.DateFrom)).Hours()/24) + 1
+}
+
+// Length represents a length of work experience in the labour accounting form:
+// a month is 30 days and a year is 12 months.
+type Length struct {
+	Years  int
+	Months int
+	Days   int
+}
+
+func newLength(days int) Length {
+	return Length{
+		Years:  days / 360,
+		Months: days % 360 / 30,
+		Days:   days % 30,
+	}
+}
+
+// ExperienceLength returns the total length of the periods and the length of
+// the last continuous period. Overlapping periods are counted only once,
+// and periods following each other without a gap are continuous.
+func ExperienceLength(periods []Period) (total, continuous Length) {
+	merged := mergePeriods(periods)
+	if len(merged) == 0 {
+		return Length{}, Length{}
+	}
+
+	var days int
+	for _, p := range merged {
+		days += p.days()
+	}
+	return newLength(days), newLength(merged[len(merged)-1].days())
+}
+
+func mergePeriods(periods []Period) []Period {
+	ps := make([]Period, 0, len(periods))
+	for _, p := range periods {
+		p = Period{DateFrom: truncateDate(p.DateFrom), DateTo: truncateDate(p.DateTo)}
+		if !p.DateTo.Before(p.DateFrom) {
+			ps = append(ps, p)
+		}
+	}
+	slices.SortFunc(ps, func(a, b Period) int {
+		return a.DateFrom.Compare(b.DateFrom)
+	})
+
+	merged := make([]Period, 0, len(ps))
+	for _, p := range ps {
+		if n := len(merged); n > 0 && !p.DateFrom.After(merged[n-1].DateTo.AddDate(0, 0, 1)) {
+			if p.DateTo.After(merged[n-1].DateTo) {
+				merged[n-1].DateTo = p.DateTo
+			}
+			continue
+		}
+		merged = append(merged, p)
+	}
+	return merged
+}
+
+func truncateDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,78 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(s string) time.Time {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestExperienceLength(t *testing.T) {
+	tests := []struct {
+		name           string
+		periods        []Period
+		wantTotal      Length
+		wantContinuous Length
+	}{
+		{
+			name: "no periods",
+		},
+		{
+			name: "single period",
+			periods: []Period{
+				{DateFrom: date("2020-01-01"), DateTo: date("2020-01-30")},
+			},
+			wantTotal:      Length{Months: 1},
+			wantContinuous: Length{Months: 1},
+		},
+		{
+			name: "overlapping periods are counted once",
+			periods: []Period{
+				{DateFrom: date("2020-01-01"), DateTo: date("2020-01-20")},
+				{DateFrom: date("2020-01-11"), DateTo: date("2020-01-30")},
+				{DateFrom: date("2020-01-05"), DateTo: date("2020-01-06")},
+			},
+			wantTotal:      Length{Months: 1},
+			wantContinuous: Length{Months: 1},
+		},
+		{
+			name: "adjacent periods are continuous",
+			periods: []Period{
+				{DateFrom: date("2021-01-01"), DateTo: date("2021-01-10")},
+				{DateFrom: date("2021-01-11"), DateTo: date("2021-01-20")},
+			},
+			wantTotal:      Length{Days: 20},
+			wantContinuous: Length{Days: 20},
+		},
+		{
+			name: "gap breaks continuity",
+			periods: []Period{
+				{DateFrom: date("2022-03-01"), DateTo: date("2022-03-10")},
+				{DateFrom: date("2010-01-01"), DateTo: date("2010-12-26")},
+			},
+			wantTotal:      Length{Years: 1, Days: 10},
+			wantContinuous: Length{Days: 10},
+		},
+		{
+			name: "invalid period is ignored",
+			periods: []Period{
+				{DateFrom: date("2022-03-10"), DateTo: date("2022-03-01")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			total, continuous := ExperienceLength(tt.periods)
+			assert.Equal(t, tt.wantTotal, total)
+			assert.Equal(t, tt.wantContinuous, continuous)
+		})
+	}
+}
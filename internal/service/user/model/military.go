@@ -0,0 +1,54 @@
+package model
+
+import "time"
+
+// MilitaryLiable represents the employee liable for military service.
+type MilitaryLiable struct {
+	UserID      uint64
+	LastName    string
+	FirstName   string
+	MiddleName  string
+	DateOfBirth time.Time
+	Department  string
+	Position    string
+	Military    Military
+}
+
+type ListMilitaryParams struct {
+	Category          *string
+	Rank              *string
+	IncludeTerminated bool
+}
+
+// MilitaryEvent represents a change to be reported to the military commissariat.
+type MilitaryEvent string
+
+const (
+	MilitaryEventRegistered      MilitaryEvent = "registered"
+	MilitaryEventChanged         MilitaryEvent = "changed"
+	MilitaryEventDeregistered    MilitaryEvent = "deregistered"
+	MilitaryEventPositionChanged MilitaryEvent = "position_changed"
+	MilitaryEventTerminated      MilitaryEvent = "terminated"
+)
+
+// MilitaryEventOf returns the event to be reported to the commissariat when the military
+// registration record old (nil if there is no record) is replaced by m.
+// changed is false if the record data is the same.
+func MilitaryEventOf(old *Military, m Military) (event MilitaryEvent, changed bool) {
+	if old == nil {
+		return MilitaryEventRegistered, true
+	}
+	if old.Rank == m.Rank && old.Speciality == m.Speciality &&
+		old.Category == m.Category && old.Commissariat == m.Commissariat {
+		return "", false
+	}
+	return MilitaryEventChanged, true
+}
+
+// MilitaryNotification represents the change of the employee data
+// for the military commissariat. Military is empty for the deregistered employee.
+type MilitaryNotification struct {
+	MilitaryLiable
+	Event MilitaryEvent
+	Date  time.Time
+}
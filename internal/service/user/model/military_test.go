@@ -0,0 +1,45 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMilitaryEventOf(t *testing.T) {
+	old := Military{
+		Rank:         "Старший лейтенант",
+		Speciality:   "101182",
+		Category:     "А2",
+		Commissariat: "Военный комиссариат Петроградского района",
+		HasScan:      true,
+		Version:      3,
+	}
+	changedRank := old
+	changedRank.Rank = "Капитан"
+	changedCategory := old
+	changedCategory.Category = "Б"
+	otherVersion := old
+	otherVersion.Version, otherVersion.HasScan = 0, false
+
+	tests := []struct {
+		name        string
+		old         *Military
+		m           Military
+		wantEvent   MilitaryEvent
+		wantChanged bool
+	}{
+		{name: "no record", m: old, wantEvent: MilitaryEventRegistered, wantChanged: true},
+		{name: "same data", old: &old, m: old, wantChanged: false},
+		{name: "same data, other version and scan", old: &old, m: otherVersion, wantChanged: false},
+		{name: "rank changed", old: &old, m: changedRank, wantEvent: MilitaryEventChanged, wantChanged: true},
+		{name: "category changed", old: &old, m: changedCategory, wantEvent: MilitaryEventChanged, wantChanged: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, changed := MilitaryEventOf(tt.old, tt.m)
+			assert.Equal(t, tt.wantEvent, event)
+			assert.Equal(t, tt.wantChanged, changed)
+		})
+	}
+}
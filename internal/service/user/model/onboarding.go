@@ -0,0 +1,89 @@
+package model
+
+import "time"
+
+// OnboardingTemplate is a checklist template for new employees of the department
+// and the position. Nil DepartmentID or PositionID matches any of them.
+type OnboardingTemplate struct {
+	ID           uint64
+	Title        string
+	DepartmentID *uint64
+	PositionID   *uint64
+	Items        []OnboardingTemplateItem
+}
+
+// OnboardingTemplateItem is an item of the checklist template.
+// The item with ScanType is completed automatically
+// when a scan of the type is uploaded, the others are completed manually.
+type OnboardingTemplateItem struct {
+	Title    string
+	ScanType *ScanType
+	DueDays  uint // days after the employee is added
+}
+
+// OnboardingItem is an item of the employee checklist.
+type OnboardingItem struct {
+	ID          uint64
+	Title       string
+	ScanType    *ScanType
+	DueDate     time.Time
+	CompletedAt *time.Time
+}
+
+// Manual reports whether the item is completed manually.
+func (i OnboardingItem) Manual() bool {
+	return i.ScanType == nil
+}
+
+// Overdue reports whether the item is not completed after the due date.
+func (i OnboardingItem) Overdue(date time.Time) bool {
+	return i.CompletedAt == nil && truncateDate(i.DueDate).Before(truncateDate(date))
+}
+
+// OverdueOnboarding represents the employee with overdue onboarding items.
+type OverdueOnboarding struct {
+	UserID     uint64
+	LastName   string
+	FirstName  string
+	MiddleName string
+	Department string
+	Position   string
+	Items      []OnboardingItem
+}
+
+// EmployeeOnboardingItem is an onboarding item with the employee data.
+type EmployeeOnboardingItem struct {
+	OnboardingItem
+	UserID     uint64
+	LastName   string
+	FirstName  string
+	MiddleName string
+	Department string
+	Position   string
+}
+
+// GroupOverdue groups overdue items by employees keeping the order of the employees.
+func GroupOverdue(items []EmployeeOnboardingItem, date time.Time) []OverdueOnboarding {
+	res := make([]OverdueOnboarding, 0)
+	idx := make(map[uint64]int)
+	for _, it := range items {
+		if !it.Overdue(date) {
+			continue
+		}
+		i, ok := idx[it.UserID]
+		if !ok {
+			i = len(res)
+			idx[it.UserID] = i
+			res = append(res, OverdueOnboarding{
+				UserID:     it.UserID,
+				LastName:   it.LastName,
+				FirstName:  it.FirstName,
+				MiddleName: it.MiddleName,
+				Department: it.Department,
+				Position:   it.Position,
+			})
+		}
+		res[i].Items = append(res[i].Items, it.OnboardingItem)
+	}
+	return res
+}
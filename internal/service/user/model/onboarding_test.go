@@ -0,0 +1,64 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnboardingItem_Overdue(t *testing.T) {
+	completed := date("2024-01-05")
+	tests := []struct {
+		name string
+		item OnboardingItem
+		want bool
+	}{
+		{
+			name: "due today",
+			item: OnboardingItem{DueDate: date("2024-01-10")},
+		},
+		{
+			name: "after due date",
+			item: OnboardingItem{DueDate: date("2024-01-09")},
+			want: true,
+		},
+		{
+			name: "completed",
+			item: OnboardingItem{DueDate: date("2024-01-01"), CompletedAt: &completed},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.item.Overdue(date("2024-01-10")))
+		})
+	}
+}
+
+func TestGroupOverdue(t *testing.T) {
+	items := []EmployeeOnboardingItem{
+		{UserID: 2, LastName: "Petrov", OnboardingItem: OnboardingItem{ID: 1, DueDate: date("2024-01-01")}},
+		{UserID: 1, LastName: "Ivanov", OnboardingItem: OnboardingItem{ID: 2, DueDate: date("2024-01-02")}},
+		{UserID: 2, LastName: "Petrov", OnboardingItem: OnboardingItem{ID: 3, DueDate: date("2024-01-03")}},
+		{UserID: 1, LastName: "Ivanov", OnboardingItem: OnboardingItem{ID: 4, DueDate: date("2024-02-01")}},
+	}
+
+	got := GroupOverdue(items, date("2024-01-10"))
+
+	assert.Equal(t, []OverdueOnboarding{
+		{
+			UserID:   2,
+			LastName: "Petrov",
+			Items: []OnboardingItem{
+				{ID: 1, DueDate: date("2024-01-01")},
+				{ID: 3, DueDate: date("2024-01-03")},
+			},
+		},
+		{
+			UserID:   1,
+			LastName: "Ivanov",
+			Items: []OnboardingItem{
+				{ID: 2, DueDate: date("2024-01-02")},
+			},
+		},
+	}, got)
+}
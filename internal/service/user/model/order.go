@@ -0,0 +1,78 @@
+package model
+
+import "time"
+
+// OrderType is the type of the personnel event the HR order is issued on.
+type OrderType string
+
+const (
+	OrderTypeHiring      OrderType = "hiring"
+	OrderTypeTransfer    OrderType = "transfer"
+	OrderTypeVacation    OrderType = "vacation"
+	OrderTypeTermination OrderType = "termination"
+	OrderTypeOther       OrderType = "other"
+)
+
+// OrderTitle returns the default title of the order of the type.
+func OrderTitle(t OrderType) string {
+	switch t {
+	case OrderTypeHiring:
+		return "О приёме работника на работу"
+	case OrderTypeTransfer:
+		return "О переводе работника на другую работу"
+	case OrderTypeVacation:
+		return "О предоставлении отпуска работнику"
+	case OrderTypeTermination:
+		return "О прекращении трудового договора с работником"
+	}
+	return ""
+}
+
+// OrderStatus is the status of the HR order.
+type OrderStatus string
+
+const (
+	OrderStatusDraft     OrderStatus = "draft"
+	OrderStatusSigned    OrderStatus = "signed"
+	OrderStatusCancelled OrderStatus = "cancelled"
+)
+
+// OrderAction is an action changing the status of the draft order.
+type OrderAction string
+
+const (
+	OrderActionSign   OrderAction = "sign"   // draft -> signed, the number is assigned
+	OrderActionCancel OrderAction = "cancel" // draft -> cancelled
+)
+
+// Order is the HR order on the personnel event of the employee. It's created as a draft,
+// the signed order gets the number of the order number sequence of its type.
+type Order struct {
+	ID         uint64
+	Type       OrderType
+	Status     OrderStatus
+	Title      string
+	UserID     uint64
+	LastName   string
+	FirstName  string
+	MiddleName string
+	// EffectiveDate is the day of the event: the hiring, the transfer, the first day
+	// of the vacation or the last working day.
+	EffectiveDate time.Time
+	ContractID    *uint64
+	VacationID    *uint64
+	// Number and Date are set when the order is signed.
+	Number    string
+	Date      *time.Time
+	HasScan   bool
+	CreatedAt time.Time
+}
+
+// ListOrdersParams are the filters of the orders, nil means any.
+type ListOrdersParams struct {
+	Type   *OrderType
+	Status *OrderStatus
+	UserID *uint64
+	// Year is the year of signing.
+	Year *int
+}
@@ -1,6 +1,10 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/Employee-s-file-cabinet/backend/pkg/docnumber"
+)
 
 type Passport struct {
 	ID         uint64
@@ -10,6 +14,7 @@ type Passport struct {
 	Type       PassportType
 	VisasCount uint
 	HasScan    bool
+	Version    int64
 }
 
 type PassportType string
@@ -20,6 +25,20 @@ const (
 	PassportTypeInternal   PassportType = "internal"
 )
 
+// ValidNumber reports whether the series and number have the shape of the passport type.
+func (p Passport) ValidNumber() bool {
+	switch p.Type {
+	case PassportTypeInternal:
+		return docnumber.InternalPassport(p.Number)
+	case PassportTypeExternal:
+		return docnumber.ExternalPassport(p.Number)
+	case PassportTypeForeigners:
+		return docnumber.ForeignPassport(p.Number)
+	default:
+		return false
+	}
+}
+
 type ExpandedPassport struct {
 	Passport
 	Visas []Visa
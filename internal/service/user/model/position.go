@@ -0,0 +1,55 @@
+package model
+
+import "time"
+
+// PositionTrackItem represents a period during which
+// the user held the position in the department.
+type PositionTrackItem struct {
+	ID           uint64
+	PositionID   uint64
+	Position     string
+	DepartmentID uint64
+	Department   string
+	DateBegin    time.Time
+	DateEnd      *time.Time
+}
+
+// CloseOn returns the last day of the period on moving the user to another position
+// from the date, it's the day before the date. It's nil if the period begins on the date:
+// the period is replaced then, so several changes in a day don't produce empty periods.
+// It's false if the date is before the beginning of the period,
+// the track is a chain of the periods and isn't rewritten backwards.
+func (i PositionTrackItem) CloseOn(date time.Time) (*time.Time, bool) {
+	begin, date := truncateDate(i.DateBegin), truncateDate(date)
+	switch {
+	case date.Before(begin):
+		return nil, false
+	case date.Equal(begin):
+		return nil, true
+	}
+	end := date.AddDate(0, 0, -1)
+	return &end, true
+}
+
+// CurrentPosition returns the open period of the track,
+// it's nil if there is none (the user is terminated).
+func CurrentPosition(track []PositionTrackItem) *PositionTrackItem {
+	for i := len(track) - 1; i >= 0; i-- {
+		if track[i].DateEnd == nil {
+			return &track[i]
+		}
+	}
+	return nil
+}
+
+// PositionHolder represents the user who held the position during the period.
+type PositionHolder struct {
+	UserID       uint64
+	LastName     string
+	FirstName    string
+	MiddleName   string
+	DepartmentID uint64
+	Department   string
+	DateBegin    time.Time
+	DateEnd      *time.Time
+}
@@ -0,0 +1,103 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPositionTrackItem_CloseOn(t *testing.T) {
+	item := PositionTrackItem{DateBegin: date("2024-01-10")}
+	dayBefore := date("2024-02-29")
+	firstDay := date("2024-01-10")
+
+	tests := []struct {
+		name     string
+		date     time.Time
+		want     *time.Time
+		rejected bool
+	}{
+		{name: "later", date: date("2024-03-01"), want: &dayBefore},
+		{name: "next day", date: date("2024-01-11"), want: &firstDay},
+		{name: "same day is replaced", date: date("2024-01-10")},
+		{name: "same day with time", date: date("2024-01-10").Add(15 * time.Hour)},
+		{name: "before the beginning", date: date("2024-01-09"), rejected: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := item.CloseOn(tt.date)
+			assert.Equal(t, !tt.rejected, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// move applies the change of the position to the track in the same way as the storage does.
+func move(track []PositionTrackItem, positionID uint64, d time.Time) ([]PositionTrackItem, bool) {
+	if cur := CurrentPosition(track); cur != nil {
+		end, ok := cur.CloseOn(d)
+		if !ok {
+			return track, false
+		}
+		if end == nil {
+			track = track[:len(track)-1]
+		} else {
+			cur.DateEnd = end
+		}
+	}
+	return append(track, PositionTrackItem{PositionID: positionID, DateBegin: d}), true
+}
+
+func TestPositionTrack_chain(t *testing.T) {
+	var (
+		track []PositionTrackItem
+		ok    bool
+	)
+	moves := []struct {
+		positionID uint64
+		date       string
+		wantOK     bool
+	}{
+		{positionID: 1, date: "2023-02-01", wantOK: true},
+		{positionID: 2, date: "2023-09-01", wantOK: true},
+		{positionID: 3, date: "2023-08-31", wantOK: false},
+		{positionID: 3, date: "2024-01-15", wantOK: true},
+		{positionID: 4, date: "2024-01-15", wantOK: true},
+		{positionID: 5, date: "2024-01-16", wantOK: true},
+	}
+	for _, m := range moves {
+		track, ok = move(track, m.positionID, date(m.date))
+		assert.Equal(t, m.wantOK, ok, m.date)
+	}
+
+	require.Len(t, track, 4)
+	want := []struct {
+		positionID uint64
+		begin      string
+		end        string
+	}{
+		{positionID: 1, begin: "2023-02-01", end: "2023-08-31"},
+		{positionID: 2, begin: "2023-09-01", end: "2024-01-14"},
+		{positionID: 4, begin: "2024-01-15", end: "2024-01-15"},
+		{positionID: 5, begin: "2024-01-16"},
+	}
+	for i, w := range want {
+		assert.Equal(t, w.positionID, track[i].PositionID)
+		assert.Equal(t, date(w.begin), track[i].DateBegin)
+		if w.end == "" {
+			assert.Nil(t, track[i].DateEnd)
+			continue
+		}
+		require.NotNil(t, track[i].DateEnd)
+		assert.Equal(t, date(w.end), *track[i].DateEnd)
+	}
+	// the periods follow each other without gaps and overlaps
+	for i := 1; i < len(track); i++ {
+		assert.Equal(t, track[i].DateBegin, track[i-1].DateEnd.AddDate(0, 0, 1))
+	}
+
+	assert.Equal(t, &track[3], CurrentPosition(track))
+	assert.Nil(t, CurrentPosition(track[:3]))
+}
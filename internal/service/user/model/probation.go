@@ -0,0 +1,57 @@
+package model
+
+import "time"
+
+type ProbationOutcome string
+
+const (
+	ProbationPassed   ProbationOutcome = "passed"
+	ProbationExtended ProbationOutcome = "extended"
+	ProbationFailed   ProbationOutcome = "failed"
+)
+
+// ProbationResult is the outcome of the probation period of the contract.
+type ProbationResult struct {
+	Outcome ProbationOutcome
+	// ExtendedTo is the new last day of the extended probation.
+	ExtendedTo *time.Time
+	Comment    string
+	DecidedAt  time.Time
+	Version    int64
+}
+
+// OnProbation reports whether the probation of the contract is not decided yet
+// or is extended. ProbationEnd must be set.
+func (c Contract) OnProbation() bool {
+	return c.ProbationEnd != nil &&
+		(c.ProbationResult == nil || c.ProbationResult.Outcome == ProbationExtended)
+}
+
+// ProbationEmployee represents the employee on probation under the contract.
+type ProbationEmployee struct {
+	UserID     uint64
+	LastName   string
+	FirstName  string
+	MiddleName string
+	Department string
+	Position   string
+	Contract   Contract
+	// RemindedOn is the day HR and the head were reminded of the probation end.
+	RemindedOn *time.Time
+}
+
+// EndsWithin reports whether the probation ends from the day to days days later inclusive.
+func (e ProbationEmployee) EndsWithin(date time.Time, days uint) bool {
+	if !e.Contract.OnProbation() {
+		return false
+	}
+	end, date := *e.Contract.ProbationEnd, truncateDate(date)
+	return !end.Before(date) && !end.After(date.AddDate(0, 0, int(days)))
+}
+
+// ReminderDue reports whether HR and the head are to be reminded on the day
+// about the probation ending within days days. The reminder is sent once,
+// it is repeated for the extended probation only.
+func (e ProbationEmployee) ReminderDue(date time.Time, days uint) bool {
+	return e.EndsWithin(date, days) && e.RemindedOn == nil
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	cmodel "github.com/Employee-s-file-cabinet/backend/internal/service/calendar/model"
+)
+
+func TestProbationEmployee_ReminderDue(t *testing.T) {
+	cal := cmodel.New(nil, nil)
+	three := uint(3)
+	extendedTo := date("2024-05-31")
+	reminded := date("2024-04-05")
+
+	tests := []struct {
+		name     string
+		result   *ProbationResult
+		reminded bool
+		date     string
+		want     bool
+	}{
+		{name: "too early", date: "2024-03-31", want: false},
+		{name: "first day of the window", date: "2024-04-01", want: true},
+		{name: "last day", date: "2024-04-15", want: true},
+		{name: "already ended", date: "2024-04-16", want: false},
+		{name: "already reminded", reminded: true, date: "2024-04-10", want: false},
+		{name: "passed", result: &ProbationResult{Outcome: ProbationPassed}, date: "2024-04-10", want: false},
+		{name: "extended, the old end", result: &ProbationResult{Outcome: ProbationExtended, ExtendedTo: &extendedTo},
+			date: "2024-04-10", want: false},
+		{name: "extended, the new end", result: &ProbationResult{Outcome: ProbationExtended, ExtendedTo: &extendedTo},
+			date: "2024-05-20", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := ProbationEmployee{Contract: Contract{
+				DateBegin:       date("2024-01-15"),
+				ProbationPeriod: &three,
+				ProbationResult: tt.result,
+			}}
+			e.Contract.SetProbationEnd(cal)
+			if tt.reminded {
+				e.RemindedOn = &reminded
+			}
+			assert.Equal(t, tt.want, e.ReminderDue(date(tt.date), 14))
+		})
+	}
+}
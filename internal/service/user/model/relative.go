@@ -0,0 +1,76 @@
+package model
+
+import "time"
+
+type RelationType string
+
+const (
+	RelationTypeSpouse RelationType = "spouse"
+	RelationTypeChild  RelationType = "child"
+	RelationTypeParent RelationType = "parent"
+	RelationTypeOther  RelationType = "other"
+)
+
+// Relative is a family member or a dependent of the employee. The certificate
+// of the relation (the marriage certificate of the spouse or the birth certificate
+// of the child) is kept as the scan of the corresponding type.
+type Relative struct {
+	ID          uint64
+	Type        RelationType
+	LastName    string
+	FirstName   string
+	MiddleName  string
+	DateOfBirth time.Time
+	// Disabled is set for a disabled child, it affects the tax deduction and the extra leave.
+	Disabled bool
+	HasScan  bool
+	Version  int64
+}
+
+// Age returns the number of full years of the relative on the date.
+func (r Relative) Age(date time.Time) int {
+	age := date.Year() - r.DateOfBirth.Year()
+	if date.Month() < r.DateOfBirth.Month() ||
+		date.Month() == r.DateOfBirth.Month() && date.Day() < r.DateOfBirth.Day() {
+		age--
+	}
+	return age
+}
+
+// Family summarizes the facts about the employee's children HR needs on the date.
+type Family struct {
+	// ChildrenUnder14 is the number of the children under 14 years.
+	ChildrenUnder14 int
+	// ChildrenUnder18 is the number of the children under 18 years,
+	// the standard tax deduction is provided for each of them.
+	ChildrenUnder18 int
+	// DisabledChildrenUnder18 is the number of the disabled children under 18 years.
+	DisabledChildrenUnder18 int
+	// ExtraLeaveEligible reports whether the employee is entitled to the additional
+	// unpaid leave of up to 14 days: two or more children under 14 years
+	// or a disabled child under 18 years (art. 263 of the Labor Code).
+	ExtraLeaveEligible bool
+}
+
+// SummarizeFamily returns the facts about the children of the relatives on the date,
+// the children born after the date are not counted.
+func SummarizeFamily(relatives []Relative, date time.Time) Family {
+	var f Family
+	for _, r := range relatives {
+		if r.Type != RelationTypeChild || r.DateOfBirth.After(date) {
+			continue
+		}
+		age := r.Age(date)
+		if age < 14 {
+			f.ChildrenUnder14++
+		}
+		if age < 18 {
+			f.ChildrenUnder18++
+			if r.Disabled {
+				f.DisabledChildrenUnder18++
+			}
+		}
+	}
+	f.ExtraLeaveEligible = f.ChildrenUnder14 >= 2 || f.DisabledChildrenUnder18 > 0
+	return f
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRelative_Age(t *testing.T) {
+	r := Relative{DateOfBirth: time.Date(2010, time.March, 15, 0, 0, 0, 0, time.UTC)}
+
+	assert.Equal(t, 13, r.Age(time.Date(2024, time.March, 14, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 14, r.Age(time.Date(2024, time.March, 15, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, 14, r.Age(time.Date(2024, time.December, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestSummarizeFamily(t *testing.T) {
+	date := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+	child := func(year int, disabled bool) Relative {
+		return Relative{
+			Type:        RelationTypeChild,
+			DateOfBirth: time.Date(year, time.June, 1, 0, 0, 0, 0, time.UTC),
+			Disabled:    disabled,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		relatives []Relative
+		want      Family
+	}{
+		{
+			name:      "no children",
+			relatives: []Relative{{Type: RelationTypeSpouse, DateOfBirth: time.Date(1990, 1, 1, 0, 0, 0, 0, time.UTC)}},
+			want:      Family{},
+		},
+		{
+			name:      "one child under 14",
+			relatives: []Relative{child(2015, false)},
+			want:      Family{ChildrenUnder14: 1, ChildrenUnder18: 1},
+		},
+		{
+			name:      "two children under 14 and an adult",
+			relatives: []Relative{child(2015, false), child(2018, false), child(2000, false)},
+			want:      Family{ChildrenUnder14: 2, ChildrenUnder18: 2, ExtraLeaveEligible: true},
+		},
+		{
+			name:      "disabled teenager",
+			relatives: []Relative{child(2008, true)},
+			want:      Family{ChildrenUnder18: 1, DisabledChildrenUnder18: 1, ExtraLeaveEligible: true},
+		},
+		{
+			name:      "child not born yet",
+			relatives: []Relative{child(2024, false)},
+			want:      Family{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, SummarizeFamily(tt.relatives, date))
+		})
+	}
+}
@@ -26,5 +26,8 @@ const (
 	ScanTypeWorkPermit ScanType = "work_permit"
 	ScanTypeMarriage   ScanType = "marriage"
 	ScanTypeBabyBirth  ScanType = "baby_birth"
+	ScanTypeAbsence    ScanType = "absence"
+	ScanTypeAmendment  ScanType = "amendment"
+	ScanTypeOrder      ScanType = "order"
 	ScanTypeOther      ScanType = "other"
 )
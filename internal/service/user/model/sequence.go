@@ -0,0 +1,73 @@
+package model
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SequenceEntity is the kind of the numbers allocated by the number sequence.
+type SequenceEntity string
+
+const (
+	SequencePersonnelNumber SequenceEntity = "personnel_number" // табельный номер
+	SequenceContractNumber  SequenceEntity = "contract_number"
+	// SequenceOrderNumber numbers the signed HR orders, there are several sequences
+	// of the order numbers, each numbers its order types.
+	SequenceOrderNumber SequenceEntity = "order_number"
+)
+
+// NumberSequence is the format of the numbers of the entity.
+// The numbers are allocated without gaps: the counter is increased
+// in the transaction adding (signing) the entity.
+type NumberSequence struct {
+	ID     uint64
+	Entity SequenceEntity
+	Title  string
+	Prefix string
+	Suffix string
+	// WithYear adds the year after the prefix, e.g. ТД-2024-0001.
+	WithYear bool
+	// Padding is the minimal number of digits, the number is padded with zeros.
+	Padding uint
+	// YearlyReset starts the numbers from 1 every year.
+	YearlyReset bool
+	// Types are the order types numbered by the sequence of the order numbers.
+	Types []OrderType
+}
+
+// Period returns the period of the counter of the year:
+// the year if the numbers start from 1 every year, otherwise 0.
+func (s NumberSequence) Period(year int) int {
+	if s.YearlyReset {
+		return year
+	}
+	return 0
+}
+
+// Format returns the n-th number of the sequence in the year.
+func (s NumberSequence) Format(year int, n uint64) string {
+	var b strings.Builder
+	b.WriteString(s.Prefix)
+	if s.WithYear {
+		fmt.Fprintf(&b, "%d-", year)
+	}
+	fmt.Fprintf(&b, "%0*d", s.Padding, n)
+	b.WriteString(s.Suffix)
+	return b.String()
+}
+
+// NextFree returns the first number of the sequence in the year after the last one
+// that isn't used yet, e.g. typed by hand. used reports whether the formatted number is used.
+func (s NumberSequence) NextFree(year int, last uint64,
+	used func(number string) (bool, error)) (uint64, string, error) {
+	for n := last + 1; ; n++ {
+		number := s.Format(year, n)
+		u, err := used(number)
+		if err != nil {
+			return 0, "", err
+		}
+		if !u {
+			return n, number, nil
+		}
+	}
+}
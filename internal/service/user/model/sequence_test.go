@@ -0,0 +1,46 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumberSequence_Format(t *testing.T) {
+	personnel := NumberSequence{Padding: 6}
+	assert.Equal(t, "000042", personnel.Format(2024, 42))
+	assert.Equal(t, "1234567", personnel.Format(2024, 1234567))
+	assert.Equal(t, 0, personnel.Period(2024))
+
+	contract := NumberSequence{Prefix: "ТД-", WithYear: true, Padding: 4, YearlyReset: true}
+	assert.Equal(t, "ТД-2024-0001", contract.Format(2024, 1))
+	assert.Equal(t, 2024, contract.Period(2024))
+
+	plain := NumberSequence{Prefix: "№"}
+	assert.Equal(t, "№7", plain.Format(2024, 7))
+
+	order := NumberSequence{Entity: SequenceOrderNumber, Suffix: "-к", YearlyReset: true}
+	assert.Equal(t, "12-к", order.Format(2024, 12))
+}
+
+func TestNumberSequence_NextFree(t *testing.T) {
+	contract := NumberSequence{Prefix: "ТД-", WithYear: true, Padding: 4, YearlyReset: true}
+	typed := map[string]bool{"ТД-2024-0005": true, "ТД-2024-0006": true}
+	used := func(number string) (bool, error) { return typed[number], nil }
+
+	n, number, err := contract.NextFree(2024, 3, used)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(4), n)
+	assert.Equal(t, "ТД-2024-0004", number)
+
+	// the numbers typed by hand are skipped
+	n, number, err = contract.NextFree(2024, 4, used)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), n)
+	assert.Equal(t, "ТД-2024-0007", number)
+
+	_, _, err = contract.NextFree(2024, 4, func(string) (bool, error) { return false, errors.New("db is down") })
+	assert.Error(t, err)
+}
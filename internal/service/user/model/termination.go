@@ -0,0 +1,51 @@
+package model
+
+import "time"
+
+// Termination represents dismissal of the employee.
+type Termination struct {
+	Date   time.Time // the last working day
+	Reason TerminationReason
+}
+
+// IsFuture reports whether the last working day is after the day of now.
+// The access is revoked at the termination, so it can't be registered in advance.
+func (t Termination) IsFuture(now time.Time) bool {
+	y, m, d := now.Date()
+	return t.Date.After(time.Date(y, m, d, 0, 0, 0, 0, t.Date.Location()))
+}
+
+// StartsAfter returns the latest beginning of the current position or of a contract
+// not ended by the last working day if it's after the day: the termination
+// would leave them open. It's false if everything begins by the last working day.
+func (t Termination) StartsAfter(contracts []Contract, position *PositionTrackItem) (time.Time, bool) {
+	date := truncateDate(t.Date)
+	var latest time.Time
+	after := func(begin time.Time) {
+		if begin = truncateDate(begin); begin.After(date) && begin.After(latest) {
+			latest = begin
+		}
+	}
+	for _, c := range contracts {
+		if c.DateEnd == nil || truncateDate(*c.DateEnd).After(date) {
+			after(c.DateBegin)
+		}
+	}
+	if position != nil {
+		after(position.DateBegin)
+	}
+	return latest, !latest.IsZero()
+}
+
+// TerminationReason is a legal reason of the termination
+// (part 1 of article 77 of the Labour Code).
+type TerminationReason string
+
+const (
+	TerminationReasonAgreement          TerminationReason = "agreement"           // clause 1
+	TerminationReasonContractExpiry     TerminationReason = "contract_expiry"     // clause 2
+	TerminationReasonEmployeeInitiative TerminationReason = "employee_initiative" // clause 3
+	TerminationReasonEmployerInitiative TerminationReason = "employer_initiative" // clause 4
+	TerminationReasonTransfer           TerminationReason = "transfer"            // clause 5
+	TerminationReasonCircumstances      TerminationReason = "circumstances"       // clause 10
+)
@@ -0,0 +1,58 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTermination_IsFuture(t *testing.T) {
+	now := time.Date(2024, 3, 15, 18, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		date string
+		want bool
+	}{
+		{name: "yesterday", date: "2024-03-14", want: false},
+		{name: "today", date: "2024-03-15", want: false},
+		{name: "tomorrow", date: "2024-03-16", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Termination{Date: date(tt.date)}.IsFuture(now))
+		})
+	}
+}
+
+func TestTermination_StartsAfter(t *testing.T) {
+	ended := date("2024-02-29")
+	contracts := []Contract{
+		{DateBegin: date("2023-01-10"), DateEnd: &ended},
+		{DateBegin: date("2024-03-01")},
+	}
+	position := &PositionTrackItem{DateBegin: date("2024-03-11")}
+
+	tests := []struct {
+		name     string
+		date     string
+		position *PositionTrackItem
+		want     string
+	}{
+		{name: "after everything", date: "2024-03-15", position: position},
+		{name: "first day of the position", date: "2024-03-11", position: position},
+		{name: "before the position", date: "2024-03-05", position: position, want: "2024-03-11"},
+		{name: "before the contract", date: "2024-02-20", want: "2024-03-01"},
+		{name: "before both", date: "2024-02-20", position: position, want: "2024-03-11"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := Termination{Date: date(tt.date)}.StartsAfter(contracts, tt.position)
+			assert.Equal(t, tt.want != "", ok)
+			if tt.want != "" {
+				assert.Equal(t, date(tt.want), got)
+			}
+		})
+	}
+}
@@ -0,0 +1,205 @@
+package model
+
+import "time"
+
+// TimesheetCode is the letter code of the day in the timesheet (form T-13).
+type TimesheetCode string
+
+const (
+	TimesheetCodeWork         TimesheetCode = "Я"  // attendance
+	TimesheetCodeWeekend      TimesheetCode = "В"  // weekend or non-working public holiday
+	TimesheetCodeVacation     TimesheetCode = "ОТ" // annual paid leave
+	TimesheetCodeSick         TimesheetCode = "Б"  // sick leave
+	TimesheetCodeBusinessTrip TimesheetCode = "К"  // business trip
+	TimesheetCodeUnpaid       TimesheetCode = "ДО" // unpaid leave granted by the employer
+	TimesheetCodeStudy        TimesheetCode = "У"  // paid study leave
+	TimesheetCodeTruancy      TimesheetCode = "ПР" // truancy
+	TimesheetCodeUnknown      TimesheetCode = "НН" // absence for unknown reasons
+)
+
+var absenceTimesheetCodes = map[AbsenceType]TimesheetCode{
+	AbsenceTypeSick:         TimesheetCodeSick,
+	AbsenceTypeUnpaid:       TimesheetCodeUnpaid,
+	AbsenceTypeBusinessTrip: TimesheetCodeBusinessTrip,
+	AbsenceTypeStudy:        TimesheetCodeStudy,
+}
+
+// TimesheetCorrection replaces the code and the hours of the day of the employee in the timesheet,
+// the note explains the correction.
+type TimesheetCorrection struct {
+	ID          uint64
+	UserID      uint64
+	Date        time.Time
+	Code        TimesheetCode
+	Hours       int
+	Note        string
+	CorrectedBy uint64
+	CorrectedAt time.Time
+}
+
+// TimesheetEmployee is the employee of the timesheet with the data of the month.
+type TimesheetEmployee struct {
+	UserID       uint64
+	LastName     string
+	FirstName    string
+	MiddleName   string
+	Position     string
+	TerminatedAt *time.Time
+	// Positions are the periods of the positions held in the department.
+	Positions   []PositionTrackItem
+	Contracts   []Contract
+	Vacations   []Vacation
+	Absences    []Absence
+	Corrections []TimesheetCorrection
+}
+
+// employed reports whether the date is within a position of the employee in the department,
+// within a contract and before the termination. The employee without contracts isn't employed.
+func (e TimesheetEmployee) employed(date time.Time) bool {
+	if e.TerminatedAt != nil && date.After(truncateDate(*e.TerminatedAt)) {
+		return false
+	}
+	if !e.inDepartment(date) {
+		return false
+	}
+	for _, c := range e.Contracts {
+		if date.Before(truncateDate(c.DateBegin)) {
+			continue
+		}
+		if c.DateEnd == nil || !date.After(truncateDate(*c.DateEnd)) {
+			return true
+		}
+	}
+	return false
+}
+
+// inDepartment reports whether the date is within a position held in the department.
+func (e TimesheetEmployee) inDepartment(date time.Time) bool {
+	for _, p := range e.Positions {
+		if date.Before(truncateDate(p.DateBegin)) {
+			continue
+		}
+		if p.DateEnd == nil || !date.After(truncateDate(*p.DateEnd)) {
+			return true
+		}
+	}
+	return false
+}
+
+// TimesheetDay is the day of the employee in the timesheet,
+// the code is empty for the days out of the employment.
+type TimesheetDay struct {
+	Date      time.Time
+	Code      TimesheetCode
+	Hours     int
+	Corrected bool
+	Note      string
+}
+
+// TimesheetRow is the employee days of the month.
+type TimesheetRow struct {
+	UserID     uint64
+	LastName   string
+	FirstName  string
+	MiddleName string
+	Position   string
+	Days       []TimesheetDay
+}
+
+// WorkedDays returns the number of the days with the attendance or the business trip.
+func (r TimesheetRow) WorkedDays() int {
+	var n int
+	for _, d := range r.Days {
+		if d.Code == TimesheetCodeWork || d.Code == TimesheetCodeBusinessTrip {
+			n++
+		}
+	}
+	return n
+}
+
+// WorkedHours returns the number of the worked hours.
+func (r TimesheetRow) WorkedHours() int {
+	var n int
+	for _, d := range r.Days {
+		n += d.Hours
+	}
+	return n
+}
+
+// Empty reports whether the employee wasn't employed during the whole month.
+func (r TimesheetRow) Empty() bool {
+	for _, d := range r.Days {
+		if d.Code != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// Timesheet is the monthly timesheet of the department.
+type Timesheet struct {
+	DepartmentID uint64
+	Department   string
+	Year         int
+	Month        time.Month
+	Rows         []TimesheetRow
+}
+
+// TimesheetPeriod returns the first and the last day of the month.
+func TimesheetPeriod(year int, month time.Month) (time.Time, time.Time) {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	return first, first.AddDate(0, 1, -1)
+}
+
+// BuildTimesheetRow fills the days of the month for the employee. The correction of the day
+// takes precedence, then the absence, the vacation and the production calendar
+// (a public holiday within the vacation is a weekend as it isn't counted as a vacation day).
+// The days out of the positions in the department and the employment contracts are left empty.
+func BuildTimesheetRow(e TimesheetEmployee, year int, month time.Month, cal Calendar) TimesheetRow {
+	first, last := TimesheetPeriod(year, month)
+	row := TimesheetRow{
+		UserID:     e.UserID,
+		LastName:   e.LastName,
+		FirstName:  e.FirstName,
+		MiddleName: e.MiddleName,
+		Position:   e.Position,
+		Days:       make([]TimesheetDay, 0, last.Day()),
+	}
+
+	byDate := make(map[time.Time]TimesheetCorrection, len(e.Corrections))
+	for _, c := range e.Corrections {
+		byDate[truncateDate(c.Date)] = c
+	}
+
+	for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+		day := TimesheetDay{Date: d}
+		if c, ok := byDate[d]; ok {
+			day.Code, day.Hours, day.Corrected, day.Note = c.Code, c.Hours, true, c.Note
+			row.Days = append(row.Days, day)
+			continue
+		}
+		if e.employed(d) {
+			day.Code, day.Hours = e.timesheetCode(d, cal)
+		}
+		row.Days = append(row.Days, day)
+	}
+	return row
+}
+
+func (e TimesheetEmployee) timesheetCode(d time.Time, cal Calendar) (TimesheetCode, int) {
+	day := Vacation{DateBegin: d, DateEnd: d}
+	for _, a := range e.Absences {
+		if a.Overlaps(day) {
+			return absenceTimesheetCodes[a.Type], 0
+		}
+	}
+	for _, v := range e.Vacations {
+		if v.Overlaps(day) && !cal.IsHoliday(d) {
+			return TimesheetCodeVacation, 0
+		}
+	}
+	if hours := cal.WorkingHours(d); hours > 0 {
+		return TimesheetCodeWork, hours
+	}
+	return TimesheetCodeWeekend, 0
+}
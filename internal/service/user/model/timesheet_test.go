@@ -0,0 +1,55 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	cmodel "github.com/Employee-s-file-cabinet/backend/internal/service/calendar/model"
+)
+
+func TestBuildTimesheetRow(t *testing.T) {
+	cal := cmodel.New([]int{2024}, []cmodel.Day{
+		{Date: date("2024-02-22"), Type: cmodel.DayTypeShortened},
+		{Date: date("2024-02-23"), Type: cmodel.DayTypeHoliday},
+	})
+	contractEnd := date("2024-02-27")
+	e := TimesheetEmployee{
+		UserID:      1,
+		Positions:   []PositionTrackItem{{DateBegin: date("2023-06-01")}},
+		Contracts:   []Contract{{DateBegin: date("2024-02-02"), DateEnd: &contractEnd}},
+		Vacations:   []Vacation{{DateBegin: date("2024-02-19"), DateEnd: date("2024-02-25")}},
+		Absences:    []Absence{{Type: AbsenceTypeSick, DateBegin: date("2024-02-07"), DateEnd: date("2024-02-08")}},
+		Corrections: []TimesheetCorrection{{Date: date("2024-02-12"), Code: TimesheetCodeUnknown, Note: "no show"}},
+	}
+
+	row := BuildTimesheetRow(e, 2024, 2, cal)
+
+	codes := make([]TimesheetCode, len(row.Days))
+	for i, d := range row.Days {
+		codes[i] = d.Code
+	}
+	assert.Equal(t, []TimesheetCode{
+		"", "Я", "В", "В", "Я", "Я", "Б", "Б", "Я", "В", // 1-10
+		"В", "НН", "Я", "Я", "Я", "Я", "В", "В", "ОТ", "ОТ", // 11-20
+		"ОТ", "ОТ", "В", "ОТ", "ОТ", "Я", "Я", "", "", // 21-29
+	}, codes)
+	assert.True(t, row.Days[11].Corrected)
+	assert.Equal(t, 10, row.WorkedDays())
+	assert.Equal(t, 80, row.WorkedHours())
+
+	// transferred to another department in the middle of the month
+	transferred := date("2024-02-15")
+	e.Positions = []PositionTrackItem{{DateBegin: date("2023-06-01"), DateEnd: &transferred}}
+	row = BuildTimesheetRow(e, 2024, 2, cal)
+	assert.Equal(t, TimesheetCodeWork, row.Days[14].Code)
+	assert.Empty(t, row.Days[15].Code)
+	assert.Equal(t, 7, row.WorkedDays())
+
+	// the employee without contracts isn't employed, only the correction is kept
+	e.Contracts = nil
+	row = BuildTimesheetRow(e, 2024, 2, cal)
+	assert.Empty(t, row.Days[1].Code)
+	assert.Equal(t, TimesheetCodeUnknown, row.Days[11].Code)
+	assert.Zero(t, row.WorkedDays())
+}
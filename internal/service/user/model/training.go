@@ -10,4 +10,5 @@ type Training struct {
 	DateTo            time.Time
 	DateFrom          time.Time
 	HasScan           bool
+	Version           int64
 }
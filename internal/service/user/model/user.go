@@ -2,6 +2,8 @@ package model
 
 import (
 	"time"
+
+	"github.com/Employee-s-file-cabinet/backend/pkg/docnumber"
 )
 
 type ShortUserInfo struct {
@@ -13,6 +15,7 @@ type ShortUserInfo struct {
 	Position     string
 	Email        string
 	PhoneNumbers map[string]string
+	Termination  *Termination // nil if the user is a current employee
 }
 
 type Insurance struct {
@@ -20,17 +23,28 @@ type Insurance struct {
 	HasScan bool
 }
 
+// ValidNumber reports whether the SNILS has the correct control digits.
+func (i Insurance) ValidNumber() bool {
+	return docnumber.SNILS(i.Number)
+}
+
 type Taxpayer struct {
 	Number  string
 	HasScan bool
 }
 
+// ValidNumber reports whether the number is a personal INN with the correct control digits.
+func (t Taxpayer) ValidNumber() bool {
+	return docnumber.PersonalINN(t.Number)
+}
+
 type Military struct {
 	Rank         string
 	Speciality   string
 	Category     string
 	Commissariat string
 	HasScan      bool
+	Version      int64
 }
 
 type PersonalDataProcessing struct {
@@ -39,19 +53,29 @@ type PersonalDataProcessing struct {
 
 type User struct {
 	ShortUserInfo
-	Gender                 gender
-	DateOfBirth            time.Time
-	PlaceOfBirth           string
-	Grade                  string
-	RegistrationAddress    string
-	ResidentialAddress     string
-	Nationality            string
-	Insurance              Insurance
-	Taxpayer               Taxpayer
-	PositionID             uint64
-	DepartmentID           uint64
-	Military               Military
+	// PersonnelNumber is the unique number of the employee (табельный номер),
+	// allocated by the sequence if empty on adding.
+	PersonnelNumber     string
+	Gender              gender
+	DateOfBirth         time.Time
+	PlaceOfBirth        string
+	Grade               string
+	RegistrationAddress string
+	ResidentialAddress  string
+	Nationality         string
+	Insurance           Insurance
+	Taxpayer            Taxpayer
+	PositionID          uint64
+	DepartmentID        uint64
+	// PositionDate is the date the department and the position are held from,
+	// today if nil. It's used on adding the user and on changing the position only.
+	PositionDate           *time.Time
+	Military               *Military   // nil if the user is not liable for military service
+	WorkPermit             *WorkPermit // the current work permit, nil if the user has none
 	PersonalDataProcessing PersonalDataProcessing
+	PositionTrack          []PositionTrackItem
+	// Version is incremented on every change of the user, it is the ETag of the user card.
+	Version int64
 }
 
 // gender represents user gender.
@@ -70,4 +94,7 @@ type ExpandedUser struct {
 	Passports  []ExpandedPassport
 	Contracts  []Contract
 	Vacations  []Vacation
+	Relatives  []Relative
+	// Family is derived from the relatives on the current date.
+	Family Family
 }
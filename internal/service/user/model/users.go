@@ -5,10 +5,11 @@ import "errors"
 const defaultLimitUsers = 10
 
 type ListUsersParams struct {
-	Limit  uint
-	Query  string
-	Page   uint
-	SortBy ListUsersParamsSortBy
+	Limit             uint
+	Query             string
+	Page              uint
+	SortBy            ListUsersParamsSortBy
+	IncludeTerminated bool
 }
 
 type ListUsersParamsSortBy string
@@ -43,6 +44,14 @@ func WithQuery(query string) ListUsersParamsOption {
 	}
 }
 
+// WithTerminated includes former employees.
+func WithTerminated(include bool) ListUsersParamsOption {
+	return func(pms *ListUsersParams) error {
+		pms.IncludeTerminated = include
+		return nil
+	}
+}
+
 func SortBy(by ListUsersParamsSortBy) ListUsersParamsOption {
 	return func(pms *ListUsersParams) error {
 		switch by {
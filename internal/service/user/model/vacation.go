@@ -6,4 +6,74 @@ type Vacation struct {
 	ID        uint64
 	DateBegin time.Time
 	DateEnd   time.Time
+	Version   int64
+}
+
+// Overlaps reports whether the vacations have common days.
+func (v Vacation) Overlaps(o Vacation) bool {
+	return !truncateDate(v.DateBegin).After(truncateDate(o.DateEnd)) &&
+		!truncateDate(o.DateBegin).After(truncateDate(v.DateEnd))
+}
+
+// FindOverlap returns the first of the vacations overlapping v,
+// the vacation with the ID of v is skipped.
+func FindOverlap(vacations []Vacation, v Vacation) (Vacation, bool) {
+	for _, o := range vacations {
+		if o.ID != 0 && o.ID == v.ID {
+			continue
+		}
+		if o.Overlaps(v) {
+			return o, true
+		}
+	}
+	return Vacation{}, false
+}
+
+// OtherVacations returns the vacations except the vacation with the ID of v.
+func OtherVacations(vacations []Vacation, v Vacation) []Vacation {
+	others := make([]Vacation, 0, len(vacations))
+	for _, o := range vacations {
+		if o.ID != 0 && o.ID == v.ID {
+			continue
+		}
+		others = append(others, o)
+	}
+	return others
+}
+
+// MaxAbsent returns the maximum number of the vacations
+// covering the same day from the date begin to the date end inclusive.
+func MaxAbsent(vacations []Vacation, begin, end time.Time) int {
+	var max int
+	for d := truncateDate(begin); !d.After(truncateDate(end)); d = d.AddDate(0, 0, 1) {
+		var n int
+		for _, v := range vacations {
+			if !d.Before(truncateDate(v.DateBegin)) && !d.After(truncateDate(v.DateEnd)) {
+				n++
+			}
+		}
+		if n > max {
+			max = n
+		}
+	}
+	return max
+}
+
+// EmployeeVacation is a vacation with the employee data.
+type EmployeeVacation struct {
+	Vacation
+	UserID     uint64
+	LastName   string
+	FirstName  string
+	MiddleName string
+	Department string
+	Position   string
+}
+
+// ListVacationsParams filters the vacations of all employees:
+// the vacations having common days with the period are returned.
+type ListVacationsParams struct {
+	DepartmentID *uint64
+	DateFrom     time.Time
+	DateTo       time.Time
 }
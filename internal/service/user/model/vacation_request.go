@@ -0,0 +1,94 @@
+package model
+
+import "time"
+
+// RequestStatus is the status of the vacation request.
+type RequestStatus string
+
+const (
+	RequestStatusDraft     RequestStatus = "draft"
+	RequestStatusPending   RequestStatus = "pending"
+	RequestStatusApproved  RequestStatus = "approved"
+	RequestStatusRejected  RequestStatus = "rejected"
+	RequestStatusCancelled RequestStatus = "cancelled"
+)
+
+// RequestAction is an action changing the status of the vacation request.
+type RequestAction string
+
+const (
+	RequestActionSubmit  RequestAction = "submit"  // by the employee: draft -> pending
+	RequestActionCancel  RequestAction = "cancel"  // by the employee: draft, pending -> cancelled
+	RequestActionApprove RequestAction = "approve" // by the head: to HR, by HR: pending -> approved
+	RequestActionReject  RequestAction = "reject"  // by the head or HR: pending -> rejected
+)
+
+// Actor is the user acting on vacation requests.
+type Actor struct {
+	UserID uint64
+	HR     bool // the user decides the requests approved by the heads
+}
+
+// VacationRequest is the request of the employee for a vacation.
+// The pending request is decided by the head of the department (if any)
+// and then by HR, the approved request becomes the vacation.
+type VacationRequest struct {
+	ID              uint64
+	UserID          uint64
+	LastName        string
+	FirstName       string
+	MiddleName      string
+	Email           string
+	DateBegin       time.Time
+	DateEnd         time.Time
+	Comment         string
+	Status          RequestStatus
+	HeadID          *uint64
+	HeadApprovedAt  *time.Time
+	SubmittedAt     *time.Time
+	DecidedBy       *uint64
+	DecidedAt       *time.Time
+	DecisionComment string
+	VacationID      *uint64
+}
+
+// AwaitsHead reports whether the request is to be decided by the head.
+func (r *VacationRequest) AwaitsHead() bool {
+	return r.Status == RequestStatusPending && r.HeadID != nil && r.HeadApprovedAt == nil
+}
+
+// AwaitsHR reports whether the request is to be decided by HR.
+func (r *VacationRequest) AwaitsHR() bool {
+	return r.Status == RequestStatusPending && !r.AwaitsHead()
+}
+
+// CanView reports whether the request is available to the actor.
+func (r *VacationRequest) CanView(a Actor) bool {
+	return a.HR || r.UserID == a.UserID || (r.HeadID != nil && *r.HeadID == a.UserID)
+}
+
+// Can reports whether the actor is allowed to do the action with the request in its current status.
+func (r *VacationRequest) Can(a Actor, action RequestAction) bool {
+	switch action {
+	case RequestActionSubmit:
+		return r.UserID == a.UserID && r.Status == RequestStatusDraft
+	case RequestActionCancel:
+		return r.UserID == a.UserID &&
+			(r.Status == RequestStatusDraft || r.Status == RequestStatusPending)
+	case RequestActionApprove, RequestActionReject:
+		if r.AwaitsHead() {
+			return *r.HeadID == a.UserID
+		}
+		return r.AwaitsHR() && a.HR && r.UserID != a.UserID
+	default:
+		return false
+	}
+}
+
+// Recipient is the user notified about vacation requests.
+type Recipient struct {
+	UserID    uint64
+	FirstName string
+	LastName  string
+	Email     string
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVacationRequest_Can(t *testing.T) {
+	headID := uint64(1)
+	approvedAt := time.Now()
+	employee := Actor{UserID: 5}
+	head := Actor{UserID: 1}
+	hr := Actor{UserID: 2, HR: true}
+
+	draft := VacationRequest{UserID: 5, Status: RequestStatusDraft}
+	toHead := VacationRequest{UserID: 5, Status: RequestStatusPending, HeadID: &headID}
+	toHR := VacationRequest{UserID: 5, Status: RequestStatusPending, HeadID: &headID, HeadApprovedAt: &approvedAt}
+	noHead := VacationRequest{UserID: 5, Status: RequestStatusPending}
+	ownHR := VacationRequest{UserID: 2, Status: RequestStatusPending}
+	approved := VacationRequest{UserID: 5, Status: RequestStatusApproved}
+
+	tests := []struct {
+		name   string
+		r      VacationRequest
+		a      Actor
+		action RequestAction
+		want   bool
+	}{
+		{"employee submits draft", draft, employee, RequestActionSubmit, true},
+		{"head submits draft", draft, head, RequestActionSubmit, false},
+		{"employee cancels pending", toHead, employee, RequestActionCancel, true},
+		{"employee cancels approved", approved, employee, RequestActionCancel, false},
+		{"head approves", toHead, head, RequestActionApprove, true},
+		{"hr approves before head", toHead, hr, RequestActionApprove, false},
+		{"hr approves after head", toHR, hr, RequestActionApprove, true},
+		{"head approves twice", toHR, head, RequestActionApprove, false},
+		{"hr rejects without head", noHead, hr, RequestActionReject, true},
+		{"hr approves own", ownHR, hr, RequestActionApprove, false},
+		{"employee approves own", noHead, employee, RequestActionApprove, false},
+		{"hr rejects approved", approved, hr, RequestActionReject, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Can(tt.a, tt.action); got != tt.want {
+				t.Errorf("Can() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
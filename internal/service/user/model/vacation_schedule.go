@@ -0,0 +1,70 @@
+package model
+
+import "time"
+
+// ScheduleStatus is the status of the yearly vacation schedule.
+type ScheduleStatus string
+
+const (
+	ScheduleStatusDraft    ScheduleStatus = "draft"
+	ScheduleStatusApproved ScheduleStatus = "approved"
+	ScheduleStatusLocked   ScheduleStatus = "locked"
+)
+
+// CanChangeTo reports whether the schedule can be moved to the status.
+// The approved schedule is returned to draft to be changed,
+// the locked schedule is final.
+func (s ScheduleStatus) CanChangeTo(to ScheduleStatus) bool {
+	switch s {
+	case ScheduleStatusDraft:
+		return to == ScheduleStatusApproved
+	case ScheduleStatusApproved:
+		return to == ScheduleStatusDraft || to == ScheduleStatusLocked
+	default:
+		return false
+	}
+}
+
+// VacationSchedule is the yearly vacation schedule (график отпусков).
+type VacationSchedule struct {
+	Year       int
+	Status     ScheduleStatus
+	ApprovedAt *time.Time
+	LockedAt   *time.Time
+	Items      []ScheduleItem
+}
+
+// ScheduleItem is a planned vacation of the employee.
+type ScheduleItem struct {
+	Vacation
+	UserID       uint64
+	LastName     string
+	FirstName    string
+	MiddleName   string
+	DepartmentID uint64
+	Department   string
+	Position     string
+}
+
+// UserVacations returns the planned vacations of the employee.
+func (s *VacationSchedule) UserVacations(userID uint64) []Vacation {
+	var vs []Vacation
+	for _, it := range s.Items {
+		if it.UserID == userID {
+			vs = append(vs, it.Vacation)
+		}
+	}
+	return vs
+}
+
+// DepartmentVacations returns the planned vacations of the department employees
+// except the employee.
+func (s *VacationSchedule) DepartmentVacations(departmentID, exceptUserID uint64) []Vacation {
+	var vs []Vacation
+	for _, it := range s.Items {
+		if it.DepartmentID == departmentID && it.UserID != exceptUserID {
+			vs = append(vs, it.Vacation)
+		}
+	}
+	return vs
+}
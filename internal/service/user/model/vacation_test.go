@@ -0,0 +1,80 @@
+package model
+
+import "testing"
+
+func TestFindOverlap(t *testing.T) {
+	vs := []Vacation{
+		{ID: 1, DateBegin: date("2024-02-12"), DateEnd: date("2024-02-25")},
+		{ID: 2, DateBegin: date("2024-08-05"), DateEnd: date("2024-08-18")},
+	}
+
+	tests := []struct {
+		name string
+		v    Vacation
+		want uint64
+		ok   bool
+	}{
+		{"before", Vacation{DateBegin: date("2024-02-01"), DateEnd: date("2024-02-11")}, 0, false},
+		{"last day", Vacation{DateBegin: date("2024-02-25"), DateEnd: date("2024-03-01")}, 1, true},
+		{"inside", Vacation{DateBegin: date("2024-08-10"), DateEnd: date("2024-08-11")}, 2, true},
+		{"itself", Vacation{ID: 2, DateBegin: date("2024-08-01"), DateEnd: date("2024-08-20")}, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := FindOverlap(vs, tt.v)
+			if ok != tt.ok || got.ID != tt.want {
+				t.Errorf("FindOverlap() = %d, %v, want %d, %v", got.ID, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestOtherVacations(t *testing.T) {
+	vs := []Vacation{
+		{ID: 1, DateBegin: date("2024-02-12"), DateEnd: date("2024-02-25")},
+		{ID: 2, DateBegin: date("2024-08-05"), DateEnd: date("2024-08-18")},
+	}
+
+	if got := OtherVacations(vs, Vacation{ID: 2}); len(got) != 1 || got[0].ID != 1 {
+		t.Errorf("OtherVacations() = %v, want the vacation 1", got)
+	}
+	if got := OtherVacations(vs, Vacation{}); len(got) != 2 {
+		t.Errorf("OtherVacations() = %v, want both vacations", got)
+	}
+}
+
+func TestMaxAbsent(t *testing.T) {
+	vs := []Vacation{
+		{DateBegin: date("2024-07-01"), DateEnd: date("2024-07-14")},
+		{DateBegin: date("2024-07-10"), DateEnd: date("2024-07-20")},
+		{DateBegin: date("2024-07-14"), DateEnd: date("2024-07-31")},
+	}
+
+	if got := MaxAbsent(vs, date("2024-07-01"), date("2024-07-31")); got != 3 {
+		t.Errorf("MaxAbsent() = %d, want 3", got)
+	}
+	if got := MaxAbsent(vs, date("2024-07-15"), date("2024-07-31")); got != 2 {
+		t.Errorf("MaxAbsent() = %d, want 2", got)
+	}
+	if got := MaxAbsent(vs, date("2024-08-01"), date("2024-08-31")); got != 0 {
+		t.Errorf("MaxAbsent() = %d, want 0", got)
+	}
+}
+
+func TestScheduleStatus_CanChangeTo(t *testing.T) {
+	tests := []struct {
+		from, to ScheduleStatus
+		want     bool
+	}{
+		{ScheduleStatusDraft, ScheduleStatusApproved, true},
+		{ScheduleStatusDraft, ScheduleStatusLocked, false},
+		{ScheduleStatusApproved, ScheduleStatusDraft, true},
+		{ScheduleStatusApproved, ScheduleStatusLocked, true},
+		{ScheduleStatusLocked, ScheduleStatusDraft, false},
+	}
+	for _, tt := range tests {
+		if got := tt.from.CanChangeTo(tt.to); got != tt.want {
+			t.Errorf("%s.CanChangeTo(%s) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
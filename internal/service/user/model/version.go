@@ -0,0 +1,6 @@
+package model
+
+// NewVersion is the version of the record to be created only if there is none (If-None-Match: *)
+// by the operations creating or replacing the record. The version 0 matches
+// any version of the existing record (If-Match: *).
+const NewVersion int64 = -1
@@ -1,6 +1,10 @@
 package model
 
-import "time"
+import (
+	"time"
+
+	"github.com/Employee-s-file-cabinet/backend/pkg/docnumber"
+)
 
 type Visa struct {
 	ID            uint64
@@ -9,6 +13,16 @@ type Visa struct {
 	ValidTo       time.Time
 	ValidFrom     time.Time
 	NumberEntries VisaNumberEntries
+	Version       int64
+}
+
+// ValidNumber reports whether the number has the visa shape,
+// the visa in the foreigners passport is issued by Russia.
+func (v Visa) ValidNumber(pt PassportType) bool {
+	if pt == PassportTypeForeigners {
+		return docnumber.RussianVisa(v.Number)
+	}
+	return docnumber.Visa(v.Number)
 }
 
 type VisaNumberEntries string
@@ -0,0 +1,47 @@
+package model
+
+import "time"
+
+// WorkPermit represents a permit for a foreign citizen to work,
+// both dates are inclusive.
+type WorkPermit struct {
+	ID        uint64
+	Number    string
+	ValidFrom time.Time
+	ValidTo   time.Time
+	HasScan   bool
+	Version   int64
+}
+
+// UncoveredPeriods returns the parts of the periods (usually the periods of
+// employment contracts) that are not covered by any of the work permits.
+func UncoveredPeriods(periods []Period, permits []WorkPermit) []Period {
+	ps := make([]Period, len(permits))
+	for i, wp := range permits {
+		ps[i] = Period{DateFrom: wp.ValidFrom, DateTo: wp.ValidTo}
+	}
+	covered := mergePeriods(ps)
+
+	var uncovered []Period
+	for _, p := range mergePeriods(periods) {
+		for _, c := range covered {
+			if c.DateTo.Before(p.DateFrom) {
+				continue
+			}
+			if c.DateFrom.After(p.DateTo) {
+				break
+			}
+			if c.DateFrom.After(p.DateFrom) {
+				uncovered = append(uncovered, Period{DateFrom: p.DateFrom, DateTo: c.DateFrom.AddDate(0, 0, -1)})
+			}
+			p.DateFrom = c.DateTo.AddDate(0, 0, 1)
+			if p.DateFrom.After(p.DateTo) {
+				break
+			}
+		}
+		if !p.DateFrom.After(p.DateTo) {
+			uncovered = append(uncovered, p)
+		}
+	}
+	return uncovered
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUncoveredPeriods(t *testing.T) {
+	tests := []struct {
+		name    string
+		periods []Period
+		permits []WorkPermit
+		want    []Period
+	}{
+		{
+			name: "no periods",
+			permits: []WorkPermit{
+				{ValidFrom: date("2020-01-01"), ValidTo: date("2020-12-31")},
+			},
+		},
+		{
+			name: "no permits",
+			periods: []Period{
+				{DateFrom: date("2020-01-01"), DateTo: date("2020-12-31")},
+			},
+			want: []Period{
+				{DateFrom: date("2020-01-01"), DateTo: date("2020-12-31")},
+			},
+		},
+		{
+			name: "covered by consecutive permits",
+			periods: []Period{
+				{DateFrom: date("2020-03-01"), DateTo: date("2021-06-30")},
+			},
+			permits: []WorkPermit{
+				{ValidFrom: date("2021-01-01"), ValidTo: date("2021-12-31")},
+				{ValidFrom: date("2020-01-01"), ValidTo: date("2020-12-31")},
+			},
+		},
+		{
+			name: "gaps before, between and after permits",
+			periods: []Period{
+				{DateFrom: date("2020-01-01"), DateTo: date("2021-12-31")},
+			},
+			permits: []WorkPermit{
+				{ValidFrom: date("2020-02-01"), ValidTo: date("2020-06-30")},
+				{ValidFrom: date("2020-09-01"), ValidTo: date("2021-06-30")},
+			},
+			want: []Period{
+				{DateFrom: date("2020-01-01"), DateTo: date("2020-01-31")},
+				{DateFrom: date("2020-07-01"), DateTo: date("2020-08-31")},
+				{DateFrom: date("2021-07-01"), DateTo: date("2021-12-31")},
+			},
+		},
+		{
+			name: "several periods",
+			periods: []Period{
+				{DateFrom: date("2019-01-01"), DateTo: date("2019-12-31")},
+				{DateFrom: date("2021-01-01"), DateTo: date("2021-03-31")},
+			},
+			permits: []WorkPermit{
+				{ValidFrom: date("2019-06-01"), ValidTo: date("2021-01-31")},
+			},
+			want: []Period{
+				{DateFrom: date("2019-01-01"), DateTo: date("2019-05-31")},
+				{DateFrom: date("2021-02-01"), DateTo: date("2021-03-31")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, UncoveredPeriods(tt.periods, tt.permits))
+		})
+	}
+}
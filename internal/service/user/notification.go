@@ -0,0 +1,56 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+// notificationQueueSize is the number of the messages waiting to be sent.
+const notificationQueueSize = 256
+
+// notification is the message to the user.
+type notification struct {
+	recipient model.Recipient
+	subject   string
+	msg       string
+}
+
+// RunNotifications sends the queued messages until the context is done.
+func (s *service) RunNotifications(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case n := <-s.notifications:
+			s.send(n)
+		}
+	}
+}
+
+// notify queues the message to the recipients, it's sent by RunNotifications.
+// Notifying doesn't wait for sending, so the message is dropped if the queue is full.
+func (s *service) notify(recipients []model.Recipient, subject, msg string) {
+	for _, r := range recipients {
+		if r.Email == "" {
+			continue
+		}
+		select {
+		case s.notifications <- notification{recipient: r, subject: subject, msg: msg}:
+		default:
+			s.logger.Warn("user service: notify: the queue is full, the message is dropped",
+				slog.String("recipient", r.Email), slog.String("subject", subject))
+		}
+	}
+}
+
+// send sends the message. Sending errors are logged only.
+func (s *service) send(n notification) {
+	r := n.recipient
+	if err := s.notifier.SendMessage(r.Email, n.subject,
+		fmt.Sprintf("%s %s,\n%s", r.FirstName, r.LastName, n.msg)); err != nil {
+		s.logger.Warn("user service: notify", slog.String("recipient", r.Email), slog.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,65 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+type notifierStub struct {
+	mu   sync.Mutex
+	sent []string
+	err  error
+}
+
+func (n *notifierStub) SendMessage(recipient, _, _ string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.sent = append(n.sent, recipient)
+	return n.err
+}
+
+func (n *notifierStub) recipients() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]string(nil), n.sent...)
+}
+
+func TestService_notify(t *testing.T) {
+	n := &notifierStub{err: errors.New("smtp is down")}
+	s := &service{
+		notifier:      n,
+		notifications: make(chan notification, 2),
+		logger:        slog.New(slog.NewTextHandler(io.Discard, nil)),
+	}
+
+	// doesn't block: the message to the third recipient is dropped, the one without email is skipped
+	s.notify([]model.Recipient{
+		{Email: "a@example.com"}, {}, {Email: "b@example.com"}, {Email: "c@example.com"},
+	}, "subject", "message")
+	assert.Empty(t, n.recipients())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- s.RunNotifications(ctx) }()
+
+	require.Eventually(t, func() bool { return len(n.recipients()) == 2 }, time.Second, 10*time.Millisecond)
+	assert.Equal(t, []string{"a@example.com", "b@example.com"}, n.recipients())
+
+	cancel()
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("RunNotifications isn't stopped")
+	}
+}
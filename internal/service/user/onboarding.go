@@ -0,0 +1,131 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *service) ListOnboardingTemplates(ctx context.Context) ([]model.OnboardingTemplate, error) {
+	const op = "user service: list onboarding templates"
+
+	ts, err := s.userRepository.ListOnboardingTemplates(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return ts, nil
+}
+
+func (s *service) GetOnboardingTemplate(ctx context.Context, templateID uint64) (*model.OnboardingTemplate, error) {
+	const op = "user service: get onboarding template"
+
+	t, err := s.userRepository.GetOnboardingTemplate(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "onboarding template not found")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return t, nil
+}
+
+func (s *service) AddOnboardingTemplate(ctx context.Context, t model.OnboardingTemplate) (uint64, error) {
+	const op = "user service: add onboarding template"
+
+	id, err := s.userRepository.AddOnboardingTemplate(ctx, t)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrConflict) {
+			return 0, serr.NewError(serr.Conflict, fmt.Sprintf("not added: %s", err))
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (s *service) UpdateOnboardingTemplate(ctx context.Context, t model.OnboardingTemplate) error {
+	const op = "user service: update onboarding template"
+
+	err := s.userRepository.UpdateOnboardingTemplate(ctx, t)
+	if err != nil {
+		switch {
+		case errors.Is(err, repoerr.ErrRecordNotAffected):
+			return serr.NewError(serr.NotFound, "onboarding template not found")
+		case errors.Is(err, repoerr.ErrConflict):
+			return serr.NewError(serr.Conflict, fmt.Sprintf("not updated: %s", err))
+		default:
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return nil
+}
+
+func (s *service) DeleteOnboardingTemplate(ctx context.Context, templateID uint64) error {
+	const op = "user service: delete onboarding template"
+
+	err := s.userRepository.DeleteOnboardingTemplate(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return serr.NewError(serr.NotFound, "onboarding template not found")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *service) ListOnboardingItems(ctx context.Context, userID uint64) ([]model.OnboardingItem, error) {
+	const op = "user service: list onboarding items"
+
+	items, err := s.userRepository.ListOnboardingItems(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return items, nil
+}
+
+// SetOnboardingItemCompleted ticks or unticks the onboarding item.
+// Items waiting for a scan are completed only by uploading the scan.
+func (s *service) SetOnboardingItemCompleted(ctx context.Context, userID, itemID uint64, completed bool) error {
+	const op = "user service: set onboarding item completed"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	item, err := s.userRepository.GetOnboardingItem(ctx, userID, itemID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return serr.NewError(serr.NotFound, "onboarding item not found")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !item.Manual() {
+		return serr.NewError(serr.Conflict, "not updated: the item is completed by uploading the scan")
+	}
+
+	err = s.userRepository.SetOnboardingItemCompleted(ctx, userID, itemID, completed)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotAffected) {
+			return serr.NewError(serr.Conflict, "not updated: user/onboarding item problem")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// ListOverdueOnboarding returns current employees with onboarding items
+// not completed in time, optionally of the department only.
+func (s *service) ListOverdueOnboarding(ctx context.Context, departmentID *uint64) ([]model.OverdueOnboarding, error) {
+	const op = "user service: list overdue onboarding"
+
+	now := time.Now()
+	items, err := s.userRepository.ListOverdueOnboarding(ctx, now, departmentID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return model.GroupOverdue(items, now), nil
+}
@@ -0,0 +1,127 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *service) ListOrders(ctx context.Context, params model.ListOrdersParams) ([]model.Order, error) {
+	const op = "user service: list orders"
+
+	os, err := s.userRepository.ListOrders(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return os, nil
+}
+
+func (s *service) GetOrder(ctx context.Context, orderID uint64) (*model.Order, error) {
+	const op = "user service: get order"
+
+	o, err := s.userRepository.GetOrder(ctx, orderID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "order not found")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return o, nil
+}
+
+// AddOrder adds the draft order. The title of the type is used if the title is empty.
+func (s *service) AddOrder(ctx context.Context, o model.Order) (uint64, error) {
+	const op = "user service: add order"
+
+	if o.Title == "" {
+		o.Title = model.OrderTitle(o.Type)
+	}
+
+	id, err := s.userRepository.AddOrder(ctx, o)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrConflict) {
+			return 0, serr.NewError(serr.Conflict, fmt.Sprintf("not added: %s", err))
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+// UpdateOrder updates the draft order, the signed and cancelled orders can't be changed.
+func (s *service) UpdateOrder(ctx context.Context, o model.Order) error {
+	const op = "user service: update order"
+
+	if o.Title == "" {
+		o.Title = model.OrderTitle(o.Type)
+	}
+
+	cur, err := s.GetOrder(ctx, o.ID)
+	if err != nil {
+		return err
+	}
+	if cur.Status != model.OrderStatusDraft {
+		return serr.NewError(serr.Conflict, fmt.Sprintf("not updated: the order is %s", cur.Status))
+	}
+
+	err = s.userRepository.UpdateOrder(ctx, o)
+	if err != nil {
+		switch {
+		case errors.Is(err, repoerr.ErrRecordNotAffected):
+			return serr.NewError(serr.Conflict, "not updated: the order has been changed")
+		case errors.Is(err, repoerr.ErrConflict):
+			return serr.NewError(serr.Conflict, fmt.Sprintf("not updated: %s", err))
+		default:
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return nil
+}
+
+// ChangeOrder does the action with the draft order. The order is signed on the date,
+// today if the date is nil, and gets the next number of the order number sequence
+// of its type in the year of the date.
+func (s *service) ChangeOrder(ctx context.Context, orderID uint64, action model.OrderAction, date *time.Time) error {
+	const op = "user service: change order"
+
+	cur, err := s.GetOrder(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if cur.Status != model.OrderStatusDraft {
+		return serr.NewError(serr.Conflict,
+			fmt.Sprintf("not updated: the action %s is not allowed for the %s order", action, cur.Status))
+	}
+
+	switch action {
+	case model.OrderActionSign:
+		d := time.Now()
+		if date != nil {
+			d = *date
+		}
+		_, err = s.userRepository.SignOrder(ctx, orderID, d)
+	case model.OrderActionCancel:
+		err = s.userRepository.CancelOrder(ctx, orderID)
+	}
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotAffected) {
+			return serr.NewError(serr.Conflict, "not updated: the order has been changed")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// addDraftOrder adds the draft order on the personnel event. It's called
+// in the transaction of the event, so the event isn't saved without its order.
+func (s *service) addDraftOrder(ctx context.Context, o model.Order) error {
+	o.Title = model.OrderTitle(o.Type)
+	if _, err := s.userRepository.AddOrder(ctx, o); err != nil {
+		return fmt.Errorf("add draft order: %w", err)
+	}
+	return nil
+}
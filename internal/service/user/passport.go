@@ -36,6 +36,18 @@ func (s *service) ListPassports(ctx context.Context, userID uint64) ([]model.Pas
 func (s *service) AddPassport(ctx context.Context, userID uint64, mp model.Passport) (uint64, error) {
 	const op = "user service: add passport"
 
+	if !mp.ValidNumber() {
+		return 0, serr.NewError(serr.InvalidArgument, "number: invalid series and number of the passport type")
+	}
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.checkPassportDuplicates(ctx, userID, mp); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
 	id, err := s.userRepository.AddPassport(ctx, userID, mp)
 	if err != nil {
 		if errors.Is(err, repoerr.ErrConflict) {
@@ -49,9 +61,23 @@ func (s *service) AddPassport(ctx context.Context, userID uint64, mp model.Passp
 func (s *service) UpdatePassport(ctx context.Context, userID uint64, p model.Passport) error {
 	const op = "user service: update passport"
 
+	if !p.ValidNumber() {
+		return serr.NewError(serr.InvalidArgument, "number: invalid series and number of the passport type")
+	}
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.checkPassportDuplicates(ctx, userID, p); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	err := s.userRepository.UpdatePassport(ctx, userID, p)
 	if err != nil {
 		switch {
+		case errors.Is(err, repoerr.ErrVersionMismatch):
+			return serr.NewError(serr.PreconditionFailed, "not updated: the passport is changed by another user")
 		case errors.Is(err, repoerr.ErrRecordNotAffected):
 			return serr.NewError(serr.Conflict, "not updated: user/passport problem")
 		default:
@@ -38,6 +38,10 @@ func (s *service) DownloadPhoto(ctx context.Context, userID uint64, hash string)
 func (s *service) UploadPhoto(ctx context.Context, userID uint64, f model.File) error {
 	const op = "user service: upload photo"
 
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	if f.Size > MaxPhotoSize {
 		return serr.NewError(serr.ContentTooLarge, "photo file size too large")
 	}
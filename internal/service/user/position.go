@@ -0,0 +1,21 @@
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+// ListPositionHolders returns the timeline of users who held the position.
+// If date is not nil, it returns users who held the position on this date.
+func (s *service) ListPositionHolders(ctx context.Context, positionID uint64, date *time.Time) ([]model.PositionHolder, error) {
+	const op = "user service: list position holders"
+
+	phs, err := s.userRepository.ListPositionHolders(ctx, positionID, date)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return phs, nil
+}
@@ -0,0 +1,153 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sort"
+	"time"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+// ListProbationEnds returns the employees whose probation ends within days days from today,
+// ordered by the probation end.
+func (s *service) ListProbationEnds(ctx context.Context, days uint) ([]model.ProbationEmployee, error) {
+	const op = "user service: list probation ends"
+
+	es, err := s.probationEmployees(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	today := time.Now()
+	ending := make([]model.ProbationEmployee, 0, len(es))
+	for _, e := range es {
+		if e.EndsWithin(today, days) {
+			ending = append(ending, e)
+		}
+	}
+	sort.SliceStable(ending, func(i, j int) bool {
+		return ending[i].Contract.ProbationEnd.Before(*ending[j].Contract.ProbationEnd)
+	})
+	return ending, nil
+}
+
+// SetProbationResult sets the outcome of the probation of the contract.
+// The probation can be extended only beyond its current end.
+func (s *service) SetProbationResult(ctx context.Context, userID, contractID uint64, r model.ProbationResult) error {
+	const op = "user service: set probation result"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	c, err := s.GetContract(ctx, userID, contractID)
+	if err != nil {
+		return err
+	}
+	if c.ProbationEnd == nil {
+		return serr.NewError(serr.Conflict, "not updated: the contract has no probation period")
+	}
+	if r.Outcome == model.ProbationExtended && !r.ExtendedTo.After(*c.ProbationEnd) {
+		return serr.NewError(serr.InvalidArgument, "the probation can be extended only beyond its end")
+	}
+
+	if err := s.userRepository.SetProbationResult(ctx, userID, contractID, r); err != nil {
+		if errors.Is(err, repoerr.ErrVersionMismatch) {
+			return serr.NewError(serr.PreconditionFailed, "not updated: the probation result is changed by another user")
+		}
+		if errors.Is(err, repoerr.ErrRecordNotAffected) {
+			return serr.NewError(serr.NotFound, "contract not found")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// probationReminderInterval is the interval of checking the probation ends to remind of.
+const probationReminderInterval = time.Hour
+
+// RunProbationReminders reminds of the probation ends until the context is done.
+func (s *service) RunProbationReminders(ctx context.Context) error {
+	ticker := time.NewTicker(probationReminderInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.RemindProbationEnds(ctx, time.Now()); err != nil {
+			s.logger.Warn("user service: remind probation ends", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// RemindProbationEnds notifies HR and the heads of the departments of the probation periods
+// ending within ProbationNoticeDays days from the date. Each probation end is reminded of once.
+func (s *service) RemindProbationEnds(ctx context.Context, date time.Time) error {
+	const op = "user service: remind probation ends"
+
+	es, err := s.probationEmployees(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var hrs []model.Recipient
+	for _, e := range es {
+		if !e.ReminderDue(date, s.Config.ProbationNoticeDays) {
+			continue
+		}
+
+		if hrs == nil {
+			if hrs, err = s.userRepository.ListHRRecipients(ctx); err != nil {
+				return fmt.Errorf("%s: %w", op, err)
+			}
+		}
+		recipients := hrs
+		head, err := s.userRepository.GetHead(ctx, e.UserID)
+		if err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+		if head != nil {
+			recipients = append(recipients[:len(recipients):len(recipients)], *head)
+		}
+
+		s.notify(recipients, "Окончание испытательного срока",
+			fmt.Sprintf("Испытательный срок сотрудника %s %s %s (%s, %s) по договору № %s заканчивается %s. "+
+				"Необходимо принять решение о его результатах.",
+				e.LastName, e.FirstName, e.MiddleName, e.Department, e.Position,
+				e.Contract.Number, e.Contract.ProbationEnd.Format("02.01.2006")))
+
+		if err := s.userRepository.SetProbationReminded(ctx, e.Contract.ID, date); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return nil
+}
+
+// probationEmployees returns the employees on probation with the probation ends set.
+func (s *service) probationEmployees(ctx context.Context) ([]model.ProbationEmployee, error) {
+	es, err := s.userRepository.ListProbationEmployees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	contracts := make([]model.Contract, len(es))
+	for i := range es {
+		contracts[i] = es[i].Contract
+	}
+	if err := s.setProbationEnds(ctx, contracts); err != nil {
+		return nil, err
+	}
+	for i := range es {
+		es[i].Contract = contracts[i]
+	}
+	return es, nil
+}
@@ -0,0 +1,91 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *service) ListRelatives(ctx context.Context, userID uint64) ([]model.Relative, error) {
+	const op = "user service: list relatives"
+
+	rs, err := s.userRepository.ListRelatives(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return rs, nil
+}
+
+func (s *service) GetRelative(ctx context.Context, userID, relativeID uint64) (*model.Relative, error) {
+	const op = "user service: get relative"
+
+	r, err := s.userRepository.GetRelative(ctx, userID, relativeID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "relative not found")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return r, nil
+}
+
+func (s *service) AddRelative(ctx context.Context, userID uint64, r model.Relative) (uint64, error) {
+	const op = "user service: add relative"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := s.userRepository.AddRelative(ctx, userID, r)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrConflict) {
+			return 0, serr.NewError(serr.Conflict, "not added: user problem")
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+func (s *service) UpdateRelative(ctx context.Context, userID uint64, r model.Relative) error {
+	const op = "user service: update relative"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := s.userRepository.UpdateRelative(ctx, userID, r)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrVersionMismatch) {
+			return serr.NewError(serr.PreconditionFailed, "not updated: the relative is changed by another user")
+		}
+		if errors.Is(err, repoerr.ErrRecordNotAffected) {
+			return serr.NewError(serr.Conflict, "not updated: user/relative problem")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *service) DeleteRelative(ctx context.Context, userID, relativeID uint64, version int64) error {
+	const op = "user service: delete relative"
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := s.userRepository.DeleteRelative(ctx, userID, relativeID, version)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrVersionMismatch) {
+			return serr.NewError(serr.PreconditionFailed, "not deleted: the relative is changed by another user")
+		}
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return serr.NewError(serr.NotFound, "relative not found")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
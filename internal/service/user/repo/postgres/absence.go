@@ -0,0 +1,181 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+const absenceColumns = `absences.id AS id, absences.type AS type, absences.date_begin AS date_begin,
+absences.date_end AS date_end, absences.document_number AS document_number, absences.comment AS comment,
+absences.version AS version,
+(SELECT COUNT(*)>0 FROM scans WHERE scans.user_id=absences.user_id AND scans.document_id=absences.id AND scans.type='Документ об отсутствии') AS has_scan`
+
+func (s *storage) ListAbsences(ctx context.Context, userID uint64) ([]model.Absence, error) {
+	const op = "postgresql user storage: list absences"
+
+	rows, err := s.DB.Query(ctx, `SELECT `+absenceColumns+`
+		FROM absences
+		WHERE user_id = @user_id
+		ORDER BY date_begin`,
+		pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	as, err := pgx.CollectRows[absence](rows, pgx.RowToStructByNameLax[absence])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	absences := make([]model.Absence, len(as))
+	for i, a := range as {
+		absences[i] = convertAbsenceToModelAbsence(a)
+	}
+	return absences, nil
+}
+
+func (s *storage) GetAbsence(ctx context.Context, userID, absenceID uint64) (*model.Absence, error) {
+	const op = "postgresql user storage: get absence"
+
+	rows, err := s.DB.Query(ctx, `SELECT `+absenceColumns+`
+		FROM absences
+		WHERE id = @id AND user_id = @user_id`,
+		pgx.NamedArgs{
+			"id":      absenceID,
+			"user_id": userID,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	a, err := pgx.CollectExactlyOneRow[absence](rows, pgx.RowToStructByNameLax[absence])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	ma := convertAbsenceToModelAbsence(a)
+	return &ma, nil
+}
+
+func (s *storage) AddAbsence(ctx context.Context, userID uint64, a model.Absence) (uint64, error) {
+	const op = "postgresql user storage: add absence"
+
+	row := s.DB.QueryRow(ctx, `INSERT INTO absences
+		("user_id", "type", "date_begin", "date_end", "document_number", "comment")
+		VALUES (@user_id, @type, @date_begin, @date_end, @document_number, @comment)
+		RETURNING "id"`,
+		pgx.NamedArgs{
+			"user_id":         userID,
+			"type":            a.Type,
+			"date_begin":      a.DateBegin,
+			"date_end":        a.DateEnd,
+			"document_number": a.DocumentNumber,
+			"comment":         a.Comment,
+		})
+
+	if err := row.Scan(&a.ID); err != nil {
+		if strings.Contains(err.Error(), "23") && // Integrity Constraint Violation
+			strings.Contains(err.Error(), "user_id") {
+			return 0, fmt.Errorf("the user does not exist: %w", repoerr.ErrConflict)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return a.ID, nil
+}
+
+func (s *storage) UpdateAbsence(ctx context.Context, userID uint64, a model.Absence) error {
+	const op = "postgresql user storage: update absence"
+
+	args := pgx.NamedArgs{
+		"user_id":         userID,
+		"id":              a.ID,
+		"type":            a.Type,
+		"date_begin":      a.DateBegin,
+		"date_end":        a.DateEnd,
+		"document_number": a.DocumentNumber,
+		"comment":         a.Comment,
+		"version":         a.Version,
+	}
+	tag, err := s.DB.Exec(ctx, `UPDATE absences
+	SET type = @type, date_begin = @date_begin, date_end = @date_end,
+	document_number = @document_number, comment = @comment
+	WHERE id=@id AND user_id=@user_id AND `+versionMatches, args)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 { // it's ok for pgx
+		return notAffected(ctx, s.DB, `SELECT 1 FROM absences WHERE id=@id AND user_id=@user_id`, args,
+			repoerr.ErrRecordNotAffected)
+	}
+	return nil
+}
+
+func (s *storage) DeleteAbsence(ctx context.Context, userID, absenceID uint64, version int64) error {
+	const op = "postgresql user storage: delete absence"
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+		"id":      absenceID,
+		"version": version,
+	}
+	tag, err := s.DB.Exec(ctx, `DELETE FROM absences WHERE id=@id AND user_id=@user_id AND `+versionMatches, args)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return notAffected(ctx, s.DB, `SELECT 1 FROM absences WHERE id=@id AND user_id=@user_id`, args,
+			repoerr.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// ListAllAbsences returns the absences of all employees having common days with the period.
+func (s *storage) ListAllAbsences(ctx context.Context, params model.ListAbsencesParams) ([]model.EmployeeAbsence, error) {
+	const op = "postgresql user storage: list all absences"
+
+	rows, err := s.DB.Query(ctx, `SELECT `+absenceColumns+`,
+		absences.user_id AS user_id, lastname, firstname, middlename,
+		departments.title AS department, positions.title AS position
+		FROM absences
+		JOIN users ON absences.user_id = users.id
+		JOIN departments ON users.department_id = departments.id
+		JOIN positions ON users.position_id = positions.id
+		WHERE absences.date_begin <= @date_to AND absences.date_end >= @date_from
+		AND (@department_id::bigint IS NULL OR users.department_id = @department_id)
+		AND (@type::varchar IS NULL OR absences.type = @type)
+		ORDER BY lastname, firstname, absences.date_begin`,
+		pgx.NamedArgs{
+			"department_id": params.DepartmentID,
+			"type":          params.Type,
+			"date_from":     params.DateFrom,
+			"date_to":       params.DateTo,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	eas, err := pgx.CollectRows[employeeAbsence](rows, pgx.RowToStructByNameLax[employeeAbsence])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	absences := make([]model.EmployeeAbsence, len(eas))
+	for i, ea := range eas {
+		absences[i] = convertEmployeeAbsenceToModelEmployeeAbsence(ea)
+	}
+	return absences, nil
+}
@@ -0,0 +1,172 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+const amendmentColumns = `contract_amendments.id AS id, contract_amendments.contract_id AS contract_id,
+contract_amendments.number AS number, contract_amendments.date AS date,
+contract_amendments.effective_from AS effective_from, contract_amendments.work_type_id AS work_type_id,
+contract_amendments.position_id AS position_id, contract_amendments.salary AS salary,
+contract_amendments.date_end AS date_end, contract_amendments.description AS description,
+contract_amendments.version AS version,
+(SELECT COUNT(*)>0 FROM scans WHERE scans.user_id=contracts.user_id AND scans.document_id=contract_amendments.id AND scans.type='Дополнительное соглашение') AS has_scan`
+
+// ListAmendments returns the amendments of the user contract in order of their effective dates.
+func (s *storage) ListAmendments(ctx context.Context, userID, contractID uint64) ([]model.Amendment, error) {
+	const op = "postgresql user storage: list amendments"
+
+	rows, err := s.DB.Query(ctx, `SELECT `+amendmentColumns+`
+		FROM contract_amendments
+		JOIN contracts ON contracts.id = contract_amendments.contract_id
+		WHERE contract_amendments.contract_id = @contract_id AND contracts.user_id = @user_id
+		ORDER BY contract_amendments.effective_from, contract_amendments.date, contract_amendments.id`,
+		pgx.NamedArgs{
+			"contract_id": contractID,
+			"user_id":     userID,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	as, err := pgx.CollectRows[amendment](rows, pgx.RowToStructByNameLax[amendment])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	amendments := make([]model.Amendment, len(as))
+	for i, a := range as {
+		amendments[i] = convertAmendmentToModelAmendment(a)
+	}
+	return amendments, nil
+}
+
+func (s *storage) GetAmendment(ctx context.Context, userID, contractID, amendmentID uint64) (*model.Amendment, error) {
+	const op = "postgresql user storage: get amendment"
+
+	rows, err := s.DB.Query(ctx, `SELECT `+amendmentColumns+`
+		FROM contract_amendments
+		JOIN contracts ON contracts.id = contract_amendments.contract_id
+		WHERE contract_amendments.id = @id AND contract_amendments.contract_id = @contract_id
+		AND contracts.user_id = @user_id`,
+		pgx.NamedArgs{
+			"id":          amendmentID,
+			"contract_id": contractID,
+			"user_id":     userID,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	a, err := pgx.CollectExactlyOneRow[amendment](rows, pgx.RowToStructByNameLax[amendment])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	ma := convertAmendmentToModelAmendment(a)
+	return &ma, nil
+}
+
+// AddAmendment adds the amendment to the user contract,
+// it returns ErrRecordNotFound if the user has no such contract.
+func (s *storage) AddAmendment(ctx context.Context, userID uint64, a model.Amendment) (uint64, error) {
+	const op = "postgresql user storage: add amendment"
+
+	row := s.DB.QueryRow(ctx, `INSERT INTO contract_amendments
+		("contract_id", "number", "date", "effective_from", "work_type_id", "position_id", "salary",
+		"date_end", "description")
+		SELECT id, @number, @date, @effective_from, @work_type_id, @position_id, @salary,
+		@date_end, @description
+		FROM contracts
+		WHERE id = @contract_id AND user_id = @user_id
+		RETURNING "id"`,
+		pgx.NamedArgs{
+			"contract_id":    a.ContractID,
+			"user_id":        userID,
+			"number":         a.Number,
+			"date":           a.Date,
+			"effective_from": a.EffectiveFrom,
+			"work_type_id":   a.WorkTypeID,
+			"position_id":    a.PositionID,
+			"salary":         a.Salary,
+			"date_end":       a.DateEnd,
+			"description":    a.Description,
+		})
+
+	if err := row.Scan(&a.ID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, repoerr.ErrRecordNotFound
+		}
+		if err := amendmentConflict(err); err != nil {
+			return 0, err
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return a.ID, nil
+}
+
+func (s *storage) UpdateAmendment(ctx context.Context, userID uint64, a model.Amendment) error {
+	const op = "postgresql user storage: update amendment"
+
+	args := pgx.NamedArgs{
+		"id":             a.ID,
+		"contract_id":    a.ContractID,
+		"user_id":        userID,
+		"number":         a.Number,
+		"date":           a.Date,
+		"effective_from": a.EffectiveFrom,
+		"work_type_id":   a.WorkTypeID,
+		"position_id":    a.PositionID,
+		"salary":         a.Salary,
+		"date_end":       a.DateEnd,
+		"description":    a.Description,
+		"version":        a.Version,
+	}
+	tag, err := s.DB.Exec(ctx, `UPDATE contract_amendments
+		SET number = @number, date = @date, effective_from = @effective_from, work_type_id = @work_type_id,
+		position_id = @position_id, salary = @salary, date_end = @date_end, description = @description
+		FROM contracts
+		WHERE contract_amendments.id = @id AND contract_amendments.contract_id = @contract_id
+		AND contracts.id = contract_amendments.contract_id AND contracts.user_id = @user_id
+		AND (@version::bigint = 0 OR contract_amendments.version = @version)`, args)
+	if err != nil {
+		if err := amendmentConflict(err); err != nil {
+			return err
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 { // it's ok for pgx
+		return notAffected(ctx, s.DB, `SELECT 1 FROM contract_amendments
+			JOIN contracts ON contracts.id = contract_amendments.contract_id
+			WHERE contract_amendments.id = @id AND contract_amendments.contract_id = @contract_id
+			AND contracts.user_id = @user_id`, args, repoerr.ErrRecordNotAffected)
+	}
+	return nil
+}
+
+// amendmentConflict returns ErrConflict wrapped with the reason
+// if err is the integrity constraint violation of the amendment, otherwise nil.
+func amendmentConflict(err error) error {
+	if !strings.Contains(err.Error(), "23") { // Integrity Constraint Violation
+		return nil
+	}
+	switch {
+	case strings.Contains(err.Error(), "work_type_id"):
+		return fmt.Errorf("the work type does not exist: %w", repoerr.ErrConflict)
+	case strings.Contains(err.Error(), "position_id"):
+		return fmt.Errorf("the position does not exist: %w", repoerr.ErrConflict)
+	case strings.Contains(err.Error(), "contract_amendments_contract_id_number_key"):
+		return fmt.Errorf("the amendment number is already used: %w", repoerr.ErrConflict)
+	}
+	return nil
+}
@@ -12,10 +12,19 @@ import (
 	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
 )
 
+// probationResultColumns are the columns of probation_results joined to contracts.
+const probationResultColumns = `probation_results.outcome AS probation_outcome,
+probation_results.extended_to AS probation_extended_to, probation_results.comment AS probation_comment,
+COALESCE(probation_results.updated_at, probation_results.created_at) AS probation_decided_at,
+probation_results.version AS probation_version`
+
 const listContractsQuery = `SELECT 
 contracts.id as id, number, contract_type, work_type_id, probation_period, date_begin, date_end,
-(SELECT COUNT(*)>0 FROM scans WHERE scans.document_id=contracts.id AND scans.type='Трудовой договор') AS has_scan
+extra_vacation_days, ` + probationResultColumns + `,
+(SELECT COUNT(*)>0 FROM scans WHERE scans.document_id=contracts.id AND scans.type='Трудовой договор') AS has_scan,
+(SELECT COUNT(*)>0 FROM contract_amendments WHERE contract_amendments.contract_id=contracts.id) AS has_amendments
 FROM contracts
+LEFT JOIN probation_results ON probation_results.contract_id = contracts.id
 WHERE user_id = @user_id`
 
 func (s *storage) ListContracts(ctx context.Context, userID uint64) ([]model.Contract, error) {
@@ -45,8 +54,11 @@ func (s *storage) GetContract(ctx context.Context, userID, contractID uint64) (*
 	rows, err := s.DB.Query(ctx,
 		`SELECT 
 		id, number, contract_type, work_type_id, probation_period, date_begin, date_end,
-		(SELECT COUNT(*)>0 FROM scans WHERE user_id=@user_id AND scans.document_id=contracts.id AND scans.type='Трудовой договор') AS has_scan
+		extra_vacation_days, contracts.version, `+probationResultColumns+`,
+		(SELECT COUNT(*)>0 FROM scans WHERE user_id=@user_id AND scans.document_id=contracts.id AND scans.type='Трудовой договор') AS has_scan,
+		(SELECT COUNT(*)>0 FROM contract_amendments WHERE contract_amendments.contract_id=contracts.id) AS has_amendments
 		FROM contracts
+		LEFT JOIN probation_results ON probation_results.contract_id = contracts.id
 		WHERE id = @contract_id AND user_id = @user_id`,
 		pgx.NamedArgs{
 			"contract_id": contractID,
@@ -69,9 +81,24 @@ func (s *storage) AddContract(ctx context.Context, userID uint64, mc model.Contr
 
 	c := convertModelContractToContract(mc)
 
-	row := s.DB.QueryRow(ctx, `INSERT INTO contracts
-		("user_id", "number", "contract_type", "work_type_id", "probation_period", "date_begin", "date_end")
-		VALUES (@user_id, @number, @contract_type, @work_type_id, @probation_period, @date_begin, @date_end)
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	if c.Number == "" {
+		c.Number, err = nextNumber(ctx, tx, model.SequenceContractNumber, c.DateBegin.Year())
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	row := tx.QueryRow(ctx, `INSERT INTO contracts
+		("user_id", "number", "contract_type", "work_type_id", "probation_period", "date_begin", "date_end",
+		"extra_vacation_days")
+		VALUES (@user_id, @number, @contract_type, @work_type_id, @probation_period, @date_begin, @date_end,
+		@extra_vacation_days)
 		RETURNING "id"`,
 		pgx.NamedArgs{
 			"user_id":          userID,
@@ -81,10 +108,15 @@ func (s *storage) AddContract(ctx context.Context, userID uint64, mc model.Contr
 			"probation_period": c.ProbationPeriod,
 			"date_begin":       c.DateBegin,
 			"date_end":         c.DateEnd,
+
+			"extra_vacation_days": c.ExtraVacationDays,
 		})
 
 	if err := row.Scan(&c.ID); err != nil {
 		if strings.Contains(err.Error(), "23") { // Integrity Constraint Violation
+			if strings.Contains(err.Error(), "contracts_number_key") {
+				return 0, fmt.Errorf("the contract number is already used: %w", repoerr.ErrRecordAlreadyExist)
+			}
 			if strings.Contains(err.Error(), "user_id") {
 				return 0, fmt.Errorf("the user does not exist: %w", repoerr.ErrConflict)
 			}
@@ -95,6 +127,9 @@ func (s *storage) AddContract(ctx context.Context, userID uint64, mc model.Contr
 		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
 	return c.ID, nil
 }
 
@@ -103,23 +138,30 @@ func (s *storage) UpdateContract(ctx context.Context, userID uint64, mc model.Co
 
 	c := convertModelContractToContract(mc)
 
+	args := pgx.NamedArgs{
+		"id":               c.ID,
+		"user_id":          userID,
+		"number":           c.Number,
+		"contract_type":    c.ContractType,
+		"work_type_id":     c.WorkTypeID,
+		"probation_period": c.ProbationPeriod,
+		"date_begin":       c.DateBegin,
+		"date_end":         c.DateEnd,
+		"version":          c.Version,
+
+		"extra_vacation_days": c.ExtraVacationDays,
+	}
 	tag, err := s.DB.Exec(ctx, `UPDATE contracts
 		SET number = @number, contract_type = @contract_type, work_type_id = @work_type_id, 
-		probation_period = @probation_period, date_begin = @date_begin, date_end = @date_end
-		WHERE id=@id AND user_id=@user_id`,
-		pgx.NamedArgs{
-			"id":               c.ID,
-			"user_id":          userID,
-			"number":           c.Number,
-			"contract_type":    c.ContractType,
-			"work_type_id":     c.WorkTypeID,
-			"probation_period": c.ProbationPeriod,
-			"date_begin":       c.DateBegin,
-			"date_end":         c.DateEnd,
-		})
+		probation_period = @probation_period, date_begin = @date_begin, date_end = @date_end,
+		extra_vacation_days = @extra_vacation_days
+		WHERE id=@id AND user_id=@user_id AND `+versionMatches, args)
 
 	if err != nil {
 		if strings.Contains(err.Error(), "23") { // Integrity Constraint Violation
+			if strings.Contains(err.Error(), "contracts_number_key") {
+				return fmt.Errorf("the contract number is already used: %w", repoerr.ErrRecordAlreadyExist)
+			}
 			if strings.Contains(err.Error(), "work_type_id") {
 				return fmt.Errorf("the work type does not exist: %w", repoerr.ErrConflict)
 			}
@@ -127,7 +169,7 @@ func (s *storage) UpdateContract(ctx context.Context, userID uint64, mc model.Co
 		return fmt.Errorf("%s: %w", op, err)
 	}
 	if tag.RowsAffected() == 0 { // it's ok for pgx
-		return repoerr.ErrRecordNotAffected
+		return notAffected(ctx, s.DB, `SELECT 1 FROM contracts WHERE id=@id AND user_id=@user_id`, args, repoerr.ErrRecordNotAffected)
 	}
 	return nil
 }
@@ -0,0 +1,161 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+// FindDuplicates returns the other users with the same insurance or taxpayer number as the user.
+func (s *storage) FindDuplicates(ctx context.Context, u model.User) ([]model.DuplicateMatch, error) {
+	const op = "postgresql user storage: find duplicates"
+
+	rows, err := s.DB.Query(ctx, `SELECT id AS user_id, 'taxpayer' AS reason, terminated_at IS NOT NULL AS terminated
+		FROM users
+		WHERE id <> @id AND taxpayer_number = @taxpayer_number
+		UNION ALL
+		SELECT id, 'insurance', terminated_at IS NOT NULL
+		FROM users
+		WHERE id <> @id AND insurance_number = @insurance_number
+		ORDER BY user_id`,
+		pgx.NamedArgs{
+			"id":               u.ID,
+			"taxpayer_number":  u.Taxpayer.Number,
+			"insurance_number": u.Insurance.Number,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	ms, err := pgx.CollectRows[duplicateMatch](rows, pgx.RowToStructByNameLax[duplicateMatch])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	matches := make([]model.DuplicateMatch, len(ms))
+	for i, m := range ms {
+		matches[i] = convertDuplicateMatchToModelDuplicateMatch(m)
+	}
+	return matches, nil
+}
+
+// FindPassportDuplicates returns the other users with the passport of the same type and number.
+func (s *storage) FindPassportDuplicates(ctx context.Context, userID uint64, mp model.Passport) ([]model.DuplicateMatch, error) {
+	const op = "postgresql user storage: find passport duplicates"
+
+	p := convertModelPassportToPassport(mp)
+
+	rows, err := s.DB.Query(ctx, `SELECT DISTINCT users.id AS user_id, 'passport' AS reason,
+		users.terminated_at IS NOT NULL AS terminated
+		FROM passports
+		JOIN users ON users.id = passports.user_id
+		WHERE passports.user_id <> @user_id AND passports.type = @type AND passports.number = @number
+		ORDER BY user_id`,
+		pgx.NamedArgs{
+			"user_id": userID,
+			"type":    p.Type,
+			"number":  p.Number,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	ms, err := pgx.CollectRows[duplicateMatch](rows, pgx.RowToStructByNameLax[duplicateMatch])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	matches := make([]model.DuplicateMatch, len(ms))
+	for i, m := range ms {
+		matches[i] = convertDuplicateMatchToModelDuplicateMatch(m)
+	}
+	return matches, nil
+}
+
+// ListPersonsBornOn returns the users born on the date except the user.
+func (s *storage) ListPersonsBornOn(ctx context.Context, date time.Time, exceptUserID uint64) ([]model.Person, error) {
+	const op = "postgresql user storage: list persons born on"
+
+	rows, err := s.DB.Query(ctx, `SELECT id, lastname, firstname, date_of_birth
+		FROM users
+		WHERE date_of_birth = @date AND id <> @user_id
+		ORDER BY id`,
+		pgx.NamedArgs{
+			"date":    date,
+			"user_id": exceptUserID,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	ps, err := pgx.CollectRows[person](rows, pgx.RowToStructByNameLax[person])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	persons := make([]model.Person, len(ps))
+	for i, p := range ps {
+		persons[i] = convertPersonToModelPerson(p)
+	}
+	return persons, nil
+}
+
+// ListPersonsSharingBirthDate returns the users born on the same date with the other users
+// ordered by the date of birth.
+func (s *storage) ListPersonsSharingBirthDate(ctx context.Context) ([]model.Person, error) {
+	const op = "postgresql user storage: list persons sharing birth date"
+
+	rows, err := s.DB.Query(ctx, `SELECT id, lastname, firstname, date_of_birth
+		FROM users
+		WHERE date_of_birth IN (SELECT date_of_birth FROM users GROUP BY date_of_birth HAVING count(*) > 1)
+		ORDER BY date_of_birth, id`)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	ps, err := pgx.CollectRows[person](rows, pgx.RowToStructByNameLax[person])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	persons := make([]model.Person, len(ps))
+	for i, p := range ps {
+		persons[i] = convertPersonToModelPerson(p)
+	}
+	return persons, nil
+}
+
+// ListDuplicates returns the groups of the users with the same insurance, taxpayer
+// or passport number.
+func (s *storage) ListDuplicates(ctx context.Context) ([]model.Duplicate, error) {
+	const op = "postgresql user storage: list duplicates"
+
+	rows, err := s.DB.Query(ctx, `SELECT 'taxpayer' AS reason, array_agg(id ORDER BY id) AS user_ids
+		FROM users
+		GROUP BY taxpayer_number
+		HAVING count(*) > 1
+		UNION ALL
+		SELECT 'insurance', array_agg(id ORDER BY id)
+		FROM users
+		GROUP BY insurance_number
+		HAVING count(*) > 1
+		UNION ALL
+		SELECT 'passport', array_agg(DISTINCT user_id ORDER BY user_id)
+		FROM passports
+		GROUP BY type, number
+		HAVING count(DISTINCT user_id) > 1
+		ORDER BY reason, user_ids`)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	ds, err := pgx.CollectRows[duplicate](rows, pgx.RowToStructByNameLax[duplicate])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	duplicates := make([]model.Duplicate, len(ds))
+	for i, d := range ds {
+		duplicates[i] = convertDuplicateToModelDuplicate(d)
+	}
+	return duplicates, nil
+}
@@ -44,7 +44,7 @@ func (s *storage) GetEducation(ctx context.Context, userID, educationID uint64)
 
 	rows, err := s.DB.Query(ctx, `SELECT
 		id, document_number, title_of_program,
-		title_of_institution, year_of_end, year_of_begin,
+		title_of_institution, year_of_end, year_of_begin, version,
 		(SELECT COUNT(*)>0 FROM scans WHERE user_id=@user_id AND scans.document_id=educations.id AND scans.type='Документ об образовании') AS has_scan
 		FROM educations
 		WHERE id = @education_id AND user_id = @user_id`,
@@ -96,28 +96,30 @@ func (s *storage) AddEducation(ctx context.Context, userID uint64, ed model.Educ
 func (s *storage) UpdateEducation(ctx context.Context, userID uint64, ed model.Education) error {
 	const op = "postrgresql user storage: update education"
 
+	args := pgx.NamedArgs{
+		"user_id":            userID,
+		"id":                 ed.ID,
+		"number":             ed.Number,
+		"program":            ed.Program,
+		"issued_institution": ed.IssuedInstitution,
+		"date_to":            ed.DateTo,
+		"date_from":          ed.DateFrom,
+		"version":            ed.Version,
+	}
 	tag, err := s.DB.Exec(ctx, `UPDATE educations
 		SET document_number = @number, 
 		title_of_program = @program, 
 		title_of_institution = @issued_institution, 
 		year_of_end = @date_to, 
 		year_of_begin = @date_from
-		WHERE id=@id AND user_id=@user_id`,
-		pgx.NamedArgs{
-			"user_id":            userID,
-			"id":                 ed.ID,
-			"number":             ed.Number,
-			"program":            ed.Program,
-			"issued_institution": ed.IssuedInstitution,
-			"date_to":            ed.DateTo,
-			"date_from":          ed.DateFrom,
-		})
+		WHERE id=@id AND user_id=@user_id AND `+versionMatches, args)
 
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 	if tag.RowsAffected() == 0 { // it's ok for pgx
-		return repoerr.ErrRecordNotAffected
+		return notAffected(ctx, s.DB, `SELECT 1 FROM educations WHERE id=@id AND user_id=@user_id`, args,
+			repoerr.ErrRecordNotAffected)
 	}
 	return nil
 }
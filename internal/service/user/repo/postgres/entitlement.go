@@ -0,0 +1,124 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+const listEntitlementEmployeesQuery = `SELECT
+	users.id AS id, lastname, firstname, middlename,
+	departments.title AS department, positions.title AS position
+	FROM users
+	JOIN departments ON users.department_id = departments.id
+	JOIN positions ON users.position_id = positions.id
+	WHERE %s
+	ORDER BY lastname, firstname, users.id`
+
+// GetEntitlement returns the data to calculate the vacation balance of the employee.
+func (s *storage) GetEntitlement(ctx context.Context, userID uint64) (*model.EmployeeEntitlement, error) {
+	const op = "postgresql user storage: get entitlement"
+
+	ets, err := s.listEntitlements(ctx, "users.id = @user_id", pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(ets) == 0 {
+		return nil, repoerr.ErrRecordNotFound
+	}
+	return &ets[0], nil
+}
+
+// ListEntitlements returns the data to calculate the vacation balances of current employees
+// of the department (of all departments if departmentID is nil).
+func (s *storage) ListEntitlements(ctx context.Context, departmentID *uint64) ([]model.EmployeeEntitlement, error) {
+	const op = "postgresql user storage: list entitlements"
+
+	ets, err := s.listEntitlements(ctx,
+		"users.terminated_at IS NULL AND (@department_id::bigint IS NULL OR users.department_id = @department_id)",
+		pgx.NamedArgs{"department_id": departmentID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return ets, nil
+}
+
+func (s *storage) listEntitlements(ctx context.Context,
+	where string, args pgx.NamedArgs) ([]model.EmployeeEntitlement, error) {
+	rows, err := s.DB.Query(ctx, fmt.Sprintf(listEntitlementEmployeesQuery, where), args)
+	if err != nil {
+		return nil, err
+	}
+	es, err := pgx.CollectRows[entitlementEmployee](rows, pgx.RowToStructByNameLax[entitlementEmployee])
+	if err != nil {
+		return nil, err
+	}
+
+	ets := make([]model.EmployeeEntitlement, len(es))
+	ids := make([]uint64, len(es))
+	idx := make(map[uint64]int, len(es))
+	for i, e := range es {
+		ets[i] = model.EmployeeEntitlement{
+			UserID:     e.UserID,
+			LastName:   e.LastName,
+			FirstName:  e.FirstName,
+			MiddleName: e.MiddleName,
+			Department: e.Department,
+			Position:   e.Position,
+		}
+		ids[i] = e.UserID
+		idx[e.UserID] = i
+	}
+	if len(ids) == 0 {
+		return ets, nil
+	}
+
+	// the additional days of the current position are given for all the contracts
+	rows, err = s.DB.Query(ctx, `SELECT
+		contracts.user_id AS user_id, date_begin, date_end,
+		contracts.extra_vacation_days + positions.extra_vacation_days AS extra_days
+		FROM contracts
+		JOIN users ON contracts.user_id = users.id
+		JOIN positions ON users.position_id = positions.id
+		WHERE contracts.user_id = ANY(@ids)
+		ORDER BY date_begin`,
+		pgx.NamedArgs{"ids": ids})
+	if err != nil {
+		return nil, err
+	}
+	ps, err := pgx.CollectRows[entitlementPeriod](rows, pgx.RowToStructByNameLax[entitlementPeriod])
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range ps {
+		i := idx[p.UserID]
+		ets[i].Periods = append(ets[i].Periods, model.EntitlementPeriod{
+			DateBegin: p.DateBegin,
+			DateEnd:   p.DateEnd,
+			ExtraDays: p.ExtraDays,
+		})
+	}
+
+	rows, err = s.DB.Query(ctx, `SELECT user_id, id, date_begin, date_end
+		FROM vacations
+		WHERE user_id = ANY(@ids)
+		ORDER BY date_begin`,
+		pgx.NamedArgs{"ids": ids})
+	if err != nil {
+		return nil, err
+	}
+	vs, err := pgx.CollectRows[userVacation](rows, pgx.RowToStructByNameLax[userVacation])
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range vs {
+		i := idx[v.UserID]
+		ets[i].Vacations = append(ets[i].Vacations, convertVacationToModelVacation(v.vacation))
+	}
+
+	return ets, nil
+}
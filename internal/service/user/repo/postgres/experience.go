@@ -0,0 +1,141 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *storage) ListExperiences(ctx context.Context, userID uint64) ([]model.Experience, error) {
+	const op = "postgresql user storage: list experiences"
+
+	rows, err := s.DB.Query(ctx,
+		`SELECT id, company_name, position, functional, awards, date_begin, date_end
+		FROM experiences
+		WHERE user_id = @user_id
+		ORDER BY date_begin`,
+		pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	exs, err := pgx.CollectRows[experience](rows, pgx.RowToStructByNameLax[experience])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	experiences := make([]model.Experience, len(exs))
+	for i, ex := range exs {
+		experiences[i] = convertExperienceToModelExperience(ex)
+	}
+
+	return experiences, nil
+}
+
+func (s *storage) GetExperience(ctx context.Context, userID, experienceID uint64) (*model.Experience, error) {
+	const op = "postgresql user storage: get experience"
+
+	rows, err := s.DB.Query(ctx,
+		`SELECT id, company_name, position, functional, awards, date_begin, date_end, version
+		FROM experiences
+		WHERE id = @id AND user_id = @user_id`,
+		pgx.NamedArgs{
+			"id":      experienceID,
+			"user_id": userID,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	ex, err := pgx.CollectExactlyOneRow[experience](rows, pgx.RowToStructByNameLax[experience])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	mex := convertExperienceToModelExperience(ex)
+	return &mex, nil
+}
+
+func (s *storage) AddExperience(ctx context.Context, userID uint64, ex model.Experience) (uint64, error) {
+	const op = "postgresql user storage: add experience"
+
+	row := s.DB.QueryRow(ctx, `INSERT INTO experiences
+		("user_id", "company_name", "position", "functional", "awards", "date_begin", "date_end")
+		VALUES (@user_id, @company_name, @position, @functional, @awards, @date_begin, @date_end)
+		RETURNING "id"`,
+		pgx.NamedArgs{
+			"user_id":      userID,
+			"company_name": ex.CompanyName,
+			"position":     ex.Position,
+			"functional":   ex.Functional,
+			"awards":       ex.Awards,
+			"date_begin":   ex.DateFrom,
+			"date_end":     ex.DateTo,
+		})
+
+	if err := row.Scan(&ex.ID); err != nil {
+		if strings.Contains(err.Error(), "23") && // Integrity Constraint Violation
+			strings.Contains(err.Error(), "user_id") {
+			return 0, fmt.Errorf("the user does not exist: %w", repoerr.ErrConflict)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return ex.ID, nil
+}
+
+func (s *storage) UpdateExperience(ctx context.Context, userID uint64, ex model.Experience) error {
+	const op = "postgresql user storage: update experience"
+
+	args := pgx.NamedArgs{
+		"user_id":      userID,
+		"id":           ex.ID,
+		"company_name": ex.CompanyName,
+		"position":     ex.Position,
+		"functional":   ex.Functional,
+		"awards":       ex.Awards,
+		"date_begin":   ex.DateFrom,
+		"date_end":     ex.DateTo,
+		"version":      ex.Version,
+	}
+	tag, err := s.DB.Exec(ctx, `UPDATE experiences
+	SET company_name = @company_name, position = @position,
+	functional = @functional, awards = @awards,
+	date_begin = @date_begin, date_end = @date_end
+	WHERE id=@id AND user_id=@user_id AND `+versionMatches, args)
+
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 { // it's ok for pgx
+		return notAffected(ctx, s.DB, `SELECT 1 FROM experiences WHERE id=@id AND user_id=@user_id`, args,
+			repoerr.ErrRecordNotAffected)
+	}
+	return nil
+}
+
+func (s *storage) DeleteExperience(ctx context.Context, userID, experienceID uint64, version int64) error {
+	const op = "postgresql user storage: delete experience"
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+		"id":      experienceID,
+		"version": version,
+	}
+	tag, err := s.DB.Exec(ctx, `DELETE FROM experiences WHERE id=@id AND user_id=@user_id AND `+versionMatches, args)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return notAffected(ctx, s.DB, `SELECT 1 FROM experiences WHERE id=@id AND user_id=@user_id`, args,
+			repoerr.ErrRecordNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,267 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+// collectMilitary returns nil if the user has no military registration record.
+func collectMilitary(rows pgx.Rows) (*model.Military, error) {
+	m, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByNameLax[military])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	mm := convertMilitaryToModelMilitary(m)
+	return &mm, nil
+}
+
+func (s *storage) GetMilitary(ctx context.Context, userID uint64) (*model.Military, error) {
+	const op = "postgresql user storage: get military"
+
+	rows, err := s.DB.Query(ctx, getMilitaryQuery, pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	m, err := collectMilitary(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if m == nil {
+		return nil, repoerr.ErrRecordNotFound
+	}
+	return m, nil
+}
+
+// SetMilitary creates the military registration record of the user if m.Version is model.NewVersion,
+// otherwise it replaces the record of the version (of any version if it's 0).
+func (s *storage) SetMilitary(ctx context.Context, userID uint64, m model.Military) error {
+	const op = "postgresql user storage: set military"
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	old, err := lockMilitary(ctx, tx, userID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	var current int64
+	if old != nil {
+		current = old.Version
+	}
+	if !versionMatch(m.Version, current) {
+		return repoerr.ErrVersionMismatch
+	}
+
+	if err := writeMilitary(ctx, tx, userID, old, m); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// setMilitary creates or replaces the military registration record of the user
+// as a part of the user data. The version of the record is checked only if the record is changed.
+func setMilitary(ctx context.Context, tx pgx.Tx, userID uint64, m model.Military) error {
+	old, err := lockMilitary(ctx, tx, userID)
+	if err != nil {
+		return err
+	}
+	if _, changed := model.MilitaryEventOf(old, m); !changed {
+		return nil
+	}
+	if old == nil && m.Version != 0 || old != nil && old.Version != m.Version {
+		return fmt.Errorf("the military registration record: %w", repoerr.ErrVersionMismatch)
+	}
+	return writeMilitary(ctx, tx, userID, old, m)
+}
+
+// lockMilitary returns the military registration record of the user locked till the end of tx
+// or nil if there is no record.
+func lockMilitary(ctx context.Context, tx pgx.Tx, userID uint64) (*model.Military, error) {
+	rows, err := tx.Query(ctx, `SELECT rank, specialty, category_of_validity, title_of_commissariat, version
+		FROM militaries WHERE user_id = @user_id FOR UPDATE`,
+		pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, err
+	}
+	return collectMilitary(rows)
+}
+
+// writeMilitary replaces the military registration record old of the user (nil if there is no record)
+// and registers the change to be reported to the commissariat. Nothing is written if the record is not changed.
+func writeMilitary(ctx context.Context, tx pgx.Tx, userID uint64, old *model.Military, m model.Military) error {
+	event, changed := model.MilitaryEventOf(old, m)
+	if !changed {
+		return nil
+	}
+
+	args := pgx.NamedArgs{
+		"user_id":               userID,
+		"rank":                  m.Rank,
+		"specialty":             m.Speciality,
+		"category_of_validity":  m.Category,
+		"title_of_commissariat": m.Commissariat,
+	}
+	if old == nil {
+		_, err := tx.Exec(ctx, `INSERT INTO militaries
+			(user_id, rank, specialty, category_of_validity, title_of_commissariat)
+			VALUES (@user_id, @rank, @specialty, @category_of_validity, @title_of_commissariat)`, args)
+		if err != nil {
+			if strings.Contains(err.Error(), "23") && // Integrity Constraint Violation
+				strings.Contains(err.Error(), "user_id") {
+				return fmt.Errorf("the user does not exist: %w", repoerr.ErrConflict)
+			}
+			return err
+		}
+	} else {
+		_, err := tx.Exec(ctx, `UPDATE militaries
+			SET rank = @rank, specialty = @specialty, category_of_validity = @category_of_validity,
+			title_of_commissariat = @title_of_commissariat
+			WHERE user_id = @user_id`, args)
+		if err != nil {
+			return err
+		}
+	}
+	return addMilitaryEvent(ctx, tx, userID, event)
+}
+
+func (s *storage) DeleteMilitary(ctx context.Context, userID uint64, version int64) error {
+	const op = "postgresql user storage: delete military"
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+		"version": version,
+	}
+	tag, err := tx.Exec(ctx, `DELETE FROM militaries WHERE user_id = @user_id AND `+versionMatches, args)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return notAffected(ctx, tx, `SELECT 1 FROM militaries WHERE user_id = @user_id`, args,
+			repoerr.ErrRecordNotFound)
+	}
+
+	if err := addMilitaryEvent(ctx, tx, userID, model.MilitaryEventDeregistered); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func addMilitaryEvent(ctx context.Context, tx pgx.Tx, userID uint64, event model.MilitaryEvent) error {
+	_, err := tx.Exec(ctx, `INSERT INTO military_events (user_id, event) VALUES (@user_id, @event)`,
+		pgx.NamedArgs{
+			"user_id": userID,
+			"event":   event,
+		})
+	return err
+}
+
+func (s *storage) ListMilitaryLiable(ctx context.Context, params model.ListMilitaryParams) ([]model.MilitaryLiable, error) {
+	const op = "postgresql user storage: list military liable"
+
+	rows, err := s.DB.Query(ctx, `SELECT
+		users.id AS id, lastname, firstname, middlename, date_of_birth,
+		departments.title AS department, positions.title AS position,
+		rank, specialty, category_of_validity, title_of_commissariat, false AS has_scan
+		FROM militaries
+		JOIN users ON militaries.user_id = users.id
+		JOIN departments ON users.department_id = departments.id
+		JOIN positions ON users.position_id = positions.id
+		WHERE (@category::varchar IS NULL OR category_of_validity = @category) AND
+		(@rank::varchar IS NULL OR rank = @rank) AND
+		(@include_terminated OR users.terminated_at IS NULL)
+		ORDER BY category_of_validity, rank, lastname, firstname`,
+		pgx.NamedArgs{
+			"category":           params.Category,
+			"rank":               params.Rank,
+			"include_terminated": params.IncludeTerminated,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	mls, err := pgx.CollectRows[militaryLiable](rows, pgx.RowToStructByNameLax[militaryLiable])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	liable := make([]model.MilitaryLiable, len(mls))
+	for i, ml := range mls {
+		liable[i] = convertMilitaryLiableToModelMilitaryLiable(ml)
+	}
+	return liable, nil
+}
+
+// ListMilitaryNotifications returns changes of the military registration records
+// and position changes of liable employees within the period.
+func (s *storage) ListMilitaryNotifications(ctx context.Context,
+	from, to time.Time) ([]model.MilitaryNotification, error) {
+	const op = "postgresql user storage: list military notifications"
+
+	rows, err := s.DB.Query(ctx, `SELECT
+		changes.user_id AS id, lastname, firstname, middlename, date_of_birth,
+		departments.title AS department, positions.title AS position,
+		COALESCE(rank, '') AS rank, COALESCE(specialty, '') AS specialty,
+		COALESCE(category_of_validity, '') AS category_of_validity,
+		COALESCE(title_of_commissariat, '') AS title_of_commissariat, false AS has_scan,
+		changes.event AS event, changes.date AS date
+		FROM (
+			SELECT user_id, event, created_at::date AS date
+			FROM military_events
+			WHERE created_at::date BETWEEN @date_from AND @date_to
+			UNION ALL
+			SELECT position_history.user_id, @position_changed::varchar, position_history.date_begin
+			FROM position_history
+			JOIN militaries ON militaries.user_id = position_history.user_id
+			WHERE position_history.date_begin BETWEEN @date_from AND @date_to
+		) AS changes
+		JOIN users ON changes.user_id = users.id
+		JOIN departments ON users.department_id = departments.id
+		JOIN positions ON users.position_id = positions.id
+		LEFT JOIN militaries ON militaries.user_id = changes.user_id
+		ORDER BY changes.date, lastname, firstname`,
+		pgx.NamedArgs{
+			"date_from":        from,
+			"date_to":          to,
+			"position_changed": model.MilitaryEventPositionChanged,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	mns, err := pgx.CollectRows[militaryNotification](rows, pgx.RowToStructByNameLax[militaryNotification])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	notifications := make([]model.MilitaryNotification, len(mns))
+	for i, mn := range mns {
+		notifications[i] = convertMilitaryNotificationToModelMilitaryNotification(mn)
+	}
+	return notifications, nil
+}
@@ -0,0 +1,359 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *storage) ListOnboardingTemplates(ctx context.Context) ([]model.OnboardingTemplate, error) {
+	const op = "postgresql user storage: list onboarding templates"
+
+	rows, err := s.DB.Query(ctx, `SELECT id, title, department_id, position_id
+		FROM onboarding_templates
+		ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	ts, err := pgx.CollectRows[onboardingTemplate](rows, pgx.RowToStructByNameLax[onboardingTemplate])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err = s.DB.Query(ctx, `SELECT template_id, title, scan_type, due_days
+		FROM onboarding_template_items
+		ORDER BY due_days, id`)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	items, err := pgx.CollectRows[onboardingTemplateItem](rows, pgx.RowToStructByNameLax[onboardingTemplateItem])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	templates := make([]model.OnboardingTemplate, len(ts))
+	idx := make(map[uint64]int, len(ts))
+	for i, t := range ts {
+		templates[i] = model.OnboardingTemplate{
+			ID:           t.ID,
+			Title:        t.Title,
+			DepartmentID: t.DepartmentID,
+			PositionID:   t.PositionID,
+			Items:        []model.OnboardingTemplateItem{},
+		}
+		idx[t.ID] = i
+	}
+	for _, item := range items {
+		if i, ok := idx[item.TemplateID]; ok {
+			templates[i].Items = append(templates[i].Items, convertOnboardingTemplateItemToModel(item))
+		}
+	}
+	return templates, nil
+}
+
+func (s *storage) GetOnboardingTemplate(ctx context.Context, templateID uint64) (*model.OnboardingTemplate, error) {
+	const op = "postgresql user storage: get onboarding template"
+
+	rows, err := s.DB.Query(ctx, `SELECT id, title, department_id, position_id
+		FROM onboarding_templates
+		WHERE id = @id`,
+		pgx.NamedArgs{"id": templateID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	t, err := pgx.CollectExactlyOneRow[onboardingTemplate](rows, pgx.RowToStructByNameLax[onboardingTemplate])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err = s.DB.Query(ctx, `SELECT template_id, title, scan_type, due_days
+		FROM onboarding_template_items
+		WHERE template_id = @id
+		ORDER BY due_days, id`,
+		pgx.NamedArgs{"id": templateID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	items, err := pgx.CollectRows[onboardingTemplateItem](rows, pgx.RowToStructByNameLax[onboardingTemplateItem])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	mt := model.OnboardingTemplate{
+		ID:           t.ID,
+		Title:        t.Title,
+		DepartmentID: t.DepartmentID,
+		PositionID:   t.PositionID,
+		Items:        make([]model.OnboardingTemplateItem, len(items)),
+	}
+	for i, item := range items {
+		mt.Items[i] = convertOnboardingTemplateItemToModel(item)
+	}
+	return &mt, nil
+}
+
+func (s *storage) AddOnboardingTemplate(ctx context.Context, t model.OnboardingTemplate) (uint64, error) {
+	const op = "postgresql user storage: add onboarding template"
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	err = tx.QueryRow(ctx, `INSERT INTO onboarding_templates
+		("title", "department_id", "position_id")
+		VALUES (@title, @department_id, @position_id)
+		RETURNING "id"`,
+		pgx.NamedArgs{
+			"title":         t.Title,
+			"department_id": t.DepartmentID,
+			"position_id":   t.PositionID,
+		}).Scan(&t.ID)
+	if err != nil {
+		if strings.Contains(err.Error(), "23") { // Integrity Constraint Violation
+			if strings.Contains(err.Error(), "department_id") {
+				return 0, fmt.Errorf("the department does not exist: %w", repoerr.ErrConflict)
+			}
+			if strings.Contains(err.Error(), "position_id") {
+				return 0, fmt.Errorf("the position does not exist: %w", repoerr.ErrConflict)
+			}
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := addOnboardingTemplateItems(ctx, tx, t.ID, t.Items); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return t.ID, nil
+}
+
+// UpdateOnboardingTemplate replaces the template and its items.
+// Checklists of the employees created from the template are kept.
+func (s *storage) UpdateOnboardingTemplate(ctx context.Context, t model.OnboardingTemplate) error {
+	const op = "postgresql user storage: update onboarding template"
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	tag, err := tx.Exec(ctx, `UPDATE onboarding_templates
+		SET title = @title, department_id = @department_id, position_id = @position_id
+		WHERE id = @id`,
+		pgx.NamedArgs{
+			"id":            t.ID,
+			"title":         t.Title,
+			"department_id": t.DepartmentID,
+			"position_id":   t.PositionID,
+		})
+	if err != nil {
+		if strings.Contains(err.Error(), "23") { // Integrity Constraint Violation
+			if strings.Contains(err.Error(), "department_id") {
+				return fmt.Errorf("the department does not exist: %w", repoerr.ErrConflict)
+			}
+			if strings.Contains(err.Error(), "position_id") {
+				return fmt.Errorf("the position does not exist: %w", repoerr.ErrConflict)
+			}
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 { // it's ok for pgx
+		return repoerr.ErrRecordNotAffected
+	}
+
+	if _, err := tx.Exec(ctx, "DELETE FROM onboarding_template_items WHERE template_id = @id",
+		pgx.NamedArgs{"id": t.ID}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if err := addOnboardingTemplateItems(ctx, tx, t.ID, t.Items); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *storage) DeleteOnboardingTemplate(ctx context.Context, templateID uint64) error {
+	const op = "postgresql user storage: delete onboarding template"
+
+	tag, err := s.DB.Exec(ctx, "DELETE FROM onboarding_templates WHERE id = @id",
+		pgx.NamedArgs{"id": templateID})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repoerr.ErrRecordNotFound
+	}
+	return nil
+}
+
+func addOnboardingTemplateItems(ctx context.Context, tx pgx.Tx, templateID uint64, items []model.OnboardingTemplateItem) error {
+	for _, item := range items {
+		if _, err := tx.Exec(ctx, `INSERT INTO onboarding_template_items
+			(template_id, title, scan_type, due_days)
+			VALUES (@template_id, @title, @scan_type, @due_days)`,
+			pgx.NamedArgs{
+				"template_id": templateID,
+				"title":       item.Title,
+				"scan_type":   convertNilModelScanType(item.ScanType),
+				"due_days":    item.DueDays,
+			}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *storage) ListOnboardingItems(ctx context.Context, userID uint64) ([]model.OnboardingItem, error) {
+	const op = "postgresql user storage: list onboarding items"
+
+	rows, err := s.DB.Query(ctx, `SELECT id, title, scan_type, due_date, completed_at
+		FROM onboarding_items
+		WHERE user_id = @user_id
+		ORDER BY due_date, id`,
+		pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	is, err := pgx.CollectRows[onboardingItem](rows, pgx.RowToStructByNameLax[onboardingItem])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	items := make([]model.OnboardingItem, len(is))
+	for i, item := range is {
+		items[i] = convertOnboardingItemToModelOnboardingItem(item)
+	}
+	return items, nil
+}
+
+func (s *storage) GetOnboardingItem(ctx context.Context, userID, itemID uint64) (*model.OnboardingItem, error) {
+	const op = "postgresql user storage: get onboarding item"
+
+	rows, err := s.DB.Query(ctx, `SELECT id, title, scan_type, due_date, completed_at
+		FROM onboarding_items
+		WHERE id = @id AND user_id = @user_id`,
+		pgx.NamedArgs{
+			"id":      itemID,
+			"user_id": userID,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	item, err := pgx.CollectExactlyOneRow[onboardingItem](rows, pgx.RowToStructByNameLax[onboardingItem])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	mi := convertOnboardingItemToModelOnboardingItem(item)
+	return &mi, nil
+}
+
+// SetOnboardingItemCompleted ticks (or unticks) the manual onboarding item.
+func (s *storage) SetOnboardingItemCompleted(ctx context.Context, userID, itemID uint64, completed bool) error {
+	const op = "postgresql user storage: set onboarding item completed"
+
+	tag, err := s.DB.Exec(ctx, `UPDATE onboarding_items
+		SET completed_at = CASE WHEN @completed THEN COALESCE(completed_at, now()) END
+		WHERE id = @id AND user_id = @user_id AND scan_type IS NULL`,
+		pgx.NamedArgs{
+			"id":        itemID,
+			"user_id":   userID,
+			"completed": completed,
+		})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 { // it's ok for pgx
+		return repoerr.ErrRecordNotAffected
+	}
+	return nil
+}
+
+// ListOverdueOnboarding returns not completed onboarding items of current employees
+// of the department (of all departments if departmentID is nil) with the due date before the date.
+func (s *storage) ListOverdueOnboarding(ctx context.Context,
+	date time.Time, departmentID *uint64) ([]model.EmployeeOnboardingItem, error) {
+	const op = "postgresql user storage: list overdue onboarding"
+
+	rows, err := s.DB.Query(ctx, `SELECT
+		onboarding_items.id AS id, onboarding_items.title AS title, scan_type, due_date, completed_at,
+		users.id AS user_id, lastname, firstname, middlename,
+		departments.title AS department, positions.title AS position
+		FROM onboarding_items
+		JOIN users ON onboarding_items.user_id = users.id
+		JOIN departments ON users.department_id = departments.id
+		JOIN positions ON users.position_id = positions.id
+		WHERE completed_at IS NULL AND due_date < @date AND users.terminated_at IS NULL AND
+		(@department_id::bigint IS NULL OR users.department_id = @department_id)
+		ORDER BY lastname, firstname, users.id, due_date, onboarding_items.id`,
+		pgx.NamedArgs{
+			"date":          date,
+			"department_id": departmentID,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	is, err := pgx.CollectRows[employeeOnboardingItem](rows, pgx.RowToStructByNameLax[employeeOnboardingItem])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	items := make([]model.EmployeeOnboardingItem, len(is))
+	for i, item := range is {
+		items[i] = convertEmployeeOnboardingItemToModel(item)
+	}
+	return items, nil
+}
+
+// startOnboarding creates the checklist of the new employee
+// from the templates matching the department and the position.
+func startOnboarding(ctx context.Context, tx pgx.Tx, userID, departmentID, positionID uint64) error {
+	_, err := tx.Exec(ctx, `INSERT INTO onboarding_items
+		(user_id, template_item_id, title, scan_type, due_date)
+		SELECT @user_id, onboarding_template_items.id, onboarding_template_items.title,
+		onboarding_template_items.scan_type, CURRENT_DATE + onboarding_template_items.due_days
+		FROM onboarding_template_items
+		JOIN onboarding_templates ON onboarding_template_items.template_id = onboarding_templates.id
+		WHERE (onboarding_templates.department_id IS NULL OR onboarding_templates.department_id = @department_id) AND
+		(onboarding_templates.position_id IS NULL OR onboarding_templates.position_id = @position_id)`,
+		pgx.NamedArgs{
+			"user_id":       userID,
+			"department_id": departmentID,
+			"position_id":   positionID,
+		})
+	return err
+}
+
+// completeOnboardingByScan completes the onboarding items waiting for a scan of the type.
+func completeOnboardingByScan(ctx context.Context, tx pgx.Tx, userID uint64, st scanType) error {
+	_, err := tx.Exec(ctx, `UPDATE onboarding_items
+		SET completed_at = now()
+		WHERE user_id = @user_id AND scan_type = @scan_type AND completed_at IS NULL`,
+		pgx.NamedArgs{
+			"user_id":   userID,
+			"scan_type": st,
+		})
+	return err
+}
@@ -0,0 +1,217 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+const orderColumns = `orders.id AS id, orders.type AS type, orders.status AS status, orders.title AS title,
+orders.user_id AS user_id, lastname, firstname, middlename, orders.effective_date AS effective_date,
+orders.contract_id AS contract_id, orders.vacation_id AS vacation_id, orders.number AS number,
+orders.date AS date, orders.created_at AS created_at,
+(SELECT COUNT(*)>0 FROM scans WHERE scans.user_id=orders.user_id AND scans.document_id=orders.id AND scans.type='Приказ') AS has_scan`
+
+func (s *storage) ListOrders(ctx context.Context, params model.ListOrdersParams) ([]model.Order, error) {
+	const op = "postgresql user storage: list orders"
+
+	rows, err := s.DB.Query(ctx, `SELECT `+orderColumns+`
+		FROM orders
+		JOIN users ON orders.user_id = users.id
+		WHERE (@type::varchar IS NULL OR orders.type = @type)
+		AND (@status::varchar IS NULL OR orders.status = @status)
+		AND (@user_id::bigint IS NULL OR orders.user_id = @user_id)
+		AND (@year::integer IS NULL OR orders.year = @year)
+		ORDER BY orders.date DESC NULLS FIRST, orders.id DESC`,
+		pgx.NamedArgs{
+			"type":    params.Type,
+			"status":  params.Status,
+			"user_id": params.UserID,
+			"year":    params.Year,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	os, err := pgx.CollectRows[order](rows, pgx.RowToStructByNameLax[order])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	orders := make([]model.Order, len(os))
+	for i, o := range os {
+		orders[i] = convertOrderToModelOrder(o)
+	}
+	return orders, nil
+}
+
+func (s *storage) GetOrder(ctx context.Context, orderID uint64) (*model.Order, error) {
+	const op = "postgresql user storage: get order"
+
+	rows, err := s.DB.Query(ctx, `SELECT `+orderColumns+`
+		FROM orders
+		JOIN users ON orders.user_id = users.id
+		WHERE orders.id = @id`,
+		pgx.NamedArgs{"id": orderID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	o, err := pgx.CollectExactlyOneRow[order](rows, pgx.RowToStructByNameLax[order])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	mo := convertOrderToModelOrder(o)
+	return &mo, nil
+}
+
+// AddOrder adds the draft order.
+func (s *storage) AddOrder(ctx context.Context, o model.Order) (uint64, error) {
+	const op = "postgresql user storage: add order"
+
+	row := s.DB.QueryRow(ctx, `INSERT INTO orders
+		("type", "title", "effective_date", "user_id", "contract_id", "vacation_id")
+		VALUES (@type, @title, @effective_date, @user_id, @contract_id, @vacation_id)
+		RETURNING "id"`,
+		pgx.NamedArgs{
+			"type":           o.Type,
+			"title":          o.Title,
+			"effective_date": o.EffectiveDate,
+			"user_id":        o.UserID,
+			"contract_id":    o.ContractID,
+			"vacation_id":    o.VacationID,
+		})
+
+	if err := row.Scan(&o.ID); err != nil {
+		if err := orderConflict(err); err != nil {
+			return 0, err
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return o.ID, nil
+}
+
+// UpdateOrder updates the draft order.
+func (s *storage) UpdateOrder(ctx context.Context, o model.Order) error {
+	const op = "postgresql user storage: update order"
+
+	tag, err := s.DB.Exec(ctx, `UPDATE orders
+		SET type = @type, title = @title, effective_date = @effective_date, user_id = @user_id,
+		contract_id = @contract_id, vacation_id = @vacation_id
+		WHERE id = @id AND status = 'draft'`,
+		pgx.NamedArgs{
+			"id":             o.ID,
+			"type":           o.Type,
+			"title":          o.Title,
+			"effective_date": o.EffectiveDate,
+			"user_id":        o.UserID,
+			"contract_id":    o.ContractID,
+			"vacation_id":    o.VacationID,
+		})
+	if err != nil {
+		if err := orderConflict(err); err != nil {
+			return err
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 { // it's ok for pgx
+		return repoerr.ErrRecordNotAffected
+	}
+	return nil
+}
+
+// SignOrder signs the draft order on the date and assigns it the next number
+// of the order number sequence of its type in the year of the date. The number counter
+// is locked until the commit, so the numbers have no gaps and duplicates.
+func (s *storage) SignOrder(ctx context.Context, orderID uint64, date time.Time) (string, error) {
+	const op = "postgresql user storage: sign order"
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	rows, err := tx.Query(ctx, `SELECT `+numberSequenceColumns+`
+		FROM orders
+		JOIN order_types ON order_types.type = orders.type
+		JOIN number_sequences ON number_sequences.id = order_types.sequence_id
+		WHERE orders.id = @id AND orders.status = 'draft'
+		FOR UPDATE OF orders`,
+		pgx.NamedArgs{"id": orderID})
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	seq, err := pgx.CollectExactlyOneRow[numberSequence](rows, pgx.RowToStructByNameLax[numberSequence])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", repoerr.ErrRecordNotAffected
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	number, err := allocateNumber(ctx, tx, convertNumberSequenceToModelNumberSequence(seq), date.Year())
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE orders
+		SET status = 'signed', sequence_id = @sequence_id, year = @year, number = @number, date = @date
+		WHERE id = @id`,
+		pgx.NamedArgs{
+			"id":          orderID,
+			"sequence_id": seq.ID,
+			"year":        date.Year(),
+			"number":      number,
+			"date":        date,
+		}); err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	return number, nil
+}
+
+// CancelOrder cancels the draft order.
+func (s *storage) CancelOrder(ctx context.Context, orderID uint64) error {
+	const op = "postgresql user storage: cancel order"
+
+	tag, err := s.DB.Exec(ctx, `UPDATE orders SET status = 'cancelled' WHERE id = @id AND status = 'draft'`,
+		pgx.NamedArgs{"id": orderID})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 { // it's ok for pgx
+		return repoerr.ErrRecordNotAffected
+	}
+	return nil
+}
+
+// orderConflict returns ErrConflict wrapped with the reason
+// if err is the integrity constraint violation of the order, otherwise nil.
+func orderConflict(err error) error {
+	if !strings.Contains(err.Error(), "23") { // Integrity Constraint Violation
+		return nil
+	}
+	switch {
+	case strings.Contains(err.Error(), "user_id"):
+		return fmt.Errorf("the user does not exist: %w", repoerr.ErrConflict)
+	case strings.Contains(err.Error(), "contract_id"):
+		return fmt.Errorf("the contract does not exist: %w", repoerr.ErrConflict)
+	case strings.Contains(err.Error(), "vacation_id"):
+		return fmt.Errorf("the vacation does not exist: %w", repoerr.ErrConflict)
+	}
+	return nil
+}
@@ -42,7 +42,7 @@ func (s *storage) GetPassport(ctx context.Context, userID, passportID uint64) (*
 	const op = "postrgresql user storage: get passport"
 
 	rows, err := s.DB.Query(ctx,
-		`SELECT id, number, type, issued_date, issued_by,
+		`SELECT id, number, type, issued_date, issued_by, version,
 		(SELECT COUNT(*) FROM visas WHERE visas.passport_id = passports.id) AS visas_count,
 		(SELECT COUNT(*)>0 FROM scans WHERE user_id=@user_id AND scans.document_id=passports.id AND scans.type='Паспорт') AS has_scan
 		FROM passports
@@ -96,26 +96,27 @@ func (s *storage) UpdatePassport(ctx context.Context, userID uint64, mp model.Pa
 
 	p := convertModelPassportToPassport(mp)
 
+	args := pgx.NamedArgs{
+		"user_id":     userID,
+		"id":          p.ID,
+		"number":      p.Number,
+		"type":        p.Type,
+		"issued_date": p.IssuedDate,
+		"issued_by":   p.IssuedBy,
+		"version":     p.Version,
+	}
 	tag, err := s.DB.Exec(ctx, `UPDATE passports
 	SET number = @number, 
 	type = @type, 
 	issued_date = @issued_date, 
 	issued_by = @issued_by
-	WHERE id=@id AND user_id=@user_id`,
-		pgx.NamedArgs{
-			"user_id":     userID,
-			"id":          p.ID,
-			"number":      p.Number,
-			"type":        p.Type,
-			"issued_date": p.IssuedDate,
-			"issued_by":   p.IssuedBy,
-		})
+	WHERE id=@id AND user_id=@user_id AND `+versionMatches, args)
 
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 	if tag.RowsAffected() == 0 { // it's ok for pgx
-		return repoerr.ErrRecordNotAffected
+		return notAffected(ctx, s.DB, `SELECT 1 FROM passports WHERE id=@id AND user_id=@user_id`, args, repoerr.ErrRecordNotAffected)
 	}
 	return nil
 }
@@ -0,0 +1,147 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+const listPositionTrackQuery = `SELECT 
+position_history.id AS id, position_history.position_id AS position_id, positions.title AS position,
+position_history.department_id AS department_id, departments.title AS department,
+date_begin, date_end
+FROM position_history
+JOIN departments ON position_history.department_id = departments.id
+JOIN positions ON position_history.position_id = positions.id
+WHERE position_history.user_id = @user_id
+ORDER BY date_begin`
+
+func (s *storage) ListPositionTrack(ctx context.Context, userID uint64) ([]model.PositionTrackItem, error) {
+	const op = "postgresql user storage: list position track"
+
+	rows, err := s.DB.Query(ctx, listPositionTrackQuery, pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	pts, err := pgx.CollectRows[positionTrackItem](rows, pgx.RowToStructByNameLax[positionTrackItem])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	track := make([]model.PositionTrackItem, len(pts))
+	for i, pt := range pts {
+		track[i] = convertPositionTrackItemToModelPositionTrackItem(pt)
+	}
+	return track, nil
+}
+
+// ListPositionHolders returns users who held the position.
+// If date is not nil, only users who held the position on this date are returned.
+func (s *storage) ListPositionHolders(ctx context.Context, positionID uint64, date *time.Time) ([]model.PositionHolder, error) {
+	const op = "postgresql user storage: list position holders"
+
+	rows, err := s.DB.Query(ctx, `SELECT 
+		users.id AS user_id, lastname, firstname, middlename,
+		position_history.department_id AS department_id, departments.title AS department,
+		date_begin, date_end
+		FROM position_history
+		JOIN users ON position_history.user_id = users.id
+		JOIN departments ON position_history.department_id = departments.id
+		WHERE position_history.position_id = @position_id AND 
+		(@date::date IS NULL OR 
+		(date_begin <= @date::date AND (date_end IS NULL OR date_end >= @date::date)))
+		ORDER BY date_begin, lastname, firstname`,
+		pgx.NamedArgs{
+			"position_id": positionID,
+			"date":        date,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	phs, err := pgx.CollectRows[positionHolder](rows, pgx.RowToStructByNameLax[positionHolder])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	holders := make([]model.PositionHolder, len(phs))
+	for i, ph := range phs {
+		holders[i] = model.PositionHolder(ph)
+	}
+	return holders, nil
+}
+
+// movePosition closes the current position history record of the user
+// the day before the date and opens a new one starting from the date.
+// A record opened on the date is replaced, so that several changes in a day
+// don't produce empty periods. The history isn't rewritten backwards:
+// it's a conflict if the current record begins after the date.
+func movePosition(ctx context.Context, tx pgx.Tx, userID, departmentID, positionID uint64, date time.Time) error {
+	args := pgx.NamedArgs{
+		"user_id":       userID,
+		"department_id": departmentID,
+		"position_id":   positionID,
+		"date":          date,
+	}
+
+	var cur model.PositionTrackItem
+	err := tx.QueryRow(ctx, `SELECT id, date_begin FROM position_history
+		WHERE user_id = @user_id AND date_end IS NULL
+		FOR UPDATE`, args).Scan(&cur.ID, &cur.DateBegin)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+	case err != nil:
+		return err
+	default:
+		end, ok := cur.CloseOn(date)
+		if !ok {
+			return fmt.Errorf("the current position is held from %s: %w",
+				cur.DateBegin.Format(time.DateOnly), repoerr.ErrConflict)
+		}
+		args["id"], args["date_end"] = cur.ID, end
+		query := `UPDATE position_history SET date_end = @date_end WHERE id = @id`
+		if end == nil {
+			query = `DELETE FROM position_history WHERE id = @id`
+		}
+		if _, err := tx.Exec(ctx, query, args); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(ctx, `INSERT INTO position_history
+		(user_id, department_id, position_id, date_begin)
+		VALUES (@user_id, @department_id, @position_id, @date)`, args)
+	return err
+}
+
+// positionDate returns the date the user holds the position from, today by default.
+func positionDate(u model.User) time.Time {
+	if u.PositionDate != nil {
+		return *u.PositionDate
+	}
+	return time.Now()
+}
+
+func (s *storage) GetPosition(ctx context.Context, userID uint64) (departmentID, positionID uint64, err error) {
+	const op = "postgresql user storage: get position"
+
+	err = s.DB.QueryRow(ctx,
+		"SELECT department_id, position_id FROM users WHERE id = @id",
+		pgx.NamedArgs{"id": userID}).Scan(&departmentID, &positionID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, 0, repoerr.ErrRecordNotFound
+		}
+		return 0, 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return departmentID, positionID, nil
+}
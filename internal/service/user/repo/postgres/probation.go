@@ -0,0 +1,127 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+// ListProbationEmployees returns the current employees with the contracts
+// having the probation period which is not decided yet or is extended.
+func (s *storage) ListProbationEmployees(ctx context.Context) ([]model.ProbationEmployee, error) {
+	const op = "postgresql user storage: list probation employees"
+
+	rows, err := s.DB.Query(ctx, `SELECT
+		users.id AS user_id, lastname, firstname, middlename,
+		departments.title AS department, positions.title AS position,
+		contracts.id AS id, contracts.number AS number, contracts.contract_type AS contract_type,
+		contracts.work_type_id AS work_type_id, contracts.probation_period AS probation_period,
+		contracts.date_begin AS date_begin, contracts.date_end AS date_end,
+		contracts.extra_vacation_days AS extra_vacation_days, probation_reminders.reminded_on AS probation_reminded_on,
+		`+probationResultColumns+`
+		FROM contracts
+		JOIN users ON contracts.user_id = users.id
+		JOIN departments ON users.department_id = departments.id
+		JOIN positions ON users.position_id = positions.id
+		LEFT JOIN probation_results ON probation_results.contract_id = contracts.id
+		LEFT JOIN probation_reminders ON probation_reminders.contract_id = contracts.id
+		WHERE contracts.probation_period > 0 AND users.terminated_at IS NULL
+		AND (probation_results.outcome IS NULL OR probation_results.outcome = 'extended')
+		ORDER BY lastname, firstname, contracts.id`)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	pes, err := pgx.CollectRows[probationEmployee](rows, pgx.RowToStructByNameLax[probationEmployee])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	employees := make([]model.ProbationEmployee, len(pes))
+	for i, pe := range pes {
+		employees[i] = convertProbationEmployeeToModelProbationEmployee(pe)
+	}
+	return employees, nil
+}
+
+// SetProbationResult sets the outcome of the probation of the user contract,
+// it's created if r.Version is model.NewVersion, otherwise the outcome of the version
+// (of any version if it's 0) is replaced. The extended probation is to be reminded of again.
+func (s *storage) SetProbationResult(ctx context.Context, userID, contractID uint64, r model.ProbationResult) error {
+	const op = "postgresql user storage: set probation result"
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	var version int64
+	err = tx.QueryRow(ctx, `SELECT probation_results.version FROM probation_results
+		JOIN contracts ON probation_results.contract_id = contracts.id
+		WHERE contract_id = @contract_id AND user_id = @user_id
+		FOR UPDATE OF probation_results`,
+		pgx.NamedArgs{
+			"contract_id": contractID,
+			"user_id":     userID,
+		}).Scan(&version)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !versionMatch(r.Version, version) {
+		return repoerr.ErrVersionMismatch
+	}
+
+	tag, err := tx.Exec(ctx, `INSERT INTO probation_results (contract_id, outcome, extended_to, comment)
+		SELECT id, @outcome, @extended_to, @comment
+		FROM contracts
+		WHERE id = @contract_id AND user_id = @user_id
+		ON CONFLICT (contract_id) DO UPDATE
+		SET outcome = excluded.outcome, extended_to = excluded.extended_to, comment = excluded.comment`,
+		pgx.NamedArgs{
+			"contract_id": contractID,
+			"user_id":     userID,
+			"outcome":     r.Outcome,
+			"extended_to": r.ExtendedTo,
+			"comment":     r.Comment,
+		})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 { // it's ok for pgx
+		return repoerr.ErrRecordNotAffected
+	}
+
+	if r.Outcome == model.ProbationExtended {
+		if _, err := tx.Exec(ctx, `DELETE FROM probation_reminders WHERE contract_id = @contract_id`,
+			pgx.NamedArgs{"contract_id": contractID}); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// SetProbationReminded marks the probation end of the contract as reminded on the day.
+func (s *storage) SetProbationReminded(ctx context.Context, contractID uint64, date time.Time) error {
+	const op = "postgresql user storage: set probation reminded"
+
+	if _, err := s.DB.Exec(ctx, `INSERT INTO probation_reminders (contract_id, reminded_on)
+		VALUES (@contract_id, @date)
+		ON CONFLICT (contract_id) DO UPDATE SET reminded_on = excluded.reminded_on`,
+		pgx.NamedArgs{
+			"contract_id": contractID,
+			"date":        date,
+		}); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+const listRelativesQuery = `SELECT
+relatives.id AS id, type, lastname, firstname, middlename, date_of_birth, disabled,
+(SELECT COUNT(*)>0 FROM scans WHERE scans.user_id=relatives.user_id AND scans.document_id=relatives.id
+AND scans.type IN ('Свидетельство о браке', 'Свидетельство о рождении')) AS has_scan
+FROM relatives
+WHERE user_id = @user_id
+ORDER BY date_of_birth`
+
+func (s *storage) ListRelatives(ctx context.Context, userID uint64) ([]model.Relative, error) {
+	const op = "postgresql user storage: list relatives"
+
+	rows, err := s.DB.Query(ctx, listRelativesQuery, pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	rs, err := pgx.CollectRows[relative](rows, pgx.RowToStructByNameLax[relative])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	relatives := make([]model.Relative, len(rs))
+	for i, r := range rs {
+		relatives[i] = convertRelativeToModelRelative(r)
+	}
+	return relatives, nil
+}
+
+func (s *storage) GetRelative(ctx context.Context, userID, relativeID uint64) (*model.Relative, error) {
+	const op = "postgresql user storage: get relative"
+
+	rows, err := s.DB.Query(ctx, `SELECT
+		id, type, lastname, firstname, middlename, date_of_birth, disabled, version,
+		(SELECT COUNT(*)>0 FROM scans WHERE user_id=@user_id AND scans.document_id=relatives.id
+		AND scans.type IN ('Свидетельство о браке', 'Свидетельство о рождении')) AS has_scan
+		FROM relatives
+		WHERE id = @id AND user_id = @user_id`,
+		pgx.NamedArgs{
+			"id":      relativeID,
+			"user_id": userID,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	r, err := pgx.CollectExactlyOneRow[relative](rows, pgx.RowToStructByNameLax[relative])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	mr := convertRelativeToModelRelative(r)
+	return &mr, nil
+}
+
+func (s *storage) AddRelative(ctx context.Context, userID uint64, r model.Relative) (uint64, error) {
+	const op = "postgresql user storage: add relative"
+
+	row := s.DB.QueryRow(ctx, `INSERT INTO relatives
+		("user_id", "type", "lastname", "firstname", "middlename", "date_of_birth", "disabled")
+		VALUES (@user_id, @type, @lastname, @firstname, @middlename, @date_of_birth, @disabled)
+		RETURNING "id"`,
+		pgx.NamedArgs{
+			"user_id":       userID,
+			"type":          r.Type,
+			"lastname":      r.LastName,
+			"firstname":     r.FirstName,
+			"middlename":    r.MiddleName,
+			"date_of_birth": r.DateOfBirth,
+			"disabled":      r.Disabled,
+		})
+
+	if err := row.Scan(&r.ID); err != nil {
+		if strings.Contains(err.Error(), "23") && // Integrity Constraint Violation
+			strings.Contains(err.Error(), "user_id") {
+			return 0, fmt.Errorf("the user does not exist: %w", repoerr.ErrConflict)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return r.ID, nil
+}
+
+func (s *storage) UpdateRelative(ctx context.Context, userID uint64, r model.Relative) error {
+	const op = "postgresql user storage: update relative"
+
+	args := pgx.NamedArgs{
+		"user_id":       userID,
+		"id":            r.ID,
+		"type":          r.Type,
+		"lastname":      r.LastName,
+		"firstname":     r.FirstName,
+		"middlename":    r.MiddleName,
+		"date_of_birth": r.DateOfBirth,
+		"disabled":      r.Disabled,
+		"version":       r.Version,
+	}
+	tag, err := s.DB.Exec(ctx, `UPDATE relatives
+	SET type = @type, lastname = @lastname, firstname = @firstname, middlename = @middlename,
+	date_of_birth = @date_of_birth, disabled = @disabled
+	WHERE id=@id AND user_id=@user_id AND `+versionMatches, args)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 { // it's ok for pgx
+		return notAffected(ctx, s.DB, `SELECT 1 FROM relatives WHERE id=@id AND user_id=@user_id`, args,
+			repoerr.ErrRecordNotAffected)
+	}
+	return nil
+}
+
+func (s *storage) DeleteRelative(ctx context.Context, userID, relativeID uint64, version int64) error {
+	const op = "postgresql user storage: delete relative"
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+		"id":      relativeID,
+		"version": version,
+	}
+	tag, err := s.DB.Exec(ctx, `DELETE FROM relatives WHERE id=@id AND user_id=@user_id AND `+versionMatches, args)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return notAffected(ctx, s.DB, `SELECT 1 FROM relatives WHERE id=@id AND user_id=@user_id`, args,
+			repoerr.ErrRecordNotFound)
+	}
+	return nil
+}
@@ -70,7 +70,13 @@ func (s *storage) AddScan(ctx context.Context, userID uint64, ms model.Scan) (ui
 
 	sc := convertModelScanToScan(ms)
 
-	row := s.DB.QueryRow(ctx, `INSERT INTO scans
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	row := tx.QueryRow(ctx, `INSERT INTO scans
 		("user_id", "document_id", "type", "description")
 		VALUES (@user_id, @document_id, @type, @description)
 		RETURNING "id"`,
@@ -89,5 +95,12 @@ func (s *storage) AddScan(ctx context.Context, userID uint64, ms model.Scan) (ui
 		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := completeOnboardingByScan(ctx, tx, userID, sc.Type); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
 	return sc.ID, nil
 }
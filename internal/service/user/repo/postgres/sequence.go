@@ -0,0 +1,205 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+const numberSequenceColumns = `number_sequences.id AS id, number_sequences.entity AS entity,
+number_sequences.title AS title, number_sequences.prefix AS prefix, number_sequences.suffix AS suffix,
+number_sequences.with_year AS with_year, number_sequences.padding AS padding,
+number_sequences.yearly_reset AS yearly_reset`
+
+func (s *storage) ListNumberSequences(ctx context.Context) ([]model.NumberSequence, error) {
+	const op = "postgresql user storage: list number sequences"
+
+	rows, err := s.DB.Query(ctx, `SELECT `+numberSequenceColumns+`,
+		ARRAY(SELECT type FROM order_types WHERE order_types.sequence_id = number_sequences.id ORDER BY type) AS types
+		FROM number_sequences
+		ORDER BY id`)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	ss, err := pgx.CollectRows[numberSequence](rows, pgx.RowToStructByNameLax[numberSequence])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	seqs := make([]model.NumberSequence, len(ss))
+	for i, seq := range ss {
+		seqs[i] = convertNumberSequenceToModelNumberSequence(seq)
+	}
+	return seqs, nil
+}
+
+// AddNumberSequence adds the sequence of the order numbers and moves its order types to it.
+func (s *storage) AddNumberSequence(ctx context.Context, seq model.NumberSequence) (uint64, error) {
+	const op = "postgresql user storage: add number sequence"
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	if err := tx.QueryRow(ctx, `INSERT INTO number_sequences
+		(entity, title, prefix, suffix, with_year, padding, yearly_reset)
+		VALUES (@entity, @title, @prefix, @suffix, @with_year, @padding, @yearly_reset)
+		RETURNING id`,
+		pgx.NamedArgs{
+			"entity":       model.SequenceOrderNumber,
+			"title":        seq.Title,
+			"prefix":       seq.Prefix,
+			"suffix":       seq.Suffix,
+			"with_year":    seq.WithYear,
+			"padding":      seq.Padding,
+			"yearly_reset": seq.YearlyReset,
+		}).Scan(&seq.ID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := setOrderTypesSequence(ctx, tx, seq); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return seq.ID, nil
+}
+
+// UpdateNumberSequence updates the format of the sequence, the numbers already
+// allocated are kept and the counters continue. The order types of the sequence
+// of the order numbers are moved to it, other sequences have no order types.
+func (s *storage) UpdateNumberSequence(ctx context.Context, seq model.NumberSequence) error {
+	const op = "postgresql user storage: update number sequence"
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	var entity model.SequenceEntity
+	err = tx.QueryRow(ctx, `UPDATE number_sequences
+		SET title = @title, prefix = @prefix, suffix = @suffix, with_year = @with_year, padding = @padding,
+		yearly_reset = @yearly_reset
+		WHERE id = @id
+		RETURNING entity`,
+		pgx.NamedArgs{
+			"id":           seq.ID,
+			"title":        seq.Title,
+			"prefix":       seq.Prefix,
+			"suffix":       seq.Suffix,
+			"with_year":    seq.WithYear,
+			"padding":      seq.Padding,
+			"yearly_reset": seq.YearlyReset,
+		}).Scan(&entity)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repoerr.ErrRecordNotAffected
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if entity != model.SequenceOrderNumber && len(seq.Types) > 0 {
+		return fmt.Errorf("the order types are numbered by the order number sequences only: %w",
+			repoerr.ErrConflict)
+	}
+	if err := setOrderTypesSequence(ctx, tx, seq); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func setOrderTypesSequence(ctx context.Context, tx pgx.Tx, seq model.NumberSequence) error {
+	if len(seq.Types) == 0 {
+		return nil
+	}
+	types := make([]string, len(seq.Types))
+	for i, t := range seq.Types {
+		types[i] = string(t)
+	}
+	_, err := tx.Exec(ctx, `UPDATE order_types SET sequence_id = @sequence_id WHERE type = ANY(@types)`,
+		pgx.NamedArgs{
+			"sequence_id": seq.ID,
+			"types":       types,
+		})
+	return err
+}
+
+// numberUsed are the queries checking whether the number of the entity is used.
+var numberUsed = map[model.SequenceEntity]string{
+	model.SequencePersonnelNumber: `SELECT EXISTS (SELECT 1 FROM users WHERE personnel_number = @number)`,
+	model.SequenceContractNumber:  `SELECT EXISTS (SELECT 1 FROM contracts WHERE number = @number)`,
+	model.SequenceOrderNumber: `SELECT EXISTS (SELECT 1 FROM orders
+		WHERE sequence_id = @sequence_id AND year = @year AND number = @number)`,
+}
+
+// nextNumber allocates the next number of the entity sequence in the year within the transaction.
+// The sequence of the order numbers is chosen by the order type, see SignOrder.
+func nextNumber(ctx context.Context, tx pgx.Tx, entity model.SequenceEntity, year int) (string, error) {
+	rows, err := tx.Query(ctx, `SELECT `+numberSequenceColumns+`
+		FROM number_sequences
+		WHERE entity = @entity`,
+		pgx.NamedArgs{"entity": entity})
+	if err != nil {
+		return "", err
+	}
+	s, err := pgx.CollectExactlyOneRow[numberSequence](rows, pgx.RowToStructByNameLax[numberSequence])
+	if err != nil {
+		return "", err
+	}
+	return allocateNumber(ctx, tx, convertNumberSequenceToModelNumberSequence(s), year)
+}
+
+// allocateNumber allocates the next number of the sequence in the year within the transaction.
+// The counter row stays locked until the transaction ends, so the concurrent allocations wait,
+// and the number is released with the rollback, so the numbers have no gaps.
+// The numbers already used (typed by hand) are skipped.
+func allocateNumber(ctx context.Context, tx pgx.Tx, seq model.NumberSequence, year int) (string, error) {
+	args := pgx.NamedArgs{
+		"sequence_id": seq.ID,
+		"year":        seq.Period(year),
+	}
+	// the no-op update locks the counter row
+	var last uint64
+	err := tx.QueryRow(ctx, `INSERT INTO number_counters (sequence_id, year, last_number)
+		VALUES (@sequence_id, @year, 0)
+		ON CONFLICT (sequence_id, year) DO UPDATE SET last_number = number_counters.last_number
+		RETURNING last_number`, args).Scan(&last)
+	if err != nil {
+		return "", err
+	}
+
+	n, number, err := seq.NextFree(year, last, func(number string) (bool, error) {
+		var used bool
+		err := tx.QueryRow(ctx, numberUsed[seq.Entity], pgx.NamedArgs{
+			"sequence_id": seq.ID,
+			"year":        year,
+			"number":      number,
+		}).Scan(&used)
+		return used, err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	args["last_number"] = n
+	_, err = tx.Exec(ctx, `UPDATE number_counters SET last_number = @last_number
+		WHERE sequence_id = @sequence_id AND year = @year`, args)
+	if err != nil {
+		return "", err
+	}
+	return number, nil
+}
@@ -0,0 +1,105 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *storage) IsTerminated(ctx context.Context, userID uint64) (bool, error) {
+	const op = "postgresql user storage: is terminated"
+
+	var terminated bool
+	err := s.DB.QueryRow(ctx,
+		"SELECT terminated_at IS NOT NULL FROM users WHERE id = @user_id",
+		pgx.NamedArgs{"user_id": userID}).Scan(&terminated)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf("%s: %w", op, err)
+	}
+	return terminated, nil
+}
+
+// Terminate marks the user as a former employee, closes the active contract
+// and the position on the date of the termination and revokes the access to the system.
+func (s *storage) Terminate(ctx context.Context, userID uint64, t model.Termination) error {
+	const op = "postgresql user storage: terminate"
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+		"date":    t.Date,
+		"reason":  t.Reason,
+	}
+
+	var terminated bool
+	err = tx.QueryRow(ctx,
+		"SELECT terminated_at IS NOT NULL FROM users WHERE id = @user_id FOR UPDATE",
+		args).Scan(&terminated)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repoerr.ErrRecordNotFound
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if terminated {
+		return fmt.Errorf("the user is already terminated: %w", repoerr.ErrConflict)
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE users
+		SET terminated_at = @date, termination_reason = @reason
+		WHERE id = @user_id`, args); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE contracts
+		SET date_end = @date
+		WHERE user_id = @user_id AND date_begin <= @date AND
+		(date_end IS NULL OR date_end > @date)`, args); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE position_history
+		SET date_end = @date
+		WHERE user_id = @user_id AND date_begin <= @date AND
+		(date_end IS NULL OR date_end > @date)`, args); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if _, err := tx.Exec(ctx,
+		"DELETE FROM authorizations WHERE user_id = @user_id", args); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if _, err := tx.Exec(ctx,
+		"DELETE FROM policies WHERE ptype = 'g' AND v0 = @user_id::varchar", args); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	var liable bool
+	if err := tx.QueryRow(ctx,
+		"SELECT COUNT(*)>0 FROM militaries WHERE user_id = @user_id", args).Scan(&liable); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if liable {
+		if err := addMilitaryEvent(ctx, tx, userID, model.MilitaryEventTerminated); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
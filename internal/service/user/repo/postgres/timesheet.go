@@ -0,0 +1,205 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *storage) GetDepartmentTitle(ctx context.Context, departmentID uint64) (string, error) {
+	const op = "postgresql user storage: get department title"
+
+	var title string
+	err := s.DB.QueryRow(ctx, `SELECT title FROM departments WHERE id = @id`,
+		pgx.NamedArgs{"id": departmentID}).Scan(&title)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", repoerr.ErrRecordNotFound
+		}
+		return "", fmt.Errorf("%s: %w", op, err)
+	}
+	return title, nil
+}
+
+// ListTimesheetEmployees returns the employees who held positions in the department during the period
+// and aren't terminated before it, with their periods in the department, contracts, vacations,
+// absences and timesheet corrections of the period. The position is the last one held in the period.
+func (s *storage) ListTimesheetEmployees(ctx context.Context,
+	departmentID uint64, from, to time.Time) ([]model.TimesheetEmployee, error) {
+	const op = "postgresql user storage: list timesheet employees"
+
+	rows, err := s.DB.Query(ctx, `SELECT
+		users.id AS id, lastname, firstname, middlename, positions.title AS position, terminated_at,
+		position_history.date_begin AS date_begin, position_history.date_end AS date_end
+		FROM position_history
+		JOIN users ON position_history.user_id = users.id
+		JOIN positions ON position_history.position_id = positions.id
+		WHERE position_history.department_id = @department_id
+		AND position_history.date_begin <= @date_to
+		AND (position_history.date_end IS NULL OR position_history.date_end >= @date_from)
+		AND (users.terminated_at IS NULL OR users.terminated_at >= @date_from)
+		ORDER BY lastname, firstname, users.id, position_history.date_begin`,
+		pgx.NamedArgs{
+			"department_id": departmentID,
+			"date_from":     from,
+			"date_to":       to,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	es, err := pgx.CollectRows[timesheetEmployee](rows, pgx.RowToStructByNameLax[timesheetEmployee])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	employees := make([]model.TimesheetEmployee, 0, len(es))
+	ids := make([]uint64, 0, len(es))
+	idx := make(map[uint64]int, len(es))
+	for _, e := range es {
+		i, ok := idx[e.UserID]
+		if !ok {
+			i = len(employees)
+			employees = append(employees, model.TimesheetEmployee{
+				UserID:       e.UserID,
+				LastName:     e.LastName,
+				FirstName:    e.FirstName,
+				MiddleName:   e.MiddleName,
+				TerminatedAt: e.TerminatedAt,
+			})
+			ids = append(ids, e.UserID)
+			idx[e.UserID] = i
+		}
+		employees[i].Position = e.Position
+		employees[i].Positions = append(employees[i].Positions,
+			model.PositionTrackItem{Position: e.Position, DateBegin: e.DateBegin, DateEnd: e.DateEnd})
+	}
+	if len(ids) == 0 {
+		return employees, nil
+	}
+	args := pgx.NamedArgs{
+		"ids":       ids,
+		"date_from": from,
+		"date_to":   to,
+	}
+
+	rows, err = s.DB.Query(ctx, `SELECT user_id, date_begin, date_end
+		FROM contracts
+		WHERE user_id = ANY(@ids)
+		ORDER BY date_begin`, args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	ps, err := pgx.CollectRows[employmentPeriod](rows, pgx.RowToStructByNameLax[employmentPeriod])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, p := range ps {
+		i := idx[p.UserID]
+		employees[i].Contracts = append(employees[i].Contracts,
+			model.Contract{DateBegin: p.DateBegin, DateEnd: p.DateEnd})
+	}
+
+	rows, err = s.DB.Query(ctx, `SELECT user_id, id, date_begin, date_end
+		FROM vacations
+		WHERE user_id = ANY(@ids) AND date_begin <= @date_to AND date_end >= @date_from
+		ORDER BY date_begin`, args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	vs, err := pgx.CollectRows[userVacation](rows, pgx.RowToStructByNameLax[userVacation])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, v := range vs {
+		i := idx[v.UserID]
+		employees[i].Vacations = append(employees[i].Vacations, convertVacationToModelVacation(v.vacation))
+	}
+
+	rows, err = s.DB.Query(ctx, `SELECT absences.user_id AS user_id, `+absenceColumns+`
+		FROM absences
+		WHERE absences.user_id = ANY(@ids) AND absences.date_begin <= @date_to AND absences.date_end >= @date_from
+		ORDER BY absences.date_begin`, args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	as, err := pgx.CollectRows[userAbsence](rows, pgx.RowToStructByNameLax[userAbsence])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, a := range as {
+		i := idx[a.UserID]
+		employees[i].Absences = append(employees[i].Absences, convertAbsenceToModelAbsence(a.absence))
+	}
+
+	rows, err = s.DB.Query(ctx, `SELECT id, user_id, date, code, hours, note, corrected_by,
+		COALESCE(updated_at, created_at) AS corrected_at
+		FROM timesheet_corrections
+		WHERE user_id = ANY(@ids) AND date BETWEEN @date_from AND @date_to`, args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	cs, err := pgx.CollectRows[timesheetCorrection](rows, pgx.RowToStructByNameLax[timesheetCorrection])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	for _, c := range cs {
+		i := idx[c.UserID]
+		employees[i].Corrections = append(employees[i].Corrections,
+			convertTimesheetCorrectionToModelTimesheetCorrection(c))
+	}
+
+	return employees, nil
+}
+
+// SetTimesheetCorrection adds the correction of the day of the employee
+// or replaces the previous correction of the day.
+func (s *storage) SetTimesheetCorrection(ctx context.Context, c model.TimesheetCorrection) error {
+	const op = "postgresql user storage: set timesheet correction"
+
+	_, err := s.DB.Exec(ctx, `INSERT INTO timesheet_corrections
+		(user_id, date, code, hours, note, corrected_by)
+		VALUES (@user_id, @date, @code, @hours, @note, @corrected_by)
+		ON CONFLICT (user_id, date) DO UPDATE
+		SET code = EXCLUDED.code, hours = EXCLUDED.hours,
+		note = EXCLUDED.note, corrected_by = EXCLUDED.corrected_by`,
+		pgx.NamedArgs{
+			"user_id":      c.UserID,
+			"date":         c.Date,
+			"code":         c.Code,
+			"hours":        c.Hours,
+			"note":         c.Note,
+			"corrected_by": c.CorrectedBy,
+		})
+	if err != nil {
+		if strings.Contains(err.Error(), "23") && // Integrity Constraint Violation
+			strings.Contains(err.Error(), "user_id") {
+			return fmt.Errorf("the user does not exist: %w", repoerr.ErrConflict)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func (s *storage) DeleteTimesheetCorrection(ctx context.Context, userID uint64, date time.Time) error {
+	const op = "postgresql user storage: delete timesheet correction"
+
+	tag, err := s.DB.Exec(ctx, `DELETE FROM timesheet_corrections WHERE user_id=@user_id AND date=@date`,
+		pgx.NamedArgs{
+			"user_id": userID,
+			"date":    date,
+		})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repoerr.ErrRecordNotFound
+	}
+	return nil
+}
@@ -45,7 +45,7 @@ func (s *storage) GetTraining(ctx context.Context, userID, trainingID uint64) (*
 	rows, err := s.DB.Query(ctx,
 		`SELECT
 		id, title_of_program, title_of_institution,
-		cost, date_end, date_begin,
+		cost, date_end, date_begin, version,
 		(SELECT COUNT(*)>0 FROM scans WHERE user_id=@user_id AND scans.document_id=trainings.id AND scans.type='Сертификат') AS has_scan
 		FROM trainings
 		WHERE id = @id AND user_id = @user_id`,
@@ -97,25 +97,27 @@ func (s *storage) AddTraining(ctx context.Context, userID uint64, tr model.Train
 func (s *storage) UpdateTraining(ctx context.Context, userID uint64, tr model.Training) error {
 	const op = "postrgresql user storage: update training"
 
+	args := pgx.NamedArgs{
+		"user_id":              userID,
+		"id":                   tr.ID,
+		"title_of_program":     tr.Program,
+		"title_of_institution": tr.IssuedInstitution,
+		"cost":                 tr.Cost,
+		"date_end":             tr.DateTo,
+		"date_begin":           tr.DateFrom,
+		"version":              tr.Version,
+	}
 	tag, err := s.DB.Exec(ctx, `UPDATE trainings
 	SET title_of_program = @title_of_program, title_of_institution = @title_of_institution, 
 	cost = @cost, date_end = @date_end, date_begin = @date_begin
-	WHERE id=@id AND user_id=@user_id`,
-		pgx.NamedArgs{
-			"user_id":              userID,
-			"id":                   tr.ID,
-			"title_of_program":     tr.Program,
-			"title_of_institution": tr.IssuedInstitution,
-			"cost":                 tr.Cost,
-			"date_end":             tr.DateTo,
-			"date_begin":           tr.DateFrom,
-		})
+	WHERE id=@id AND user_id=@user_id AND `+versionMatches, args)
 
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 	if tag.RowsAffected() == 0 { // it's ok for pgx
-		return repoerr.ErrRecordNotAffected
+		return notAffected(ctx, s.DB, `SELECT 1 FROM trainings WHERE id=@id AND user_id=@user_id`, args,
+			repoerr.ErrRecordNotAffected)
 	}
 	return nil
 }
@@ -18,10 +18,13 @@ type shortUserInfo struct {
 	Department   string       `db:"department"`
 	Email        string       `db:"work_email"`
 	PhoneNumbers phoneNumbers `db:"phone_numbers"`
+	TerminatedAt *time.Time   `db:"terminated_at"`
+	Reason       *string      `db:"termination_reason"`
 }
 
 type user struct {
 	shortUserInfo
+	PersonnelNumber               string    `db:"personnel_number"`
 	Gender                        gender    `db:"gender"`
 	DateOfBirth                   time.Time `db:"date_of_birth"`
 	PlaceOfBirth                  string    `db:"place_of_birth"`
@@ -35,8 +38,8 @@ type user struct {
 	TaxpayerHasScan               bool      `db:"taxpayer_has_scan"`
 	PositionID                    uint64    `db:"position_id"`
 	DepartmentID                  uint64    `db:"department_id"`
-	Military                      military
-	PersonalDataProcessingHasScan bool `db:"pdp_has_scan"`
+	PersonalDataProcessingHasScan bool      `db:"pdp_has_scan"`
+	Version                       int64     `db:"version"`
 }
 
 type gender string
@@ -68,10 +71,15 @@ type military struct {
 	Category     string `db:"category_of_validity"`
 	Commissariat string `db:"title_of_commissariat"`
 	HasScan      bool   `db:"has_scan"`
+	Version      int64  `db:"version"`
+}
+
+func convertMilitaryToModelMilitary(m military) model.Military {
+	return model.Military(m)
 }
 
 func convertShortUserInfoToModelShortUserInfo(info shortUserInfo) model.ShortUserInfo {
-	return model.ShortUserInfo{
+	mi := model.ShortUserInfo{
 		ID:           info.ID,
 		Department:   info.Department,
 		Email:        info.Email,
@@ -81,11 +89,19 @@ func convertShortUserInfoToModelShortUserInfo(info shortUserInfo) model.ShortUse
 		PhoneNumbers: info.PhoneNumbers,
 		Position:     info.Position,
 	}
+	if info.TerminatedAt != nil {
+		mi.Termination = &model.Termination{Date: *info.TerminatedAt}
+		if info.Reason != nil {
+			mi.Termination.Reason = model.TerminationReason(*info.Reason)
+		}
+	}
+	return mi
 }
 
 func convertUserToModelUser(user *user) model.User {
 	mu := model.User{
 		ShortUserInfo:       convertShortUserInfoToModelShortUserInfo(user.shortUserInfo),
+		PersonnelNumber:     user.PersonnelNumber,
 		DateOfBirth:         user.DateOfBirth,
 		PlaceOfBirth:        user.PlaceOfBirth,
 		Grade:               user.Grade,
@@ -102,16 +118,10 @@ func convertUserToModelUser(user *user) model.User {
 		},
 		PositionID:   user.PositionID,
 		DepartmentID: user.DepartmentID,
-		Military: model.Military{
-			Rank:         user.Military.Rank,
-			Speciality:   user.Military.Speciality,
-			Category:     user.Military.Category,
-			Commissariat: user.Military.Commissariat,
-			HasScan:      user.Military.HasScan,
-		},
 		PersonalDataProcessing: model.PersonalDataProcessing{
 			HasScan: user.PersonalDataProcessingHasScan,
 		},
+		Version: user.Version,
 	}
 	switch user.Gender {
 	case genderMale:
@@ -142,6 +152,7 @@ func convertModelUserToUser(u *model.User) user {
 			Email:        u.Email,
 			PhoneNumbers: u.PhoneNumbers,
 		},
+		PersonnelNumber:     u.PersonnelNumber,
 		Gender:              gr,
 		DateOfBirth:         u.DateOfBirth,
 		PlaceOfBirth:        u.PlaceOfBirth,
@@ -153,6 +164,7 @@ func convertModelUserToUser(u *model.User) user {
 		TaxpayerNumber:      u.Taxpayer.Number,
 		PositionID:          u.PositionID,
 		DepartmentID:        u.DepartmentID,
+		Version:             u.Version,
 	}
 }
 
@@ -169,6 +181,7 @@ type education struct {
 	DateTo            time.Time `db:"year_of_end"`
 	DateFrom          time.Time `db:"year_of_begin"`
 	HasScan           bool      `db:"has_scan"`
+	Version           int64     `db:"version"`
 }
 
 func convertEducationToModelEducation(ed education) model.Education {
@@ -183,12 +196,37 @@ type training struct {
 	DateTo            time.Time `db:"date_end"`
 	DateFrom          time.Time `db:"date_begin"`
 	HasScan           bool      `db:"has_scan"`
+	Version           int64     `db:"version"`
 }
 
 func convertTrainingToModelTraining(tr training) model.Training {
 	return model.Training(tr)
 }
 
+type experience struct {
+	ID          uint64    `db:"id"`
+	CompanyName string    `db:"company_name"`
+	Position    string    `db:"position"`
+	Functional  string    `db:"functional"`
+	Awards      string    `db:"awards"`
+	DateFrom    time.Time `db:"date_begin"`
+	DateTo      time.Time `db:"date_end"`
+	Version     int64     `db:"version"`
+}
+
+func convertExperienceToModelExperience(ex experience) model.Experience {
+	return model.Experience(ex)
+}
+
+type workPermit struct {
+	ID        uint64    `db:"id"`
+	Number    string    `db:"number"`
+	ValidFrom time.Time `db:"valid_from"`
+	ValidTo   time.Time `db:"valid_to"`
+	HasScan   bool      `db:"has_scan"`
+	Version   int64     `db:"version"`
+}
+
 type passport struct {
 	ID         uint64       `db:"id"`
 	IssuedBy   string       `db:"issued_by"`
@@ -197,6 +235,7 @@ type passport struct {
 	Type       passportType `db:"type"`
 	VisasCount uint         `db:"visas_count"`
 	HasScan    bool         `db:"has_scan"`
+	Version    int64        `db:"version"`
 }
 
 type passportType string
@@ -226,6 +265,7 @@ func convertPassportToModelPassport(p passport) model.Passport {
 		Type:       pt,
 		VisasCount: p.VisasCount,
 		HasScan:    p.HasScan,
+		Version:    p.Version,
 	}
 }
 
@@ -246,6 +286,7 @@ func convertModelPassportToPassport(mp model.Passport) passport {
 		IssuedDate: mp.IssuedDate,
 		Number:     mp.Number,
 		Type:       t,
+		Version:    mp.Version,
 	}
 }
 
@@ -257,6 +298,7 @@ type visa struct {
 	ValidTo       time.Time               `db:"valid_to"`
 	ValidFrom     time.Time               `db:"valid_from"`
 	NumberEntries model.VisaNumberEntries `db:"number_entries"`
+	Version       int64                   `db:"version"`
 }
 
 func convertVisaToModelVisa(v visa) model.Visa {
@@ -267,6 +309,7 @@ func convertVisaToModelVisa(v visa) model.Visa {
 		ValidTo:       v.ValidTo,
 		ValidFrom:     v.ValidFrom,
 		NumberEntries: v.NumberEntries,
+		Version:       v.Version,
 	}
 }
 
@@ -278,6 +321,7 @@ func convertModelVisaToVisa(mv model.Visa) visa {
 		ValidTo:       mv.ValidTo,
 		ValidFrom:     mv.ValidFrom,
 		NumberEntries: mv.NumberEntries,
+		Version:       mv.Version,
 	}
 }
 
@@ -285,6 +329,7 @@ type vacation struct {
 	ID        uint64    `db:"id"`
 	DateBegin time.Time `db:"date_begin"`
 	DateEnd   time.Time `db:"date_end"`
+	Version   int64     `db:"version"`
 }
 
 func convertVacationToModelVacation(v vacation) model.Vacation {
@@ -315,52 +360,65 @@ const (
 	scanTypeWorkPermit scanType = "Разрешение на работу"
 	scanTypeMarriage   scanType = "Свидетельство о браке"
 	scanTypeBabyBirth  scanType = "Свидетельство о рождении"
+	scanTypeAbsence    scanType = "Документ об отсутствии"
+	scanTypeAmendment  scanType = "Дополнительное соглашение"
+	scanTypeOrder      scanType = "Приказ"
 	scanTypeOther      scanType = "Другое"
 )
 
-func convertScanToModelScan(s scan) model.Scan {
-	var st model.ScanType
-	switch s.Type {
+// convertScanTypeToModelScanType returns an empty type for an unknown scan type.
+func convertScanTypeToModelScanType(st scanType) model.ScanType {
+	var mst model.ScanType
+	switch st {
 	case scanTypePassport:
-		st = model.ScanTypePassport
+		mst = model.ScanTypePassport
 	case scanTypeTaxpayer:
-		st = model.ScanTypeTaxpayer
+		mst = model.ScanTypeTaxpayer
 	case scanTypeInsurance:
-		st = model.ScanTypeInsurance
+		mst = model.ScanTypeInsurance
 	case scanTypeContract:
-		st = model.ScanTypeContract
+		mst = model.ScanTypeContract
 	case scanTypePDP:
-		st = model.ScanTypePDP
+		mst = model.ScanTypePDP
 	case scanTypeMilitary:
-		st = model.ScanTypeMilitary
+		mst = model.ScanTypeMilitary
 	case scanTypeEducation:
-		st = model.ScanTypeEducation
+		mst = model.ScanTypeEducation
 	case scanTypeTraining:
-		st = model.ScanTypeTraining
+		mst = model.ScanTypeTraining
 	case scanTypeBriefing:
-		st = model.ScanTypeBriefing
+		mst = model.ScanTypeBriefing
 	case scanTypeWorkPermit:
-		st = model.ScanTypeWorkPermit
+		mst = model.ScanTypeWorkPermit
 	case scanTypeMarriage:
-		st = model.ScanTypeMarriage
+		mst = model.ScanTypeMarriage
 	case scanTypeBabyBirth:
-		st = model.ScanTypeBabyBirth
+		mst = model.ScanTypeBabyBirth
+	case scanTypeAbsence:
+		mst = model.ScanTypeAbsence
+	case scanTypeAmendment:
+		mst = model.ScanTypeAmendment
+	case scanTypeOrder:
+		mst = model.ScanTypeOrder
 	case scanTypeOther:
-		st = model.ScanTypeOther
+		mst = model.ScanTypeOther
 	}
+	return mst
+}
 
+func convertScanToModelScan(s scan) model.Scan {
 	return model.Scan{
 		ID:          s.ID,
-		Type:        st,
+		Type:        convertScanTypeToModelScanType(s.Type),
 		DocumentID:  s.DocumentID,
 		Description: s.Description,
 		UploadedAt:  s.UploadedAt,
 	}
 }
 
-func convertModelScanToScan(ms model.Scan) scan {
+func convertModelScanTypeToScanType(mst model.ScanType) scanType {
 	var t scanType
-	switch ms.Type {
+	switch mst {
 	case model.ScanTypePassport:
 		t = scanTypePassport
 	case model.ScanTypeTaxpayer:
@@ -385,14 +443,23 @@ func convertModelScanToScan(ms model.Scan) scan {
 		t = scanTypeMarriage
 	case model.ScanTypeBabyBirth:
 		t = scanTypeBabyBirth
+	case model.ScanTypeAbsence:
+		t = scanTypeAbsence
+	case model.ScanTypeAmendment:
+		t = scanTypeAmendment
+	case model.ScanTypeOrder:
+		t = scanTypeOrder
 	case model.ScanTypeOther:
 		t = scanTypeOther
 	}
+	return t
+}
 
+func convertModelScanToScan(ms model.Scan) scan {
 	return scan{
 		ID:          ms.ID,
 		DocumentID:  ms.DocumentID,
-		Type:        t,
+		Type:        convertModelScanTypeToScanType(ms.Type),
 		Description: ms.Description,
 	}
 }
@@ -406,6 +473,20 @@ type contract struct {
 	DateBegin       time.Time    `db:"date_begin"`
 	DateEnd         *time.Time   `db:"date_end"`
 	HasScan         bool         `db:"has_scan"`
+	// additional annual paid leave days
+	ExtraVacationDays uint `db:"extra_vacation_days"`
+	HasAmendments     bool `db:"has_amendments"`
+	probationResult
+	Version int64 `db:"version"`
+}
+
+// probationResult is the outcome of the probation joined to the contract, all nil until it is decided.
+type probationResult struct {
+	Outcome       *string    `db:"probation_outcome"`
+	ExtendedTo    *time.Time `db:"probation_extended_to"`
+	Comment       *string    `db:"probation_comment"`
+	DecidedAt     *time.Time `db:"probation_decided_at"`
+	ResultVersion *int64     `db:"probation_version"`
 }
 
 type contractType string
@@ -424,6 +505,10 @@ func convertContractToModelContract(c contract) model.Contract {
 		DateBegin:       c.DateBegin,
 		DateEnd:         c.DateEnd,
 		HasScan:         c.HasScan,
+		HasAmendments:   c.HasAmendments,
+		Version:         c.Version,
+
+		ExtraVacationDays: c.ExtraVacationDays,
 	}
 
 	switch c.ContractType {
@@ -433,6 +518,22 @@ func convertContractToModelContract(c contract) model.Contract {
 		mc.Type = model.ContractTypeTemporary
 	}
 
+	if c.Outcome != nil {
+		mc.ProbationResult = &model.ProbationResult{
+			Outcome:    model.ProbationOutcome(*c.Outcome),
+			ExtendedTo: c.ExtendedTo,
+		}
+		if c.Comment != nil {
+			mc.ProbationResult.Comment = *c.Comment
+		}
+		if c.DecidedAt != nil {
+			mc.ProbationResult.DecidedAt = *c.DecidedAt
+		}
+		if c.ResultVersion != nil {
+			mc.ProbationResult.Version = *c.ResultVersion
+		}
+	}
+
 	return mc
 }
 
@@ -444,6 +545,9 @@ func convertModelContractToContract(mc model.Contract) contract {
 		ProbationPeriod: mc.ProbationPeriod,
 		DateBegin:       mc.DateBegin,
 		DateEnd:         mc.DateEnd,
+		Version:         mc.Version,
+
+		ExtraVacationDays: mc.ExtraVacationDays,
 	}
 
 	switch mc.Type {
@@ -455,3 +559,566 @@ func convertModelContractToContract(mc model.Contract) contract {
 
 	return c
 }
+
+type positionTrackItem struct {
+	ID           uint64     `db:"id"`
+	PositionID   uint64     `db:"position_id"`
+	Position     string     `db:"position"`
+	DepartmentID uint64     `db:"department_id"`
+	Department   string     `db:"department"`
+	DateBegin    time.Time  `db:"date_begin"`
+	DateEnd      *time.Time `db:"date_end"`
+}
+
+func convertPositionTrackItemToModelPositionTrackItem(pt positionTrackItem) model.PositionTrackItem {
+	return model.PositionTrackItem(pt)
+}
+
+type positionHolder struct {
+	UserID       uint64     `db:"user_id"`
+	LastName     string     `db:"lastname"`
+	FirstName    string     `db:"firstname"`
+	MiddleName   string     `db:"middlename"`
+	DepartmentID uint64     `db:"department_id"`
+	Department   string     `db:"department"`
+	DateBegin    time.Time  `db:"date_begin"`
+	DateEnd      *time.Time `db:"date_end"`
+}
+
+type militaryLiable struct {
+	ID          uint64    `db:"id"`
+	LastName    string    `db:"lastname"`
+	FirstName   string    `db:"firstname"`
+	MiddleName  string    `db:"middlename"`
+	DateOfBirth time.Time `db:"date_of_birth"`
+	Department  string    `db:"department"`
+	Position    string    `db:"position"`
+	military
+}
+
+func convertMilitaryLiableToModelMilitaryLiable(ml militaryLiable) model.MilitaryLiable {
+	return model.MilitaryLiable{
+		UserID:      ml.ID,
+		LastName:    ml.LastName,
+		FirstName:   ml.FirstName,
+		MiddleName:  ml.MiddleName,
+		DateOfBirth: ml.DateOfBirth,
+		Department:  ml.Department,
+		Position:    ml.Position,
+		Military:    convertMilitaryToModelMilitary(ml.military),
+	}
+}
+
+type militaryNotification struct {
+	militaryLiable
+	Event string    `db:"event"`
+	Date  time.Time `db:"date"`
+}
+
+func convertMilitaryNotificationToModelMilitaryNotification(mn militaryNotification) model.MilitaryNotification {
+	return model.MilitaryNotification{
+		MilitaryLiable: convertMilitaryLiableToModelMilitaryLiable(mn.militaryLiable),
+		Event:          model.MilitaryEvent(mn.Event),
+		Date:           mn.Date,
+	}
+}
+
+type onboardingTemplate struct {
+	ID           uint64  `db:"id"`
+	Title        string  `db:"title"`
+	DepartmentID *uint64 `db:"department_id"`
+	PositionID   *uint64 `db:"position_id"`
+}
+
+type onboardingTemplateItem struct {
+	TemplateID uint64    `db:"template_id"`
+	Title      string    `db:"title"`
+	ScanType   *scanType `db:"scan_type"`
+	DueDays    uint      `db:"due_days"`
+}
+
+func convertOnboardingTemplateItemToModel(i onboardingTemplateItem) model.OnboardingTemplateItem {
+	return model.OnboardingTemplateItem{
+		Title:    i.Title,
+		ScanType: convertNilScanTypeToModel(i.ScanType),
+		DueDays:  i.DueDays,
+	}
+}
+
+type onboardingItem struct {
+	ID          uint64     `db:"id"`
+	Title       string     `db:"title"`
+	ScanType    *scanType  `db:"scan_type"`
+	DueDate     time.Time  `db:"due_date"`
+	CompletedAt *time.Time `db:"completed_at"`
+}
+
+func convertOnboardingItemToModelOnboardingItem(i onboardingItem) model.OnboardingItem {
+	return model.OnboardingItem{
+		ID:          i.ID,
+		Title:       i.Title,
+		ScanType:    convertNilScanTypeToModel(i.ScanType),
+		DueDate:     i.DueDate,
+		CompletedAt: i.CompletedAt,
+	}
+}
+
+type employeeOnboardingItem struct {
+	onboardingItem
+	UserID     uint64 `db:"user_id"`
+	LastName   string `db:"lastname"`
+	FirstName  string `db:"firstname"`
+	MiddleName string `db:"middlename"`
+	Department string `db:"department"`
+	Position   string `db:"position"`
+}
+
+func convertEmployeeOnboardingItemToModel(i employeeOnboardingItem) model.EmployeeOnboardingItem {
+	return model.EmployeeOnboardingItem{
+		OnboardingItem: convertOnboardingItemToModelOnboardingItem(i.onboardingItem),
+		UserID:         i.UserID,
+		LastName:       i.LastName,
+		FirstName:      i.FirstName,
+		MiddleName:     i.MiddleName,
+		Department:     i.Department,
+		Position:       i.Position,
+	}
+}
+
+func convertNilScanTypeToModel(st *scanType) *model.ScanType {
+	if st == nil {
+		return nil
+	}
+	mst := convertScanTypeToModelScanType(*st)
+	return &mst
+}
+
+func convertNilModelScanType(mst *model.ScanType) *scanType {
+	if mst == nil {
+		return nil
+	}
+	st := convertModelScanTypeToScanType(*mst)
+	return &st
+}
+
+type entitlementEmployee struct {
+	UserID     uint64 `db:"id"`
+	LastName   string `db:"lastname"`
+	FirstName  string `db:"firstname"`
+	MiddleName string `db:"middlename"`
+	Department string `db:"department"`
+	Position   string `db:"position"`
+}
+
+type entitlementPeriod struct {
+	UserID    uint64     `db:"user_id"`
+	DateBegin time.Time  `db:"date_begin"`
+	DateEnd   *time.Time `db:"date_end"`
+	ExtraDays uint       `db:"extra_days"`
+}
+
+type userVacation struct {
+	UserID uint64 `db:"user_id"`
+	vacation
+}
+
+type employeeVacation struct {
+	UserID     uint64 `db:"user_id"`
+	LastName   string `db:"lastname"`
+	FirstName  string `db:"firstname"`
+	MiddleName string `db:"middlename"`
+	Department string `db:"department"`
+	Position   string `db:"position"`
+	vacation
+}
+
+func convertEmployeeVacationToModelEmployeeVacation(ev employeeVacation) model.EmployeeVacation {
+	return model.EmployeeVacation{
+		Vacation:   convertVacationToModelVacation(ev.vacation),
+		UserID:     ev.UserID,
+		LastName:   ev.LastName,
+		FirstName:  ev.FirstName,
+		MiddleName: ev.MiddleName,
+		Department: ev.Department,
+		Position:   ev.Position,
+	}
+}
+
+type vacationSchedule struct {
+	Year       int        `db:"year"`
+	Status     string     `db:"status"`
+	ApprovedAt *time.Time `db:"approved_at"`
+	LockedAt   *time.Time `db:"locked_at"`
+}
+
+func convertVacationScheduleToModelVacationSchedule(vs vacationSchedule) model.VacationSchedule {
+	return model.VacationSchedule{
+		Year:       vs.Year,
+		Status:     model.ScheduleStatus(vs.Status),
+		ApprovedAt: vs.ApprovedAt,
+		LockedAt:   vs.LockedAt,
+	}
+}
+
+type scheduleItem struct {
+	UserID       uint64 `db:"user_id"`
+	LastName     string `db:"lastname"`
+	FirstName    string `db:"firstname"`
+	MiddleName   string `db:"middlename"`
+	DepartmentID uint64 `db:"department_id"`
+	Department   string `db:"department"`
+	Position     string `db:"position"`
+	vacation
+}
+
+func convertScheduleItemToModelScheduleItem(it scheduleItem) model.ScheduleItem {
+	return model.ScheduleItem{
+		Vacation:     convertVacationToModelVacation(it.vacation),
+		UserID:       it.UserID,
+		LastName:     it.LastName,
+		FirstName:    it.FirstName,
+		MiddleName:   it.MiddleName,
+		DepartmentID: it.DepartmentID,
+		Department:   it.Department,
+		Position:     it.Position,
+	}
+}
+
+type vacationRequest struct {
+	ID              uint64     `db:"id"`
+	UserID          uint64     `db:"user_id"`
+	LastName        string     `db:"lastname"`
+	FirstName       string     `db:"firstname"`
+	MiddleName      string     `db:"middlename"`
+	Email           string     `db:"work_email"`
+	DateBegin       time.Time  `db:"date_begin"`
+	DateEnd         time.Time  `db:"date_end"`
+	Comment         string     `db:"comment"`
+	Status          string     `db:"status"`
+	HeadID          *uint64    `db:"head_id"`
+	HeadApprovedAt  *time.Time `db:"head_approved_at"`
+	SubmittedAt     *time.Time `db:"submitted_at"`
+	DecidedBy       *uint64    `db:"decided_by"`
+	DecidedAt       *time.Time `db:"decided_at"`
+	DecisionComment string     `db:"decision_comment"`
+	VacationID      *uint64    `db:"vacation_id"`
+}
+
+func convertVacationRequestToModelVacationRequest(vr vacationRequest) model.VacationRequest {
+	return model.VacationRequest{
+		ID:              vr.ID,
+		UserID:          vr.UserID,
+		LastName:        vr.LastName,
+		FirstName:       vr.FirstName,
+		MiddleName:      vr.MiddleName,
+		Email:           vr.Email,
+		DateBegin:       vr.DateBegin,
+		DateEnd:         vr.DateEnd,
+		Comment:         vr.Comment,
+		Status:          model.RequestStatus(vr.Status),
+		HeadID:          vr.HeadID,
+		HeadApprovedAt:  vr.HeadApprovedAt,
+		SubmittedAt:     vr.SubmittedAt,
+		DecidedBy:       vr.DecidedBy,
+		DecidedAt:       vr.DecidedAt,
+		DecisionComment: vr.DecisionComment,
+		VacationID:      vr.VacationID,
+	}
+}
+
+type recipient struct {
+	UserID    uint64 `db:"id"`
+	FirstName string `db:"firstname"`
+	LastName  string `db:"lastname"`
+	Email     string `db:"work_email"`
+}
+
+type absence struct {
+	ID             uint64    `db:"id"`
+	Type           string    `db:"type"`
+	DateBegin      time.Time `db:"date_begin"`
+	DateEnd        time.Time `db:"date_end"`
+	DocumentNumber string    `db:"document_number"`
+	Comment        string    `db:"comment"`
+	HasScan        bool      `db:"has_scan"`
+	Version        int64     `db:"version"`
+}
+
+func convertAbsenceToModelAbsence(a absence) model.Absence {
+	return model.Absence{
+		ID:             a.ID,
+		Type:           model.AbsenceType(a.Type),
+		DateBegin:      a.DateBegin,
+		DateEnd:        a.DateEnd,
+		DocumentNumber: a.DocumentNumber,
+		Comment:        a.Comment,
+		HasScan:        a.HasScan,
+		Version:        a.Version,
+	}
+}
+
+type employeeAbsence struct {
+	UserID     uint64 `db:"user_id"`
+	LastName   string `db:"lastname"`
+	FirstName  string `db:"firstname"`
+	MiddleName string `db:"middlename"`
+	Department string `db:"department"`
+	Position   string `db:"position"`
+	absence
+}
+
+func convertEmployeeAbsenceToModelEmployeeAbsence(ea employeeAbsence) model.EmployeeAbsence {
+	return model.EmployeeAbsence{
+		Absence:    convertAbsenceToModelAbsence(ea.absence),
+		UserID:     ea.UserID,
+		LastName:   ea.LastName,
+		FirstName:  ea.FirstName,
+		MiddleName: ea.MiddleName,
+		Department: ea.Department,
+		Position:   ea.Position,
+	}
+}
+
+type timesheetEmployee struct {
+	UserID       uint64     `db:"id"`
+	LastName     string     `db:"lastname"`
+	FirstName    string     `db:"firstname"`
+	MiddleName   string     `db:"middlename"`
+	Position     string     `db:"position"`
+	TerminatedAt *time.Time `db:"terminated_at"`
+	DateBegin    time.Time  `db:"date_begin"`
+	DateEnd      *time.Time `db:"date_end"`
+}
+
+type employmentPeriod struct {
+	UserID    uint64     `db:"user_id"`
+	DateBegin time.Time  `db:"date_begin"`
+	DateEnd   *time.Time `db:"date_end"`
+}
+
+type userAbsence struct {
+	UserID uint64 `db:"user_id"`
+	absence
+}
+
+type timesheetCorrection struct {
+	ID          uint64    `db:"id"`
+	UserID      uint64    `db:"user_id"`
+	Date        time.Time `db:"date"`
+	Code        string    `db:"code"`
+	Hours       int       `db:"hours"`
+	Note        string    `db:"note"`
+	CorrectedBy uint64    `db:"corrected_by"`
+	CorrectedAt time.Time `db:"corrected_at"`
+}
+
+func convertTimesheetCorrectionToModelTimesheetCorrection(c timesheetCorrection) model.TimesheetCorrection {
+	return model.TimesheetCorrection{
+		ID:          c.ID,
+		UserID:      c.UserID,
+		Date:        c.Date,
+		Code:        model.TimesheetCode(c.Code),
+		Hours:       c.Hours,
+		Note:        c.Note,
+		CorrectedBy: c.CorrectedBy,
+		CorrectedAt: c.CorrectedAt,
+	}
+}
+
+type relative struct {
+	ID          uint64    `db:"id"`
+	Type        string    `db:"type"`
+	LastName    string    `db:"lastname"`
+	FirstName   string    `db:"firstname"`
+	MiddleName  string    `db:"middlename"`
+	DateOfBirth time.Time `db:"date_of_birth"`
+	Disabled    bool      `db:"disabled"`
+	HasScan     bool      `db:"has_scan"`
+	Version     int64     `db:"version"`
+}
+
+func convertRelativeToModelRelative(r relative) model.Relative {
+	return model.Relative{
+		ID:          r.ID,
+		Type:        model.RelationType(r.Type),
+		LastName:    r.LastName,
+		FirstName:   r.FirstName,
+		MiddleName:  r.MiddleName,
+		DateOfBirth: r.DateOfBirth,
+		Disabled:    r.Disabled,
+		HasScan:     r.HasScan,
+		Version:     r.Version,
+	}
+}
+
+type probationEmployee struct {
+	UserID     uint64     `db:"user_id"`
+	LastName   string     `db:"lastname"`
+	FirstName  string     `db:"firstname"`
+	MiddleName string     `db:"middlename"`
+	Department string     `db:"department"`
+	Position   string     `db:"position"`
+	RemindedOn *time.Time `db:"probation_reminded_on"`
+	contract
+}
+
+func convertProbationEmployeeToModelProbationEmployee(e probationEmployee) model.ProbationEmployee {
+	return model.ProbationEmployee{
+		UserID:     e.UserID,
+		LastName:   e.LastName,
+		FirstName:  e.FirstName,
+		MiddleName: e.MiddleName,
+		Department: e.Department,
+		Position:   e.Position,
+		Contract:   convertContractToModelContract(e.contract),
+		RemindedOn: e.RemindedOn,
+	}
+}
+
+type amendment struct {
+	ID            uint64     `db:"id"`
+	ContractID    uint64     `db:"contract_id"`
+	Number        string     `db:"number"`
+	Date          time.Time  `db:"date"`
+	EffectiveFrom time.Time  `db:"effective_from"`
+	WorkTypeID    *uint64    `db:"work_type_id"`
+	PositionID    *uint64    `db:"position_id"`
+	Salary        *int64     `db:"salary"`
+	DateEnd       *time.Time `db:"date_end"`
+	Description   string     `db:"description"`
+	HasScan       bool       `db:"has_scan"`
+	Version       int64      `db:"version"`
+}
+
+func convertAmendmentToModelAmendment(a amendment) model.Amendment {
+	return model.Amendment{
+		ID:            a.ID,
+		ContractID:    a.ContractID,
+		Number:        a.Number,
+		Date:          a.Date,
+		EffectiveFrom: a.EffectiveFrom,
+		WorkTypeID:    a.WorkTypeID,
+		PositionID:    a.PositionID,
+		Salary:        a.Salary,
+		DateEnd:       a.DateEnd,
+		Description:   a.Description,
+		HasScan:       a.HasScan,
+		Version:       a.Version,
+	}
+}
+
+type order struct {
+	ID            uint64     `db:"id"`
+	Type          string     `db:"type"`
+	Status        string     `db:"status"`
+	Title         string     `db:"title"`
+	UserID        uint64     `db:"user_id"`
+	LastName      string     `db:"lastname"`
+	FirstName     string     `db:"firstname"`
+	MiddleName    string     `db:"middlename"`
+	EffectiveDate time.Time  `db:"effective_date"`
+	ContractID    *uint64    `db:"contract_id"`
+	VacationID    *uint64    `db:"vacation_id"`
+	Number        *string    `db:"number"`
+	Date          *time.Time `db:"date"`
+	HasScan       bool       `db:"has_scan"`
+	CreatedAt     time.Time  `db:"created_at"`
+}
+
+func convertOrderToModelOrder(o order) model.Order {
+	mo := model.Order{
+		ID:            o.ID,
+		Type:          model.OrderType(o.Type),
+		Status:        model.OrderStatus(o.Status),
+		Title:         o.Title,
+		UserID:        o.UserID,
+		LastName:      o.LastName,
+		FirstName:     o.FirstName,
+		MiddleName:    o.MiddleName,
+		EffectiveDate: o.EffectiveDate,
+		ContractID:    o.ContractID,
+		VacationID:    o.VacationID,
+		Date:          o.Date,
+		HasScan:       o.HasScan,
+		CreatedAt:     o.CreatedAt,
+	}
+	if o.Number != nil {
+		mo.Number = *o.Number
+	}
+	return mo
+}
+
+type numberSequence struct {
+	ID          uint64   `db:"id"`
+	Entity      string   `db:"entity"`
+	Title       string   `db:"title"`
+	Prefix      string   `db:"prefix"`
+	Suffix      string   `db:"suffix"`
+	WithYear    bool     `db:"with_year"`
+	Padding     uint     `db:"padding"`
+	YearlyReset bool     `db:"yearly_reset"`
+	Types       []string `db:"types"`
+}
+
+func convertNumberSequenceToModelNumberSequence(s numberSequence) model.NumberSequence {
+	var types []model.OrderType
+	if len(s.Types) > 0 {
+		types = make([]model.OrderType, len(s.Types))
+		for i, t := range s.Types {
+			types[i] = model.OrderType(t)
+		}
+	}
+	return model.NumberSequence{
+		ID:          s.ID,
+		Entity:      model.SequenceEntity(s.Entity),
+		Title:       s.Title,
+		Prefix:      s.Prefix,
+		Suffix:      s.Suffix,
+		WithYear:    s.WithYear,
+		Padding:     s.Padding,
+		YearlyReset: s.YearlyReset,
+		Types:       types,
+	}
+}
+
+type duplicateMatch struct {
+	UserID     uint64 `db:"user_id"`
+	Reason     string `db:"reason"`
+	Terminated bool   `db:"terminated"`
+}
+
+func convertDuplicateMatchToModelDuplicateMatch(m duplicateMatch) model.DuplicateMatch {
+	return model.DuplicateMatch{
+		UserID:     m.UserID,
+		Reason:     model.DuplicateReason(m.Reason),
+		Terminated: m.Terminated,
+	}
+}
+
+type duplicate struct {
+	Reason  string   `db:"reason"`
+	UserIDs []uint64 `db:"user_ids"`
+}
+
+func convertDuplicateToModelDuplicate(d duplicate) model.Duplicate {
+	return model.Duplicate{
+		Reason:  model.DuplicateReason(d.Reason),
+		UserIDs: d.UserIDs,
+	}
+}
+
+type person struct {
+	ID          uint64    `db:"id"`
+	LastName    string    `db:"lastname"`
+	FirstName   string    `db:"firstname"`
+	DateOfBirth time.Time `db:"date_of_birth"`
+}
+
+func convertPersonToModelPerson(p person) model.Person {
+	return model.Person{
+		ID:          p.ID,
+		LastName:    p.LastName,
+		FirstName:   p.FirstName,
+		DateOfBirth: p.DateOfBirth,
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/henvic/pgq"
 	"github.com/jackc/pgx/v5"
@@ -33,11 +34,12 @@ func (s *storage) Exist(ctx context.Context, userID uint64) (bool, error) {
 
 const (
 	getUserQuery = `SELECT 
-users.id AS id, lastname, firstname, middlename, gender,
+users.id AS id, personnel_number, lastname, firstname, middlename, gender,
 date_of_birth, place_of_birth, grade, phone_numbers,
 work_email, registration_address, residential_address, nationality,
 insurance_number, taxpayer_number, users.department_id AS department_id, position_id,
 positions.title AS position, departments.title AS department,
+terminated_at, termination_reason, users.version AS version,
 (SELECT COUNT(*)>0 FROM scans WHERE user_id=@user_id AND scans.type='ИНН') AS insurance_has_scan,
 (SELECT COUNT(*)>0 FROM scans WHERE user_id=@user_id AND scans.type='СНИЛС') AS taxpayer_has_scan,
 (SELECT COUNT(*)>0 FROM scans WHERE user_id=@user_id AND scans.type='Согласие на обработку данных') AS pdp_has_scan
@@ -59,7 +61,7 @@ FROM visas
 WHERE visas.user_id = @user_id`
 
 	getMilitaryQuery = `SELECT
-rank, specialty, category_of_validity, title_of_commissariat,
+rank, specialty, category_of_validity, title_of_commissariat, version,
 (SELECT COUNT(*)>0 FROM scans WHERE scans.user_id=@user_id AND scans.document_id=militaries.id AND scans.type='Военный билет') AS has_scan
 FROM militaries
 WHERE militaries.user_id = @user_id`
@@ -80,20 +82,30 @@ func (s *storage) Get(ctx context.Context, userID uint64) (*model.User, error) {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
+	mu := convertUserToModelUser(u)
+
 	rows, err = s.DB.Query(ctx, getMilitaryQuery, pgx.NamedArgs{"user_id": userID})
 	if err != nil {
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
-	m, err := pgx.CollectExactlyOneRow(rows, pgx.RowToAddrOfStructByNameLax[military])
+	mu.Military, err = collectMilitary(rows)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, fmt.Errorf("%s: %w", op, repoerr.ErrRecordNotFound)
-		}
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
 
-	u.Military = *m
-	mu := convertUserToModelUser(u)
+	rows, err = s.DB.Query(ctx, getCurrentWorkPermitQuery, pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	mu.WorkPermit, err = collectWorkPermit(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	mu.PositionTrack, err = s.ListPositionTrack(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
 	return &mu, nil
 }
 
@@ -105,12 +117,16 @@ func (s *storage) GetExpandedUser(ctx context.Context, userID uint64) (*model.Ex
 
 	batch := &pgx.Batch{}
 	batch.Queue(getUserQuery, pgx.NamedArgs{"user_id": userID})
+	batch.Queue(getMilitaryQuery, pgx.NamedArgs{"user_id": userID})
+	batch.Queue(getCurrentWorkPermitQuery, pgx.NamedArgs{"user_id": userID})
 	batch.Queue(listEducationsQuery, pgx.NamedArgs{"user_id": userID})
 	batch.Queue(listTrainingsQuery, pgx.NamedArgs{"user_id": userID})
 	batch.Queue(listPassportsQuery, pgx.NamedArgs{"user_id": userID})
 	batch.Queue(listVisasQuery, pgx.NamedArgs{"user_id": userID})
 	batch.Queue(listVacationsQuery, pgx.NamedArgs{"user_id": userID})
 	batch.Queue(listContractsQuery, pgx.NamedArgs{"user_id": userID})
+	batch.Queue(listPositionTrackQuery, pgx.NamedArgs{"user_id": userID})
+	batch.Queue(listRelativesQuery, pgx.NamedArgs{"user_id": userID})
 	br := s.DB.SendBatch(ctx, batch)
 	defer br.Close()
 
@@ -132,6 +148,26 @@ func (s *storage) GetExpandedUser(ctx context.Context, userID uint64) (*model.Ex
 	}
 	expUser.User = convertUserToModelUser(u)
 
+	// get military
+	rows, err = br.Query()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	expUser.Military, err = collectMilitary(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	// get work permit
+	rows, err = br.Query()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	expUser.WorkPermit, err = collectWorkPermit(rows)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	// get educations
 	rows, err = br.Query()
 	if err != nil {
@@ -220,6 +256,34 @@ func (s *storage) GetExpandedUser(ctx context.Context, userID uint64) (*model.Ex
 		expUser.Contracts[i] = convertContractToModelContract(c)
 	}
 
+	// get position track
+	rows, err = br.Query()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	pts, err := pgx.CollectRows[positionTrackItem](rows, pgx.RowToStructByNameLax[positionTrackItem])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	expUser.PositionTrack = make([]model.PositionTrackItem, len(pts))
+	for i, pt := range pts {
+		expUser.PositionTrack[i] = convertPositionTrackItemToModelPositionTrackItem(pt)
+	}
+
+	// get relatives
+	rows, err = br.Query()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	rs, err := pgx.CollectRows[relative](rows, pgx.RowToStructByNameLax[relative])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	expUser.Relatives = make([]model.Relative, len(rs))
+	for i, r := range rs {
+		expUser.Relatives[i] = convertRelativeToModelRelative(r)
+	}
+
 	return &expUser, nil
 }
 
@@ -230,13 +294,17 @@ func (s *storage) ListShortUserInfo(ctx context.Context, pms model.ListUsersPara
 		Select(`users.id AS id, lastname, firstname, middlename, 
 		phone_numbers, work_email, 
 		positions.title AS position, departments.title AS department, 
+		terminated_at, termination_reason,
 		count(*) OVER() AS total_count`).
 		From("users").
 		Join("departments ON users.department_id = departments.id").
 		Join("positions ON users.position_id = positions.id")
 	if pms.Query != "" {
 		q := "%" + pms.Query + "%"
-		sb = sb.Where(`users.lastname ILIKE ? OR departments.title ILIKE ?`, q, q)
+		sb = sb.Where(`(users.lastname ILIKE ? OR departments.title ILIKE ?)`, q, q)
+	}
+	if !pms.IncludeTerminated {
+		sb = sb.Where("users.terminated_at IS NULL")
 	}
 	// nolint:exhaustive
 	switch pms.SortBy {
@@ -278,16 +346,29 @@ func (s *storage) Add(ctx context.Context, mu model.User) (uint64, error) {
 
 	user := convertModelUserToUser(&mu)
 
-	row := s.DB.QueryRow(ctx,
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	if user.PersonnelNumber == "" {
+		user.PersonnelNumber, err = nextNumber(ctx, tx, model.SequencePersonnelNumber, time.Now().Year())
+		if err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	row := tx.QueryRow(ctx,
 		`INSERT INTO users 
-			(lastname, firstname, middlename, 
+			(personnel_number, lastname, firstname, middlename, 
 			gender, date_of_birth, place_of_birth, 
 			grade, phone_numbers, work_email, 
 			registration_address, residential_address, 
 			nationality, insurance_number, 
 			taxpayer_number, department_id, position_id)
 		VALUES
-			(@lastname, @firstname, @middlename, 
+			(@personnel_number, @lastname, @firstname, @middlename, 
 			@gender, @date_of_birth, @place_of_birth, 
 			@grade, @phone_numbers, @email, 
 			@registration_address, @residential_address, 
@@ -295,6 +376,7 @@ func (s *storage) Add(ctx context.Context, mu model.User) (uint64, error) {
 			@taxpayer_number, @department_id, @position_id)
 			RETURNING id`,
 		pgx.NamedArgs{
+			"personnel_number":     user.PersonnelNumber,
 			"lastname":             user.LastName,
 			"firstname":            user.FirstName,
 			"middlename":           user.MiddleName,
@@ -315,6 +397,9 @@ func (s *storage) Add(ctx context.Context, mu model.User) (uint64, error) {
 
 	if err := row.Scan(&user.ID); err != nil {
 		if strings.Contains(err.Error(), "23") { // Integrity Constraint Violation
+			if strings.Contains(err.Error(), "personnel_number") {
+				return 0, fmt.Errorf("the personnel number is already used: %w", repoerr.ErrRecordAlreadyExist)
+			}
 			if strings.Contains(err.Error(), "department_id") {
 				return 0, fmt.Errorf("the department does not exist: %w", repoerr.ErrConflict)
 			}
@@ -325,6 +410,29 @@ func (s *storage) Add(ctx context.Context, mu model.User) (uint64, error) {
 		return 0, fmt.Errorf("%s: %w", op, err)
 	}
 
+	if err := movePosition(ctx, tx, user.ID, user.DepartmentID, user.PositionID, positionDate(mu)); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := startOnboarding(ctx, tx, user.ID, user.DepartmentID, user.PositionID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if mu.Military != nil {
+		if err := setMilitary(ctx, tx, user.ID, *mu.Military); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if mu.WorkPermit != nil {
+		if err := setWorkPermit(ctx, tx, user.ID, *mu.WorkPermit); err != nil {
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
 	return user.ID, nil
 }
 
@@ -333,17 +441,42 @@ func (s *storage) Update(ctx context.Context, mu model.User) error {
 
 	user := convertModelUserToUser(&mu)
 
-	tag, err := s.DB.Exec(ctx, `UPDATE users
-	SET lastname = @lastname, firstname = @firstname, middlename = @middlename, 
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	var (
+		departmentID, positionID uint64
+		version                  int64
+	)
+	err = tx.QueryRow(ctx,
+		"SELECT department_id, position_id, version FROM users WHERE id = @id FOR UPDATE",
+		pgx.NamedArgs{"id": user.ID}).Scan(&departmentID, &positionID, &version)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return repoerr.ErrRecordNotAffected
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if user.Version != 0 && version != user.Version {
+		return repoerr.ErrVersionMismatch
+	}
+
+	tag, err := tx.Exec(ctx, `UPDATE users
+	SET personnel_number = COALESCE(NULLIF(@personnel_number, ''), personnel_number),
+	lastname = @lastname, firstname = @firstname, middlename = @middlename, 
 	gender = @gender, date_of_birth = @date_of_birth, place_of_birth = @place_of_birth, 
 	grade = @grade, phone_numbers = @phone_numbers, work_email = @email, 
 	registration_address = @registration_address, residential_address = @residential_address, 
 	nationality = @nationality, insurance_number = @insurance_number, 
 	taxpayer_number = @taxpayer_number, 
 	department_id = @department_id, position_id = @position_id
-	WHERE id=@id`,
+	WHERE id=@id AND `+versionMatches,
 		pgx.NamedArgs{
 			"id":                   user.ID,
+			"personnel_number":     user.PersonnelNumber,
 			"lastname":             user.LastName,
 			"firstname":            user.FirstName,
 			"middlename":           user.MiddleName,
@@ -360,10 +493,14 @@ func (s *storage) Update(ctx context.Context, mu model.User) error {
 			"taxpayer_number":      user.TaxpayerNumber,
 			"department_id":        user.DepartmentID,
 			"position_id":          user.PositionID,
+			"version":              user.Version,
 		})
 
 	if err != nil {
 		if strings.Contains(err.Error(), "23") { // Integrity Constraint Violation
+			if strings.Contains(err.Error(), "personnel_number") {
+				return fmt.Errorf("the personnel number is already used: %w", repoerr.ErrRecordAlreadyExist)
+			}
 			if strings.Contains(err.Error(), "department_id") {
 				return fmt.Errorf("the department does not exist: %w", repoerr.ErrConflict)
 			}
@@ -377,5 +514,27 @@ func (s *storage) Update(ctx context.Context, mu model.User) error {
 	if tag.RowsAffected() == 0 { // it's ok for pgx
 		return repoerr.ErrRecordNotAffected
 	}
+
+	if departmentID != user.DepartmentID || positionID != user.PositionID {
+		if err := movePosition(ctx, tx, user.ID, user.DepartmentID, user.PositionID, positionDate(mu)); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if mu.Military != nil {
+		if err := setMilitary(ctx, tx, user.ID, *mu.Military); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if mu.WorkPermit != nil {
+		if err := setWorkPermit(ctx, tx, user.ID, *mu.WorkPermit); err != nil {
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
 	return nil
 }
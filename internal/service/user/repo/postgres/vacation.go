@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 
@@ -17,7 +18,7 @@ func (s *storage) GetVacation(ctx context.Context, userID, vacationID uint64) (*
 
 	rows, err := s.DB.Query(ctx,
 		`SELECT 
-		id, date_begin, date_end 
+		id, date_begin, date_end, version
 		FROM vacations
 		WHERE id = @id AND user_id = @user_id`,
 		pgx.NamedArgs{
@@ -92,21 +93,95 @@ func (s *storage) AddVacation(ctx context.Context, userID uint64, v model.Vacati
 func (s *storage) UpdateVacation(ctx context.Context, userID uint64, v model.Vacation) error {
 	const op = "postrgresql user storage: update vacation"
 
+	args := pgx.NamedArgs{
+		"user_id":    userID,
+		"id":         v.ID,
+		"date_begin": v.DateBegin,
+		"date_end":   v.DateEnd,
+		"version":    v.Version,
+	}
 	tag, err := s.DB.Exec(ctx, `UPDATE vacations
 	SET date_begin = @date_begin, date_end = @date_end
-	WHERE id=@id AND user_id=@user_id`,
-		pgx.NamedArgs{
-			"user_id":    userID,
-			"id":         v.ID,
-			"date_begin": v.DateBegin,
-			"date_end":   v.DateEnd,
-		})
+	WHERE id=@id AND user_id=@user_id AND `+versionMatches, args)
 
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 	if tag.RowsAffected() == 0 { // it's ok for pgx
-		return repoerr.ErrRecordNotAffected
+		return notAffected(ctx, s.DB, `SELECT 1 FROM vacations WHERE id=@id AND user_id=@user_id`, args,
+			repoerr.ErrRecordNotAffected)
 	}
 	return nil
 }
+
+// ListAllVacations returns the vacations of all employees having common days with the period.
+func (s *storage) ListAllVacations(ctx context.Context, params model.ListVacationsParams) ([]model.EmployeeVacation, error) {
+	const op = "postgresql user storage: list all vacations"
+
+	rows, err := s.DB.Query(ctx, `SELECT
+		vacations.id AS id, vacations.user_id AS user_id, date_begin, date_end,
+		lastname, firstname, middlename,
+		departments.title AS department, positions.title AS position
+		FROM vacations
+		JOIN users ON vacations.user_id = users.id
+		JOIN departments ON users.department_id = departments.id
+		JOIN positions ON users.position_id = positions.id
+		WHERE date_begin <= @date_to AND date_end >= @date_from
+		AND (@department_id::bigint IS NULL OR users.department_id = @department_id)
+		ORDER BY date_begin, lastname, firstname`,
+		pgx.NamedArgs{
+			"department_id": params.DepartmentID,
+			"date_from":     params.DateFrom,
+			"date_to":       params.DateTo,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	evs, err := pgx.CollectRows[employeeVacation](rows, pgx.RowToStructByNameLax[employeeVacation])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	vacations := make([]model.EmployeeVacation, len(evs))
+	for i, ev := range evs {
+		vacations[i] = convertEmployeeVacationToModelEmployeeVacation(ev)
+	}
+	return vacations, nil
+}
+
+// ListDepartmentVacations returns the vacations of current employees of the user department
+// except the user having common days with the period.
+func (s *storage) ListDepartmentVacations(ctx context.Context,
+	userID uint64, from, to time.Time) ([]model.Vacation, error) {
+	const op = "postgresql user storage: list department vacations"
+
+	rows, err := s.DB.Query(ctx, `SELECT
+		vacations.id AS id, date_begin, date_end
+		FROM vacations
+		JOIN users ON vacations.user_id = users.id
+		WHERE users.department_id = (SELECT department_id FROM users WHERE id = @user_id)
+		AND users.id <> @user_id AND users.terminated_at IS NULL
+		AND date_begin <= @date_to AND date_end >= @date_from`,
+		pgx.NamedArgs{
+			"user_id":   userID,
+			"date_from": from,
+			"date_to":   to,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	defer rows.Close()
+
+	vs, err := pgx.CollectRows[vacation](rows, pgx.RowToStructByNameLax[vacation])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	vacations := make([]model.Vacation, len(vs))
+	for i, v := range vs {
+		vacations[i] = convertVacationToModelVacation(v)
+	}
+	return vacations, nil
+}
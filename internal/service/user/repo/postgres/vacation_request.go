@@ -0,0 +1,286 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+const listVacationRequestsQuery = `SELECT
+	vacation_requests.id AS id, user_id, lastname, firstname, middlename, work_email,
+	vacation_requests.date_begin AS date_begin, vacation_requests.date_end AS date_end,
+	comment, status, head_id, head_approved_at, submitted_at,
+	decided_by, decided_at, decision_comment, vacation_id
+	FROM vacation_requests
+	JOIN users ON vacation_requests.user_id = users.id
+	WHERE %s
+	ORDER BY vacation_requests.date_begin, vacation_requests.id`
+
+func (s *storage) GetVacationRequest(ctx context.Context, requestID uint64) (*model.VacationRequest, error) {
+	const op = "postgresql user storage: get vacation request"
+
+	rs, err := s.listVacationRequests(ctx, "vacation_requests.id = @id", pgx.NamedArgs{"id": requestID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(rs) == 0 {
+		return nil, repoerr.ErrRecordNotFound
+	}
+	return &rs[0], nil
+}
+
+// ListUserVacationRequests returns the vacation requests of the employee.
+func (s *storage) ListUserVacationRequests(ctx context.Context, userID uint64) ([]model.VacationRequest, error) {
+	const op = "postgresql user storage: list user vacation requests"
+
+	rs, err := s.listVacationRequests(ctx, "user_id = @user_id", pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return rs, nil
+}
+
+// ListPendingVacationRequests returns the pending requests to be decided by the head
+// and, if hr is true, the requests approved by the heads or having no heads.
+func (s *storage) ListPendingVacationRequests(ctx context.Context,
+	headID uint64, hr bool) ([]model.VacationRequest, error) {
+	const op = "postgresql user storage: list pending vacation requests"
+
+	rs, err := s.listVacationRequests(ctx, `status = 'pending' AND (
+		(head_id = @head_id AND head_approved_at IS NULL)
+		OR (@hr AND (head_id IS NULL OR head_approved_at IS NOT NULL) AND user_id <> @head_id))`,
+		pgx.NamedArgs{
+			"head_id": headID,
+			"hr":      hr,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return rs, nil
+}
+
+func (s *storage) listVacationRequests(ctx context.Context,
+	where string, args pgx.NamedArgs) ([]model.VacationRequest, error) {
+	rows, err := s.DB.Query(ctx, fmt.Sprintf(listVacationRequestsQuery, where), args)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	vrs, err := pgx.CollectRows[vacationRequest](rows, pgx.RowToStructByNameLax[vacationRequest])
+	if err != nil {
+		return nil, err
+	}
+
+	rs := make([]model.VacationRequest, len(vrs))
+	for i, vr := range vrs {
+		rs[i] = convertVacationRequestToModelVacationRequest(vr)
+	}
+	return rs, nil
+}
+
+func (s *storage) AddVacationRequest(ctx context.Context, r model.VacationRequest) (uint64, error) {
+	const op = "postgresql user storage: add vacation request"
+
+	row := s.DB.QueryRow(ctx, `INSERT INTO vacation_requests
+		("user_id", "date_begin", "date_end", "comment")
+		VALUES (@user_id, @date_begin, @date_end, @comment)
+		RETURNING "id"`,
+		pgx.NamedArgs{
+			"user_id":    r.UserID,
+			"date_begin": r.DateBegin,
+			"date_end":   r.DateEnd,
+			"comment":    r.Comment,
+		})
+	if err := row.Scan(&r.ID); err != nil {
+		if strings.Contains(err.Error(), "23") && // Integrity Constraint Violation
+			strings.Contains(err.Error(), "user_id") {
+			return 0, fmt.Errorf("the user does not exist: %w", repoerr.ErrConflict)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return r.ID, nil
+}
+
+// UpdateVacationRequest changes the dates and the comment of the draft request.
+func (s *storage) UpdateVacationRequest(ctx context.Context, r model.VacationRequest) error {
+	const op = "postgresql user storage: update vacation request"
+
+	tag, err := s.DB.Exec(ctx, `UPDATE vacation_requests
+		SET date_begin = @date_begin, date_end = @date_end, comment = @comment
+		WHERE id = @id AND user_id = @user_id AND status = 'draft'`,
+		pgx.NamedArgs{
+			"id":         r.ID,
+			"user_id":    r.UserID,
+			"date_begin": r.DateBegin,
+			"date_end":   r.DateEnd,
+			"comment":    r.Comment,
+		})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repoerr.ErrRecordNotAffected
+	}
+	return nil
+}
+
+// SetVacationRequestStatus saves the status and the decision data of the request
+// if the request is still in the status from.
+func (s *storage) SetVacationRequestStatus(ctx context.Context,
+	r model.VacationRequest, from model.RequestStatus) error {
+	const op = "postgresql user storage: set vacation request status"
+
+	tag, err := s.DB.Exec(ctx, setVacationRequestStatusQuery, setVacationRequestStatusArgs(r, from))
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repoerr.ErrRecordNotAffected
+	}
+	return nil
+}
+
+// ApproveVacationRequest adds the vacation of the pending request and marks the request approved.
+func (s *storage) ApproveVacationRequest(ctx context.Context, r model.VacationRequest) (uint64, error) {
+	const op = "postgresql user storage: approve vacation request"
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	var vacationID uint64
+	err = tx.QueryRow(ctx, `INSERT INTO vacations
+		("user_id", "date_begin", "date_end")
+		VALUES (@user_id, @date_begin, @date_end)
+		RETURNING "id"`,
+		pgx.NamedArgs{
+			"user_id":    r.UserID,
+			"date_begin": r.DateBegin,
+			"date_end":   r.DateEnd,
+		}).Scan(&vacationID)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	r.Status = model.RequestStatusApproved
+	r.VacationID = &vacationID
+	tag, err := tx.Exec(ctx, setVacationRequestStatusQuery,
+		setVacationRequestStatusArgs(r, model.RequestStatusPending))
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return 0, repoerr.ErrRecordNotAffected
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return vacationID, nil
+}
+
+const setVacationRequestStatusQuery = `UPDATE vacation_requests
+	SET status = @status, head_id = @head_id, head_approved_at = @head_approved_at,
+	submitted_at = @submitted_at, decided_by = @decided_by, decided_at = @decided_at,
+	decision_comment = @decision_comment, vacation_id = @vacation_id
+	WHERE id = @id AND status = @from`
+
+func setVacationRequestStatusArgs(r model.VacationRequest, from model.RequestStatus) pgx.NamedArgs {
+	return pgx.NamedArgs{
+		"id":               r.ID,
+		"from":             string(from),
+		"status":           string(r.Status),
+		"head_id":          r.HeadID,
+		"head_approved_at": r.HeadApprovedAt,
+		"submitted_at":     r.SubmittedAt,
+		"decided_by":       r.DecidedBy,
+		"decided_at":       r.DecidedAt,
+		"decision_comment": r.DecisionComment,
+		"vacation_id":      r.VacationID,
+	}
+}
+
+// GetHead returns the head of the employee department by the organization structure,
+// nil if the department has no head or the employee is the head.
+func (s *storage) GetHead(ctx context.Context, userID uint64) (*model.Recipient, error) {
+	const op = "postgresql user storage: get head"
+
+	rows, err := s.DB.Query(ctx, `SELECT users.id AS id, firstname, lastname, work_email
+		FROM organization_structure
+		JOIN users ON users.department_id = organization_structure.head_department_id
+		AND users.position_id = organization_structure.head_position_id
+		WHERE organization_structure.subordinate_department_id =
+		(SELECT department_id FROM users WHERE id = @user_id)
+		AND users.terminated_at IS NULL AND users.id <> @user_id
+		ORDER BY users.id
+		LIMIT 1`,
+		pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	rs, err := pgx.CollectRows[recipient](rows, pgx.RowToStructByNameLax[recipient])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if len(rs) == 0 {
+		return nil, nil
+	}
+	r := model.Recipient(rs[0])
+	return &r, nil
+}
+
+// GetRecipient returns the contacts of the user.
+func (s *storage) GetRecipient(ctx context.Context, userID uint64) (*model.Recipient, error) {
+	const op = "postgresql user storage: get recipient"
+
+	rows, err := s.DB.Query(ctx, `SELECT id, firstname, lastname, work_email
+		FROM users
+		WHERE id = @user_id`,
+		pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	r, err := pgx.CollectExactlyOneRow[recipient](rows, pgx.RowToStructByNameLax[recipient])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	mr := model.Recipient(r)
+	return &mr, nil
+}
+
+// ListHRRecipients returns the contacts of current employees having the hr role.
+func (s *storage) ListHRRecipients(ctx context.Context) ([]model.Recipient, error) {
+	const op = "postgresql user storage: list hr recipients"
+
+	rows, err := s.DB.Query(ctx, `SELECT users.id AS id, firstname, lastname, work_email
+		FROM users
+		JOIN authorizations ON authorizations.user_id = users.id
+		JOIN roles ON authorizations.role_id = roles.id
+		WHERE roles.title = 'hr' AND users.terminated_at IS NULL
+		ORDER BY users.id`)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	rs, err := pgx.CollectRows[recipient](rows, pgx.RowToStructByNameLax[recipient])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	recipients := make([]model.Recipient, len(rs))
+	for i, r := range rs {
+		recipients[i] = model.Recipient(r)
+	}
+	return recipients, nil
+}
@@ -0,0 +1,169 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+// GetVacationSchedule returns the vacation schedule of the year
+// with the planned vacations of the department (of all departments if departmentID is nil).
+func (s *storage) GetVacationSchedule(ctx context.Context,
+	year int, departmentID *uint64) (*model.VacationSchedule, error) {
+	const op = "postgresql user storage: get vacation schedule"
+
+	rows, err := s.DB.Query(ctx, `SELECT year, status, approved_at, locked_at
+		FROM vacation_schedules
+		WHERE year = @year`,
+		pgx.NamedArgs{"year": year})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	vs, err := pgx.CollectExactlyOneRow[vacationSchedule](rows, pgx.RowToStructByNameLax[vacationSchedule])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	rows, err = s.DB.Query(ctx, `SELECT
+		vacation_schedule_items.id AS id, vacation_schedule_items.user_id AS user_id,
+		date_begin, date_end, lastname, firstname, middlename,
+		users.department_id AS department_id,
+		departments.title AS department, positions.title AS position
+		FROM vacation_schedule_items
+		JOIN users ON vacation_schedule_items.user_id = users.id
+		JOIN departments ON users.department_id = departments.id
+		JOIN positions ON users.position_id = positions.id
+		WHERE year = @year
+		AND (@department_id::bigint IS NULL OR users.department_id = @department_id)
+		ORDER BY date_begin, lastname, firstname`,
+		pgx.NamedArgs{
+			"year":          year,
+			"department_id": departmentID,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	items, err := pgx.CollectRows[scheduleItem](rows, pgx.RowToStructByNameLax[scheduleItem])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	ms := convertVacationScheduleToModelVacationSchedule(vs)
+	ms.Items = make([]model.ScheduleItem, len(items))
+	for i, it := range items {
+		ms.Items[i] = convertScheduleItemToModelScheduleItem(it)
+	}
+	return &ms, nil
+}
+
+// AddVacationScheduleItem adds the planned vacation,
+// the draft schedule of the year is created if it doesn't exist.
+func (s *storage) AddVacationScheduleItem(ctx context.Context, year int, item model.ScheduleItem) (uint64, error) {
+	const op = "postgresql user storage: add vacation schedule item"
+
+	tx, err := s.DB.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	_, err = tx.Exec(ctx, `INSERT INTO vacation_schedules (year)
+		VALUES (@year)
+		ON CONFLICT (year) DO NOTHING`,
+		pgx.NamedArgs{"year": year})
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	row := tx.QueryRow(ctx, `INSERT INTO vacation_schedule_items
+		("year", "user_id", "date_begin", "date_end")
+		VALUES (@year, @user_id, @date_begin, @date_end)
+		RETURNING "id"`,
+		pgx.NamedArgs{
+			"year":       year,
+			"user_id":    item.UserID,
+			"date_begin": item.DateBegin,
+			"date_end":   item.DateEnd,
+		})
+	if err := row.Scan(&item.ID); err != nil {
+		if strings.Contains(err.Error(), "23") && // Integrity Constraint Violation
+			strings.Contains(err.Error(), "user_id") {
+			return 0, fmt.Errorf("the user does not exist: %w", repoerr.ErrConflict)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return item.ID, nil
+}
+
+func (s *storage) UpdateVacationScheduleItem(ctx context.Context, year int, item model.ScheduleItem) error {
+	const op = "postgresql user storage: update vacation schedule item"
+
+	tag, err := s.DB.Exec(ctx, `UPDATE vacation_schedule_items
+		SET date_begin = @date_begin, date_end = @date_end
+		WHERE id = @id AND year = @year`,
+		pgx.NamedArgs{
+			"id":         item.ID,
+			"year":       year,
+			"date_begin": item.DateBegin,
+			"date_end":   item.DateEnd,
+		})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repoerr.ErrRecordNotAffected
+	}
+	return nil
+}
+
+func (s *storage) DeleteVacationScheduleItem(ctx context.Context, year int, itemID uint64) error {
+	const op = "postgresql user storage: delete vacation schedule item"
+
+	tag, err := s.DB.Exec(ctx, `DELETE FROM vacation_schedule_items WHERE id = @id AND year = @year`,
+		pgx.NamedArgs{
+			"id":   itemID,
+			"year": year,
+		})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repoerr.ErrRecordNotFound
+	}
+	return nil
+}
+
+// SetVacationScheduleStatus sets the status of the schedule and the time of approval or locking.
+func (s *storage) SetVacationScheduleStatus(ctx context.Context, year int, status model.ScheduleStatus) error {
+	const op = "postgresql user storage: set vacation schedule status"
+
+	tag, err := s.DB.Exec(ctx, `UPDATE vacation_schedules
+		SET status = @status,
+		approved_at = CASE @status WHEN 'approved' THEN now() WHEN 'draft' THEN NULL ELSE approved_at END,
+		locked_at = CASE @status WHEN 'locked' THEN now() ELSE NULL END
+		WHERE year = @year`,
+		pgx.NamedArgs{
+			"year":   year,
+			"status": string(status),
+		})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return repoerr.ErrRecordNotAffected
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package postgresql
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+// versionMatches is the condition of the update or deletion by @version,
+// the version 0 (If-Match: *) matches any version of the row.
+const versionMatches = `(@version::bigint = 0 OR version = @version)`
+
+// versionMatch reports whether the version expected by creating or replacing the record
+// matches the current version of the record (0 if there is no record).
+func versionMatch(expected, current int64) bool {
+	switch expected {
+	case model.NewVersion:
+		return current == 0
+	case 0:
+		return current != 0
+	}
+	return expected == current
+}
+
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// notAffected tells the stale version of the record from the absent one if the update
+// or deletion by the version affected nothing, the query selects the record without the version.
+// It returns ErrVersionMismatch if the record exists, otherwise the absent error.
+func notAffected(ctx context.Context, q rowQuerier, query string, args pgx.NamedArgs, absent error) error {
+	var exists bool
+	if err := q.QueryRow(ctx, "SELECT EXISTS ("+query+")", args).Scan(&exists); err != nil {
+		return err
+	}
+	if exists {
+		return repoerr.ErrVersionMismatch
+	}
+	return absent
+}
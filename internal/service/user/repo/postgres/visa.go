@@ -45,7 +45,7 @@ func (s *storage) GetVisa(ctx context.Context, userID, passportID, visaID uint64
 
 	rows, err := s.DB.Query(ctx,
 		`SELECT id, number, issued_state, 
-		valid_to, valid_from, number_entries 
+		valid_to, valid_from, number_entries, version
 		FROM visas
 		WHERE visas.id = @visa_id AND visas.passport_id = @passport_id AND visas.user_id = @user_id`,
 		pgx.NamedArgs{
@@ -107,26 +107,28 @@ func (s *storage) UpdateVisa(ctx context.Context, userID, passportID uint64, mv
 
 	v := convertModelVisaToVisa(mv)
 
+	args := pgx.NamedArgs{
+		"user_id":        userID,
+		"passport_id":    passportID,
+		"id":             v.ID,
+		"number":         v.Number,
+		"issued_state":   v.IssuedState,
+		"valid_to":       v.ValidTo,
+		"valid_from":     v.ValidFrom,
+		"number_entries": v.NumberEntries,
+		"version":        v.Version,
+	}
 	tag, err := s.DB.Exec(ctx, `UPDATE visas
 	SET number = @number, issued_state = @issued_state, 
 	valid_from = @valid_from, valid_to = @valid_to, number_entries = @number_entries
-	WHERE id=@id AND user_id=@user_id AND passport_id=@passport_id`,
-		pgx.NamedArgs{
-			"user_id":        userID,
-			"passport_id":    passportID,
-			"id":             v.ID,
-			"number":         v.Number,
-			"issued_state":   v.IssuedState,
-			"valid_to":       v.ValidTo,
-			"valid_from":     v.ValidFrom,
-			"number_entries": v.NumberEntries,
-		})
+	WHERE id=@id AND user_id=@user_id AND passport_id=@passport_id AND `+versionMatches, args)
 
 	if err != nil {
 		return fmt.Errorf("%s: %w", op, err)
 	}
 	if tag.RowsAffected() == 0 { // it's ok for pgx
-		return repoerr.ErrRecordNotAffected
+		return notAffected(ctx, s.DB,
+			`SELECT 1 FROM visas WHERE id=@id AND user_id=@user_id AND passport_id=@passport_id`, args, repoerr.ErrRecordNotAffected)
 	}
 	return nil
 }
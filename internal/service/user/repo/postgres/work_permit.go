@@ -0,0 +1,207 @@
+package postgresql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+const (
+	listWorkPermitsQuery = `SELECT
+id, number, valid_from, valid_to, version,
+(SELECT COUNT(*)>0 FROM scans WHERE scans.user_id=@user_id AND scans.document_id=work_permits.id AND scans.type='Разрешение на работу') AS has_scan
+FROM work_permits
+WHERE work_permits.user_id = @user_id
+ORDER BY valid_from`
+
+	getCurrentWorkPermitQuery = `SELECT
+id, number, valid_from, valid_to, version,
+(SELECT COUNT(*)>0 FROM scans WHERE scans.user_id=@user_id AND scans.document_id=work_permits.id AND scans.type='Разрешение на работу') AS has_scan
+FROM work_permits
+WHERE work_permits.user_id = @user_id
+ORDER BY valid_from DESC
+LIMIT 1`
+)
+
+// collectWorkPermit returns nil if the user has no work permit.
+func collectWorkPermit(rows pgx.Rows) (*model.WorkPermit, error) {
+	wp, err := pgx.CollectExactlyOneRow(rows, pgx.RowToStructByNameLax[workPermit])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	mwp := model.WorkPermit(wp)
+	return &mwp, nil
+}
+
+func (s *storage) ListWorkPermits(ctx context.Context, userID uint64) ([]model.WorkPermit, error) {
+	const op = "postgresql user storage: list work permits"
+
+	rows, err := s.DB.Query(ctx, listWorkPermitsQuery, pgx.NamedArgs{"user_id": userID})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	wps, err := pgx.CollectRows[workPermit](rows, pgx.RowToStructByNameLax[workPermit])
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	permits := make([]model.WorkPermit, len(wps))
+	for i, wp := range wps {
+		permits[i] = model.WorkPermit(wp)
+	}
+
+	return permits, nil
+}
+
+func (s *storage) GetWorkPermit(ctx context.Context, userID, workPermitID uint64) (*model.WorkPermit, error) {
+	const op = "postgresql user storage: get work permit"
+
+	rows, err := s.DB.Query(ctx,
+		`SELECT id, number, valid_from, valid_to, version,
+		(SELECT COUNT(*)>0 FROM scans WHERE scans.user_id=@user_id AND scans.document_id=work_permits.id AND scans.type='Разрешение на работу') AS has_scan
+		FROM work_permits
+		WHERE id = @id AND user_id = @user_id`,
+		pgx.NamedArgs{
+			"id":      workPermitID,
+			"user_id": userID,
+		})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	wp, err := pgx.CollectExactlyOneRow[workPermit](rows, pgx.RowToStructByNameLax[workPermit])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, repoerr.ErrRecordNotFound
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	mwp := model.WorkPermit(wp)
+	return &mwp, nil
+}
+
+func (s *storage) AddWorkPermit(ctx context.Context, userID uint64, wp model.WorkPermit) (uint64, error) {
+	const op = "postgresql user storage: add work permit"
+
+	row := s.DB.QueryRow(ctx, `INSERT INTO work_permits
+		("user_id", "number", "valid_from", "valid_to")
+		VALUES (@user_id, @number, @valid_from, @valid_to)
+		RETURNING "id"`,
+		pgx.NamedArgs{
+			"user_id":    userID,
+			"number":     wp.Number,
+			"valid_from": wp.ValidFrom,
+			"valid_to":   wp.ValidTo,
+		})
+
+	if err := row.Scan(&wp.ID); err != nil {
+		if strings.Contains(err.Error(), "23505") { // Unique Violation
+			return 0, fmt.Errorf("the work permit already exists: %w", repoerr.ErrRecordAlreadyExist)
+		}
+		if strings.Contains(err.Error(), "23") && // Integrity Constraint Violation
+			strings.Contains(err.Error(), "user_id") {
+			return 0, fmt.Errorf("the user does not exist: %w", repoerr.ErrConflict)
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	return wp.ID, nil
+}
+
+func (s *storage) UpdateWorkPermit(ctx context.Context, userID uint64, wp model.WorkPermit) error {
+	const op = "postgresql user storage: update work permit"
+
+	args := pgx.NamedArgs{
+		"user_id":    userID,
+		"id":         wp.ID,
+		"number":     wp.Number,
+		"valid_from": wp.ValidFrom,
+		"valid_to":   wp.ValidTo,
+		"version":    wp.Version,
+	}
+	tag, err := s.DB.Exec(ctx, `UPDATE work_permits
+	SET number = @number, valid_from = @valid_from, valid_to = @valid_to
+	WHERE id=@id AND user_id=@user_id AND `+versionMatches, args)
+
+	if err != nil {
+		if strings.Contains(err.Error(), "23505") { // Unique Violation
+			return fmt.Errorf("the work permit already exists: %w", repoerr.ErrRecordAlreadyExist)
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 { // it's ok for pgx
+		return notAffected(ctx, s.DB, `SELECT 1 FROM work_permits WHERE id=@id AND user_id=@user_id`, args,
+			repoerr.ErrRecordNotAffected)
+	}
+	return nil
+}
+
+func (s *storage) DeleteWorkPermit(ctx context.Context, userID, workPermitID uint64, version int64) error {
+	const op = "postgresql user storage: delete work permit"
+
+	args := pgx.NamedArgs{
+		"user_id": userID,
+		"id":      workPermitID,
+		"version": version,
+	}
+	tag, err := s.DB.Exec(ctx, `DELETE FROM work_permits WHERE id=@id AND user_id=@user_id AND `+versionMatches, args)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return notAffected(ctx, s.DB, `SELECT 1 FROM work_permits WHERE id=@id AND user_id=@user_id`, args,
+			repoerr.ErrRecordNotFound)
+	}
+	return nil
+}
+
+// setWorkPermit adds the work permit to the history of the user,
+// the dates of a permit with the same number are replaced.
+// The version of the permit is checked only if the dates are changed,
+// a new number is a new permit with no version.
+func setWorkPermit(ctx context.Context, tx pgx.Tx, userID uint64, wp model.WorkPermit) error {
+	args := pgx.NamedArgs{
+		"user_id":    userID,
+		"number":     wp.Number,
+		"valid_from": wp.ValidFrom,
+		"valid_to":   wp.ValidTo,
+	}
+
+	var old model.WorkPermit
+	err := tx.QueryRow(ctx, `SELECT id, valid_from, valid_to, version FROM work_permits
+		WHERE user_id = @user_id AND number = @number FOR UPDATE`, args).
+		Scan(&old.ID, &old.ValidFrom, &old.ValidTo, &old.Version)
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			return err
+		}
+		if wp.Version != 0 {
+			return fmt.Errorf("the work permit: %w", repoerr.ErrVersionMismatch)
+		}
+		_, err = tx.Exec(ctx, `INSERT INTO work_permits
+			(user_id, number, valid_from, valid_to)
+			VALUES (@user_id, @number, @valid_from, @valid_to)`, args)
+		return err
+	}
+
+	if old.ValidFrom.Equal(wp.ValidFrom) && old.ValidTo.Equal(wp.ValidTo) {
+		return nil
+	}
+	if old.Version != wp.Version {
+		return fmt.Errorf("the work permit: %w", repoerr.ErrVersionMismatch)
+	}
+	args["id"] = old.ID
+	_, err = tx.Exec(ctx, `UPDATE work_permits
+		SET valid_from = @valid_from, valid_to = @valid_to
+		WHERE id = @id`, args)
+	return err
+}
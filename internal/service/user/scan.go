@@ -54,6 +54,10 @@ func (s *service) ListScans(ctx context.Context, userID uint64) ([]model.Scan, e
 func (s *service) UploadScan(ctx context.Context, userID uint64, ms model.Scan, f model.File) (uint64, error) {
 	const op = "user service: upload scan"
 
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
 	if f.Size > MaxScanSize {
 		return 0, serr.NewError(serr.ContentTooLarge, "scan file size too large")
 	}
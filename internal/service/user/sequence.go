@@ -0,0 +1,52 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+func (s *service) ListNumberSequences(ctx context.Context) ([]model.NumberSequence, error) {
+	const op = "user service: list number sequences"
+
+	seqs, err := s.userRepository.ListNumberSequences(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return seqs, nil
+}
+
+// AddNumberSequence adds the sequence of the order numbers, the orders of its types
+// are numbered by it from then on. The other entities have the only sequence each.
+func (s *service) AddNumberSequence(ctx context.Context, seq model.NumberSequence) (uint64, error) {
+	const op = "user service: add number sequence"
+
+	id, err := s.userRepository.AddNumberSequence(ctx, seq)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+// UpdateNumberSequence changes the format of the numbers allocated from then on
+// and moves the order types to the sequence of the order numbers.
+func (s *service) UpdateNumberSequence(ctx context.Context, seq model.NumberSequence) error {
+	const op = "user service: update number sequence"
+
+	err := s.userRepository.UpdateNumberSequence(ctx, seq)
+	if err != nil {
+		switch {
+		case errors.Is(err, repoerr.ErrRecordNotAffected):
+			return serr.NewError(serr.NotFound, "number sequence not found")
+		case errors.Is(err, repoerr.ErrConflict):
+			return serr.NewError(serr.Conflict, fmt.Sprintf("not updated: %s", err))
+		default:
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return nil
+}
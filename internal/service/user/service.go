@@ -1,13 +1,33 @@
 package user
 
+import "log/slog"
+
 type service struct {
-	userRepository userRepository
-	fileRepository s3FileRepository
+	userRepository  userRepository
+	fileRepository  s3FileRepository
+	staffingChecker staffingChecker
+	calendar        productionCalendar
+	notifier        notificationDeliverer
+	notifications   chan notification
+	logger          *slog.Logger
+	Config          Config
 }
 
-func NewService(userRepository userRepository, fileRepository s3FileRepository) *service {
+func NewService(userRepository userRepository,
+	fileRepository s3FileRepository,
+	staffingChecker staffingChecker,
+	calendar productionCalendar,
+	notifier notificationDeliverer,
+	cfg Config,
+	logger *slog.Logger) *service {
 	return &service{
-		userRepository: userRepository,
-		fileRepository: fileRepository,
+		userRepository:  userRepository,
+		fileRepository:  fileRepository,
+		staffingChecker: staffingChecker,
+		calendar:        calendar,
+		notifier:        notifier,
+		notifications:   make(chan notification, notificationQueueSize),
+		logger:          logger,
+		Config:          cfg,
 	}
 }
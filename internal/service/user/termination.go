@@ -0,0 +1,74 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+// Terminate dismisses the employee: the active contract and the position are closed
+// on the date of the termination, the access to the system is revoked.
+// The data of the former employee becomes read-only.
+// The last working day can't be in the future or before the beginning
+// of the current position or of a contract, they would stay open.
+func (s *service) Terminate(ctx context.Context, userID uint64, t model.Termination) error {
+	const op = "user service: terminate"
+
+	if t.IsFuture(time.Now()) {
+		return serr.NewError(serr.InvalidArgument, "not terminated: the last working day is in the future")
+	}
+
+	contracts, err := s.userRepository.ListContracts(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	track, err := s.userRepository.ListPositionTrack(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if begin, ok := t.StartsAfter(contracts, model.CurrentPosition(track)); ok {
+		return serr.NewError(serr.InvalidArgument, fmt.Sprintf(
+			"not terminated: the last working day is before the contract or the position beginning on %s",
+			begin.Format(time.DateOnly)))
+	}
+
+	err = s.userRepository.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.userRepository.Terminate(ctx, userID, t); err != nil {
+			switch {
+			case errors.Is(err, repoerr.ErrRecordNotFound):
+				return serr.NewError(serr.NotFound, "user not found")
+			case errors.Is(err, repoerr.ErrConflict):
+				return serr.NewError(serr.Conflict, "not terminated: the user is already terminated")
+			default:
+				return err
+			}
+		}
+
+		return s.addDraftOrder(ctx, model.Order{
+			Type:          model.OrderTypeTermination,
+			UserID:        userID,
+			EffectiveDate: t.Date,
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// checkNotTerminated returns an error if the user is a former employee.
+func (s *service) checkNotTerminated(ctx context.Context, userID uint64) error {
+	terminated, err := s.userRepository.IsTerminated(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if terminated {
+		return serr.NewError(serr.Conflict, "the user is terminated, the data is read-only")
+	}
+	return nil
+}
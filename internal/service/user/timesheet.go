@@ -0,0 +1,105 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+// GetTimesheet builds the timesheet of the department for the month.
+// The employees not employed during the month are omitted.
+func (s *service) GetTimesheet(ctx context.Context,
+	departmentID uint64, year int, month time.Month) (*model.Timesheet, error) {
+	const op = "user service: get timesheet"
+
+	if month < time.January || month > time.December {
+		return nil, serr.NewError(serr.InvalidArgument, "invalid month")
+	}
+
+	title, err := s.userRepository.GetDepartmentTitle(ctx, departmentID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "department not found")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	first, last := model.TimesheetPeriod(year, month)
+	employees, err := s.userRepository.ListTimesheetEmployees(ctx, departmentID, first, last)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cal, err := s.calendar.Calendar(ctx, first, last)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	ts := &model.Timesheet{
+		DepartmentID: departmentID,
+		Department:   title,
+		Year:         year,
+		Month:        month,
+		Rows:         make([]model.TimesheetRow, 0, len(employees)),
+	}
+	for _, e := range employees {
+		row := model.BuildTimesheetRow(e, year, month, cal)
+		if row.Empty() {
+			continue
+		}
+		ts.Rows = append(ts.Rows, row)
+	}
+	return ts, nil
+}
+
+// SetTimesheetCorrection replaces the day of the employee in the timesheet of the month.
+func (s *service) SetTimesheetCorrection(ctx context.Context,
+	year int, month time.Month, c model.TimesheetCorrection) error {
+	const op = "user service: set timesheet correction"
+
+	if err := checkTimesheetDate(year, month, c.Date); err != nil {
+		return err
+	}
+
+	if err := s.userRepository.SetTimesheetCorrection(ctx, c); err != nil {
+		if errors.Is(err, repoerr.ErrConflict) {
+			return serr.NewError(serr.Conflict, "not updated: user problem")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// DeleteTimesheetCorrection restores the calculated day of the employee in the timesheet of the month.
+func (s *service) DeleteTimesheetCorrection(ctx context.Context,
+	year int, month time.Month, userID uint64, date time.Time) error {
+	const op = "user service: delete timesheet correction"
+
+	if err := checkTimesheetDate(year, month, date); err != nil {
+		return err
+	}
+
+	if err := s.userRepository.DeleteTimesheetCorrection(ctx, userID, date); err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return serr.NewError(serr.NotFound, "timesheet correction not found")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+func checkTimesheetDate(year int, month time.Month, date time.Time) error {
+	if month < time.January || month > time.December {
+		return serr.NewError(serr.InvalidArgument, "invalid month")
+	}
+	first, last := model.TimesheetPeriod(year, month)
+	if date.Before(first) || date.After(last) {
+		return serr.NewError(serr.InvalidArgument, "the day is out of the month")
+	}
+	return nil
+}
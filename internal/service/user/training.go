@@ -36,6 +36,10 @@ func (s *service) ListTrainings(ctx context.Context, userID uint64) ([]model.Tra
 func (s *service) AddTraining(ctx context.Context, userID uint64, ed model.Training) (uint64, error) {
 	const op = "user service: add training"
 
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
 	id, err := s.userRepository.AddTraining(ctx, userID, ed)
 	if err != nil {
 		if errors.Is(err, repoerr.ErrConflict) {
@@ -49,9 +53,15 @@ func (s *service) AddTraining(ctx context.Context, userID uint64, ed model.Train
 func (s *service) UpdateTraining(ctx context.Context, userID uint64, tr model.Training) error {
 	const op = "user service: update training"
 
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	err := s.userRepository.UpdateTraining(ctx, userID, tr)
 	if err != nil {
 		switch {
+		case errors.Is(err, repoerr.ErrVersionMismatch):
+			return serr.NewError(serr.PreconditionFailed, "not updated: the training is changed by another user")
 		case errors.Is(err, repoerr.ErrRecordNotAffected):
 			return serr.NewError(serr.Conflict, "not updated: user/training problem")
 		default:
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
 	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
@@ -26,6 +27,9 @@ func (s *service) Get(ctx context.Context, userID uint64) (*model.User, error) {
 		}
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	if !s.Config.ForeignCitizens {
+		u.WorkPermit = nil
+	}
 	return u, nil
 }
 
@@ -39,6 +43,13 @@ func (s *service) GetExpanded(ctx context.Context, userID uint64) (*model.Expand
 		}
 		return nil, fmt.Errorf("%s: %w", op, err)
 	}
+	if !s.Config.ForeignCitizens {
+		eu.WorkPermit = nil
+	}
+	if err := s.setProbationEnds(ctx, eu.Contracts); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	eu.Family = model.SummarizeFamily(eu.Relatives, time.Now())
 	return eu, nil
 }
 
@@ -52,36 +63,193 @@ func (s *service) ListShortUserInfo(ctx context.Context, params model.ListUsersP
 	return users, count, nil
 }
 
-func (s *service) Add(ctx context.Context, u model.User) (uint64, error) {
+// Add adds the user. If the staffing table has no free slot for the user's position,
+// the user is added with the warning (it's not added in strict staffing mode).
+// The probable duplicates of the existing users are ignored by force only.
+// The user gets the next personnel number of the sequence if the number is empty.
+// The position is held from the position date, today by default, it can't be in the future.
+func (s *service) Add(ctx context.Context, u model.User, force bool) (uint64, []string, error) {
 	const op = "user service: add user"
 
+	if u.WorkPermit != nil {
+		if err := s.checkForeignCitizens(); err != nil {
+			return 0, nil, err
+		}
+	}
+
+	if err := checkDocumentNumbers(u); err != nil {
+		return 0, nil, err
+	}
+
+	if err := checkPositionDate(u); err != nil {
+		return 0, nil, err
+	}
+
+	warnings, err := s.checkVacancy(ctx, u.DepartmentID, u.PositionID, 0)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.checkDuplicates(ctx, u, force); err != nil {
+		return 0, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
 	// TODO: add user to authorizations, use transaction
 
 	id, err := s.userRepository.Add(ctx, u)
 	if err != nil {
 		switch {
+		case errors.Is(err, repoerr.ErrRecordAlreadyExist):
+			return 0, nil, serr.NewError(serr.AlreadyExists, "not added: the personnel number is already used")
 		case errors.Is(err, repoerr.ErrConflict):
-			return 0, serr.NewError(serr.Conflict, "not added: department or position not found")
+			return 0, nil, serr.NewError(serr.Conflict, "not added: department or position not found")
 		default:
-			return 0, fmt.Errorf("%s: %w", op, err)
+			return 0, nil, fmt.Errorf("%s: %w", op, err)
 		}
 	}
-	return id, nil
+	return id, warnings, nil
 }
 
-func (s *service) Update(ctx context.Context, user model.User) error {
+// Update updates the user. Moving to a position without a free slot
+// in the staffing table and the duplicates are checked in the same way as in Add.
+// The new position is held from the position date, today by default: the current period
+// of the position history is closed the day before, it can't begin after the date.
+func (s *service) Update(ctx context.Context, user model.User, force bool) ([]string, error) {
 	const op = "user service: update user"
 
-	err := s.userRepository.Update(ctx, user)
+	if err := s.checkNotTerminated(ctx, user.ID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if user.WorkPermit != nil {
+		if err := s.checkForeignCitizens(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := checkDocumentNumbers(user); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkDuplicates(ctx, user, force); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	departmentID, positionID, err := s.userRepository.GetPosition(ctx, user.ID)
 	if err != nil {
-		switch {
-		case errors.Is(err, repoerr.ErrRecordNotAffected):
-			return serr.NewError(serr.Conflict, "not updated: user problem")
-		case errors.Is(err, repoerr.ErrConflict):
-			return serr.NewError(serr.Conflict, "not updated: department/position problem")
-		default:
-			return fmt.Errorf("%s: %w", op, err)
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "user not found")
 		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	var warnings []string
+	moved := departmentID != user.DepartmentID || positionID != user.PositionID
+	if moved {
+		if user.PositionDate == nil {
+			now := time.Now()
+			user.PositionDate = &now
+		}
+		if err := s.checkPositionMove(ctx, user); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		warnings, err = s.checkVacancy(ctx, user.DepartmentID, user.PositionID, user.ID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	err = s.userRepository.WithTx(ctx, func(ctx context.Context) error {
+		if err := s.userRepository.Update(ctx, user); err != nil {
+			switch {
+			case errors.Is(err, repoerr.ErrVersionMismatch):
+				return serr.NewError(serr.PreconditionFailed, "not updated: the user is changed by another user")
+			case errors.Is(err, repoerr.ErrRecordNotAffected):
+				return serr.NewError(serr.Conflict, "not updated: user problem")
+			case errors.Is(err, repoerr.ErrRecordAlreadyExist):
+				return serr.NewError(serr.AlreadyExists, "not updated: the personnel number is already used")
+			case errors.Is(err, repoerr.ErrConflict):
+				return serr.NewError(serr.Conflict, "not updated: department/position problem")
+			default:
+				return err
+			}
+		}
+
+		if !moved {
+			return nil
+		}
+		return s.addDraftOrder(ctx, model.Order{
+			Type:          model.OrderTypeTransfer,
+			UserID:        user.ID,
+			EffectiveDate: *user.PositionDate,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return warnings, nil
+}
+
+// checkDocumentNumbers returns an error naming the field if the number of the document
+// has wrong control digits, so a typo is found before the payroll rejects it.
+func checkDocumentNumbers(u model.User) error {
+	if u.Insurance.Number != "" && !u.Insurance.ValidNumber() {
+		return serr.NewError(serr.InvalidArgument, "insurance.number: invalid SNILS, wrong control digits")
+	}
+	if u.Taxpayer.Number != "" && !u.Taxpayer.ValidNumber() {
+		return serr.NewError(serr.InvalidArgument,
+			"taxpayer.number: invalid personal INN, must be 12 digits with correct control digits")
 	}
 	return nil
 }
+
+// checkPositionDate returns an error if the position is held from the future date:
+// the department and the position of the user are changed at once.
+func checkPositionDate(u model.User) error {
+	if u.PositionDate == nil {
+		return nil
+	}
+	y, m, d := time.Now().Date()
+	if u.PositionDate.After(time.Date(y, m, d, 0, 0, 0, 0, u.PositionDate.Location())) {
+		return serr.NewError(serr.InvalidArgument, "position_date: the date is in the future")
+	}
+	return nil
+}
+
+// checkPositionMove returns an error if the user can't move to the position
+// from the date: the position history isn't rewritten before the current position.
+func (s *service) checkPositionMove(ctx context.Context, u model.User) error {
+	if err := checkPositionDate(u); err != nil {
+		return err
+	}
+
+	track, err := s.userRepository.ListPositionTrack(ctx, u.ID)
+	if err != nil {
+		return err
+	}
+	cur := model.CurrentPosition(track)
+	if cur == nil {
+		return nil
+	}
+	if _, ok := cur.CloseOn(*u.PositionDate); !ok {
+		return serr.NewError(serr.Conflict, fmt.Sprintf(
+			"not updated: the position date is before the current position held from %s",
+			cur.DateBegin.Format(time.DateOnly)))
+	}
+	return nil
+}
+
+// checkVacancy returns the warning if there is no free slot for the position
+// in the staffing table, it's an error in the strict staffing mode.
+func (s *service) checkVacancy(ctx context.Context, departmentID, positionID, userID uint64) ([]string, error) {
+	ok, err := s.staffingChecker.HasVacancy(ctx, departmentID, positionID, userID)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case ok:
+		return nil, nil
+	case s.Config.StrictStaffing:
+		return nil, serr.NewError(serr.Conflict, "no free slot for the position in the staffing table")
+	}
+	return []string{"no free slot for the position in the staffing table"}, nil
+}
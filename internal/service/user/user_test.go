@@ -0,0 +1,49 @@
+package user
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+)
+
+type vacancyStub bool
+
+func (v vacancyStub) HasVacancy(context.Context, uint64, uint64, uint64) (bool, error) {
+	return bool(v), nil
+}
+
+func TestService_checkVacancy(t *testing.T) {
+	tests := []struct {
+		name         string
+		hasVacancy   bool
+		strict       bool
+		wantWarnings int
+		wantErr      bool
+	}{
+		{name: "free slot", hasVacancy: true},
+		{name: "free slot, strict", hasVacancy: true, strict: true},
+		{name: "no free slot", wantWarnings: 1},
+		{name: "no free slot, strict", strict: true, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &service{
+				staffingChecker: vacancyStub(tt.hasVacancy),
+				Config:          Config{StrictStaffing: tt.strict},
+			}
+			warnings, err := s.checkVacancy(context.Background(), 1, 1, 0)
+			if tt.wantErr {
+				var serviceErr *serr.Error
+				require.ErrorAs(t, err, &serviceErr)
+				assert.Equal(t, serr.Conflict, serviceErr.Status)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, warnings, tt.wantWarnings)
+		})
+	}
+}
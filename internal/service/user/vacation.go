@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	cmodel "github.com/Employee-s-file-cabinet/backend/internal/service/calendar/model"
 	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
 	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
 )
@@ -33,30 +35,243 @@ func (s *service) ListVacations(ctx context.Context, userID uint64) ([]model.Vac
 	return vcs, nil
 }
 
-func (s *service) AddVacation(ctx context.Context, userID uint64, v model.Vacation) (uint64, error) {
+// ListAllVacations returns the vacations of all employees having common days with the period.
+func (s *service) ListAllVacations(ctx context.Context,
+	params model.ListVacationsParams) ([]model.EmployeeVacation, error) {
+	const op = "user service: list all vacations"
+
+	vcs, err := s.userRepository.ListAllVacations(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return vcs, nil
+}
+
+// AddVacation adds the vacation to the employee. The vacation overlapping
+// another vacation of the employee is rejected. If the vacation exceeds
+// the balance of the annual paid leave, it's added only if force is true.
+// Exceeding the limit of absent employees of the department is returned as the warning.
+func (s *service) AddVacation(ctx context.Context, userID uint64, v model.Vacation, force bool) (uint64, []string, error) {
 	const op = "user service: add vacation"
 
-	id, err := s.userRepository.AddVacation(ctx, userID, v)
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return 0, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	vcs, err := s.userRepository.ListVacations(ctx, userID)
 	if err != nil {
-		if errors.Is(err, repoerr.ErrConflict) {
-			return 0, serr.NewError(serr.Conflict, "not added: user problem")
+		return 0, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := checkVacationOverlap("not added", vcs, v); err != nil {
+		return 0, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	warnings, err := s.checkVacationLimits(ctx, "not added", userID, v, force)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var id uint64
+	err = s.userRepository.WithTx(ctx, func(ctx context.Context) error {
+		var err error
+		id, err = s.userRepository.AddVacation(ctx, userID, v)
+		if err != nil {
+			if errors.Is(err, repoerr.ErrConflict) {
+				return serr.NewError(serr.Conflict, "not added: user problem")
+			}
+			return err
 		}
-		return 0, fmt.Errorf("%s: %w", op, err)
+
+		return s.addDraftOrder(ctx, model.Order{
+			Type:          model.OrderTypeVacation,
+			UserID:        userID,
+			EffectiveDate: v.DateBegin,
+			VacationID:    &id,
+		})
+	})
+	if err != nil {
+		return 0, nil, fmt.Errorf("%s: %w", op, err)
 	}
-	return id, nil
+	return id, warnings, nil
 }
 
-func (s *service) UpdateVacation(ctx context.Context, userID uint64, v model.Vacation) error {
+// UpdateVacation changes the vacation of the employee. The vacation overlapping
+// another vacation of the employee is rejected. If the changed vacation exceeds
+// the balance of the annual paid leave, it's changed only if force is true.
+// Exceeding the limit of absent employees of the department is returned as the warning.
+func (s *service) UpdateVacation(ctx context.Context, userID uint64, v model.Vacation, force bool) ([]string, error) {
 	const op = "user service: update vacation"
 
-	err := s.userRepository.UpdateVacation(ctx, userID, v)
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	vcs, err := s.userRepository.ListVacations(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := checkVacationOverlap("not updated", vcs, v); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	warnings, err := s.checkVacationLimits(ctx, "not updated", userID, v, force)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.userRepository.UpdateVacation(ctx, userID, v)
 	if err != nil {
 		switch {
+		case errors.Is(err, repoerr.ErrVersionMismatch):
+			return nil, serr.NewError(serr.PreconditionFailed, "not updated: the vacation is changed by another user")
 		case errors.Is(err, repoerr.ErrRecordNotAffected):
-			return serr.NewError(serr.Conflict, "not updated: vacation/user problem")
+			return nil, serr.NewError(serr.Conflict, "not updated: vacation/user problem")
 		default:
-			return fmt.Errorf("%s: %w", op, err)
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return warnings, nil
+}
+
+// GetVacationBalance returns the balance of the annual paid leave of the employee on the current date.
+func (s *service) GetVacationBalance(ctx context.Context, userID uint64) (*model.VacationBalance, error) {
+	const op = "user service: get vacation balance"
+
+	et, err := s.userRepository.GetEntitlement(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "user not found")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	cal, err := s.vacationCalendar(ctx, et.Vacations)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	b := model.CalculateVacationBalance(s.Config.VacationDays, et.Periods, et.Vacations, cal, time.Now())
+	return &b, nil
+}
+
+// ListVacationBalances returns the balances of the annual paid leave of current employees
+// on the current date, optionally of the department only.
+func (s *service) ListVacationBalances(ctx context.Context,
+	departmentID *uint64) ([]model.EmployeeVacationBalance, error) {
+	const op = "user service: list vacation balances"
+
+	ets, err := s.userRepository.ListEntitlements(ctx, departmentID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var vs []model.Vacation
+	for _, et := range ets {
+		vs = append(vs, et.Vacations...)
+	}
+	cal, err := s.vacationCalendar(ctx, vs)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := time.Now()
+	bs := make([]model.EmployeeVacationBalance, len(ets))
+	for i, et := range ets {
+		bs[i] = model.EmployeeVacationBalance{
+			UserID:     et.UserID,
+			LastName:   et.LastName,
+			FirstName:  et.FirstName,
+			MiddleName: et.MiddleName,
+			Department: et.Department,
+			Position:   et.Position,
+			VacationBalance: model.CalculateVacationBalance(s.Config.VacationDays,
+				et.Periods, et.Vacations, cal, now),
+		}
+	}
+	return bs, nil
+}
+
+// checkVacationLimits returns an error if the vacation of the employee exceeds
+// the balance of the annual paid leave, unless force is true, and the warning
+// if it exceeds the limit of absent employees of the department.
+func (s *service) checkVacationLimits(ctx context.Context,
+	action string, userID uint64, v model.Vacation, force bool) ([]string, error) {
+	if !force {
+		if err := s.checkVacationBalance(ctx, action, userID, v); err != nil {
+			return nil, err
+		}
+	}
+
+	others, err := s.userRepository.ListDepartmentVacations(ctx, userID, v.DateBegin, v.DateEnd)
+	if err != nil {
+		return nil, err
+	}
+	return s.checkDepartmentAbsent(others, v), nil
+}
+
+// checkVacationBalance returns an error if the vacation exceeds
+// the days accrued by the beginning of the vacation.
+// The changed vacation replaces its old days in the used ones.
+func (s *service) checkVacationBalance(ctx context.Context, action string, userID uint64, v model.Vacation) error {
+	et, err := s.userRepository.GetEntitlement(ctx, userID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return serr.NewError(serr.Conflict, action+": user problem")
 		}
+		return err
+	}
+
+	used := model.OtherVacations(et.Vacations, v)
+	cal, err := s.vacationCalendar(ctx, append(used, v))
+	if err != nil {
+		return err
+	}
+
+	b := model.CalculateVacationBalance(s.Config.VacationDays, et.Periods, used, cal, v.DateBegin)
+	if days := cal.CalendarDays(v.DateBegin, v.DateEnd); float64(days) > b.Balance {
+		return serr.NewError(serr.Conflict,
+			fmt.Sprintf("%s: the vacation of %d days exceeds the balance of %.2f days (use force to ignore)",
+				action, days, b.Balance))
+	}
+	return nil
+}
+
+// vacationCalendar returns the production calendar of the years of the vacations.
+func (s *service) vacationCalendar(ctx context.Context, vacations []model.Vacation) (*cmodel.Calendar, error) {
+	from, to := time.Now(), time.Now()
+	for _, v := range vacations {
+		if v.DateBegin.Before(from) {
+			from = v.DateBegin
+		}
+		if v.DateEnd.After(to) {
+			to = v.DateEnd
+		}
+	}
+	return s.calendar.Calendar(ctx, from, to)
+}
+
+// checkVacationOverlap returns an error if the vacation overlaps another vacation of the employee.
+func checkVacationOverlap(action string, vacations []model.Vacation, v model.Vacation) error {
+	if o, ok := model.FindOverlap(vacations, v); ok {
+		return serr.NewError(serr.Conflict,
+			fmt.Sprintf("%s: the vacation overlaps the vacation from %s to %s", action,
+				o.DateBegin.Format(time.DateOnly), o.DateEnd.Format(time.DateOnly)))
+	}
+	return nil
+}
+
+// checkDepartmentAbsent returns the warning if with the vacation more employees
+// of the department than allowed are on vacation at once.
+// others are the vacations of the other employees of the department.
+func (s *service) checkDepartmentAbsent(others []model.Vacation, v model.Vacation) []string {
+	if s.Config.MaxDepartmentAbsent == 0 {
+		return nil
+	}
+
+	vs := append(others[:len(others):len(others)], v)
+	if n := model.MaxAbsent(vs, v.DateBegin, v.DateEnd); n > int(s.Config.MaxDepartmentAbsent) {
+		return []string{fmt.Sprintf("%d employees of the department are on vacation at once, the limit is %d",
+			n, s.Config.MaxDepartmentAbsent)}
 	}
 	return nil
 }
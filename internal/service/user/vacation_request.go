@@ -0,0 +1,262 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+// GetVacationRequest returns the vacation request available to the actor:
+// the own request, the request of a subordinate or any request for HR.
+func (s *service) GetVacationRequest(ctx context.Context,
+	a model.Actor, requestID uint64) (*model.VacationRequest, error) {
+	const op = "user service: get vacation request"
+
+	r, err := s.userRepository.GetVacationRequest(ctx, requestID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "vacation request not found")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !r.CanView(a) {
+		return nil, serr.NewError(serr.NotFound, "vacation request not found")
+	}
+	return r, nil
+}
+
+// ListVacationRequests returns the own vacation requests of the actor.
+func (s *service) ListVacationRequests(ctx context.Context, a model.Actor) ([]model.VacationRequest, error) {
+	const op = "user service: list vacation requests"
+
+	rs, err := s.userRepository.ListUserVacationRequests(ctx, a.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return rs, nil
+}
+
+// ListIncomingVacationRequests returns the pending vacation requests to be decided by the actor.
+func (s *service) ListIncomingVacationRequests(ctx context.Context, a model.Actor) ([]model.VacationRequest, error) {
+	const op = "user service: list incoming vacation requests"
+
+	rs, err := s.userRepository.ListPendingVacationRequests(ctx, a.UserID, a.HR)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return rs, nil
+}
+
+// AddVacationRequest adds the draft vacation request of the actor.
+func (s *service) AddVacationRequest(ctx context.Context, a model.Actor, r model.VacationRequest) (uint64, error) {
+	const op = "user service: add vacation request"
+
+	if err := s.checkNotTerminated(ctx, a.UserID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	r.UserID = a.UserID
+	id, err := s.userRepository.AddVacationRequest(ctx, r)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrConflict) {
+			return 0, serr.NewError(serr.Conflict, "not added: user problem")
+		}
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, nil
+}
+
+// UpdateVacationRequest changes the own draft vacation request of the actor.
+func (s *service) UpdateVacationRequest(ctx context.Context, a model.Actor, r model.VacationRequest) error {
+	const op = "user service: update vacation request"
+
+	cur, err := s.GetVacationRequest(ctx, a, r.ID)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if cur.UserID != a.UserID {
+		return serr.NewError(serr.PermissionDenied, "not updated: the request of another user")
+	}
+	if cur.Status != model.RequestStatusDraft {
+		return serr.NewError(serr.Conflict,
+			fmt.Sprintf("not updated: the request is %s, only draft requests are changed", cur.Status))
+	}
+
+	r.UserID = a.UserID
+	err = s.userRepository.UpdateVacationRequest(ctx, r)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotAffected) {
+			return serr.NewError(serr.Conflict, "not updated: the request has been changed")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// ChangeVacationRequest does the action with the vacation request:
+// the employee submits the draft to the head (to HR if the department has no head)
+// or cancels it, the head approves it to HR or rejects it, HR approves or rejects it.
+// The request approved by HR becomes the vacation, it's checked as the added vacation:
+// exceeding the balance of the annual paid leave is allowed only if force is true,
+// exceeding the limit of absent employees of the department is returned as the warning.
+// The users deciding the request next and the employee are notified.
+func (s *service) ChangeVacationRequest(ctx context.Context,
+	a model.Actor, requestID uint64, action model.RequestAction, comment string, force bool) ([]string, error) {
+	const op = "user service: change vacation request"
+
+	r, err := s.GetVacationRequest(ctx, a, requestID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if !r.Can(a, action) {
+		return nil, serr.NewError(serr.Conflict,
+			fmt.Sprintf("not updated: the action %s is not allowed for the %s request", action, r.Status))
+	}
+
+	from, now := r.Status, time.Now()
+	var warnings []string
+	// the approvers of the pending request are notified of its cancelling
+	var approvers []model.Recipient
+	if from == model.RequestStatusPending {
+		if approvers, err = s.approvers(ctx, r); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+
+	switch action {
+	case model.RequestActionSubmit:
+		if err := s.checkRequestOverlap(ctx, r); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		head, err := s.userRepository.GetHead(ctx, r.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		r.Status, r.SubmittedAt = model.RequestStatusPending, &now
+		if head != nil {
+			r.HeadID = &head.UserID
+		}
+	case model.RequestActionCancel:
+		r.Status, r.DecidedAt = model.RequestStatusCancelled, &now
+	case model.RequestActionReject:
+		r.Status, r.DecidedBy, r.DecidedAt, r.DecisionComment = model.RequestStatusRejected, &a.UserID, &now, comment
+	case model.RequestActionApprove:
+		if r.AwaitsHead() {
+			r.HeadApprovedAt = &now
+			break
+		}
+		if err := s.checkNotTerminated(ctx, r.UserID); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if err := s.checkRequestOverlap(ctx, r); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		if warnings, err = s.checkVacationLimits(ctx, "not approved", r.UserID,
+			model.Vacation{DateBegin: r.DateBegin, DateEnd: r.DateEnd}, force); err != nil {
+			return nil, fmt.Errorf("%s: %w", op, err)
+		}
+		r.Status, r.DecidedBy, r.DecidedAt, r.DecisionComment = model.RequestStatusApproved, &a.UserID, &now, comment
+	}
+
+	err = s.userRepository.WithTx(ctx, func(ctx context.Context) error {
+		var (
+			vacationID uint64
+			err        error
+		)
+		if r.Status == model.RequestStatusApproved {
+			vacationID, err = s.userRepository.ApproveVacationRequest(ctx, *r)
+		} else {
+			err = s.userRepository.SetVacationRequestStatus(ctx, *r, from)
+		}
+		if err != nil {
+			if errors.Is(err, repoerr.ErrRecordNotAffected) {
+				return serr.NewError(serr.Conflict, "not updated: the request has been changed")
+			}
+			return err
+		}
+
+		if r.Status != model.RequestStatusApproved {
+			return nil
+		}
+		return s.addDraftOrder(ctx, model.Order{
+			Type:          model.OrderTypeVacation,
+			UserID:        r.UserID,
+			EffectiveDate: r.DateBegin,
+			VacationID:    &vacationID,
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	s.notifyVacationRequest(ctx, r, action, approvers)
+	return warnings, nil
+}
+
+// checkRequestOverlap returns an error if the requested vacation overlaps a vacation of the employee.
+func (s *service) checkRequestOverlap(ctx context.Context, r *model.VacationRequest) error {
+	vcs, err := s.userRepository.ListVacations(ctx, r.UserID)
+	if err != nil {
+		return err
+	}
+	return checkVacationOverlap("not updated", vcs, model.Vacation{DateBegin: r.DateBegin, DateEnd: r.DateEnd})
+}
+
+// approvers returns the users deciding the pending request next.
+func (s *service) approvers(ctx context.Context, r *model.VacationRequest) ([]model.Recipient, error) {
+	if r.AwaitsHead() {
+		head, err := s.userRepository.GetRecipient(ctx, *r.HeadID)
+		if err != nil {
+			return nil, err
+		}
+		return []model.Recipient{*head}, nil
+	}
+	return s.userRepository.ListHRRecipients(ctx)
+}
+
+// notifyVacationRequest notifies the users about the changed request: the approvers
+// of the pending request and the employee of the decisions. Sending errors are logged only,
+// the request is changed anyway.
+func (s *service) notifyVacationRequest(ctx context.Context,
+	r *model.VacationRequest, action model.RequestAction, prevApprovers []model.Recipient) {
+	employee := model.Recipient{UserID: r.UserID, FirstName: r.FirstName, LastName: r.LastName, Email: r.Email}
+	period := fmt.Sprintf("с %s по %s", r.DateBegin.Format("02.01.2006"), r.DateEnd.Format("02.01.2006"))
+
+	var next []model.Recipient
+	if r.Status == model.RequestStatusPending {
+		var err error
+		if next, err = s.approvers(ctx, r); err != nil {
+			s.logger.Warn("user service: notify vacation request", slog.String("error", err.Error()))
+		}
+	}
+
+	switch action {
+	case model.RequestActionSubmit:
+		s.notify(next, "Заявка на отпуск",
+			fmt.Sprintf("Заявка сотрудника %s %s на отпуск %s ожидает вашего решения.",
+				r.LastName, r.FirstName, period))
+	case model.RequestActionCancel:
+		s.notify(prevApprovers, "Заявка на отпуск отменена",
+			fmt.Sprintf("Заявка сотрудника %s %s на отпуск %s отменена.", r.LastName, r.FirstName, period))
+	case model.RequestActionApprove:
+		if r.Status == model.RequestStatusPending {
+			s.notify(next, "Заявка на отпуск",
+				fmt.Sprintf("Заявка сотрудника %s %s на отпуск %s согласована руководителем и ожидает вашего решения.",
+					r.LastName, r.FirstName, period))
+			s.notify([]model.Recipient{employee}, "Заявка на отпуск согласована руководителем",
+				fmt.Sprintf("Ваша заявка на отпуск %s согласована руководителем и передана в отдел кадров.", period))
+			return
+		}
+		s.notify([]model.Recipient{employee}, "Заявка на отпуск одобрена",
+			fmt.Sprintf("Ваша заявка на отпуск %s одобрена.", period))
+	case model.RequestActionReject:
+		s.notify([]model.Recipient{employee}, "Заявка на отпуск отклонена",
+			fmt.Sprintf("Ваша заявка на отпуск %s отклонена. %s", period, r.DecisionComment))
+	}
+}
@@ -0,0 +1,193 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+// GetVacationSchedule returns the vacation schedule of the year
+// with the planned vacations of the department (of all departments if departmentID is nil).
+func (s *service) GetVacationSchedule(ctx context.Context,
+	year int, departmentID *uint64) (*model.VacationSchedule, error) {
+	const op = "user service: get vacation schedule"
+
+	vs, err := s.userRepository.GetVacationSchedule(ctx, year, departmentID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "vacation schedule not found")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return vs, nil
+}
+
+// AddVacationScheduleItem plans the vacation of the employee in the draft schedule of the year,
+// the schedule is created with the first vacation. The vacation overlapping another planned
+// vacation of the employee is rejected. Exceeding the limit of absent employees
+// of the department is returned as the warning.
+func (s *service) AddVacationScheduleItem(ctx context.Context,
+	year int, item model.ScheduleItem) (uint64, []string, error) {
+	const op = "user service: add vacation schedule item"
+
+	if err := s.checkNotTerminated(ctx, item.UserID); err != nil {
+		return 0, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	if err := checkScheduleYear(year, item); err != nil {
+		return 0, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	vs, err := s.draftVacationSchedule(ctx, year, "not added")
+	if err != nil {
+		return 0, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	departmentID, _, err := s.userRepository.GetPosition(ctx, item.UserID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return 0, nil, serr.NewError(serr.Conflict, "not added: the user does not exist")
+		}
+		return 0, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	item.DepartmentID = departmentID
+
+	warnings, err := s.checkScheduleItem("not added", vs, item)
+	if err != nil {
+		return 0, nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := s.userRepository.AddVacationScheduleItem(ctx, year, item)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrConflict) {
+			return 0, nil, serr.NewError(serr.Conflict, "not added: user problem")
+		}
+		return 0, nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return id, warnings, nil
+}
+
+// UpdateVacationScheduleItem changes the dates of the planned vacation
+// with the same checks as AddVacationScheduleItem.
+func (s *service) UpdateVacationScheduleItem(ctx context.Context,
+	year int, item model.ScheduleItem) ([]string, error) {
+	const op = "user service: update vacation schedule item"
+
+	if err := checkScheduleYear(year, item); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	vs, err := s.draftVacationSchedule(ctx, year, "not updated")
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	var found bool
+	for _, it := range vs.Items {
+		if it.ID == item.ID {
+			item.UserID, item.DepartmentID = it.UserID, it.DepartmentID
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, serr.NewError(serr.NotFound, "vacation schedule item not found")
+	}
+
+	if err := s.checkNotTerminated(ctx, item.UserID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	warnings, err := s.checkScheduleItem("not updated", vs, item)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	err = s.userRepository.UpdateVacationScheduleItem(ctx, year, item)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotAffected) {
+			return nil, serr.NewError(serr.NotFound, "vacation schedule item not found")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return warnings, nil
+}
+
+func (s *service) DeleteVacationScheduleItem(ctx context.Context, year int, itemID uint64) error {
+	const op = "user service: delete vacation schedule item"
+
+	if _, err := s.draftVacationSchedule(ctx, year, "not deleted"); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := s.userRepository.DeleteVacationScheduleItem(ctx, year, itemID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return serr.NewError(serr.NotFound, "vacation schedule item not found")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// SetVacationScheduleStatus approves, locks or returns to draft the vacation schedule of the year.
+func (s *service) SetVacationScheduleStatus(ctx context.Context, year int, status model.ScheduleStatus) error {
+	const op = "user service: set vacation schedule status"
+
+	vs, err := s.userRepository.GetVacationSchedule(ctx, year, nil)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return serr.NewError(serr.NotFound, "vacation schedule not found")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	if !vs.Status.CanChangeTo(status) {
+		return serr.NewError(serr.Conflict,
+			fmt.Sprintf("not updated: the %s vacation schedule can't be %s", vs.Status, status))
+	}
+
+	err = s.userRepository.SetVacationScheduleStatus(ctx, year, status)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotAffected) {
+			return serr.NewError(serr.NotFound, "vacation schedule not found")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// draftVacationSchedule returns the schedule of the year if it can be changed.
+// A schedule not created yet is returned as an empty draft.
+func (s *service) draftVacationSchedule(ctx context.Context,
+	year int, action string) (*model.VacationSchedule, error) {
+	vs, err := s.userRepository.GetVacationSchedule(ctx, year, nil)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return &model.VacationSchedule{Year: year, Status: model.ScheduleStatusDraft}, nil
+		}
+		return nil, err
+	}
+	if vs.Status != model.ScheduleStatusDraft {
+		return nil, serr.NewError(serr.Conflict,
+			fmt.Sprintf("%s: the vacation schedule of %d is %s", action, year, vs.Status))
+	}
+	return vs, nil
+}
+
+func (s *service) checkScheduleItem(action string,
+	vs *model.VacationSchedule, item model.ScheduleItem) ([]string, error) {
+	if err := checkVacationOverlap(action, vs.UserVacations(item.UserID), item.Vacation); err != nil {
+		return nil, err
+	}
+	return s.checkDepartmentAbsent(vs.DepartmentVacations(item.DepartmentID, item.UserID), item.Vacation), nil
+}
+
+func checkScheduleYear(year int, item model.ScheduleItem) error {
+	if item.DateBegin.Year() != year || item.DateEnd.Year() != year {
+		return serr.NewError(serr.InvalidArgument,
+			fmt.Sprintf("the vacation is not in the year %d", year))
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+)
+
+func TestService_checkScheduleItem(t *testing.T) {
+	july := func(begin, end int) model.Vacation {
+		return model.Vacation{
+			DateBegin: time.Date(2024, time.July, begin, 0, 0, 0, 0, time.UTC),
+			DateEnd:   time.Date(2024, time.July, end, 0, 0, 0, 0, time.UTC),
+		}
+	}
+	vs := &model.VacationSchedule{Year: 2024, Items: []model.ScheduleItem{
+		{Vacation: july(1, 14), UserID: 1, DepartmentID: 1},
+		{Vacation: july(10, 20), UserID: 2, DepartmentID: 1},
+		{Vacation: july(10, 20), UserID: 3, DepartmentID: 2},
+	}}
+
+	tests := []struct {
+		name         string
+		limit        uint
+		item         model.ScheduleItem
+		wantWarnings int
+		wantErr      bool
+	}{
+		{name: "no limit", item: model.ScheduleItem{Vacation: july(12, 25), UserID: 4, DepartmentID: 1}},
+		{name: "within the limit", limit: 2,
+			item: model.ScheduleItem{Vacation: july(15, 25), UserID: 4, DepartmentID: 1}},
+		{name: "over the limit", limit: 2, wantWarnings: 1,
+			item: model.ScheduleItem{Vacation: july(12, 25), UserID: 4, DepartmentID: 1}},
+		{name: "other department", limit: 1,
+			item: model.ScheduleItem{Vacation: july(21, 25), UserID: 4, DepartmentID: 2}},
+		{name: "own vacations overlap", limit: 1, wantErr: true,
+			item: model.ScheduleItem{Vacation: july(14, 25), UserID: 1, DepartmentID: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &service{Config: Config{MaxDepartmentAbsent: tt.limit}}
+			warnings, err := s.checkScheduleItem("not added", vs, tt.item)
+			if tt.wantErr {
+				var serviceErr *serr.Error
+				require.ErrorAs(t, err, &serviceErr)
+				assert.Equal(t, serr.Conflict, serviceErr.Status)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, warnings, tt.wantWarnings)
+		})
+	}
+}
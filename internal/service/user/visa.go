@@ -36,6 +36,14 @@ func (s *service) ListVisas(ctx context.Context, userID, passportID uint64) ([]m
 func (s *service) AddVisa(ctx context.Context, userID, passportID uint64, mv model.Visa) (uint64, error) {
 	const op = "user service: add visa"
 
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.checkVisaNumber(ctx, userID, passportID, mv); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
 	id, err := s.userRepository.AddVisa(ctx, userID, passportID, mv)
 	if err != nil {
 		if errors.Is(err, repoerr.ErrConflict) {
@@ -49,9 +57,19 @@ func (s *service) AddVisa(ctx context.Context, userID, passportID uint64, mv mod
 func (s *service) UpdateVisa(ctx context.Context, userID, passportID uint64, v model.Visa) error {
 	const op = "user service: update visa"
 
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	if err := s.checkVisaNumber(ctx, userID, passportID, v); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
 	err := s.userRepository.UpdateVisa(ctx, userID, passportID, v)
 	if err != nil {
 		switch {
+		case errors.Is(err, repoerr.ErrVersionMismatch):
+			return serr.NewError(serr.PreconditionFailed, "not updated: the visa is changed by another user")
 		case errors.Is(err, repoerr.ErrRecordNotAffected):
 			return serr.NewError(serr.Conflict, "not updated: user/passport/visa problem")
 		default:
@@ -60,3 +78,18 @@ func (s *service) UpdateVisa(ctx context.Context, userID, passportID uint64, v m
 	}
 	return nil
 }
+
+// checkVisaNumber checks the number shape by the type of the passport the visa is in.
+func (s *service) checkVisaNumber(ctx context.Context, userID, passportID uint64, v model.Visa) error {
+	p, err := s.userRepository.GetPassport(ctx, userID, passportID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return serr.NewError(serr.NotFound, "passport not found")
+		}
+		return err
+	}
+	if !v.ValidNumber(p.Type) {
+		return serr.NewError(serr.InvalidArgument, "number: invalid visa number for the passport type")
+	}
+	return nil
+}
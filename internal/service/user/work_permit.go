@@ -0,0 +1,168 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	serr "github.com/Employee-s-file-cabinet/backend/internal/service"
+	"github.com/Employee-s-file-cabinet/backend/internal/service/user/model"
+	"github.com/Employee-s-file-cabinet/backend/pkg/repoerr"
+)
+
+// checkForeignCitizens returns an error if work permits are disabled in the settings.
+func (s *service) checkForeignCitizens() error {
+	if !s.Config.ForeignCitizens {
+		return serr.NewError(serr.PermissionDenied, "work permits are disabled (foreign citizens setting)")
+	}
+	return nil
+}
+
+func (s *service) ListWorkPermits(ctx context.Context, userID uint64) ([]model.WorkPermit, error) {
+	const op = "user service: list work permits"
+
+	if err := s.checkForeignCitizens(); err != nil {
+		return nil, err
+	}
+
+	wps, err := s.userRepository.ListWorkPermits(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return wps, nil
+}
+
+func (s *service) GetWorkPermit(ctx context.Context, userID, workPermitID uint64) (*model.WorkPermit, error) {
+	const op = "user service: get work permit"
+
+	if err := s.checkForeignCitizens(); err != nil {
+		return nil, err
+	}
+
+	wp, err := s.userRepository.GetWorkPermit(ctx, userID, workPermitID)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return nil, serr.NewError(serr.NotFound, "work permit not found")
+		}
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	return wp, nil
+}
+
+func (s *service) AddWorkPermit(ctx context.Context, userID uint64, wp model.WorkPermit) (uint64, error) {
+	const op = "user service: add work permit"
+
+	if err := s.checkForeignCitizens(); err != nil {
+		return 0, err
+	}
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return 0, fmt.Errorf("%s: %w", op, err)
+	}
+
+	id, err := s.userRepository.AddWorkPermit(ctx, userID, wp)
+	if err != nil {
+		switch {
+		case errors.Is(err, repoerr.ErrRecordAlreadyExist):
+			return 0, serr.NewError(serr.AlreadyExists, "not added: work permit with the same number already exists")
+		case errors.Is(err, repoerr.ErrConflict):
+			return 0, serr.NewError(serr.Conflict, "not added: user problem")
+		default:
+			return 0, fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return id, nil
+}
+
+func (s *service) UpdateWorkPermit(ctx context.Context, userID uint64, wp model.WorkPermit) error {
+	const op = "user service: update work permit"
+
+	if err := s.checkForeignCitizens(); err != nil {
+		return err
+	}
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := s.userRepository.UpdateWorkPermit(ctx, userID, wp)
+	if err != nil {
+		switch {
+		case errors.Is(err, repoerr.ErrRecordAlreadyExist):
+			return serr.NewError(serr.AlreadyExists, "not updated: work permit with the same number already exists")
+		case errors.Is(err, repoerr.ErrVersionMismatch):
+			return serr.NewError(serr.PreconditionFailed, "not updated: the work permit is changed by another user")
+		case errors.Is(err, repoerr.ErrRecordNotAffected):
+			return serr.NewError(serr.Conflict, "not updated: user/work permit problem")
+		default:
+			return fmt.Errorf("%s: %w", op, err)
+		}
+	}
+	return nil
+}
+
+func (s *service) DeleteWorkPermit(ctx context.Context, userID, workPermitID uint64, version int64) error {
+	const op = "user service: delete work permit"
+
+	if err := s.checkForeignCitizens(); err != nil {
+		return err
+	}
+
+	if err := s.checkNotTerminated(ctx, userID); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+
+	err := s.userRepository.DeleteWorkPermit(ctx, userID, workPermitID, version)
+	if err != nil {
+		if errors.Is(err, repoerr.ErrVersionMismatch) {
+			return serr.NewError(serr.PreconditionFailed, "not deleted: the work permit is changed by another user")
+		}
+		if errors.Is(err, repoerr.ErrRecordNotFound) {
+			return serr.NewError(serr.NotFound, "work permit not found")
+		}
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// CheckWorkPermits returns the periods of the employment contracts
+// that are not covered by the work permits of the user.
+// Open-ended contracts are checked up to the current date.
+func (s *service) CheckWorkPermits(ctx context.Context, userID uint64) ([]model.Period, error) {
+	const op = "user service: check work permits"
+
+	if err := s.checkForeignCitizens(); err != nil {
+		return nil, err
+	}
+
+	if exist, err := s.userRepository.Exist(ctx, userID); err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	} else if !exist {
+		return nil, serr.NewError(serr.NotFound, "user not found")
+	}
+
+	cs, err := s.userRepository.ListContracts(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+	wps, err := s.userRepository.ListWorkPermits(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", op, err)
+	}
+
+	now := time.Now()
+	periods := make([]model.Period, len(cs))
+	for i, c := range cs {
+		dateTo := now
+		switch {
+		case c.DateEnd != nil:
+			dateTo = *c.DateEnd
+		case c.DateBegin.After(now):
+			dateTo = c.DateBegin
+		}
+		periods[i] = model.Period{DateFrom: c.DateBegin, DateTo: dateTo}
+	}
+
+	return model.UncoveredPeriods(periods, wps), nil
+}
@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "position_history"
+(
+    "id"            bigserial PRIMARY KEY,
+    "user_id"       bigint NOT NULL,
+    "department_id" bigint NOT NULL,
+    "position_id"   bigint NOT NULL,
+    "date_begin"    date   NOT NULL,
+    "date_end"      date,
+    "created_at"    timestamptz DEFAULT (now()),
+    "updated_at"    timestamptz
+);
+
+ALTER TABLE "position_history"
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id"),
+    ADD FOREIGN KEY ("department_id") REFERENCES "departments" ("id"),
+    ADD FOREIGN KEY ("position_id") REFERENCES "positions" ("id");
+
+CREATE INDEX IF NOT EXISTS position_history_user_id_idx ON position_history (user_id);
+CREATE INDEX IF NOT EXISTS position_history_position_id_idx ON position_history (position_id, date_begin);
+
+-- only one open (current) record per user
+CREATE UNIQUE INDEX IF NOT EXISTS position_history_current_idx ON position_history (user_id)
+    WHERE date_end IS NULL;
+
+CREATE OR REPLACE TRIGGER trigger_position_history_set_updated_at
+    BEFORE UPDATE
+    ON position_history
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+-- current positions of existing users become the first history records
+INSERT INTO position_history (user_id, department_id, position_id, date_begin)
+SELECT id, department_id, position_id, created_at::date
+FROM users;
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+DROP TABLE IF EXISTS position_history;
+
+COMMIT;
+-- +goose StatementEnd
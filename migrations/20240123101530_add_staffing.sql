@@ -0,0 +1,43 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "staff_units"
+(
+    "id"            bigserial PRIMARY KEY,
+    "department_id" bigint        NOT NULL,
+    "position_id"   bigint        NOT NULL,
+    "quantity"      integer       NOT NULL CHECK (quantity > 0),
+    "rate"          numeric(3, 2) NOT NULL CHECK (rate > 0 AND rate <= 1),
+    "grade"         varchar       NOT NULL,
+    "salary_min"    bigint        NOT NULL,
+    "salary_max"    bigint        NOT NULL CHECK (salary_max >= salary_min),
+    "date_begin"    date          NOT NULL,
+    "date_end"      date,
+    "created_at"    timestamptz DEFAULT (now()),
+    "updated_at"    timestamptz
+);
+
+ALTER TABLE "staff_units"
+    ADD FOREIGN KEY ("department_id") REFERENCES "departments" ("id"),
+    ADD FOREIGN KEY ("position_id") REFERENCES "positions" ("id");
+
+CREATE INDEX IF NOT EXISTS staff_units_department_position_idx ON staff_units (department_id, position_id);
+
+CREATE OR REPLACE TRIGGER trigger_staff_units_set_updated_at
+    BEFORE UPDATE
+    ON staff_units
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+DROP TABLE IF EXISTS staff_units;
+
+COMMIT;
+-- +goose StatementEnd
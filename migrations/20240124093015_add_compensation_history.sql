@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+ALTER TABLE "finances"
+    ADD COLUMN "currency"   varchar(3) NOT NULL DEFAULT 'RUB',
+    ADD COLUMN "date_begin" date;
+
+-- existing records are effective from the beginning of their contracts
+UPDATE finances
+SET date_begin = contracts.date_begin
+FROM contracts
+WHERE finances.contract_id = contracts.id;
+
+ALTER TABLE "finances"
+    ALTER COLUMN "date_begin" SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS finances_contract_id_idx ON finances (contract_id, date_begin);
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+DROP INDEX IF EXISTS finances_contract_id_idx;
+
+ALTER TABLE "finances"
+    DROP COLUMN IF EXISTS "currency",
+    DROP COLUMN IF EXISTS "date_begin";
+
+COMMIT;
+-- +goose StatementEnd
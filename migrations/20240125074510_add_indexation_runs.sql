@@ -0,0 +1,71 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+-- the raises are set in basis points (hundredths of a percent), so 4.5% is 450
+CREATE TABLE IF NOT EXISTS "indexation_runs"
+(
+    "id"            bigserial PRIMARY KEY,
+    "department_id" bigint,
+    "basis_points"  integer NOT NULL,
+    "date_begin"    date    NOT NULL,
+    "created_at"    timestamptz DEFAULT (now()),
+    "updated_at"    timestamptz
+);
+
+ALTER TABLE "indexation_runs"
+    ADD FOREIGN KEY ("department_id") REFERENCES "departments" ("id");
+
+CREATE OR REPLACE TRIGGER trigger_indexation_runs_set_updated_at
+    BEFORE UPDATE
+    ON indexation_runs
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+-- currency was a reference to nowhere, amounts are in rubles
+ALTER TABLE "indexations"
+    ALTER COLUMN "currency" TYPE varchar(3) USING 'RUB',
+    ADD COLUMN "salary_after" bigint,
+    ADD COLUMN "run_id"       bigint,
+    ADD COLUMN "finance_id"   bigint;
+
+UPDATE indexations
+SET salary_after = salary_before * (100 + percents) / 100;
+
+ALTER TABLE "indexations"
+    RENAME COLUMN "percents" TO "basis_points";
+UPDATE indexations SET basis_points = basis_points * 100;
+
+ALTER TABLE "indexations"
+    ALTER COLUMN "salary_after" SET NOT NULL,
+    ADD FOREIGN KEY ("run_id") REFERENCES "indexation_runs" ("id"),
+    ADD FOREIGN KEY ("finance_id") REFERENCES "finances" ("id");
+
+CREATE INDEX IF NOT EXISTS indexations_user_id_idx ON indexations (user_id);
+CREATE INDEX IF NOT EXISTS indexations_run_id_idx ON indexations (run_id);
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+DROP INDEX IF EXISTS indexations_user_id_idx;
+DROP INDEX IF EXISTS indexations_run_id_idx;
+
+-- the fractions of a percent are rounded
+ALTER TABLE "indexations"
+    RENAME COLUMN "basis_points" TO "percents";
+UPDATE indexations SET percents = round(percents / 100.0);
+
+ALTER TABLE "indexations"
+    DROP COLUMN IF EXISTS "salary_after",
+    DROP COLUMN IF EXISTS "run_id",
+    DROP COLUMN IF EXISTS "finance_id",
+    ALTER COLUMN "currency" TYPE bigint USING 1;
+
+DROP TABLE IF EXISTS indexation_runs;
+
+COMMIT;
+-- +goose StatementEnd
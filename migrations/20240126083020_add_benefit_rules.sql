@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+-- the unique constraint allowed every benefit to be used only once company-wide,
+-- the link table relied on it and duplicated benefit_uses.benefit_id
+DROP TABLE IF EXISTS benefits_benefit_uses;
+
+ALTER TABLE "benefit_uses"
+    DROP CONSTRAINT IF EXISTS benefit_uses_benefit_id_key,
+    ADD FOREIGN KEY ("benefit_id") REFERENCES "benefits" ("id");
+
+CREATE INDEX IF NOT EXISTS benefit_uses_user_id_idx ON benefit_uses (user_id);
+CREATE INDEX IF NOT EXISTS benefit_uses_benefit_id_idx ON benefit_uses (benefit_id);
+
+-- eligibility rules, an empty rule means no restriction
+ALTER TABLE "benefits"
+    ADD COLUMN "grade"             varchar,
+    ADD COLUMN "department_id"     bigint,
+    ADD COLUMN "min_tenure_months" integer NOT NULL DEFAULT 0;
+
+ALTER TABLE "benefits"
+    ADD FOREIGN KEY ("department_id") REFERENCES "departments" ("id");
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+ALTER TABLE "benefits"
+    DROP COLUMN IF EXISTS "grade",
+    DROP COLUMN IF EXISTS "department_id",
+    DROP COLUMN IF EXISTS "min_tenure_months";
+
+DROP INDEX IF EXISTS benefit_uses_user_id_idx;
+DROP INDEX IF EXISTS benefit_uses_benefit_id_idx;
+
+ALTER TABLE "benefit_uses"
+    DROP CONSTRAINT IF EXISTS benefit_uses_benefit_id_fkey,
+    ADD UNIQUE (benefit_id);
+
+CREATE TABLE "benefits_benefit_uses"
+(
+    "benefits_id"             bigint,
+    "benefit_uses_benefit_id" bigint,
+    PRIMARY KEY ("benefits_id", "benefit_uses_benefit_id")
+);
+
+ALTER TABLE "benefits_benefit_uses"
+    ADD FOREIGN KEY ("benefits_id") REFERENCES "benefits" ("id");
+
+ALTER TABLE "benefits_benefit_uses"
+    ADD FOREIGN KEY ("benefit_uses_benefit_id") REFERENCES "benefit_uses" ("benefit_id");
+
+COMMIT;
+-- +goose StatementEnd
@@ -0,0 +1,40 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+-- an employee has at most one military registration record,
+-- no record means the employee is not liable for military service
+DELETE FROM militaries
+WHERE id NOT IN (SELECT MAX(id) FROM militaries GROUP BY user_id);
+
+ALTER TABLE "militaries"
+    ADD UNIQUE (user_id);
+
+-- changes to be reported to the military commissariat
+CREATE TABLE IF NOT EXISTS "military_events"
+(
+    "id"         bigserial PRIMARY KEY,
+    "user_id"    bigint  NOT NULL,
+    "event"      varchar NOT NULL,
+    "created_at" timestamptz DEFAULT (now())
+);
+
+ALTER TABLE "military_events"
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
+
+CREATE INDEX IF NOT EXISTS military_events_created_at_idx ON military_events (created_at);
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+DROP TABLE IF EXISTS military_events;
+
+ALTER TABLE "militaries"
+    DROP CONSTRAINT IF EXISTS militaries_user_id_key;
+
+COMMIT;
+-- +goose StatementEnd
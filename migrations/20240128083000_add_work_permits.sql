@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+-- the history of work permits of foreign employees,
+-- the current permit is the one with the latest valid_from
+CREATE TABLE IF NOT EXISTS "work_permits"
+(
+    "id"         bigserial PRIMARY KEY,
+    "user_id"    bigint  NOT NULL,
+    "number"     varchar NOT NULL,
+    "valid_from" date    NOT NULL,
+    "valid_to"   date    NOT NULL,
+    "created_at" timestamptz DEFAULT (now()),
+    "updated_at" timestamptz,
+    UNIQUE (user_id, number),
+    CHECK (valid_to >= valid_from)
+);
+
+ALTER TABLE "work_permits"
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
+
+CREATE OR REPLACE TRIGGER trigger_work_permits_set_updated_at
+    BEFORE UPDATE
+    ON work_permits
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+DROP TABLE IF EXISTS work_permits;
+
+COMMIT;
+-- +goose StatementEnd
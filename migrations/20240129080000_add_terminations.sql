@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+-- terminated_at is the last working day of a former employee
+ALTER TABLE "users"
+    ADD COLUMN IF NOT EXISTS "terminated_at"      date,
+    ADD COLUMN IF NOT EXISTS "termination_reason" varchar;
+
+CREATE INDEX IF NOT EXISTS users_terminated_at_idx ON users (terminated_at);
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+DROP INDEX IF EXISTS users_terminated_at_idx;
+
+ALTER TABLE "users"
+    DROP COLUMN IF EXISTS "terminated_at",
+    DROP COLUMN IF EXISTS "termination_reason";
+
+COMMIT;
+-- +goose StatementEnd
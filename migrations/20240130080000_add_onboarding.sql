@@ -0,0 +1,70 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+-- checklist templates, a template without department or position applies to all of them
+CREATE TABLE IF NOT EXISTS "onboarding_templates"
+(
+    "id"            bigserial PRIMARY KEY,
+    "title"         varchar NOT NULL,
+    "department_id" bigint,
+    "position_id"   bigint,
+    "created_at"    timestamptz DEFAULT (now()),
+    "updated_at"    timestamptz
+);
+
+ALTER TABLE "onboarding_templates"
+    ADD FOREIGN KEY ("department_id") REFERENCES "departments" ("id"),
+    ADD FOREIGN KEY ("position_id") REFERENCES "positions" ("id");
+
+CREATE OR REPLACE TRIGGER trigger_onboarding_templates_set_updated_at
+    BEFORE UPDATE
+    ON onboarding_templates
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+-- an item with scan_type is completed when a scan of the type is uploaded,
+-- the others are completed manually
+CREATE TABLE IF NOT EXISTS "onboarding_template_items"
+(
+    "id"          bigserial PRIMARY KEY,
+    "template_id" bigint  NOT NULL,
+    "title"       varchar NOT NULL,
+    "scan_type"   scan_type,
+    "due_days"    integer NOT NULL DEFAULT 0 CHECK (due_days >= 0)
+);
+
+ALTER TABLE "onboarding_template_items"
+    ADD FOREIGN KEY ("template_id") REFERENCES "onboarding_templates" ("id") ON DELETE CASCADE;
+
+CREATE TABLE IF NOT EXISTS "onboarding_items"
+(
+    "id"               bigserial PRIMARY KEY,
+    "user_id"          bigint  NOT NULL,
+    "template_item_id" bigint,
+    "title"            varchar NOT NULL,
+    "scan_type"        scan_type,
+    "due_date"         date    NOT NULL,
+    "completed_at"     timestamptz
+);
+
+ALTER TABLE "onboarding_items"
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id"),
+    ADD FOREIGN KEY ("template_item_id") REFERENCES "onboarding_template_items" ("id") ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS onboarding_items_user_id_idx ON onboarding_items (user_id);
+CREATE INDEX IF NOT EXISTS onboarding_items_due_date_idx ON onboarding_items (due_date) WHERE completed_at IS NULL;
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+DROP TABLE IF EXISTS onboarding_items;
+DROP TABLE IF EXISTS onboarding_template_items;
+DROP TABLE IF EXISTS onboarding_templates;
+
+COMMIT;
+-- +goose StatementEnd
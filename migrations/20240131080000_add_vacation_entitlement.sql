@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+-- additional annual paid leave days (irregular working hours, harmful working conditions, etc.),
+-- the annual leave is the base days (28 by default) plus the days of the position and of the contract
+ALTER TABLE "positions"
+    ADD COLUMN IF NOT EXISTS "extra_vacation_days" integer NOT NULL DEFAULT 0 CHECK (extra_vacation_days >= 0);
+
+ALTER TABLE "contracts"
+    ADD COLUMN IF NOT EXISTS "extra_vacation_days" integer NOT NULL DEFAULT 0 CHECK (extra_vacation_days >= 0);
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+ALTER TABLE "contracts"
+    DROP COLUMN IF EXISTS "extra_vacation_days";
+
+ALTER TABLE "positions"
+    DROP COLUMN IF EXISTS "extra_vacation_days";
+
+COMMIT;
+-- +goose StatementEnd
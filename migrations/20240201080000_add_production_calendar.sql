@@ -0,0 +1,39 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+-- years loaded to the production calendar,
+-- other years are the five-day working week with public holidays
+CREATE TABLE IF NOT EXISTS "calendar_years"
+(
+    "year"       integer PRIMARY KEY,
+    "created_at" timestamptz DEFAULT (now()),
+    "updated_at" timestamptz
+);
+
+CREATE OR REPLACE TRIGGER trigger_calendar_years_set_updated_at
+    BEFORE UPDATE
+    ON calendar_years
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+-- days differing from the five-day working week
+CREATE TABLE IF NOT EXISTS "calendar_days"
+(
+    "date"  date PRIMARY KEY,
+    "type"  varchar NOT NULL CHECK (type IN ('holiday', 'day_off', 'working', 'shortened')),
+    "title" varchar NOT NULL DEFAULT ''
+);
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+DROP TABLE IF EXISTS calendar_days;
+DROP TABLE IF EXISTS calendar_years;
+
+COMMIT;
+-- +goose StatementEnd
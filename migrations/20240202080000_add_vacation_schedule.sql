@@ -0,0 +1,60 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+-- yearly vacation schedule: draft -> approved -> locked,
+-- an approved schedule can be returned to draft, a locked one can not be changed
+CREATE TABLE IF NOT EXISTS "vacation_schedules"
+(
+    "year"        integer PRIMARY KEY,
+    "status"      varchar NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'approved', 'locked')),
+    "approved_at" timestamptz,
+    "locked_at"   timestamptz,
+    "created_at"  timestamptz DEFAULT (now()),
+    "updated_at"  timestamptz
+);
+
+CREATE OR REPLACE TRIGGER trigger_vacation_schedules_set_updated_at
+    BEFORE UPDATE
+    ON vacation_schedules
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+CREATE TABLE IF NOT EXISTS "vacation_schedule_items"
+(
+    "id"         bigserial PRIMARY KEY,
+    "year"       integer NOT NULL,
+    "user_id"    bigint  NOT NULL,
+    "date_begin" date    NOT NULL,
+    "date_end"   date    NOT NULL CHECK (date_end >= date_begin),
+    "created_at" timestamptz DEFAULT (now()),
+    "updated_at" timestamptz
+);
+
+ALTER TABLE "vacation_schedule_items"
+    ADD FOREIGN KEY ("year") REFERENCES "vacation_schedules" ("year") ON DELETE CASCADE,
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
+
+CREATE INDEX IF NOT EXISTS vacation_schedule_items_year_idx ON vacation_schedule_items (year);
+
+CREATE OR REPLACE TRIGGER trigger_vacation_schedule_items_set_updated_at
+    BEFORE UPDATE
+    ON vacation_schedule_items
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+CREATE INDEX IF NOT EXISTS vacations_date_begin_date_end_idx ON vacations (date_begin, date_end);
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+DROP INDEX IF EXISTS vacations_date_begin_date_end_idx;
+DROP TABLE IF EXISTS vacation_schedule_items;
+DROP TABLE IF EXISTS vacation_schedules;
+
+COMMIT;
+-- +goose StatementEnd
@@ -0,0 +1,53 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+-- vacation requests of employees: draft -> pending -> approved/rejected,
+-- draft and pending requests can be cancelled by the employee;
+-- a pending request is decided by the head (if the department has one) and then by HR
+CREATE TABLE IF NOT EXISTS "vacation_requests"
+(
+    "id"               bigserial PRIMARY KEY,
+    "user_id"          bigint  NOT NULL,
+    "date_begin"       date    NOT NULL,
+    "date_end"         date    NOT NULL CHECK (date_end >= date_begin),
+    "comment"          varchar NOT NULL DEFAULT '',
+    "status"           varchar NOT NULL DEFAULT 'draft'
+        CHECK (status IN ('draft', 'pending', 'approved', 'rejected', 'cancelled')),
+    "head_id"          bigint,
+    "head_approved_at" timestamptz,
+    "submitted_at"     timestamptz,
+    "decided_by"       bigint,
+    "decided_at"       timestamptz,
+    "decision_comment" varchar NOT NULL DEFAULT '',
+    "vacation_id"      bigint,
+    "created_at"       timestamptz DEFAULT (now()),
+    "updated_at"       timestamptz
+);
+
+ALTER TABLE "vacation_requests"
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id"),
+    ADD FOREIGN KEY ("head_id") REFERENCES "users" ("id"),
+    ADD FOREIGN KEY ("decided_by") REFERENCES "users" ("id"),
+    ADD FOREIGN KEY ("vacation_id") REFERENCES "vacations" ("id") ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS vacation_requests_user_id_idx ON vacation_requests (user_id);
+CREATE INDEX IF NOT EXISTS vacation_requests_pending_idx ON vacation_requests (head_id) WHERE status = 'pending';
+
+CREATE OR REPLACE TRIGGER trigger_vacation_requests_set_updated_at
+    BEFORE UPDATE
+    ON vacation_requests
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+DROP TABLE IF EXISTS vacation_requests;
+
+COMMIT;
+-- +goose StatementEnd
@@ -0,0 +1,46 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+ALTER TYPE scan_type ADD VALUE IF NOT EXISTS 'Документ об отсутствии' BEFORE 'Другое';
+
+-- absences of employees other than vacations: sick leave, unpaid leave, business trip, study leave,
+-- the document (e.g. the sick-leave certificate) is stored by its number and a scan of the absence type
+CREATE TABLE IF NOT EXISTS "absences"
+(
+    "id"              bigserial PRIMARY KEY,
+    "user_id"         bigint  NOT NULL,
+    "type"            varchar NOT NULL CHECK (type IN ('sick', 'unpaid', 'business_trip', 'study')),
+    "date_begin"      date    NOT NULL,
+    "date_end"        date    NOT NULL CHECK (date_end >= date_begin),
+    "document_number" varchar NOT NULL DEFAULT '',
+    "comment"         varchar NOT NULL DEFAULT '',
+    "created_at"      timestamptz DEFAULT (now()),
+    "updated_at"      timestamptz
+);
+
+ALTER TABLE "absences"
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
+
+CREATE INDEX IF NOT EXISTS absences_user_id_idx ON absences (user_id);
+CREATE INDEX IF NOT EXISTS absences_date_begin_date_end_idx ON absences (date_begin, date_end);
+
+CREATE OR REPLACE TRIGGER trigger_absences_set_updated_at
+    BEFORE UPDATE
+    ON absences
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+-- the value of scan_type can't be dropped, the scans of absences are kept as other documents
+UPDATE scans SET type = 'Другое' WHERE type = 'Документ об отсутствии';
+DROP TABLE IF EXISTS absences;
+
+COMMIT;
+-- +goose StatementEnd
@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+-- manual corrections of the timesheet (form T-13): the code and the hours of the day
+-- replace the calculated ones, the note and the author are kept for the audit
+CREATE TABLE IF NOT EXISTS "timesheet_corrections"
+(
+    "id"           bigserial PRIMARY KEY,
+    "user_id"      bigint   NOT NULL,
+    "date"         date     NOT NULL,
+    "code"         varchar  NOT NULL CHECK (code IN ('Я', 'В', 'ОТ', 'Б', 'К', 'ДО', 'У', 'ПР', 'НН')),
+    "hours"        smallint NOT NULL DEFAULT 0 CHECK (hours BETWEEN 0 AND 24),
+    "note"         varchar  NOT NULL CHECK (note <> ''),
+    "corrected_by" bigint   NOT NULL,
+    "created_at"   timestamptz DEFAULT (now()),
+    "updated_at"   timestamptz,
+    UNIQUE (user_id, date)
+);
+
+ALTER TABLE "timesheet_corrections"
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id"),
+    ADD FOREIGN KEY ("corrected_by") REFERENCES "users" ("id");
+
+CREATE OR REPLACE TRIGGER trigger_timesheet_corrections_set_updated_at
+    BEFORE UPDATE
+    ON timesheet_corrections
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+DROP TABLE IF EXISTS timesheet_corrections;
+
+COMMIT;
+-- +goose StatementEnd
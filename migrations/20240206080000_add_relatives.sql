@@ -0,0 +1,42 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+-- family members and dependents of employees, the certificate of the relation
+-- is stored as a scan: the marriage certificate of the spouse, the birth certificate of the child
+CREATE TABLE IF NOT EXISTS "relatives"
+(
+    "id"            bigserial PRIMARY KEY,
+    "user_id"       bigint  NOT NULL,
+    "type"          varchar NOT NULL CHECK (type IN ('spouse', 'child', 'parent', 'other')),
+    "lastname"      varchar NOT NULL,
+    "firstname"     varchar NOT NULL,
+    "middlename"    varchar NOT NULL DEFAULT '',
+    "date_of_birth" date    NOT NULL,
+    "disabled"      boolean NOT NULL DEFAULT false,
+    "created_at"    timestamptz DEFAULT (now()),
+    "updated_at"    timestamptz
+);
+
+ALTER TABLE "relatives"
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
+
+CREATE INDEX IF NOT EXISTS relatives_user_id_idx ON relatives (user_id);
+
+CREATE OR REPLACE TRIGGER trigger_relatives_set_updated_at
+    BEFORE UPDATE
+    ON relatives
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+DROP TABLE IF EXISTS relatives;
+
+COMMIT;
+-- +goose StatementEnd
@@ -0,0 +1,106 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+-- vacancies of the positions of the departments
+CREATE TABLE IF NOT EXISTS "vacancies"
+(
+    "id"            bigserial PRIMARY KEY,
+    "title"         varchar NOT NULL,
+    "description"   varchar NOT NULL DEFAULT '',
+    "position_id"   bigint  NOT NULL,
+    "department_id" bigint  NOT NULL,
+    "status"        varchar NOT NULL DEFAULT 'open' CHECK (status IN ('open', 'closed')),
+    "created_at"    timestamptz DEFAULT (now()),
+    "updated_at"    timestamptz
+);
+
+ALTER TABLE "vacancies"
+    ADD FOREIGN KEY ("position_id") REFERENCES "positions" ("id"),
+    ADD FOREIGN KEY ("department_id") REFERENCES "departments" ("id");
+
+-- candidates for the vacancies with their personal contacts and the pipeline stage,
+-- user_id refers to the employee created from the hired candidate
+CREATE TABLE IF NOT EXISTS "candidates"
+(
+    "id"            bigserial PRIMARY KEY,
+    "vacancy_id"    bigint  NOT NULL,
+    "lastname"      varchar NOT NULL,
+    "firstname"     varchar NOT NULL,
+    "middlename"    varchar NOT NULL DEFAULT '',
+    "email"         varchar NOT NULL DEFAULT '',
+    "phone"         varchar NOT NULL DEFAULT '',
+    "date_of_birth" date,
+    "stage"         varchar NOT NULL DEFAULT 'new'
+        CHECK (stage IN ('new', 'screening', 'interview', 'offer', 'hired', 'rejected')),
+    "user_id"       bigint,
+    "created_at"    timestamptz DEFAULT (now()),
+    "updated_at"    timestamptz,
+    CHECK ((stage = 'hired') = (user_id IS NOT NULL))
+);
+
+ALTER TABLE "candidates"
+    ADD FOREIGN KEY ("vacancy_id") REFERENCES "vacancies" ("id"),
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id");
+
+CREATE INDEX IF NOT EXISTS candidates_vacancy_id_idx ON candidates (vacancy_id);
+
+-- notes on the candidates (interview feedback)
+CREATE TABLE IF NOT EXISTS "candidate_notes"
+(
+    "id"           bigserial PRIMARY KEY,
+    "candidate_id" bigint  NOT NULL,
+    "author_id"    bigint  NOT NULL,
+    "stage"        varchar NOT NULL,
+    "text"         varchar NOT NULL CHECK (text <> ''),
+    "created_at"   timestamptz DEFAULT (now())
+);
+
+ALTER TABLE "candidate_notes"
+    ADD FOREIGN KEY ("candidate_id") REFERENCES "candidates" ("id") ON DELETE CASCADE,
+    ADD FOREIGN KEY ("author_id") REFERENCES "users" ("id");
+
+CREATE INDEX IF NOT EXISTS candidate_notes_candidate_id_idx ON candidate_notes (candidate_id);
+
+-- CV files of the candidates, the content is kept in the file storage
+CREATE TABLE IF NOT EXISTS "candidate_cvs"
+(
+    "id"           bigserial PRIMARY KEY,
+    "candidate_id" bigint  NOT NULL,
+    "file_name"    varchar NOT NULL,
+    "content_type" varchar NOT NULL,
+    "size"         bigint  NOT NULL,
+    "uploaded_at"  timestamptz DEFAULT (now())
+);
+
+ALTER TABLE "candidate_cvs"
+    ADD FOREIGN KEY ("candidate_id") REFERENCES "candidates" ("id") ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS candidate_cvs_candidate_id_idx ON candidate_cvs (candidate_id);
+
+CREATE OR REPLACE TRIGGER trigger_vacancies_set_updated_at
+    BEFORE UPDATE
+    ON vacancies
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+CREATE OR REPLACE TRIGGER trigger_candidates_set_updated_at
+    BEFORE UPDATE
+    ON candidates
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+DROP TABLE IF EXISTS candidate_cvs;
+DROP TABLE IF EXISTS candidate_notes;
+DROP TABLE IF EXISTS candidates;
+DROP TABLE IF EXISTS vacancies;
+
+COMMIT;
+-- +goose StatementEnd
@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+-- outcomes of the probation periods of the contracts,
+-- the extended probation ends on extended_to
+CREATE TABLE IF NOT EXISTS "probation_results"
+(
+    "contract_id" bigint PRIMARY KEY,
+    "outcome"     varchar NOT NULL CHECK (outcome IN ('passed', 'extended', 'failed')),
+    "extended_to" date,
+    "comment"     varchar NOT NULL DEFAULT '',
+    "version"     bigint  NOT NULL DEFAULT 1,
+    "created_at"  timestamptz DEFAULT (now()),
+    "updated_at"  timestamptz,
+    CHECK ((outcome = 'extended') = (extended_to IS NOT NULL))
+);
+
+ALTER TABLE "probation_results"
+    ADD FOREIGN KEY ("contract_id") REFERENCES "contracts" ("id") ON DELETE CASCADE;
+
+CREATE OR REPLACE TRIGGER trigger_probation_results_set_updated_at
+    BEFORE UPDATE
+    ON probation_results
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+-- the day HR and the head were reminded of the probation end of the contract,
+-- deleted when the probation is extended; it's kept apart from the contract
+-- so the reminders don't change the contract
+CREATE TABLE IF NOT EXISTS "probation_reminders"
+(
+    "contract_id" bigint PRIMARY KEY,
+    "reminded_on" date NOT NULL
+);
+
+ALTER TABLE "probation_reminders"
+    ADD FOREIGN KEY ("contract_id") REFERENCES "contracts" ("id") ON DELETE CASCADE;
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+DROP TABLE IF EXISTS probation_reminders;
+
+DROP TABLE IF EXISTS probation_results;
+
+COMMIT;
+-- +goose StatementEnd
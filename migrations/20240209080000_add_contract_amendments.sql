@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+ALTER TYPE scan_type ADD VALUE IF NOT EXISTS 'Дополнительное соглашение' BEFORE 'Другое';
+
+-- amendments (supplementary agreements) to the contracts, each changes the set terms
+-- from effective_from and keeps the rest, the contract itself is not changed after the first one
+CREATE TABLE IF NOT EXISTS "contract_amendments"
+(
+    "id"             bigserial PRIMARY KEY,
+    "contract_id"    bigint  NOT NULL,
+    "number"         varchar NOT NULL,
+    "date"           date    NOT NULL,
+    "effective_from" date    NOT NULL,
+    "work_type_id"   bigint,
+    "position_id"    bigint,
+    "salary"         bigint CHECK (salary > 0),
+    "date_end"       date CHECK (date_end >= effective_from),
+    "description"    varchar NOT NULL DEFAULT '',
+    "created_at"     timestamptz DEFAULT (now()),
+    "updated_at"     timestamptz,
+    UNIQUE ("contract_id", "number")
+);
+
+ALTER TABLE "contract_amendments"
+    ADD FOREIGN KEY ("contract_id") REFERENCES "contracts" ("id") ON DELETE CASCADE,
+    ADD FOREIGN KEY ("work_type_id") REFERENCES "work_types" ("id"),
+    ADD FOREIGN KEY ("position_id") REFERENCES "positions" ("id");
+
+CREATE INDEX IF NOT EXISTS contract_amendments_contract_id_idx ON contract_amendments (contract_id, effective_from);
+
+CREATE OR REPLACE TRIGGER trigger_contract_amendments_set_updated_at
+    BEFORE UPDATE
+    ON contract_amendments
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+-- the value of scan_type can't be dropped, the scans of amendments are kept as other documents
+UPDATE scans SET type = 'Другое' WHERE type = 'Дополнительное соглашение';
+DROP TABLE IF EXISTS contract_amendments;
+
+COMMIT;
+-- +goose StatementEnd
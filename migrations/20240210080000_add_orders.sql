@@ -0,0 +1,71 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+ALTER TYPE scan_type ADD VALUE IF NOT EXISTS 'Приказ' BEFORE 'Другое';
+
+-- the order types, each type is numbered by its number sequence
+-- (the sequence is set with the number sequences)
+CREATE TABLE IF NOT EXISTS "order_types"
+(
+    "type" varchar PRIMARY KEY CHECK (type IN ('hiring', 'transfer', 'vacation', 'termination', 'other'))
+);
+
+-- the orders are created as drafts (automatically by the personnel events or by HR)
+-- and get the number when signed
+CREATE TABLE IF NOT EXISTS "orders"
+(
+    "id"             bigserial PRIMARY KEY,
+    "type"           varchar NOT NULL,
+    "status"         varchar NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'signed', 'cancelled')),
+    "title"          varchar NOT NULL,
+    "effective_date" date    NOT NULL,
+    "user_id"        bigint  NOT NULL,
+    "contract_id"    bigint,
+    "vacation_id"    bigint,
+    "sequence_id"    bigint,
+    "year"           integer,
+    "number"         varchar,
+    "date"           date,
+    "created_at"     timestamptz DEFAULT (now()),
+    "updated_at"     timestamptz,
+    CHECK ((status = 'signed') = (number IS NOT NULL)),
+    UNIQUE ("sequence_id", "year", "number")
+);
+
+ALTER TABLE "orders"
+    ADD FOREIGN KEY ("type") REFERENCES "order_types" ("type"),
+    ADD FOREIGN KEY ("user_id") REFERENCES "users" ("id"),
+    ADD FOREIGN KEY ("contract_id") REFERENCES "contracts" ("id") ON DELETE SET NULL,
+    ADD FOREIGN KEY ("vacation_id") REFERENCES "vacations" ("id") ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS orders_user_id_idx ON orders (user_id);
+CREATE INDEX IF NOT EXISTS orders_status_type_idx ON orders (status, type);
+
+CREATE OR REPLACE TRIGGER trigger_orders_set_updated_at
+    BEFORE UPDATE
+    ON orders
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+INSERT INTO order_types (type)
+VALUES ('hiring'),
+       ('transfer'),
+       ('termination'),
+       ('vacation'),
+       ('other');
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+-- the value of scan_type can't be dropped, the scans of orders are kept as other documents
+UPDATE scans SET type = 'Другое' WHERE type = 'Приказ';
+DROP TABLE IF EXISTS orders;
+DROP TABLE IF EXISTS order_types;
+
+COMMIT;
+-- +goose StatementEnd
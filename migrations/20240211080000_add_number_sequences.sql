@@ -0,0 +1,99 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+-- formats of the personnel numbers, the contract numbers and the order numbers,
+-- e.g. prefix 'ТД-', with year, padding 4: ТД-2024-0001, or suffix '-к': 12-к;
+-- several sequences of the order numbers are allowed, each numbers its order types
+CREATE TABLE IF NOT EXISTS "number_sequences"
+(
+    "id"           bigserial PRIMARY KEY,
+    "entity"       varchar NOT NULL CHECK (entity IN ('personnel_number', 'contract_number', 'order_number')),
+    "title"        varchar NOT NULL,
+    "prefix"       varchar NOT NULL DEFAULT '',
+    "suffix"       varchar NOT NULL DEFAULT '',
+    "with_year"    boolean NOT NULL DEFAULT false,
+    "padding"      integer NOT NULL DEFAULT 0 CHECK (padding BETWEEN 0 AND 12),
+    "yearly_reset" boolean NOT NULL DEFAULT false,
+    "created_at"   timestamptz DEFAULT (now()),
+    "updated_at"   timestamptz
+);
+
+-- the last numbers allocated by the sequences, the year is 0 if the numbers are not reset yearly;
+-- the row is locked by the allocating transaction until it commits, so the numbers have no gaps
+CREATE TABLE IF NOT EXISTS "number_counters"
+(
+    "sequence_id" bigint  NOT NULL,
+    "year"        integer NOT NULL,
+    "last_number" bigint  NOT NULL,
+    PRIMARY KEY ("sequence_id", "year")
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS number_sequences_entity_key
+    ON number_sequences (entity) WHERE entity <> 'order_number';
+
+ALTER TABLE "number_counters"
+    ADD FOREIGN KEY ("sequence_id") REFERENCES "number_sequences" ("id");
+
+CREATE OR REPLACE TRIGGER trigger_number_sequences_set_updated_at
+    BEFORE UPDATE
+    ON number_sequences
+    FOR EACH ROW
+EXECUTE FUNCTION update_updated_at();
+
+INSERT INTO number_sequences (id, entity, title, prefix, suffix, with_year, padding, yearly_reset)
+VALUES (1, 'personnel_number', 'Табельные номера', '', '', false, 6, false),
+       (2, 'contract_number', 'Номера трудовых договоров', 'ТД-', '', true, 4, true),
+       (3, 'order_number', 'Приказы по личному составу', '', '-к', false, 0, true),
+       (4, 'order_number', 'Приказы об отпусках', '', '-о', false, 0, true),
+       (5, 'order_number', 'Прочие приказы', '', '', false, 0, true);
+SELECT setval('number_sequences_id_seq', 5);
+
+-- the orders are numbered by the sequences of their types
+ALTER TABLE "order_types"
+    ADD COLUMN IF NOT EXISTS "sequence_id" bigint;
+UPDATE order_types
+SET sequence_id = CASE type WHEN 'vacation' THEN 4 WHEN 'other' THEN 5 ELSE 3 END;
+ALTER TABLE "order_types"
+    ALTER COLUMN "sequence_id" SET NOT NULL,
+    ADD FOREIGN KEY ("sequence_id") REFERENCES "number_sequences" ("id");
+ALTER TABLE "orders"
+    ADD FOREIGN KEY ("sequence_id") REFERENCES "number_sequences" ("id");
+
+-- the current employees get the personnel numbers in the order of adding
+ALTER TABLE "users"
+    ADD COLUMN IF NOT EXISTS "personnel_number" varchar;
+UPDATE users SET personnel_number = lpad(id::text, 6, '0');
+INSERT INTO number_counters (sequence_id, year, last_number)
+SELECT 1, 0, max(id) FROM users HAVING max(id) IS NOT NULL;
+ALTER TABLE "users"
+    ALTER COLUMN "personnel_number" SET NOT NULL,
+    ADD CONSTRAINT users_personnel_number_key UNIQUE (personnel_number);
+
+-- the duplicated contract numbers typed by hand get the contract ID
+UPDATE contracts
+SET number = number || '/' || id
+WHERE EXISTS (SELECT 1 FROM contracts c WHERE c.number = contracts.number AND c.id < contracts.id);
+ALTER TABLE "contracts"
+    ADD CONSTRAINT contracts_number_key UNIQUE (number);
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+ALTER TABLE "contracts"
+    DROP CONSTRAINT IF EXISTS contracts_number_key;
+ALTER TABLE "users"
+    DROP COLUMN IF EXISTS "personnel_number";
+ALTER TABLE "orders"
+    DROP CONSTRAINT IF EXISTS orders_sequence_id_fkey;
+ALTER TABLE "order_types"
+    DROP COLUMN IF EXISTS "sequence_id";
+DROP TABLE IF EXISTS number_counters;
+DROP TABLE IF EXISTS number_sequences;
+
+COMMIT;
+-- +goose StatementEnd
@@ -0,0 +1,24 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+-- the identifiers and the dates of birth are looked up to find the duplicates of the users
+CREATE INDEX IF NOT EXISTS users_taxpayer_number_idx ON users (taxpayer_number);
+CREATE INDEX IF NOT EXISTS users_insurance_number_idx ON users (insurance_number);
+CREATE INDEX IF NOT EXISTS users_date_of_birth_idx ON users (date_of_birth);
+CREATE INDEX IF NOT EXISTS passports_type_number_idx ON passports (type, number);
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+DROP INDEX IF EXISTS passports_type_number_idx;
+DROP INDEX IF EXISTS users_date_of_birth_idx;
+DROP INDEX IF EXISTS users_insurance_number_idx;
+DROP INDEX IF EXISTS users_taxpayer_number_idx;
+
+COMMIT;
+-- +goose StatementEnd
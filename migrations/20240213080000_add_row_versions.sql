@@ -0,0 +1,109 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+-- the versions of the rows are the ETags of the resources, the update is done
+-- only if the version is the same as the client has read
+CREATE OR REPLACE FUNCTION increment_version()
+    RETURNS TRIGGER AS
+$increment_version$
+BEGIN
+    NEW.version = OLD.version + 1;
+    RETURN NEW;
+END;
+$increment_version$ LANGUAGE plpgsql;
+
+ALTER TABLE "users" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "passports" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "visas" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "contracts" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "contract_amendments" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "educations" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "trainings" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "experiences" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "relatives" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "vacations" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "absences" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "militaries" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "work_permits" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "finances" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+ALTER TABLE "benefit_uses" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+
+CREATE OR REPLACE TRIGGER trigger_users_increment_version
+    BEFORE UPDATE ON users FOR EACH ROW EXECUTE FUNCTION increment_version();
+CREATE OR REPLACE TRIGGER trigger_passports_increment_version
+    BEFORE UPDATE ON passports FOR EACH ROW EXECUTE FUNCTION increment_version();
+CREATE OR REPLACE TRIGGER trigger_visas_increment_version
+    BEFORE UPDATE ON visas FOR EACH ROW EXECUTE FUNCTION increment_version();
+CREATE OR REPLACE TRIGGER trigger_contracts_increment_version
+    BEFORE UPDATE ON contracts FOR EACH ROW EXECUTE FUNCTION increment_version();
+CREATE OR REPLACE TRIGGER trigger_contract_amendments_increment_version
+    BEFORE UPDATE ON contract_amendments FOR EACH ROW EXECUTE FUNCTION increment_version();
+CREATE OR REPLACE TRIGGER trigger_educations_increment_version
+    BEFORE UPDATE ON educations FOR EACH ROW EXECUTE FUNCTION increment_version();
+CREATE OR REPLACE TRIGGER trigger_trainings_increment_version
+    BEFORE UPDATE ON trainings FOR EACH ROW EXECUTE FUNCTION increment_version();
+CREATE OR REPLACE TRIGGER trigger_experiences_increment_version
+    BEFORE UPDATE ON experiences FOR EACH ROW EXECUTE FUNCTION increment_version();
+CREATE OR REPLACE TRIGGER trigger_relatives_increment_version
+    BEFORE UPDATE ON relatives FOR EACH ROW EXECUTE FUNCTION increment_version();
+CREATE OR REPLACE TRIGGER trigger_vacations_increment_version
+    BEFORE UPDATE ON vacations FOR EACH ROW EXECUTE FUNCTION increment_version();
+CREATE OR REPLACE TRIGGER trigger_absences_increment_version
+    BEFORE UPDATE ON absences FOR EACH ROW EXECUTE FUNCTION increment_version();
+CREATE OR REPLACE TRIGGER trigger_militaries_increment_version
+    BEFORE UPDATE ON militaries FOR EACH ROW EXECUTE FUNCTION increment_version();
+CREATE OR REPLACE TRIGGER trigger_work_permits_increment_version
+    BEFORE UPDATE ON work_permits FOR EACH ROW EXECUTE FUNCTION increment_version();
+CREATE OR REPLACE TRIGGER trigger_finances_increment_version
+    BEFORE UPDATE ON finances FOR EACH ROW EXECUTE FUNCTION increment_version();
+CREATE OR REPLACE TRIGGER trigger_benefit_uses_increment_version
+    BEFORE UPDATE ON benefit_uses FOR EACH ROW EXECUTE FUNCTION increment_version();
+-- probation_results is created with the version
+CREATE OR REPLACE TRIGGER trigger_probation_results_increment_version
+    BEFORE UPDATE ON probation_results FOR EACH ROW EXECUTE FUNCTION increment_version();
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+DROP TRIGGER IF EXISTS trigger_users_increment_version ON users;
+DROP TRIGGER IF EXISTS trigger_passports_increment_version ON passports;
+DROP TRIGGER IF EXISTS trigger_visas_increment_version ON visas;
+DROP TRIGGER IF EXISTS trigger_contracts_increment_version ON contracts;
+DROP TRIGGER IF EXISTS trigger_contract_amendments_increment_version ON contract_amendments;
+DROP TRIGGER IF EXISTS trigger_educations_increment_version ON educations;
+DROP TRIGGER IF EXISTS trigger_trainings_increment_version ON trainings;
+DROP TRIGGER IF EXISTS trigger_experiences_increment_version ON experiences;
+DROP TRIGGER IF EXISTS trigger_relatives_increment_version ON relatives;
+DROP TRIGGER IF EXISTS trigger_vacations_increment_version ON vacations;
+DROP TRIGGER IF EXISTS trigger_absences_increment_version ON absences;
+DROP TRIGGER IF EXISTS trigger_militaries_increment_version ON militaries;
+DROP TRIGGER IF EXISTS trigger_work_permits_increment_version ON work_permits;
+DROP TRIGGER IF EXISTS trigger_finances_increment_version ON finances;
+DROP TRIGGER IF EXISTS trigger_benefit_uses_increment_version ON benefit_uses;
+DROP TRIGGER IF EXISTS trigger_probation_results_increment_version ON probation_results;
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "passports" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "visas" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "contracts" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "contract_amendments" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "educations" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "trainings" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "experiences" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "relatives" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "vacations" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "absences" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "militaries" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "work_permits" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "finances" DROP COLUMN IF EXISTS "version";
+ALTER TABLE "benefit_uses" DROP COLUMN IF EXISTS "version";
+
+DROP FUNCTION IF EXISTS increment_version();
+
+COMMIT;
+-- +goose StatementEnd
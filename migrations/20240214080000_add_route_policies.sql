@@ -0,0 +1,116 @@
+-- +goose Up
+-- +goose StatementBegin
+BEGIN;
+
+-- sub is the role id: the policies are added for the roles which already exist
+INSERT INTO policies (ptype, v0, v1, v2)
+SELECT 'p', roles.id::varchar, p.obj, p.act
+FROM (VALUES ('employee', '/vacation-requests', '*'),
+             ('employee', '/vacation-requests/*', '*'),
+             ('hr', '/positions/*', 'GET'),
+             ('hr', '/departments', 'GET'),
+             ('hr', '/staffing', '*'),
+             ('hr', '/staffing/*', '*'),
+             ('hr', '/indexations', '*'),
+             ('hr', '/indexations/*', '*'),
+             ('hr', '/benefits', '*'),
+             ('hr', '/benefits/*', '*'),
+             ('hr', '/military', '*'),
+             ('hr', '/military/*', '*'),
+             ('hr', '/onboarding', '*'),
+             ('hr', '/onboarding/*', '*'),
+             ('hr', '/vacations', '*'),
+             ('hr', '/vacations/*', '*'),
+             ('hr', '/absences', '*'),
+             ('hr', '/absences/*', '*'),
+             ('hr', '/timesheets', '*'),
+             ('hr', '/timesheets/*', '*'),
+             ('hr', '/calendar', '*'),
+             ('hr', '/calendar/*', '*'),
+             ('hr', '/vacation-requests', '*'),
+             ('hr', '/vacation-requests/*', '*'),
+             ('hr', 'compensations', 'read'),
+             ('hr', 'compensations', 'write'),
+             ('hr', 'vacation_requests', 'decide'),
+             ('hr', '/probations', 'GET'),
+             ('hr', '/orders', '*'),
+             ('hr', '/orders/*', '*'),
+             ('hr', '/number-sequences', '*'),
+             ('hr', '/number-sequences/*', '*'),
+             ('hr', '/vacancies', '*'),
+             ('hr', '/vacancies/*', '*'),
+             ('hr', '/candidates', '*'),
+             ('hr', '/candidates/*', '*'),
+             ('recruiter', '/vacancies', '*'),
+             ('recruiter', '/vacancies/*', '*'),
+             ('recruiter', '/candidates', '*'),
+             ('recruiter', '/candidates/*', '*'),
+             ('recruiter', '/departments', 'GET'),
+             ('admin', '/duplicates', 'GET')) AS p (role, obj, act)
+         JOIN roles ON roles.title = p.role
+WHERE NOT EXISTS (SELECT 1
+                  FROM policies
+                  WHERE policies.ptype = 'p'
+                    AND policies.v0 = roles.id::varchar
+                    AND policies.v1 = p.obj
+                    AND policies.v2 = p.act);
+
+COMMIT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+BEGIN;
+
+DELETE
+FROM policies
+    USING roles, (VALUES ('employee', '/vacation-requests', '*'),
+             ('employee', '/vacation-requests/*', '*'),
+             ('hr', '/positions/*', 'GET'),
+             ('hr', '/departments', 'GET'),
+             ('hr', '/staffing', '*'),
+             ('hr', '/staffing/*', '*'),
+             ('hr', '/indexations', '*'),
+             ('hr', '/indexations/*', '*'),
+             ('hr', '/benefits', '*'),
+             ('hr', '/benefits/*', '*'),
+             ('hr', '/military', '*'),
+             ('hr', '/military/*', '*'),
+             ('hr', '/onboarding', '*'),
+             ('hr', '/onboarding/*', '*'),
+             ('hr', '/vacations', '*'),
+             ('hr', '/vacations/*', '*'),
+             ('hr', '/absences', '*'),
+             ('hr', '/absences/*', '*'),
+             ('hr', '/timesheets', '*'),
+             ('hr', '/timesheets/*', '*'),
+             ('hr', '/calendar', '*'),
+             ('hr', '/calendar/*', '*'),
+             ('hr', '/vacation-requests', '*'),
+             ('hr', '/vacation-requests/*', '*'),
+             ('hr', 'compensations', 'read'),
+             ('hr', 'compensations', 'write'),
+             ('hr', 'vacation_requests', 'decide'),
+             ('hr', '/probations', 'GET'),
+             ('hr', '/orders', '*'),
+             ('hr', '/orders/*', '*'),
+             ('hr', '/number-sequences', '*'),
+             ('hr', '/number-sequences/*', '*'),
+             ('hr', '/vacancies', '*'),
+             ('hr', '/vacancies/*', '*'),
+             ('hr', '/candidates', '*'),
+             ('hr', '/candidates/*', '*'),
+             ('recruiter', '/vacancies', '*'),
+             ('recruiter', '/vacancies/*', '*'),
+             ('recruiter', '/candidates', '*'),
+             ('recruiter', '/candidates/*', '*'),
+             ('recruiter', '/departments', 'GET'),
+             ('admin', '/duplicates', 'GET')) AS p (role, obj, act)
+WHERE roles.title = p.role
+  AND policies.ptype = 'p'
+  AND policies.v0 = roles.id::varchar
+  AND policies.v1 = p.obj
+  AND policies.v2 = p.act;
+
+COMMIT;
+-- +goose StatementEnd
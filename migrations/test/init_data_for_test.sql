@@ -11,16 +11,41 @@ TRUNCATE TABLE departments RESTART IDENTITY CASCADE;
 TRUNCATE TABLE policies RESTART IDENTITY CASCADE;
 TRUNCATE TABLE passports RESTART IDENTITY CASCADE;
 TRUNCATE TABLE visas RESTART IDENTITY CASCADE;
+TRUNCATE TABLE work_permits RESTART IDENTITY CASCADE;
 TRUNCATE TABLE experiences RESTART IDENTITY CASCADE;
 TRUNCATE TABLE work_types RESTART IDENTITY CASCADE;
 TRUNCATE TABLE finances RESTART IDENTITY CASCADE;
 TRUNCATE TABLE militaries RESTART IDENTITY CASCADE;
+TRUNCATE TABLE military_events RESTART IDENTITY CASCADE;
 TRUNCATE TABLE indexations RESTART IDENTITY CASCADE;
+TRUNCATE TABLE indexation_runs RESTART IDENTITY CASCADE;
 TRUNCATE TABLE trainings RESTART IDENTITY CASCADE;
 TRUNCATE TABLE vacations RESTART IDENTITY CASCADE;
 TRUNCATE TABLE benefit_uses RESTART IDENTITY CASCADE;
 TRUNCATE TABLE benefits RESTART IDENTITY CASCADE;
 TRUNCATE TABLE contracts RESTART IDENTITY CASCADE;
+TRUNCATE TABLE position_history RESTART IDENTITY CASCADE;
+TRUNCATE TABLE staff_units RESTART IDENTITY CASCADE;
+TRUNCATE TABLE onboarding_items RESTART IDENTITY CASCADE;
+TRUNCATE TABLE onboarding_template_items RESTART IDENTITY CASCADE;
+TRUNCATE TABLE onboarding_templates RESTART IDENTITY CASCADE;
+TRUNCATE TABLE calendar_days CASCADE;
+TRUNCATE TABLE calendar_years CASCADE;
+TRUNCATE TABLE vacation_schedule_items RESTART IDENTITY CASCADE;
+TRUNCATE TABLE vacation_schedules CASCADE;
+TRUNCATE TABLE vacation_requests RESTART IDENTITY CASCADE;
+TRUNCATE TABLE absences RESTART IDENTITY CASCADE;
+TRUNCATE TABLE timesheet_corrections RESTART IDENTITY CASCADE;
+TRUNCATE TABLE relatives RESTART IDENTITY CASCADE;
+TRUNCATE TABLE probation_results RESTART IDENTITY CASCADE;
+TRUNCATE TABLE probation_reminders RESTART IDENTITY CASCADE;
+TRUNCATE TABLE contract_amendments RESTART IDENTITY CASCADE;
+TRUNCATE TABLE orders RESTART IDENTITY CASCADE;
+TRUNCATE TABLE number_counters CASCADE;
+TRUNCATE TABLE candidate_cvs RESTART IDENTITY CASCADE;
+TRUNCATE TABLE candidate_notes RESTART IDENTITY CASCADE;
+TRUNCATE TABLE candidates RESTART IDENTITY CASCADE;
+TRUNCATE TABLE vacancies RESTART IDENTITY CASCADE;
 
 INSERT INTO public.roles (title, description)
 VALUES ('admin', 'Администратор системы. Имеет доступ к созданию аккаунтов для HR и рекрутёров, их настройке.'),
@@ -42,81 +67,81 @@ VALUES  ('Директор', 'Самый гуманный и солнцелик
         ('Специалист', 'Сотрудник любого отдела');
 
 INSERT INTO public.organization_structure (head_department_id, head_position_id, subordinate_department_id)
-VALUES  (1, null, 2),
-        (1, null, 3),
-        (1, null, 4);
+VALUES  (1, 1, 2),
+        (1, 1, 3),
+        (1, 1, 4);
 
-INSERT INTO public.users (lastname, firstname, middlename, gender, date_of_birth, place_of_birth, position_id, department_id, grade, phone_numbers, work_email, registration_address, residential_address, nationality, insurance_number, taxpayer_number)
-VALUES  ('Корепанов', 'Роман', 'Даниилович', 'Мужской', '1988-12-14', 'г. Серпухов', 1, 1, '6', '{"mobile": "79215511436"}', 'korepanov@company.com', 'Россия, г. Хасавюрт, Заречная ул., д. 24 кв.199', 'Россия, г. Серпухов, Зеленая ул., д. 2 кв.90', 'русский', '34665359207', '298885601004'),
-        ('Яппарова', 'Галина', 'Гермоновна', 'Женский', '1993-08-09', 'г. Дербент', 2, 2, '5', '{"mobile": "79215511436"}', 'yapparova@company.com', 'Россия, г. Рязань, Ленина В.И.ул., д. 6 кв.145', 'Россия, г. Дербент, Комсомольская ул., д. 21 кв.50', 'русский', '52759362623', '154912198705'),
-        ('Кучеров', 'Герман', 'Антонович', 'Мужской', '1981-08-22', 'г. Красноярск', 2, 3, '5', '{"mobile": "79215511436"}', 'kucherov@company.com', 'Россия, г. Реутов, Ленина В.И.ул., д. 23 кв.22', 'Россия, г. Красноярск, Почтовая ул., д. 3 кв.201', 'русский', '64190091782', '581551039113'),
-        ('Дарюшина', 'Ольга', 'Герасимовна', 'Женский', '1983-05-13', 'г. Железногорск', 2, 4, '5', '{"mobile": "79215511436"}', 'daryushina@company.com', 'Россия, г. Казань, Чкалова ул., д. 7 кв.149', 'Россия, г. Железногорск, Речная ул., д. 22 кв.137', 'русский', '87293349859', '110483833670'),
-        ('Яловенко', 'Римма', 'Феодосьевна', 'Женский', '1979-04-17', 'г. Нижний Тагил', 3, 4, '4', '{"mobile": "79215511436"}', 'yalovenko@company.com', 'Россия, г. Ковров, Колхозный пер., д. 11 кв.57', 'Россия, г. Нижний Тагил, Новоселов ул., д. 17 кв.105', 'русский', '48499927384', '660070348269'),
-        ('Гроссман', 'Семен', 'Сергеевич', 'Мужской', '1997-02-23', 'г. Новомосковск', 4, 4, '3', '{"mobile": "79215511436"}', 'grossman@company.com', 'Россия, г. Одинцово, Коммунистическая ул., д. 7 кв.102', 'Россия, г. Новомосковск, Якуба Коласа ул., д. 15 кв.101', 'русский', '26899661063', '472328433371'),
-        ('Цельнер', 'Татьяна', 'Аркадивна', 'Женский', '1987-03-09', 'г. Брянск', 4, 2, '3', '{"mobile": "79215511436"}', 'zelner@company.com', 'Россия, г. Абакан, Юбилейная ул., д. 15 кв.64', 'Россия, г. Брянск, Речная ул., д. 2 кв.3', 'русский', '30049154628', '273169571690'),
-        ('Мартюшева', 'Полина', 'Акимовна', 'Женский', '1988-07-21', 'г. Орехово-Зуево', 4, 2, '3', '{"mobile": "79215511436"}', 'martyusheva@company.com', 'Россия, г. Арзамас, Якуба Коласа ул., д. 3 кв.35', 'Россия, г. Орехово-Зуево, Новый пер., д. 12 кв.43', 'русский', '79294540861', '646764546435'),
-        ('Сиянович', 'Мила', 'Павловна', 'Женский', '1998-11-14', 'г. Первоуральск', 4, 3, '3', '{"mobile": "79215511436"}', 'siyanovich@company.com', 'Россия, г. Муром, Березовая ул., д. 5 кв.201', 'Россия, г. Первоуральск, Заводская ул., д. 5 кв.56', 'русский', '65167909125', '350651114375'),
-        ('Грибов', 'Захар', 'Вениаминович', 'Мужской', '1956-09-09', 'г. Норильск', 4, 3, '3', '{"mobile": "79215511436"}', 'gribov@company.com', 'Россия, г. Салават, Молодежный пер., д. 12 кв.141', 'Россия, г. Норильск, Речная ул., д. 23 кв.82', 'русский', '27281788613', '993152634947'),
-        ('Трохин', 'Леонид', 'Юринович', 'Мужской', '1981-12-16', 'г. Владимир', 4, 2, '3', '{"mobile": "79944477518"}', 'trohin@company.com', 'Россия, г. Тверь, Строителей ул., д. 14 кв.2', 'Россия, г. Октябрьский, Строителей ул., д. 22 кв.179', 'русский', '78905432165', '546486428967'),
-        ('Головаха', 'Юлия', 'Валерьевна', 'Женский', '1977-10-17', ' г. Улан-Удэ', 4, 2, '3', '{"mobile": "79068801143"}', 'golovaha@company.com', 'Россия, г. Белгород, Заречная ул., д. 2 кв.73', 'Россия, г. Череповец, Ленинская ул., д. 13 кв.111', 'русский', '43216543217', '110408673323'),
-        ('Ермилова', 'Оксана', 'Константиновна', 'Женский', '1996-06-11', 'г.  Рыбинск', 4, 2, '3', '{"mobile": "79855621221"}', 'ermilova@company.com', 'Россия, г. Коломна, Октябрьская ул., д. 11 кв.160', 'Россия, г. Якутск, Березовая ул., д. 25 кв.99', 'русский', '27890543216', '112418777221'),
-        ('Милехина', 'Светлана', 'Артемова', 'Женский', '1970-07-06', 'г. Ижевск', 4, 3 , '3', '{"mobile": "79927083380"}', 'milehina@company.com', 'Россия, г. Каспийск, Первомайский пер., д. 15 кв.216', 'Россия, г. Псков, Колхозная ул., д. 19 кв.76', 'русский', '53978718262', '333640999544'),
-        ('Платущихина', 'Жанна', 'Петровна', 'Женский', '1971-08-26', 'г. Санкт-Петербург', 3, 3, '4', '{"mobile": "7996327453"}', 'platushchihina@company.com', 'Россия, г. Сургут, Дорожная ул., д. 14 кв.75', 'Россия, г. Братск, Приозерная ул., д. 9 кв.156', 'русский', '12372689744','543210987654321'),
-        ('Тесла', 'Елена', 'Антоновна', 'Женский', '1995-07-16', 'г. Новочеркасск', 4, 4, '3', '{"mobile": "79791034416"}', 'tesla@company.com', 'Россия, г. Владимир, Сельская ул., д. 22 кв.138', 'Россия, г. Норильск, Чкалова ул., д. 2 кв.102', 'русский', '65432178905', '543210987654321'),
-        ('Ярославцева',	'Ярослава',	'Тарасовна', 'Женский', '1967-02-09', ' г. Волгодонск', 4, 4, '3', '{"mobile": "79839613895"}', 'yaroslavec@company.com', 'Россия, г. Дербент, Луговая ул., д. 6 кв.39', 'Россия, г. Миасс, Почтовая ул., д. 15 кв.197', 'русский', '98714563278', '109876543210987'),
-        ('Гордеев',	'Вениамин',	'Федорович', 'Мужской', '1977-05-12', 'г. Красногорск', 4, 4, '3', '{"mobile": "79544053136"}', 'gordeev@company.com', 'Россия, г. Тула, Трудовая ул., д. 3 кв.83', 'Россия, г. Березники, Дорожная ул., д. 2 кв.70', 'русский', '27890543216', '876543210987654'),
-        ('Ажикелямова',	'Римма', 'Николаевна', 'Женский', '1963-07-24', 'г. Южно-Сахалинск', 4,4, '3', '{"mobile": "79292561624"}', 'ajikelyamova@company.com', 'Россия, г. Ачинск, Цветочная ул., д. 13 кв.107', 'Россия, г. Северодвинск, Первомайская ул., д. 4 кв.56', 'русский', '78905432165', '765432109876543'),
-        ('Ложкина',	'Кира',	'Венедиктовна', 'Женский', '1967-02-05', 'г. Ижевск', 4, 2, '3', '{"mobile": "79074117464"}', 'lozhkina@company.com', 'Россия, г. Ковров, Октябрьский пер., д. 9 кв.87', 'Россия, г. Владикавказ, Почтовая ул., д. 6 кв.36', 'русский', '43216543217', '654321098765432'),
-        ('Сабитова', 'Варвара',	'Юлиановна', 'Женский', '1985-09-19', 'г. Якутск', 4, 2, '3', '{"mobile": "79806549141"}', 'sabitova@company.com', 'Россия, г. Волгодонск, Приозерная ул., д. 11 кв.186', 'Россия, г. Пермь, Первомайский пер., д. 8 кв.40','русский', '28369435526', '543210987654321'),
-        ('Шукшин',	'Георгий',	'Ильич', 'Мужской', '1983-06-20', 'г. Армавир', 4, 3, '3', '{"mobile": "79691164323"}', 'shchukhin@company.com', 'Россия, г. Новокузнецк, 3 Марта ул., д. 25 кв.1', 'Россия, г. Новошахтинск, Молодежная ул., д. 5 кв.131', 'русский', '71546772611', '1480495294212'),
-        ('Менщико', 'Герман', 'Игнатьевич', 'Мужской', '1983-06-17', 'г.Саранск', 4, 4, '3', '{"mobile": "79962889768"}', 'meshchinko@company.com', 'Россия, г. Владивосток, Школьная ул., д. 1 кв.168', 'Россия, г. Димитровград, Садовый пер., д. 17 кв.165', 'русский', '34579007891', '7871752964758'),
-        ('Мухин', 'Тимофей', 'Панкратович', 'Мужской', '1983-05-07', 'г. Тюмень', 4, 2,'3', '{"mobile": "79108704379"}', 'muchin@company.com', 'Россия, г. Ногинск, Дружбы ул., д. 14 кв.216', 'Россия, г. Томск, Октябрьский пер., д. 19 кв.210', 'русский', '36023379858', '5170397536306'),
-        ('Щеголева', 'Валерия', 'Павловна', 'Женский', '1984-06-20', 'г. Новосибирск', 4, 4, '3', '{"mobile": "79614205510"}', 'shegoleva@company.com', 'Россия, г. Кемерово, Озерная ул., д. 12 кв.214', 'Россия, г. Новомосковск, Светлая ул., д. 12 кв.140', 'русский','84810380798', '9433854869035'),
-        ('Кучерова', 'Дарья', 'Валентиновна','Женский','1973-01-05', 'г. Екатеринбург', 4, 3,'3', '{"mobile": "79184159769"}', 'kucherova@company.com', 'Россия, г. Улан-Удэ, Трудовая ул., д. 12 кв.216', 'Россия, г. Ярославль, Дорожная ул., д. 7 кв.22', 'русский','62214262842', '8378404073107'),
-        ('Судленков', 'Афанасий', 'Даниилович', 'Мужской', '1983-05-08', 'г. Нижний Новгород', 4, 4, '3', '{"mobile": "79063102049"}', 'sudlenkov@company.com', 'Россия, г. Находка, Тихая ул., д. 6 кв.85', 'Россия, г. Сергиев Посад, Речная ул., д. 4 кв.38', 'русский', '21629807121', '5221364142313'),
-        ('Кратенко', 'Ася',	'Михаиловна', 'Женский', '1975-10-21', 'г. Казань', 4, 2, '3', '{"mobile": " 79722083038"}' ,'kratenko@company.com', 'Россия, г. Мытищи, Гагарина ул., д. 12 кв.174', 'Россия, г. Красногорск, Юбилейная ул., д. 22 кв.75', 'русский', '03623519871', '9737629623931'),
-        ('Церетели', 'Тимофей',	'Александрович', 'Мужской', '1986-08-24', 'г. Самара', 4, 3, '3','{"mobile": "79181232092"}', 'tsereteli@company.com', 'Россия, г. Дзержинск, Спортивная ул., д. 2 кв.63', 'Россия, г. Таганрог, Западная ул., д. 3 кв.108', 'русский', '38776852740', '5347369877528'),
-        ('Ерофеев',	'Адам',	'Константинович', 'Мужской', '1985-09-09', 'г. Омск', 4, 2, '3', '{"mobile": "79108704379"}', 'erofeev@company.com', 'Россия, г. Ижевск, Заводская ул., д. 16 кв.99', 'Россия, г. Раменское, Школьный пер., д. 16 кв.189', 'русский', '41836931546', '5526895429122'),
-        ('Шипулин',	'Афанасий',	'Макарович', 'Мужской', '1995-04-07', 'г. Ростов-на-Дону', 4, 4, '3', '{"mobile": "79119999599"}', 'shipulin@company.com', 'Россия, г. Кызыл, Пионерская ул., д. 9 кв.14', 'Россия, г. Одинцово, Сосновая ул., д. 25 кв.128', 'русский', '36483678276', '6574271573697'),
-        ('Чукчов', 'Тарас', 'Антонович', 'Мужской',  '1970-12-18', 'г. Уфа', 4, 4, '3', '{"mobile": "79838094276"}', 'chukchov@company.com', 'Россия, г. Хабаровск, Новоселов ул., д. 14 кв.179', 'Россия, г. Дербент, Березовая ул., д. 11 кв.18', 'русский', '67781725748', '2331609835263'),
-        ('Доронин',	'Вениамин', 'Ильич', 'Мужской', '1985-09-10', 'г. Пермь', 4, 3, '3', '{"mobile": "79734057572"}','doronin@company.com', 'Россия, г. Ростов-на-Дону, Солнечная ул., д. 9 кв.140', 'Россия, г. Иркутск, Березовая ул., д. 15 кв.146', 'русский', '72732841066', '8056901318928'),
-        ('Мальцев', 'Леонтий', 'Иванович', 'Мужской', '1988-03-24', 'г. Воронеж', 4, 3, '3', '{"mobile": "79498495019"}', 'maltsev@company.com', 'Россия, г. Сергиев Посад, Октябрьская ул., д. 25 кв.131', 'Россия, г. Новокузнецк, Садовый пер., д. 19 кв.16', 'русский', '22531683042', '3411664941598'),
-        ('Канкия', 'Настасья', 'Емельяновна', 'Женский', '1986-02-06', 'г. Краснодар', 4, 2, '3', '{"mobile": "79203235793"}', 'kankiya@company.com', 'Россия, г. Березники, Майская ул., д. 9 кв.6', 'Россия, г. Иваново, Заречная ул., д. 17 кв.6', 'русский', '96439603291', '3986750350379'),
-        ('Мусин', 'Георгий', 'Никанорович', 'Мужской', '1987-09-24', 'г. Саратов', 4, 3, '3', '{"mobile": "79313982935"}', 'musin@company.com', 'Россия, г. Пермь, Песчаная ул., д. 10 кв.141', 'Россия, г. Сызрань, Светлая ул., д. 3 кв.110', 'русский', '78020750868', '4107579111453'),
-        ('Халски', 'Севастьян', 'Арсеньевич', 'Мужской', '1989-08-20', 'г. Тюмень', 4, 3, '3', '{"mobile": "79091157195"}', 'halski@company.com', 'Россия, г. Иваново, Дзержинского ул., д. 25 кв.184', 'Россия, г. Курган, Полевая ул., д. 5 кв.146', 'русский', '03199069569', '9888488425679'),
-        ('Гершельмана',	'Зоя', 'Семеновна', 'Женский', '1980-06-27', 'г. Тольятти', 4, 2, '3', '{"mobile": "79201611579"}','gershelman@company.com', 'Россия, г. Шахты, Совхозная ул., д. 7 кв.88', 'Россия, г. Саранск, Лесной пер., д. 20 кв.60', 'русский', '87054957934', '3314247309585'),
-        ('Уицкая', 'Лариса', 'Нифонтовна', 'Женский', '1988-04-04', 'г. Ижевск', 4, 4, '3', '{"mobile": "79779914854"}', 'uitskaya@company.com', 'Россия, г. Мурманск, Заречная ул., д. 23 кв.37', 'Россия, г. Октябрьский, Интернациональная ул., д. 24 кв.15', 'русский', '33177106120', '0164525574120'),
-        ('Осминин',	'Давид', 'Макарович', 'Мужской', '1986-01-12', 'г. Барнаул', 4, 3, '3', '{"mobile": "79135503371"}', 'osminin@company.com', 'Россия, г. Южно-Сахалинск, 17 Сентября ул., д. 2 кв.152', 'Россия, г. Салават, Ленинская ул., д. 7 кв.130', 'русский', '20952813008', '0976966956815'),
-        ('Мурогов',	'Никита', 'Леонтьевич', 'Мужской', '1983-06-10', 'г. Ульяновск', 4, 2, '3', '{"mobile": "79437377216"}', 'murogov@company.com', 'Россия, г. Волгодонск, Октябрьская ул., д. 16 кв.43', 'Россия, г. Краснодар, Озерная ул., д. 14 кв.151', 'русский', '51300921828', '3372247699424'),
-        ('Куимова',	'Сюзанна', 'Макаровна', 'Женский', '1986-05-28', 'г. Киров', 4, 4, '3', '{"mobile": "79801933746"}', 'kuimova@company.com', 'Россия, г. Благовещенск, Полевая ул., д. 6 кв.197', 'Россия, г. Нижневартовск, Речная ул., д. 7 кв.56', 'русский', '44309505398', '8053998095525'),
-        ('Кривчиков', 'Павел', 'Константинович', 'Мужской', '1983-01-04', 'г. Нефтеюганск', 4, 3, '3', '{"mobile": "79096856887"}', 'krivchikov@company.com', 'Россия, г. Брянск, Новый пер., д. 10 кв.187', 'Россия, г. Петропавловск-Камчатский, Дорожная ул., д. 11 кв.204', 'русский', '61635024193', '7196674884026'),
-        ('Медникова', 'Ольга', 'Лукьяновна', 'Женский', '1992-07-14', 'г. Майкоп', 4, 2, '3', '{"mobile": "79276997364"}', 'mednikova@company.com', 'Россия, г. Рыбинск, Речной пер., д. 4 кв.102', 'Россия, г. Хабаровск, Солнечная ул., д. 22 кв.94', 'русский', '65530515369', '3576410983234'),
-        ('Приходько', 'Лидия', 'Афанасьевна', 'Женский', '1986-02-01', 'г. Новый Уренгой', 4, 3, '3', '{"mobile": "79257346427"}', 'prikhodko@company.com', 'Россия, г. Саранск, Лесной пер., д. 21 кв.33', 'Россия, г. Омск, Новоселов ул., д. 18 кв.157', 'русский', '68826361170', '7583783232648'),
-        ('Киселев', 'Степан', 'Михаилович', 'Мужской', '1989-09-18', 'г. Батайск', 4, 4, '3', '{"mobile": "79248207576"}', 'kiselev@company.com', 'Россия, г. Ульяновск, Космонавтов ул., д. 24 кв.218', 'Россия, г. Волжский, Озерная ул., д. 8 кв.81', 'русский', '06074932773', '2070390458745'),
-        ('Старков', 'Афанасий', 'Кириллович', 'Мужской', '1981-07-22', 'г. Салават', 4, 2,'3', '{"mobile": "79099494526"}', 'starkov@company.com', 'Россия, г. Санкт-Петербург, Ленина В.И.ул., д. 13 кв.113', 'Россия, г. Тольятти, Октябрьская ул., д. 4 кв.205', 'русский', '71988362091', '3803473783912'),
-        ('Попырин', 'Роман', 'Никитович', 'Мужской', '1988-02-24', 'г. Иркутск', 4, 3, '3', '{"mobile": "79993905153"}', 'popyrin@company.com', 'Россия, г. Раменское, Майская ул., д. 2 кв.65', 'Россия, г. Старый Оскол, Комсомольская ул., д. 10 кв.103', 'русский', '82792460780', '9248200895907'),
-        ('Бабченко', 'Георгий',	'Денисович', 'Мужской', '1980-03-24', 'г. Хабаровск', 4, 2, '3', '{"mobile": "79841561595"}', 'babchenko@company.com', 'Россия, г. Красногорск, Якуба Коласа ул., д. 13 кв.87', 'Россия, г. Миасс, Социалистическая ул., д. 1 кв.136', 'русский', '15514769848', '4435193060485'),
-        ('Бруевич',	'Леонтий', 'Леонидович', 'Мужской', '1984-03-28', 'г. Ярославль', 4, 3, '3', '{"mobile": "79672162945"}', 'bruevich@company.com', 'Россия, г. Тольятти, Железнодорожная ул., д. 11 кв.25', 'Россия, г. Смоленск, Почтовая ул., д. 4 кв.183', 'русский', '84865996801', '7111740143620'),
-        ('Оскорбина', 'Лидия', 'Семеновна', 'Женский', '1979-01-03', 'г. Владивосток', 4, 4, '3', '{"mobile": "79703787295"}', 'oskorbin@company.com', 'Россия, г. Орск, Дружная ул., д. 7 кв.170', 'Россия, г. Керчь, Мирная ул., д. 5 кв.81', 'русский', '66730372919', '2452828330119'),
-        ('Проничев', 'Георгий', 'Степанович', 'Мужской', '1995-10-20', 'г. Махачкала', 4, 2, '3', '{"mobile": "79684436999"}', 'pronichev@company.com', 'Россия, г. Домодедово, Зеленый пер., д. 5 кв.99', 'Россия, г. Нефтекамск, Речной пер., д. 16 кв.58', 'русский', '79310399526', '8314880212419'),
-        ('Былинкин', 'Максим', 'Наумович', 'Мужской', '1988-09-01', 'г. Оренбург', 4, 3, '3', '{"mobile": "79579591744"}', 'bylinkin@company.com', 'Россия, г. Тюмень, Ленина В.И.ул., д. 19 кв.67', 'Россия, г. Таганрог, Полевая ул., д. 17 кв.194', 'русский', '95999412492', '5806641668080'),
-        ('Рыкова', 'Милана', 'Филипповна', 'Женский', '1981-09-12', 'г. Кемерово', 4, 2, '3', '{"mobile": "79863573759"}', 'rykova@company.com', 'Россия, г. Армавир, Трудовая ул., д. 25 кв.157', 'Россия, г. Благовещенск, Школьная ул., д. 3 кв.211', 'русский', '53382951534', '4904285930734'),
-        ('Филипова', 'Алина', 'Захаровна','Женский', '1986-07-12', 'г. Новокузнецк', 4, 3, '3', '{"mobile": "79208394674"}', 'filipova@company.com', 'Россия, г. Невинномысск, Калинина ул., д. 16 кв.83', 'Россия, г. Одинцово, Садовая ул., д. 16 кв.199', 'русский', '23793883440', '3049437566022'),
-        ('Голубев', 'Серафим', 'Аркадинович', 'Мужской', '1985-07-06', 'г. Набережные Челны', 4, 2, '3', '{"mobile": "79132868783"}', 'golubev@company.com', 'Россия, г. Ижевск, Дружбы ул., д. 14 кв.77', 'Россия, г. Тверь, Восточная ул., д. 6 кв.119', 'русский', '97122043571', '7968655328738'),
-        ('Бабкина', 'Римма', 'Захаровна','Женский', '1989-10-12', 'г. Чебоксары', 4, 4, '3', '{"mobile": "79703303338"}', 'babkina@company.com', 'Россия, г. Салават, Кирова ул., д. 1 кв.140', 'Россия, г. Санкт-Петербург, Ленина В.И.ул., д. 25 кв.157', 'русский', '89794973665', '8720213388760'),
-        ('Труш', 'Ксения', 'Афанасьевна','Женский', '1974-02-10', 'г. Калининград', 4, 3, '3', '{"mobile": "79796773040"}', 'trush@company.com', 'Россия, г. Батайск, Колхозная ул., д. 17 кв.85', 'Россия, г. Каменск - Уральский, Ленина ул., д. 25 кв.5', 'русский', '86019834881', '0311109975018'),
-        ('Казакевич', 'Федор', 'Яковлевич', 'Мужской', '1982-01-14', 'г. Брянск', 4, 4, '3', '{"mobile": "79367992981"}', 'kazakevich@company.com', 'Россия, г. Новый Уренгой, Солнечная ул., д. 11 кв.67', 'Россия, г. Невинномысск, Луговой пер., д. 8 кв.123', 'русский', '44896722178', '9068643204708'),
-        ('Ядыкин', 'Семен', 'Михаилович', 'Мужской', '1977-01-08', 'г. Курск', 4, 2, '3', '{"mobile": "79175034535"}', 'yadykin@company.com', 'Россия, г. Керчь, Приозерная ул., д. 13 кв.180', 'Россия, г. Камышин, Центральная ул., д. 11 кв.215', 'русский', '35845404620', '7637811608355'),
-        ('Ядренкин', 'Прохор', 'Валентинович', 'Мужской', '1985-02-06', 'г. Иваново', 4, 3,'3', '{"mobile": "79628462275"}', 'yadrenkin@company.com', 'Россия, г. Череповец, Комсомольская ул., д. 24 кв.196', 'Россия, г. Астрахань, Трудовая ул., д. 21 кв.165', 'русский', '22107045052', '8139522191377'),
-        ('Шишлова', 'Алина', 'Ефимовна','Женский', '1981-09-15', 'г. Муром', 4, 2, '3', '{"mobile": "79802013990"}', 'shishlova@company.com', 'Россия, г. Мурманск, Приозерная ул., д. 11 кв.180', 'Россия, г. Невинномысск, Советская ул., д. 25 кв.61', 'русский', '16832951050', '1707961632820'),
-        ('Семенов', 'Семен', 'Валерьевич', 'Мужской', '1982-10-13', 'г. Магнитогорск', 4, 4, '3', '{"mobile": "79179293188"}', 'semenov@company.com', 'Россия, г. Майкоп, Рабочая ул., д. 2 кв.201', 'Россия, г. Екатеринбург, Сосновая ул., д. 21 кв.203', 'русский', '30781982018', '5951377369073'),
-        ('Рудавин', 'Виктор', 'Феликсович', 'Мужской', '1982-02-16','г. Ковров', 4, 2, '3', '{"mobile": "79695048457"}', 'rudavin@company.com', 'Россия, г. Норильск, Лесная ул., д. 25 кв.164', 'Россия, г. Электросталь, Парковая ул., д. 10 кв.152', 'русский', '98060107578', '3754323622036'),
-        ('Каткова', 'Надежда', 'Ефимовна','Женский', '1979-08-06', 'г. Выкса', 4, 3, '3', '{"mobile": "79906247558"}', 'katkova@company.com', 'Россия, г. Химки, Первомайская ул., д. 12 кв.6', 'Россия, г. Сызрань, Шоссейная ул., д. 16 кв.132', 'русский', '53502999802', '8385119026114'),
-        ('Катькин', 'Афанасий', 'Иванович', 'Женский', '1980-04-17', 'г. Армавир', 4, 3, '3', '{"mobile": "79363351874"}', 'katkin@company.com', 'Россия, г. Нефтеюганск, Победы ул., д. 20 кв.139', 'Россия, г. Южно-Сахалинск, Минская ул., д. 15 кв.142', 'русский', '15981774341', '0595406763826'),
-        ('Патрушев', 'Иван', 'Аркадинович', 'Мужской', '1984-12-01', 'г. Южно-Сахалинск', 4, 2, '3', '{"mobile": "79356079725"}', 'patrushev@company.com', 'Россия, г. Рыбинск, Лесной пер., д. 6 кв.156', 'Россия, г. Пушкино, Молодежный пер., д. 23 кв.90', 'русский', '75907609408', '9432375976810'),
-        ('Яцунова', 'Пелагея', 'Георгьевна','Женский', '1989-03-27', 'г. Шахты', 4, 4, '3', '{"mobile": "79409863489"}', 'yatsunova@company.com', 'Россия, г. Владивосток, Колхозный пер., д. 25 кв.152', 'Россия, г. Черкесск, Солнечная ул., д. 5 кв.185', 'русский', '19362311071', '9779328543647'),
-        ('Щуров', 'Филипп', 'Федорович', 'Мужской', '1992-05-02', 'г. Березники', 4, 2, '3', '{"mobile": "79191102860"}', 'shchurov@company.com', 'Россия, г. Ставрополь, Солнечная ул., д. 11 кв.93', 'Россия, г. Якутск, Заводская ул., д. 12 кв.76', 'русский','31265094203', '0417420441423'),
-        ('Бабанин', 'Артем', 'Филиппович', 'Мужской', '1995-10-05', 'г. Сергиев Посад', 4, 3, '3', '{"mobile": "79579592711"}', 'babanin@company.com', 'Россия, г. Архангельск, Юбилейная ул., д. 23 кв.56', 'Россия, г. Севастополь, Садовая ул., д. 15 кв.143', 'русский', '48045248936', '0199854388986');
+INSERT INTO public.users (lastname, firstname, middlename, gender, date_of_birth, place_of_birth, position_id, department_id, grade, phone_numbers, work_email, registration_address, residential_address, nationality, insurance_number, taxpayer_number, personnel_number)
+VALUES  ('Корепанов', 'Роман', 'Даниилович', 'Мужской', '1988-12-14', 'г. Серпухов', 1, 1, '6', '{"mobile": "79215511436"}', 'korepanov@company.com', 'Россия, г. Хасавюрт, Заречная ул., д. 24 кв.199', 'Россия, г. Серпухов, Зеленая ул., д. 2 кв.90', 'русский', '34665359207', '298885601004', '000001'),
+        ('Яппарова', 'Галина', 'Гермоновна', 'Женский', '1993-08-09', 'г. Дербент', 2, 2, '5', '{"mobile": "79215511436"}', 'yapparova@company.com', 'Россия, г. Рязань, Ленина В.И.ул., д. 6 кв.145', 'Россия, г. Дербент, Комсомольская ул., д. 21 кв.50', 'русский', '52759362623', '154912198705', '000002'),
+        ('Кучеров', 'Герман', 'Антонович', 'Мужской', '1981-08-22', 'г. Красноярск', 2, 3, '5', '{"mobile": "79215511436"}', 'kucherov@company.com', 'Россия, г. Реутов, Ленина В.И.ул., д. 23 кв.22', 'Россия, г. Красноярск, Почтовая ул., д. 3 кв.201', 'русский', '64190091782', '581551039113', '000003'),
+        ('Дарюшина', 'Ольга', 'Герасимовна', 'Женский', '1983-05-13', 'г. Железногорск', 2, 4, '5', '{"mobile": "79215511436"}', 'daryushina@company.com', 'Россия, г. Казань, Чкалова ул., д. 7 кв.149', 'Россия, г. Железногорск, Речная ул., д. 22 кв.137', 'русский', '87293349859', '110483833670', '000004'),
+        ('Яловенко', 'Римма', 'Феодосьевна', 'Женский', '1979-04-17', 'г. Нижний Тагил', 3, 4, '4', '{"mobile": "79215511436"}', 'yalovenko@company.com', 'Россия, г. Ковров, Колхозный пер., д. 11 кв.57', 'Россия, г. Нижний Тагил, Новоселов ул., д. 17 кв.105', 'русский', '48499927384', '660070348269', '000005'),
+        ('Гроссман', 'Семен', 'Сергеевич', 'Мужской', '1997-02-23', 'г. Новомосковск', 4, 4, '3', '{"mobile": "79215511436"}', 'grossman@company.com', 'Россия, г. Одинцово, Коммунистическая ул., д. 7 кв.102', 'Россия, г. Новомосковск, Якуба Коласа ул., д. 15 кв.101', 'русский', '26899661063', '472328433371', '000006'),
+        ('Цельнер', 'Татьяна', 'Аркадивна', 'Женский', '1987-03-09', 'г. Брянск', 4, 2, '3', '{"mobile": "79215511436"}', 'zelner@company.com', 'Россия, г. Абакан, Юбилейная ул., д. 15 кв.64', 'Россия, г. Брянск, Речная ул., д. 2 кв.3', 'русский', '30049154628', '273169571690', '000007'),
+        ('Мартюшева', 'Полина', 'Акимовна', 'Женский', '1988-07-21', 'г. Орехово-Зуево', 4, 2, '3', '{"mobile": "79215511436"}', 'martyusheva@company.com', 'Россия, г. Арзамас, Якуба Коласа ул., д. 3 кв.35', 'Россия, г. Орехово-Зуево, Новый пер., д. 12 кв.43', 'русский', '79294540861', '646764546435', '000008'),
+        ('Сиянович', 'Мила', 'Павловна', 'Женский', '1998-11-14', 'г. Первоуральск', 4, 3, '3', '{"mobile": "79215511436"}', 'siyanovich@company.com', 'Россия, г. Муром, Березовая ул., д. 5 кв.201', 'Россия, г. Первоуральск, Заводская ул., д. 5 кв.56', 'русский', '65167909125', '350651114375', '000009'),
+        ('Грибов', 'Захар', 'Вениаминович', 'Мужской', '1956-09-09', 'г. Норильск', 4, 3, '3', '{"mobile": "79215511436"}', 'gribov@company.com', 'Россия, г. Салават, Молодежный пер., д. 12 кв.141', 'Россия, г. Норильск, Речная ул., д. 23 кв.82', 'русский', '27281788613', '993152634947', '000010'),
+        ('Трохин', 'Леонид', 'Юринович', 'Мужской', '1981-12-16', 'г. Владимир', 4, 2, '3', '{"mobile": "79944477518"}', 'trohin@company.com', 'Россия, г. Тверь, Строителей ул., д. 14 кв.2', 'Россия, г. Октябрьский, Строителей ул., д. 22 кв.179', 'русский', '78905432165', '546486428967', '000011'),
+        ('Головаха', 'Юлия', 'Валерьевна', 'Женский', '1977-10-17', ' г. Улан-Удэ', 4, 2, '3', '{"mobile": "79068801143"}', 'golovaha@company.com', 'Россия, г. Белгород, Заречная ул., д. 2 кв.73', 'Россия, г. Череповец, Ленинская ул., д. 13 кв.111', 'русский', '43216543217', '110408673323', '000012'),
+        ('Ермилова', 'Оксана', 'Константиновна', 'Женский', '1996-06-11', 'г.  Рыбинск', 4, 2, '3', '{"mobile": "79855621221"}', 'ermilova@company.com', 'Россия, г. Коломна, Октябрьская ул., д. 11 кв.160', 'Россия, г. Якутск, Березовая ул., д. 25 кв.99', 'русский', '27890543216', '112418777221', '000013'),
+        ('Милехина', 'Светлана', 'Артемова', 'Женский', '1970-07-06', 'г. Ижевск', 4, 3 , '3', '{"mobile": "79927083380"}', 'milehina@company.com', 'Россия, г. Каспийск, Первомайский пер., д. 15 кв.216', 'Россия, г. Псков, Колхозная ул., д. 19 кв.76', 'русский', '53978718262', '333640999544', '000014'),
+        ('Платущихина', 'Жанна', 'Петровна', 'Женский', '1971-08-26', 'г. Санкт-Петербург', 3, 3, '4', '{"mobile": "7996327453"}', 'platushchihina@company.com', 'Россия, г. Сургут, Дорожная ул., д. 14 кв.75', 'Россия, г. Братск, Приозерная ул., д. 9 кв.156', 'русский', '12372689744','543210987654321', '000015'),
+        ('Тесла', 'Елена', 'Антоновна', 'Женский', '1995-07-16', 'г. Новочеркасск', 4, 4, '3', '{"mobile": "79791034416"}', 'tesla@company.com', 'Россия, г. Владимир, Сельская ул., д. 22 кв.138', 'Россия, г. Норильск, Чкалова ул., д. 2 кв.102', 'русский', '65432178905', '543210987654321', '000016'),
+        ('Ярославцева',	'Ярослава',	'Тарасовна', 'Женский', '1967-02-09', ' г. Волгодонск', 4, 4, '3', '{"mobile": "79839613895"}', 'yaroslavec@company.com', 'Россия, г. Дербент, Луговая ул., д. 6 кв.39', 'Россия, г. Миасс, Почтовая ул., д. 15 кв.197', 'русский', '98714563278', '109876543210987', '000017'),
+        ('Гордеев',	'Вениамин',	'Федорович', 'Мужской', '1977-05-12', 'г. Красногорск', 4, 4, '3', '{"mobile": "79544053136"}', 'gordeev@company.com', 'Россия, г. Тула, Трудовая ул., д. 3 кв.83', 'Россия, г. Березники, Дорожная ул., д. 2 кв.70', 'русский', '27890543216', '876543210987654', '000018'),
+        ('Ажикелямова',	'Римма', 'Николаевна', 'Женский', '1963-07-24', 'г. Южно-Сахалинск', 4,4, '3', '{"mobile": "79292561624"}', 'ajikelyamova@company.com', 'Россия, г. Ачинск, Цветочная ул., д. 13 кв.107', 'Россия, г. Северодвинск, Первомайская ул., д. 4 кв.56', 'русский', '78905432165', '765432109876543', '000019'),
+        ('Ложкина',	'Кира',	'Венедиктовна', 'Женский', '1967-02-05', 'г. Ижевск', 4, 2, '3', '{"mobile": "79074117464"}', 'lozhkina@company.com', 'Россия, г. Ковров, Октябрьский пер., д. 9 кв.87', 'Россия, г. Владикавказ, Почтовая ул., д. 6 кв.36', 'русский', '43216543217', '654321098765432', '000020'),
+        ('Сабитова', 'Варвара',	'Юлиановна', 'Женский', '1985-09-19', 'г. Якутск', 4, 2, '3', '{"mobile": "79806549141"}', 'sabitova@company.com', 'Россия, г. Волгодонск, Приозерная ул., д. 11 кв.186', 'Россия, г. Пермь, Первомайский пер., д. 8 кв.40','русский', '28369435526', '543210987654321', '000021'),
+        ('Шукшин',	'Георгий',	'Ильич', 'Мужской', '1983-06-20', 'г. Армавир', 4, 3, '3', '{"mobile": "79691164323"}', 'shchukhin@company.com', 'Россия, г. Новокузнецк, 3 Марта ул., д. 25 кв.1', 'Россия, г. Новошахтинск, Молодежная ул., д. 5 кв.131', 'русский', '71546772611', '1480495294212', '000022'),
+        ('Менщико', 'Герман', 'Игнатьевич', 'Мужской', '1983-06-17', 'г.Саранск', 4, 4, '3', '{"mobile": "79962889768"}', 'meshchinko@company.com', 'Россия, г. Владивосток, Школьная ул., д. 1 кв.168', 'Россия, г. Димитровград, Садовый пер., д. 17 кв.165', 'русский', '34579007891', '7871752964758', '000023'),
+        ('Мухин', 'Тимофей', 'Панкратович', 'Мужской', '1983-05-07', 'г. Тюмень', 4, 2,'3', '{"mobile": "79108704379"}', 'muchin@company.com', 'Россия, г. Ногинск, Дружбы ул., д. 14 кв.216', 'Россия, г. Томск, Октябрьский пер., д. 19 кв.210', 'русский', '36023379858', '5170397536306', '000024'),
+        ('Щеголева', 'Валерия', 'Павловна', 'Женский', '1984-06-20', 'г. Новосибирск', 4, 4, '3', '{"mobile": "79614205510"}', 'shegoleva@company.com', 'Россия, г. Кемерово, Озерная ул., д. 12 кв.214', 'Россия, г. Новомосковск, Светлая ул., д. 12 кв.140', 'русский','84810380798', '9433854869035', '000025'),
+        ('Кучерова', 'Дарья', 'Валентиновна','Женский','1973-01-05', 'г. Екатеринбург', 4, 3,'3', '{"mobile": "79184159769"}', 'kucherova@company.com', 'Россия, г. Улан-Удэ, Трудовая ул., д. 12 кв.216', 'Россия, г. Ярославль, Дорожная ул., д. 7 кв.22', 'русский','62214262842', '8378404073107', '000026'),
+        ('Судленков', 'Афанасий', 'Даниилович', 'Мужской', '1983-05-08', 'г. Нижний Новгород', 4, 4, '3', '{"mobile": "79063102049"}', 'sudlenkov@company.com', 'Россия, г. Находка, Тихая ул., д. 6 кв.85', 'Россия, г. Сергиев Посад, Речная ул., д. 4 кв.38', 'русский', '21629807121', '5221364142313', '000027'),
+        ('Кратенко', 'Ася',	'Михаиловна', 'Женский', '1975-10-21', 'г. Казань', 4, 2, '3', '{"mobile": " 79722083038"}' ,'kratenko@company.com', 'Россия, г. Мытищи, Гагарина ул., д. 12 кв.174', 'Россия, г. Красногорск, Юбилейная ул., д. 22 кв.75', 'русский', '03623519871', '9737629623931', '000028'),
+        ('Церетели', 'Тимофей',	'Александрович', 'Мужской', '1986-08-24', 'г. Самара', 4, 3, '3','{"mobile": "79181232092"}', 'tsereteli@company.com', 'Россия, г. Дзержинск, Спортивная ул., д. 2 кв.63', 'Россия, г. Таганрог, Западная ул., д. 3 кв.108', 'русский', '38776852740', '5347369877528', '000029'),
+        ('Ерофеев',	'Адам',	'Константинович', 'Мужской', '1985-09-09', 'г. Омск', 4, 2, '3', '{"mobile": "79108704379"}', 'erofeev@company.com', 'Россия, г. Ижевск, Заводская ул., д. 16 кв.99', 'Россия, г. Раменское, Школьный пер., д. 16 кв.189', 'русский', '41836931546', '5526895429122', '000030'),
+        ('Шипулин',	'Афанасий',	'Макарович', 'Мужской', '1995-04-07', 'г. Ростов-на-Дону', 4, 4, '3', '{"mobile": "79119999599"}', 'shipulin@company.com', 'Россия, г. Кызыл, Пионерская ул., д. 9 кв.14', 'Россия, г. Одинцово, Сосновая ул., д. 25 кв.128', 'русский', '36483678276', '6574271573697', '000031'),
+        ('Чукчов', 'Тарас', 'Антонович', 'Мужской',  '1970-12-18', 'г. Уфа', 4, 4, '3', '{"mobile": "79838094276"}', 'chukchov@company.com', 'Россия, г. Хабаровск, Новоселов ул., д. 14 кв.179', 'Россия, г. Дербент, Березовая ул., д. 11 кв.18', 'русский', '67781725748', '2331609835263', '000032'),
+        ('Доронин',	'Вениамин', 'Ильич', 'Мужской', '1985-09-10', 'г. Пермь', 4, 3, '3', '{"mobile": "79734057572"}','doronin@company.com', 'Россия, г. Ростов-на-Дону, Солнечная ул., д. 9 кв.140', 'Россия, г. Иркутск, Березовая ул., д. 15 кв.146', 'русский', '72732841066', '8056901318928', '000033'),
+        ('Мальцев', 'Леонтий', 'Иванович', 'Мужской', '1988-03-24', 'г. Воронеж', 4, 3, '3', '{"mobile": "79498495019"}', 'maltsev@company.com', 'Россия, г. Сергиев Посад, Октябрьская ул., д. 25 кв.131', 'Россия, г. Новокузнецк, Садовый пер., д. 19 кв.16', 'русский', '22531683042', '3411664941598', '000034'),
+        ('Канкия', 'Настасья', 'Емельяновна', 'Женский', '1986-02-06', 'г. Краснодар', 4, 2, '3', '{"mobile": "79203235793"}', 'kankiya@company.com', 'Россия, г. Березники, Майская ул., д. 9 кв.6', 'Россия, г. Иваново, Заречная ул., д. 17 кв.6', 'русский', '96439603291', '3986750350379', '000035'),
+        ('Мусин', 'Георгий', 'Никанорович', 'Мужской', '1987-09-24', 'г. Саратов', 4, 3, '3', '{"mobile": "79313982935"}', 'musin@company.com', 'Россия, г. Пермь, Песчаная ул., д. 10 кв.141', 'Россия, г. Сызрань, Светлая ул., д. 3 кв.110', 'русский', '78020750868', '4107579111453', '000036'),
+        ('Халски', 'Севастьян', 'Арсеньевич', 'Мужской', '1989-08-20', 'г. Тюмень', 4, 3, '3', '{"mobile": "79091157195"}', 'halski@company.com', 'Россия, г. Иваново, Дзержинского ул., д. 25 кв.184', 'Россия, г. Курган, Полевая ул., д. 5 кв.146', 'русский', '03199069569', '9888488425679', '000037'),
+        ('Гершельмана',	'Зоя', 'Семеновна', 'Женский', '1980-06-27', 'г. Тольятти', 4, 2, '3', '{"mobile": "79201611579"}','gershelman@company.com', 'Россия, г. Шахты, Совхозная ул., д. 7 кв.88', 'Россия, г. Саранск, Лесной пер., д. 20 кв.60', 'русский', '87054957934', '3314247309585', '000038'),
+        ('Уицкая', 'Лариса', 'Нифонтовна', 'Женский', '1988-04-04', 'г. Ижевск', 4, 4, '3', '{"mobile": "79779914854"}', 'uitskaya@company.com', 'Россия, г. Мурманск, Заречная ул., д. 23 кв.37', 'Россия, г. Октябрьский, Интернациональная ул., д. 24 кв.15', 'русский', '33177106120', '0164525574120', '000039'),
+        ('Осминин',	'Давид', 'Макарович', 'Мужской', '1986-01-12', 'г. Барнаул', 4, 3, '3', '{"mobile": "79135503371"}', 'osminin@company.com', 'Россия, г. Южно-Сахалинск, 17 Сентября ул., д. 2 кв.152', 'Россия, г. Салават, Ленинская ул., д. 7 кв.130', 'русский', '20952813008', '0976966956815', '000040'),
+        ('Мурогов',	'Никита', 'Леонтьевич', 'Мужской', '1983-06-10', 'г. Ульяновск', 4, 2, '3', '{"mobile": "79437377216"}', 'murogov@company.com', 'Россия, г. Волгодонск, Октябрьская ул., д. 16 кв.43', 'Россия, г. Краснодар, Озерная ул., д. 14 кв.151', 'русский', '51300921828', '3372247699424', '000041'),
+        ('Куимова',	'Сюзанна', 'Макаровна', 'Женский', '1986-05-28', 'г. Киров', 4, 4, '3', '{"mobile": "79801933746"}', 'kuimova@company.com', 'Россия, г. Благовещенск, Полевая ул., д. 6 кв.197', 'Россия, г. Нижневартовск, Речная ул., д. 7 кв.56', 'русский', '44309505398', '8053998095525', '000042'),
+        ('Кривчиков', 'Павел', 'Константинович', 'Мужской', '1983-01-04', 'г. Нефтеюганск', 4, 3, '3', '{"mobile": "79096856887"}', 'krivchikov@company.com', 'Россия, г. Брянск, Новый пер., д. 10 кв.187', 'Россия, г. Петропавловск-Камчатский, Дорожная ул., д. 11 кв.204', 'русский', '61635024193', '7196674884026', '000043'),
+        ('Медникова', 'Ольга', 'Лукьяновна', 'Женский', '1992-07-14', 'г. Майкоп', 4, 2, '3', '{"mobile": "79276997364"}', 'mednikova@company.com', 'Россия, г. Рыбинск, Речной пер., д. 4 кв.102', 'Россия, г. Хабаровск, Солнечная ул., д. 22 кв.94', 'русский', '65530515369', '3576410983234', '000044'),
+        ('Приходько', 'Лидия', 'Афанасьевна', 'Женский', '1986-02-01', 'г. Новый Уренгой', 4, 3, '3', '{"mobile": "79257346427"}', 'prikhodko@company.com', 'Россия, г. Саранск, Лесной пер., д. 21 кв.33', 'Россия, г. Омск, Новоселов ул., д. 18 кв.157', 'русский', '68826361170', '7583783232648', '000045'),
+        ('Киселев', 'Степан', 'Михаилович', 'Мужской', '1989-09-18', 'г. Батайск', 4, 4, '3', '{"mobile": "79248207576"}', 'kiselev@company.com', 'Россия, г. Ульяновск, Космонавтов ул., д. 24 кв.218', 'Россия, г. Волжский, Озерная ул., д. 8 кв.81', 'русский', '06074932773', '2070390458745', '000046'),
+        ('Старков', 'Афанасий', 'Кириллович', 'Мужской', '1981-07-22', 'г. Салават', 4, 2,'3', '{"mobile": "79099494526"}', 'starkov@company.com', 'Россия, г. Санкт-Петербург, Ленина В.И.ул., д. 13 кв.113', 'Россия, г. Тольятти, Октябрьская ул., д. 4 кв.205', 'русский', '71988362091', '3803473783912', '000047'),
+        ('Попырин', 'Роман', 'Никитович', 'Мужской', '1988-02-24', 'г. Иркутск', 4, 3, '3', '{"mobile": "79993905153"}', 'popyrin@company.com', 'Россия, г. Раменское, Майская ул., д. 2 кв.65', 'Россия, г. Старый Оскол, Комсомольская ул., д. 10 кв.103', 'русский', '82792460780', '9248200895907', '000048'),
+        ('Бабченко', 'Георгий',	'Денисович', 'Мужской', '1980-03-24', 'г. Хабаровск', 4, 2, '3', '{"mobile": "79841561595"}', 'babchenko@company.com', 'Россия, г. Красногорск, Якуба Коласа ул., д. 13 кв.87', 'Россия, г. Миасс, Социалистическая ул., д. 1 кв.136', 'русский', '15514769848', '4435193060485', '000049'),
+        ('Бруевич',	'Леонтий', 'Леонидович', 'Мужской', '1984-03-28', 'г. Ярославль', 4, 3, '3', '{"mobile": "79672162945"}', 'bruevich@company.com', 'Россия, г. Тольятти, Железнодорожная ул., д. 11 кв.25', 'Россия, г. Смоленск, Почтовая ул., д. 4 кв.183', 'русский', '84865996801', '7111740143620', '000050'),
+        ('Оскорбина', 'Лидия', 'Семеновна', 'Женский', '1979-01-03', 'г. Владивосток', 4, 4, '3', '{"mobile": "79703787295"}', 'oskorbin@company.com', 'Россия, г. Орск, Дружная ул., д. 7 кв.170', 'Россия, г. Керчь, Мирная ул., д. 5 кв.81', 'русский', '66730372919', '2452828330119', '000051'),
+        ('Проничев', 'Георгий', 'Степанович', 'Мужской', '1995-10-20', 'г. Махачкала', 4, 2, '3', '{"mobile": "79684436999"}', 'pronichev@company.com', 'Россия, г. Домодедово, Зеленый пер., д. 5 кв.99', 'Россия, г. Нефтекамск, Речной пер., д. 16 кв.58', 'русский', '79310399526', '8314880212419', '000052'),
+        ('Былинкин', 'Максим', 'Наумович', 'Мужской', '1988-09-01', 'г. Оренбург', 4, 3, '3', '{"mobile": "79579591744"}', 'bylinkin@company.com', 'Россия, г. Тюмень, Ленина В.И.ул., д. 19 кв.67', 'Россия, г. Таганрог, Полевая ул., д. 17 кв.194', 'русский', '95999412492', '5806641668080', '000053'),
+        ('Рыкова', 'Милана', 'Филипповна', 'Женский', '1981-09-12', 'г. Кемерово', 4, 2, '3', '{"mobile": "79863573759"}', 'rykova@company.com', 'Россия, г. Армавир, Трудовая ул., д. 25 кв.157', 'Россия, г. Благовещенск, Школьная ул., д. 3 кв.211', 'русский', '53382951534', '4904285930734', '000054'),
+        ('Филипова', 'Алина', 'Захаровна','Женский', '1986-07-12', 'г. Новокузнецк', 4, 3, '3', '{"mobile": "79208394674"}', 'filipova@company.com', 'Россия, г. Невинномысск, Калинина ул., д. 16 кв.83', 'Россия, г. Одинцово, Садовая ул., д. 16 кв.199', 'русский', '23793883440', '3049437566022', '000055'),
+        ('Голубев', 'Серафим', 'Аркадинович', 'Мужской', '1985-07-06', 'г. Набережные Челны', 4, 2, '3', '{"mobile": "79132868783"}', 'golubev@company.com', 'Россия, г. Ижевск, Дружбы ул., д. 14 кв.77', 'Россия, г. Тверь, Восточная ул., д. 6 кв.119', 'русский', '97122043571', '7968655328738', '000056'),
+        ('Бабкина', 'Римма', 'Захаровна','Женский', '1989-10-12', 'г. Чебоксары', 4, 4, '3', '{"mobile": "79703303338"}', 'babkina@company.com', 'Россия, г. Салават, Кирова ул., д. 1 кв.140', 'Россия, г. Санкт-Петербург, Ленина В.И.ул., д. 25 кв.157', 'русский', '89794973665', '8720213388760', '000057'),
+        ('Труш', 'Ксения', 'Афанасьевна','Женский', '1974-02-10', 'г. Калининград', 4, 3, '3', '{"mobile": "79796773040"}', 'trush@company.com', 'Россия, г. Батайск, Колхозная ул., д. 17 кв.85', 'Россия, г. Каменск - Уральский, Ленина ул., д. 25 кв.5', 'русский', '86019834881', '0311109975018', '000058'),
+        ('Казакевич', 'Федор', 'Яковлевич', 'Мужской', '1982-01-14', 'г. Брянск', 4, 4, '3', '{"mobile": "79367992981"}', 'kazakevich@company.com', 'Россия, г. Новый Уренгой, Солнечная ул., д. 11 кв.67', 'Россия, г. Невинномысск, Луговой пер., д. 8 кв.123', 'русский', '44896722178', '9068643204708', '000059'),
+        ('Ядыкин', 'Семен', 'Михаилович', 'Мужской', '1977-01-08', 'г. Курск', 4, 2, '3', '{"mobile": "79175034535"}', 'yadykin@company.com', 'Россия, г. Керчь, Приозерная ул., д. 13 кв.180', 'Россия, г. Камышин, Центральная ул., д. 11 кв.215', 'русский', '35845404620', '7637811608355', '000060'),
+        ('Ядренкин', 'Прохор', 'Валентинович', 'Мужской', '1985-02-06', 'г. Иваново', 4, 3,'3', '{"mobile": "79628462275"}', 'yadrenkin@company.com', 'Россия, г. Череповец, Комсомольская ул., д. 24 кв.196', 'Россия, г. Астрахань, Трудовая ул., д. 21 кв.165', 'русский', '22107045052', '8139522191377', '000061'),
+        ('Шишлова', 'Алина', 'Ефимовна','Женский', '1981-09-15', 'г. Муром', 4, 2, '3', '{"mobile": "79802013990"}', 'shishlova@company.com', 'Россия, г. Мурманск, Приозерная ул., д. 11 кв.180', 'Россия, г. Невинномысск, Советская ул., д. 25 кв.61', 'русский', '16832951050', '1707961632820', '000062'),
+        ('Семенов', 'Семен', 'Валерьевич', 'Мужской', '1982-10-13', 'г. Магнитогорск', 4, 4, '3', '{"mobile": "79179293188"}', 'semenov@company.com', 'Россия, г. Майкоп, Рабочая ул., д. 2 кв.201', 'Россия, г. Екатеринбург, Сосновая ул., д. 21 кв.203', 'русский', '30781982018', '5951377369073', '000063'),
+        ('Рудавин', 'Виктор', 'Феликсович', 'Мужской', '1982-02-16','г. Ковров', 4, 2, '3', '{"mobile": "79695048457"}', 'rudavin@company.com', 'Россия, г. Норильск, Лесная ул., д. 25 кв.164', 'Россия, г. Электросталь, Парковая ул., д. 10 кв.152', 'русский', '98060107578', '3754323622036', '000064'),
+        ('Каткова', 'Надежда', 'Ефимовна','Женский', '1979-08-06', 'г. Выкса', 4, 3, '3', '{"mobile": "79906247558"}', 'katkova@company.com', 'Россия, г. Химки, Первомайская ул., д. 12 кв.6', 'Россия, г. Сызрань, Шоссейная ул., д. 16 кв.132', 'русский', '53502999802', '8385119026114', '000065'),
+        ('Катькин', 'Афанасий', 'Иванович', 'Женский', '1980-04-17', 'г. Армавир', 4, 3, '3', '{"mobile": "79363351874"}', 'katkin@company.com', 'Россия, г. Нефтеюганск, Победы ул., д. 20 кв.139', 'Россия, г. Южно-Сахалинск, Минская ул., д. 15 кв.142', 'русский', '15981774341', '0595406763826', '000066'),
+        ('Патрушев', 'Иван', 'Аркадинович', 'Мужской', '1984-12-01', 'г. Южно-Сахалинск', 4, 2, '3', '{"mobile": "79356079725"}', 'patrushev@company.com', 'Россия, г. Рыбинск, Лесной пер., д. 6 кв.156', 'Россия, г. Пушкино, Молодежный пер., д. 23 кв.90', 'русский', '75907609408', '9432375976810', '000067'),
+        ('Яцунова', 'Пелагея', 'Георгьевна','Женский', '1989-03-27', 'г. Шахты', 4, 4, '3', '{"mobile": "79409863489"}', 'yatsunova@company.com', 'Россия, г. Владивосток, Колхозный пер., д. 25 кв.152', 'Россия, г. Черкесск, Солнечная ул., д. 5 кв.185', 'русский', '19362311071', '9779328543647', '000068'),
+        ('Щуров', 'Филипп', 'Федорович', 'Мужской', '1992-05-02', 'г. Березники', 4, 2, '3', '{"mobile": "79191102860"}', 'shchurov@company.com', 'Россия, г. Ставрополь, Солнечная ул., д. 11 кв.93', 'Россия, г. Якутск, Заводская ул., д. 12 кв.76', 'русский','31265094203', '0417420441423', '000069'),
+        ('Бабанин', 'Артем', 'Филиппович', 'Мужской', '1995-10-05', 'г. Сергиев Посад', 4, 3, '3', '{"mobile": "79579592711"}', 'babanin@company.com', 'Россия, г. Архангельск, Юбилейная ул., д. 23 кв.56', 'Россия, г. Севастополь, Садовая ул., д. 15 кв.143', 'русский', '48045248936', '0199854388986', '000070');
 
 INSERT INTO public.educations (user_id, title_of_institution, title_of_program, document_number, year_of_begin, year_of_end)
 VALUES  (1, 'Казанский (Приволжский) федеральный университет', 'Математика', '9129755011871', '1995-01-01', '2000-01-01'),
@@ -269,9 +294,51 @@ VALUES  (1, '$2a$12$rrNnco5DWbaFxKMq457MouwkzL/R2XFoe1MrpoX9bN0ms09Zgk6ee', 1),
 -- v2 - 'act' from policy_definition = available HTTP methods for this role
 INSERT INTO public.policies (ptype, v0, v1, v2)
 VALUES ('p', '4', '/users/{user_id}', 'GET'),
+       ('p', '4', '/vacation-requests', '*'),
+       ('p', '4', '/vacation-requests/*', '*'),
        ('p', '2', '/users', '*'),
        ('p', '2', '/users/*', '*'),
-       ('p', '1', '/accounts', '*');
+       ('p', '2', '/positions/*', 'GET'),
+       ('p', '2', '/departments', 'GET'),
+       ('p', '2', '/staffing', '*'),
+       ('p', '2', '/staffing/*', '*'),
+       ('p', '2', '/indexations', '*'),
+       ('p', '2', '/indexations/*', '*'),
+       ('p', '2', '/benefits', '*'),
+       ('p', '2', '/benefits/*', '*'),
+       ('p', '2', '/military', '*'),
+       ('p', '2', '/military/*', '*'),
+       ('p', '2', '/onboarding', '*'),
+       ('p', '2', '/onboarding/*', '*'),
+       ('p', '2', '/vacations', '*'),
+       ('p', '2', '/vacations/*', '*'),
+       ('p', '2', '/absences', '*'),
+       ('p', '2', '/absences/*', '*'),
+       ('p', '2', '/timesheets', '*'),
+       ('p', '2', '/timesheets/*', '*'),
+       ('p', '2', '/calendar', '*'),
+       ('p', '2', '/calendar/*', '*'),
+       ('p', '2', '/vacation-requests', '*'),
+       ('p', '2', '/vacation-requests/*', '*'),
+       ('p', '2', 'compensations', 'read'),
+       ('p', '2', 'compensations', 'write'),
+       ('p', '2', 'vacation_requests', 'decide'),
+       ('p', '2', '/probations', 'GET'),
+       ('p', '2', '/orders', '*'),
+       ('p', '2', '/orders/*', '*'),
+       ('p', '2', '/number-sequences', '*'),
+       ('p', '2', '/number-sequences/*', '*'),
+       ('p', '2', '/vacancies', '*'),
+       ('p', '2', '/vacancies/*', '*'),
+       ('p', '2', '/candidates', '*'),
+       ('p', '2', '/candidates/*', '*'),
+       ('p', '3', '/vacancies', '*'),
+       ('p', '3', '/vacancies/*', '*'),
+       ('p', '3', '/candidates', '*'),
+       ('p', '3', '/candidates/*', '*'),
+       ('p', '3', '/departments', 'GET'),
+       ('p', '1', '/accounts', '*'),
+       ('p', '1', '/duplicates', 'GET');
 
 -- Insert users:
 -- ptype = 'g'
@@ -822,195 +889,201 @@ VALUES (1, 'CT-4579-567', 'Срочный', 1, 6, '2023-11-02', '2024-11-25'),
        (23, 'CT-2395-463', 'Бессрочный', 7, NULL, '2023-01-11', NULL),
        (24, 'CT-8252-512', 'Бессрочный', 5, NULL, '2023-09-15', NULL),
        (25, 'CT-6742-844', 'Бессрочный', 8, 6, '2023-08-29', NULL),
-       (26, 'CT-9578-274', 'Срочный', 6, NULL, '2023-06-01', '2024-05-21'),
-       (27, 'CT-9463-654', 'Срочный', 2, NULL, '2023-09-02', '2024-02-26'),
-       (28, 'CT-6385-786', 'Срочный', 7, NULL, '2023-01-14', '2024-06-28'),
-       (29, 'CT-9753-464', 'Срочный', 1, 6, '2023-10-16', '2024-04-11'),
-       (30, 'CT-3724-953', 'Бессрочный', 1, NULL, '2023-03-28', NULL),
-       (31, 'CT-1847-377', 'Бессрочный', 2, 12, '2023-04-15', NULL),
-       (32, 'CT-9264-239', 'Срочный', 4, 3, '2023-07-23', '2024-07-18'),
-       (33, 'CT-8883-354', 'Бессрочный', 3, 3, '2023-12-31', NULL),
-       (34, 'CT-5637-451', 'Бессрочный', 5, 12, '2023-05-13', NULL),
-       (35, 'CT-3287-328', 'Срочный', 1, 6, '2023-12-31', '2025-02-13'),
-       (36, 'CT-3393-108', 'Срочный', 6, 12, '2023-07-27', '2025-06-18'),
-       (37, 'CT-6385-786', 'Срочный', 7, NULL, '2023-01-14', '2024-06-28'),
-       (38, 'CT-9753-464', 'Срочный', 9, 6, '2023-10-16', '2024-04-11'),
-       (39, 'CT-3724-953', 'Бессрочный', 8, NULL, '2023-03-28', NULL),
-       (40, 'CT-1823-194', 'Бессрочный', 3, NULL, '2023-05-12', NULL),
-       (41, 'CT-4579-567', 'Срочный', 5, 6, '2023-11-02', '2024-11-25'),
-       (42, 'CT-1934-577', 'Срочный', 9, NULL, '2023-02-15', '2024-08-09'),
-       (43, 'CT-2395-463', 'Бессрочный', 4, NULL, '2023-01-11', NULL),
-       (44, 'CT-8252-512', 'Бессрочный', 2, NULL, '2023-09-15', NULL),
-       (45, 'CT-6742-844', 'Бессрочный', 7, 6, '2023-08-29', NULL),
-       (46, 'CT-9463-654', 'Срочный', 3, NULL, '2023-09-02', '2024-02-26'),
-       (47, 'CT-6385-786', 'Срочный', 5, NULL, '2023-01-14', '2024-06-28'),
-       (48, 'CT-9753-464', 'Срочный', 4, 6, '2023-10-16', '2024-04-11'),
-       (49, 'CT-3724-953', 'Бессрочный', 6, NULL, '2023-03-28', NULL),
-       (50, 'CT-1847-377', 'Бессрочный', 7, 12, '2023-04-15', NULL),
-       (51, 'CT-1847-377', 'Бессрочный', 2, 12, '2023-04-15', NULL),
-       (52, 'CT-9264-239', 'Срочный', 4, 3, '2023-07-23', '2024-07-18'),
-       (53, 'CT-8883-354', 'Бессрочный', 3, 3, '2023-12-31', NULL),
-       (54, 'CT-5637-451', 'Бессрочный', 1, 12, '2023-05-13', NULL),
-       (55, 'CT-3287-328', 'Срочный', 3, 6, '2023-12-31', '2025-02-13'),
-       (56, 'CT-9578-274', 'Срочный', 5, NULL, '2023-06-01', '2024-05-21'),
-       (57, 'CT-9463-654', 'Срочный', 3, NULL, '2023-09-02', '2024-02-26'),
-       (58, 'CT-6385-786', 'Срочный', 7, NULL, '2023-01-14', '2024-06-28'),
-       (59, 'CT-9753-464', 'Срочный', 8, 6, '2023-10-16', '2024-04-11'),
-       (60, 'CT-3724-953', 'Бессрочный', 9, NULL, '2023-03-28', NULL),
-       (61, 'CT-1872-543', 'Бессрочный', 3, NULL, '2023-12-11', NULL),
-       (62, 'CT-3132-735', 'Срочный', 5, NULL, '2023-02-27', '2024-08-07'),
-       (63, 'CT-6732-412', 'Бессрочный', 2, 12, '2023-05-10', NULL),
-       (64, 'CT-8252-512', 'Бессрочный', 7, NULL, '2023-09-15', NULL),
-       (65, 'CT-6742-844', 'Бессрочный', 4, 6, '2023-08-29', NULL),
-       (66, 'CT-3393-108', 'Срочный', 6, 12, '2023-07-27', '2025-06-18'),
-       (67, 'CT-6385-786', 'Срочный', 2, NULL, '2023-01-14', '2024-06-28'),
-       (68, 'CT-9753-464', 'Срочный', 4, 6, '2023-10-16', '2024-04-11'),
-       (69, 'CT-9578-274', 'Срочный', 7, NULL, '2023-06-01', '2024-05-21'),
-       (70, 'CT-1872-543', 'Бессрочный', 1, NULL, '2023-12-11', NULL);
+       (26, 'CT-6764-164', 'Срочный', 6, NULL, '2023-06-01', '2024-05-21'),
+       (27, 'CT-8048-666', 'Срочный', 2, NULL, '2023-09-02', '2024-02-26'),
+       (28, 'CT-8432-684', 'Срочный', 7, NULL, '2023-01-14', '2024-06-28'),
+       (29, 'CT-6627-363', 'Срочный', 1, 6, '2023-10-16', '2024-04-11'),
+       (30, 'CT-9395-495', 'Бессрочный', 1, NULL, '2023-03-28', NULL),
+       (31, 'CT-7429-730', 'Бессрочный', 2, 12, '2023-04-15', NULL),
+       (32, 'CT-1670-914', 'Срочный', 4, 3, '2023-07-23', '2024-07-18'),
+       (33, 'CT-7857-774', 'Бессрочный', 3, 3, '2023-12-31', NULL),
+       (34, 'CT-1387-204', 'Бессрочный', 5, 12, '2023-05-13', NULL),
+       (35, 'CT-1010-370', 'Срочный', 1, 6, '2023-12-31', '2025-02-13'),
+       (36, 'CT-8889-841', 'Срочный', 6, 12, '2023-07-27', '2025-06-18'),
+       (37, 'CT-9956-109', 'Срочный', 7, NULL, '2023-01-14', '2024-06-28'),
+       (38, 'CT-6170-681', 'Срочный', 9, 6, '2023-10-16', '2024-04-11'),
+       (39, 'CT-8007-335', 'Бессрочный', 8, NULL, '2023-03-28', NULL),
+       (40, 'CT-4895-635', 'Бессрочный', 3, NULL, '2023-05-12', NULL),
+       (41, 'CT-9420-467', 'Срочный', 5, 6, '2023-11-02', '2024-11-25'),
+       (42, 'CT-8245-347', 'Срочный', 9, NULL, '2023-02-15', '2024-08-09'),
+       (43, 'CT-1544-674', 'Бессрочный', 4, NULL, '2023-01-11', NULL),
+       (44, 'CT-7130-833', 'Бессрочный', 2, NULL, '2023-09-15', NULL),
+       (45, 'CT-6790-715', 'Бессрочный', 7, 6, '2023-08-29', NULL),
+       (46, 'CT-6601-671', 'Срочный', 3, NULL, '2023-09-02', '2024-02-26'),
+       (47, 'CT-4210-344', 'Срочный', 5, NULL, '2023-01-14', '2024-06-28'),
+       (48, 'CT-1578-228', 'Срочный', 4, 6, '2023-10-16', '2024-04-11'),
+       (49, 'CT-6471-200', 'Бессрочный', 6, NULL, '2023-03-28', NULL),
+       (50, 'CT-6944-692', 'Бессрочный', 7, 12, '2023-04-15', NULL),
+       (51, 'CT-7284-620', 'Бессрочный', 2, 12, '2023-04-15', NULL),
+       (52, 'CT-9849-932', 'Срочный', 4, 3, '2023-07-23', '2024-07-18'),
+       (53, 'CT-5142-199', 'Бессрочный', 3, 3, '2023-12-31', NULL),
+       (54, 'CT-4718-815', 'Бессрочный', 1, 12, '2023-05-13', NULL),
+       (55, 'CT-6486-323', 'Срочный', 3, 6, '2023-12-31', '2025-02-13'),
+       (56, 'CT-8717-862', 'Срочный', 5, NULL, '2023-06-01', '2024-05-21'),
+       (57, 'CT-2804-891', 'Срочный', 3, NULL, '2023-09-02', '2024-02-26'),
+       (58, 'CT-7826-719', 'Срочный', 7, NULL, '2023-01-14', '2024-06-28'),
+       (59, 'CT-6784-791', 'Срочный', 8, 6, '2023-10-16', '2024-04-11'),
+       (60, 'CT-5691-891', 'Бессрочный', 9, NULL, '2023-03-28', NULL),
+       (61, 'CT-4915-928', 'Бессрочный', 3, NULL, '2023-12-11', NULL),
+       (62, 'CT-5285-465', 'Срочный', 5, NULL, '2023-02-27', '2024-08-07'),
+       (63, 'CT-9727-678', 'Бессрочный', 2, 12, '2023-05-10', NULL),
+       (64, 'CT-1657-595', 'Бессрочный', 7, NULL, '2023-09-15', NULL),
+       (65, 'CT-6115-676', 'Бессрочный', 4, 6, '2023-08-29', NULL),
+       (66, 'CT-7479-366', 'Срочный', 6, 12, '2023-07-27', '2025-06-18'),
+       (67, 'CT-9509-918', 'Срочный', 2, NULL, '2023-01-14', '2024-06-28'),
+       (68, 'CT-4619-720', 'Срочный', 4, 6, '2023-10-16', '2024-04-11'),
+       (69, 'CT-2866-742', 'Срочный', 7, NULL, '2023-06-01', '2024-05-21'),
+       (70, 'CT-4392-488', 'Бессрочный', 1, NULL, '2023-12-11', NULL);
 
-INSERT INTO public.finances (user_id, contract_id, salary, salary_rate, social_security_tax, income_tax)
-VALUES (1, 1, 13217900, 1.0347, 1718327, 2643580),
-       (2, 2, 7697600, 0.9090, 1000688, 1539520),
-       (3, 3, 19841300, 1.4244, 2579369, 3968260),
-       (4, 4, 12186200, 0.8584, 1584206, 2437200),
-       (5, 5, 19644000, 0.5556, 2553720, 3928800),
-       (6, 6, 19754500, 1.0009, 2568085, 3950900),
-       (7, 7, 5966100, 1.0501, 775593, 1193220),
-       (8, 8, 19379200, 1.0000, 2521296, 3875840),
-       (9, 9, 14236700, 0.7138, 1850771, 2847340),
-       (10, 10, 11175900, 0.7020, 1452867, 2235180),
-       (11, 11, 11428600, 1.2496, 1485718, 2285720),
-       (12, 12, 16741300, 0.7469, 2176369, 3348260),
-       (13, 13, 11342600, 1.3267, 1474538, 2268520),
-       (14, 14, 9246200, 0.8543, 1202006, 1849240),
-       (15, 25, 12306500, 1.1034, 1599845, 2461300),
-       (16, 16, 16853500, 0.9594, 2190955, 3370700),
-       (17, 17, 16174200, 1.0217, 2102646, 3234840),
-       (18, 18, 14367800, 1.1367, 1867814, 2873560),
-       (19, 19, 9820600, 1.4047, 1276678, 1964120),
-       (20, 20, 15680900, 0.5023, 2038517, 3136180),
-       (21, 21, 13786900, 0.6473, 1792297, 2757380),
-       (22, 22, 15458700, 1.2784, 2009631, 3091740),
-       (23, 23, 11921700, 1.1235, 1549821, 2384340),
-       (24, 24, 16972300, 1.0556, 2206399, 3394460),
-       (25, 25, 10197200, 1.0903, 1325636, 2039440),
-       (26, 26, 16853500, 0.9594, 2190955, 3370700),
-       (27, 27, 16174200, 1.0217, 2102646, 3234840),
-       (28, 28, 19379200, 1.0000, 2521296, 3875840),
-       (29, 29, 14236700, 0.7138, 1850771, 2847340),
-       (30, 30, 19841300, 1.4244, 2579369, 3968260),
-       (31, 31, 13217900, 1.0347, 1718327, 2643580),
-       (32, 32, 16741300, 0.7469, 2176369, 3348260),
-       (33, 33, 11342600, 1.3267, 1474538, 2268520),
-       (34, 34, 12186200, 0.8584, 1584206, 2437240),
-       (35, 35, 12306500, 1.1034, 1599845, 2461300),
-       (36, 36, 16853500, 0.9594, 2190955, 3370700),
-       (37, 37, 16174200, 1.0217, 2102646, 3234840),
-       (38, 38, 19379200, 1.0000, 2521296, 3875840),
-       (39, 39, 14236700, 0.7138, 1850771, 2847340),
-       (40, 40, 16972300, 1.0556, 2206399, 3394460),
-       (41, 41, 13217900, 1.0347, 1718327, 2643580),
-       (42, 42, 7697600, 0.9090, 1000688, 1539520),
-       (43, 43, 19841300, 1.4244, 2579369, 3968260),
-       (44, 44, 12186200, 0.8584, 1584206, 2437240),
-       (45, 45, 10197200, 1.0903, 1325636, 2039440),
-       (46, 46, 16853500, 0.9594, 2190955, 3370700),
-       (47, 47, 16174200, 1.0217, 2102646, 3234840),
-       (48, 48, 14367800, 1.1367, 1867814, 2873560),
-       (49, 49, 9820600, 1.4047, 1276678, 1964120),
-       (50, 50, 19644000, 0.5556, 2553720, 3928800),
-       (51, 51, 13217900, 1.0347, 1718327, 2643580),
-       (52, 52, 16741300, 0.7469, 2176369, 3348260),
-       (53, 53, 11342600, 1.3267, 1474538, 2268520),
-       (54, 54, 12186200, 0.8584, 1584206, 2437240),
-       (55, 55, 10197200, 1.0903, 1325636, 2039440),
-       (56, 56, 19754500, 1.0009, 2568085, 3950900),
-       (57, 57, 5966100, 1.0501, 775593, 1193220),
-       (58, 58, 19379200, 1.0000, 2521296, 3875840),
-       (59, 59, 14236700, 0.7138, 1850771, 2847340),
-       (60, 60, 12306500, 1.1034, 1599845, 2461300),
-       (61, 61, 13217900, 1.0347, 1718327, 2643580),
-       (62, 62, 16741300, 0.7469, 2176369, 3348260),
-       (63, 63, 11342600, 1.3267, 1474538, 2268520),
-       (64, 64, 9246200, 0.8543, 1202006, 1849240),
-       (65, 65, 10197200, 1.0903, 1325636, 2039440),
-       (66, 66, 16853500, 0.9594, 2190955, 3370700),
-       (67, 67, 16174200, 1.0217, 2102646, 3234840),
-       (68, 68, 19379200, 1.0000, 2521296, 3875840),
-       (69, 69, 14236700, 0.7138, 1850771, 2847340),
-       (70, 70, 15680900, 0.5023, 2038517, 3136180);
+-- compensations are effective from the beginning of contracts
+INSERT INTO public.finances (user_id, contract_id, salary, salary_rate, social_security_tax, income_tax, date_begin)
+SELECT v.*, contracts.date_begin
+FROM (VALUES (1, 1, 13217900, 1.0347, 1718327, 2643580),
+             (2, 2, 7697600, 0.9090, 1000688, 1539520),
+             (3, 3, 19841300, 1.4244, 2579369, 3968260),
+             (4, 4, 12186200, 0.8584, 1584206, 2437200),
+             (5, 5, 19644000, 0.5556, 2553720, 3928800),
+             (6, 6, 19754500, 1.0009, 2568085, 3950900),
+             (7, 7, 5966100, 1.0501, 775593, 1193220),
+             (8, 8, 19379200, 1.0000, 2521296, 3875840),
+             (9, 9, 14236700, 0.7138, 1850771, 2847340),
+             (10, 10, 11175900, 0.7020, 1452867, 2235180),
+             (11, 11, 11428600, 1.2496, 1485718, 2285720),
+             (12, 12, 16741300, 0.7469, 2176369, 3348260),
+             (13, 13, 11342600, 1.3267, 1474538, 2268520),
+             (14, 14, 9246200, 0.8543, 1202006, 1849240),
+             (15, 25, 12306500, 1.1034, 1599845, 2461300),
+             (16, 16, 16853500, 0.9594, 2190955, 3370700),
+             (17, 17, 16174200, 1.0217, 2102646, 3234840),
+             (18, 18, 14367800, 1.1367, 1867814, 2873560),
+             (19, 19, 9820600, 1.4047, 1276678, 1964120),
+             (20, 20, 15680900, 0.5023, 2038517, 3136180),
+             (21, 21, 13786900, 0.6473, 1792297, 2757380),
+             (22, 22, 15458700, 1.2784, 2009631, 3091740),
+             (23, 23, 11921700, 1.1235, 1549821, 2384340),
+             (24, 24, 16972300, 1.0556, 2206399, 3394460),
+             (25, 25, 10197200, 1.0903, 1325636, 2039440),
+             (26, 26, 16853500, 0.9594, 2190955, 3370700),
+             (27, 27, 16174200, 1.0217, 2102646, 3234840),
+             (28, 28, 19379200, 1.0000, 2521296, 3875840),
+             (29, 29, 14236700, 0.7138, 1850771, 2847340),
+             (30, 30, 19841300, 1.4244, 2579369, 3968260),
+             (31, 31, 13217900, 1.0347, 1718327, 2643580),
+             (32, 32, 16741300, 0.7469, 2176369, 3348260),
+             (33, 33, 11342600, 1.3267, 1474538, 2268520),
+             (34, 34, 12186200, 0.8584, 1584206, 2437240),
+             (35, 35, 12306500, 1.1034, 1599845, 2461300),
+             (36, 36, 16853500, 0.9594, 2190955, 3370700),
+             (37, 37, 16174200, 1.0217, 2102646, 3234840),
+             (38, 38, 19379200, 1.0000, 2521296, 3875840),
+             (39, 39, 14236700, 0.7138, 1850771, 2847340),
+             (40, 40, 16972300, 1.0556, 2206399, 3394460),
+             (41, 41, 13217900, 1.0347, 1718327, 2643580),
+             (42, 42, 7697600, 0.9090, 1000688, 1539520),
+             (43, 43, 19841300, 1.4244, 2579369, 3968260),
+             (44, 44, 12186200, 0.8584, 1584206, 2437240),
+             (45, 45, 10197200, 1.0903, 1325636, 2039440),
+             (46, 46, 16853500, 0.9594, 2190955, 3370700),
+             (47, 47, 16174200, 1.0217, 2102646, 3234840),
+             (48, 48, 14367800, 1.1367, 1867814, 2873560),
+             (49, 49, 9820600, 1.4047, 1276678, 1964120),
+             (50, 50, 19644000, 0.5556, 2553720, 3928800),
+             (51, 51, 13217900, 1.0347, 1718327, 2643580),
+             (52, 52, 16741300, 0.7469, 2176369, 3348260),
+             (53, 53, 11342600, 1.3267, 1474538, 2268520),
+             (54, 54, 12186200, 0.8584, 1584206, 2437240),
+             (55, 55, 10197200, 1.0903, 1325636, 2039440),
+             (56, 56, 19754500, 1.0009, 2568085, 3950900),
+             (57, 57, 5966100, 1.0501, 775593, 1193220),
+             (58, 58, 19379200, 1.0000, 2521296, 3875840),
+             (59, 59, 14236700, 0.7138, 1850771, 2847340),
+             (60, 60, 12306500, 1.1034, 1599845, 2461300),
+             (61, 61, 13217900, 1.0347, 1718327, 2643580),
+             (62, 62, 16741300, 0.7469, 2176369, 3348260),
+             (63, 63, 11342600, 1.3267, 1474538, 2268520),
+             (64, 64, 9246200, 0.8543, 1202006, 1849240),
+             (65, 65, 10197200, 1.0903, 1325636, 2039440),
+             (66, 66, 16853500, 0.9594, 2190955, 3370700),
+             (67, 67, 16174200, 1.0217, 2102646, 3234840),
+             (68, 68, 19379200, 1.0000, 2521296, 3875840),
+             (69, 69, 14236700, 0.7138, 1850771, 2847340),
+             (70, 70, 15680900, 0.5023, 2038517, 3136180))
+         AS v (user_id, contract_id, salary, salary_rate, social_security_tax, income_tax)
+         JOIN contracts ON contracts.id = v.contract_id;
 
 
-INSERT INTO public.indexations (user_id, date_begin, percents, currency, salary_before)
-VALUES (1, '2023-01-01', 5, 1, 120000),
-       (2, '2023-02-01', 7, 1, 130000),
-       (3, '2023-03-01', 6, 1, 125000),
-       (4, '2023-04-01', 4, 1, 115000),
-       (5, '2023-05-01', 8, 1, 135000),
-       (6, '2023-06-01', 5, 1, 122000),
-       (7, '2023-07-01', 7, 1, 128000),
-       (8, '2023-08-01', 6, 1, 126000),
-       (9, '2023-09-01', 5, 1, 121000),
-       (10, '2023-10-01', 4, 1, 114000),
-       (11, '2023-11-01', 8, 1, 136000),
-       (12, '2023-12-01', 7, 1, 129000),
-       (13, '2024-01-01', 6, 1, 127000),
-       (14, '2024-02-01', 5, 1, 123000),
-       (15, '2024-03-01', 9, 1, 138000),
-       (16, '2024-04-01', 8, 1, 135000),
-       (17, '2024-05-01', 6, 1, 126000),
-       (18, '2024-06-01', 7, 1, 128000),
-       (19, '2024-07-01', 5, 1, 121000),
-       (20, '2024-08-01', 4, 1, 118000),
-       (21, '2024-09-01', 9, 1, 139000),
-       (22, '2024-10-01', 8, 1, 136000),
-       (23, '2024-11-01', 6, 1, 124000),
-       (24, '2023-10-01', 7, 1, 128000),
-       (25, '2024-07-01', 3, 1, 138000),
-       (26, '2023-11-01', 8, 1, 136000),
-       (27, '2024-05-01', 6, 1, 126000),
-       (28, '2023-08-01', 6, 1, 126000),
-       (29, '2024-07-01', 5, 1, 121000),
-       (30, '2023-03-01', 6, 1, 125000),
-       (31, '2023-11-01', 8, 1, 136000),
-       (32, '2023-12-01', 7, 1, 129000),
-       (33, '2024-11-01', 6, 1, 124000),
-       (34, '2023-06-01', 5, 1, 122000),
-       (35, '2023-07-01', 7, 1, 128000),
-       (36, '2024-04-01', 8, 1, 135000),
-       (37, '2024-05-01', 6, 1, 126000),
-       (38, '2023-08-01', 6, 1, 126000),
-       (39, '2023-09-01', 5, 1, 121000),
-       (40, '2024-02-01', 5, 1, 123000),
-       (41, '2024-09-01', 9, 1, 139000),
-       (42, '2024-10-01', 8, 1, 136000),
-       (43, '2024-01-01', 6, 1, 127000),
-       (44, '2024-02-01', 5, 1, 123000),
-       (45, '2024-03-01', 9, 1, 138000),
-       (46, '2023-06-01', 5, 1, 122000),
-       (47, '2023-07-01', 7, 1, 128000),
-       (48, '2024-06-01', 7, 1, 128000),
-       (49, '2024-07-01', 5, 1, 121000),
-       (50, '2024-03-01', 9, 1, 138000),
-       (51, '2024-07-01', 3, 1, 138000),
-       (52, '2023-12-01', 7, 1, 129000),
-       (53, '2024-11-01', 6, 1, 124000),
-       (54, '2023-06-01', 5, 1, 122000),
-       (56, '2023-06-01', 5, 1, 122000),
-       (57, '2023-07-01', 7, 1, 128000),
-       (58, '2023-08-01', 6, 1, 126000),
-       (59, '2024-07-01', 5, 1, 121000),
-       (60, '2023-03-01', 6, 1, 125000),
-       (61, '2024-09-01', 9, 1, 139000),
-       (62, '2024-10-01', 8, 1, 136000),
-       (63, '2024-11-01', 6, 1, 124000),
-       (64, '2023-10-01', 7, 1, 128000),
-       (65, '2024-03-01', 9, 1, 138000),
-       (66, '2024-04-01', 8, 1, 135000),
-       (67, '2023-07-01', 7, 1, 128000),
-       (68, '2023-08-01', 6, 1, 126000),
-       (69, '2023-09-01', 5, 1, 121000),
-       (70, '2024-02-01', 5, 1, 123000);
+INSERT INTO public.indexations (user_id, date_begin, basis_points, currency, salary_before, salary_after)
+SELECT user_id, date_begin::date, percents * 100, currency, salary_before, salary_before * (100 + percents) / 100
+FROM (VALUES (1, '2023-01-01', 5, 'RUB', 120000),
+             (2, '2023-02-01', 7, 'RUB', 130000),
+             (3, '2023-03-01', 6, 'RUB', 125000),
+             (4, '2023-04-01', 4, 'RUB', 115000),
+             (5, '2023-05-01', 8, 'RUB', 135000),
+             (6, '2023-06-01', 5, 'RUB', 122000),
+             (7, '2023-07-01', 7, 'RUB', 128000),
+             (8, '2023-08-01', 6, 'RUB', 126000),
+             (9, '2023-09-01', 5, 'RUB', 121000),
+             (10, '2023-10-01', 4, 'RUB', 114000),
+             (11, '2023-11-01', 8, 'RUB', 136000),
+             (12, '2023-12-01', 7, 'RUB', 129000),
+             (13, '2024-01-01', 6, 'RUB', 127000),
+             (14, '2024-02-01', 5, 'RUB', 123000),
+             (15, '2024-03-01', 9, 'RUB', 138000),
+             (16, '2024-04-01', 8, 'RUB', 135000),
+             (17, '2024-05-01', 6, 'RUB', 126000),
+             (18, '2024-06-01', 7, 'RUB', 128000),
+             (19, '2024-07-01', 5, 'RUB', 121000),
+             (20, '2024-08-01', 4, 'RUB', 118000),
+             (21, '2024-09-01', 9, 'RUB', 139000),
+             (22, '2024-10-01', 8, 'RUB', 136000),
+             (23, '2024-11-01', 6, 'RUB', 124000),
+             (24, '2023-10-01', 7, 'RUB', 128000),
+             (25, '2024-07-01', 3, 'RUB', 138000),
+             (26, '2023-11-01', 8, 'RUB', 136000),
+             (27, '2024-05-01', 6, 'RUB', 126000),
+             (28, '2023-08-01', 6, 'RUB', 126000),
+             (29, '2024-07-01', 5, 'RUB', 121000),
+             (30, '2023-03-01', 6, 'RUB', 125000),
+             (31, '2023-11-01', 8, 'RUB', 136000),
+             (32, '2023-12-01', 7, 'RUB', 129000),
+             (33, '2024-11-01', 6, 'RUB', 124000),
+             (34, '2023-06-01', 5, 'RUB', 122000),
+             (35, '2023-07-01', 7, 'RUB', 128000),
+             (36, '2024-04-01', 8, 'RUB', 135000),
+             (37, '2024-05-01', 6, 'RUB', 126000),
+             (38, '2023-08-01', 6, 'RUB', 126000),
+             (39, '2023-09-01', 5, 'RUB', 121000),
+             (40, '2024-02-01', 5, 'RUB', 123000),
+             (41, '2024-09-01', 9, 'RUB', 139000),
+             (42, '2024-10-01', 8, 'RUB', 136000),
+             (43, '2024-01-01', 6, 'RUB', 127000),
+             (44, '2024-02-01', 5, 'RUB', 123000),
+             (45, '2024-03-01', 9, 'RUB', 138000),
+             (46, '2023-06-01', 5, 'RUB', 122000),
+             (47, '2023-07-01', 7, 'RUB', 128000),
+             (48, '2024-06-01', 7, 'RUB', 128000),
+             (49, '2024-07-01', 5, 'RUB', 121000),
+             (50, '2024-03-01', 9, 'RUB', 138000),
+             (51, '2024-07-01', 3, 'RUB', 138000),
+             (52, '2023-12-01', 7, 'RUB', 129000),
+             (53, '2024-11-01', 6, 'RUB', 124000),
+             (54, '2023-06-01', 5, 'RUB', 122000),
+             (56, '2023-06-01', 5, 'RUB', 122000),
+             (57, '2023-07-01', 7, 'RUB', 128000),
+             (58, '2023-08-01', 6, 'RUB', 126000),
+             (59, '2024-07-01', 5, 'RUB', 121000),
+             (60, '2023-03-01', 6, 'RUB', 125000),
+             (61, '2024-09-01', 9, 'RUB', 139000),
+             (62, '2024-10-01', 8, 'RUB', 136000),
+             (63, '2024-11-01', 6, 'RUB', 124000),
+             (64, '2023-10-01', 7, 'RUB', 128000),
+             (65, '2024-03-01', 9, 'RUB', 138000),
+             (66, '2024-04-01', 8, 'RUB', 135000),
+             (67, '2023-07-01', 7, 'RUB', 128000),
+             (68, '2023-08-01', 6, 'RUB', 126000),
+             (69, '2023-09-01', 5, 'RUB', 121000),
+             (70, '2024-02-01', 5, 'RUB', 123000))
+         AS v (user_id, date_begin, percents, currency, salary_before);
 
 
 INSERT INTO public.experiences (user_id, company_name, date_begin, date_end, position, functional, awards)
@@ -1157,5 +1230,121 @@ VALUES
     (68, 68, 'VISA1010', 'Япония', '2026-12-31', '2025-12-15', '1'),
     (69, 69, 'VISA1011', 'Южная Корея', '2024-06-18', '2023-05-03', '2'),
     (70, 70, 'VISA1001', 'Россия', '2025-01-01', '2023-01-01', '1');
+
+INSERT INTO position_history (user_id, department_id, position_id, date_begin)
+SELECT users.id, users.department_id, users.position_id, MIN(contracts.date_begin)
+FROM users
+         JOIN contracts ON contracts.user_id = users.id
+GROUP BY users.id, users.department_id, users.position_id;
+
+INSERT INTO public.onboarding_templates (title)
+VALUES ('Общий чек-лист нового сотрудника');
+
+INSERT INTO public.onboarding_template_items (template_id, title, scan_type, due_days)
+VALUES (1, 'Подписать согласие на обработку персональных данных', 'Согласие на обработку данных', 1),
+       (1, 'Загрузить скан СНИЛС', 'СНИЛС', 7),
+       (1, 'Пройти инструктаж по охране труда', 'Инструктаж', 3),
+       (1, 'Получить пропуск и рабочее место', NULL, 1);
+
+INSERT INTO public.calendar_years (year)
+VALUES (2024);
+
+INSERT INTO public.calendar_days (date, type, title)
+VALUES ('2024-01-01', 'holiday', 'Новогодние каникулы'),
+       ('2024-01-02', 'holiday', 'Новогодние каникулы'),
+       ('2024-01-03', 'holiday', 'Новогодние каникулы'),
+       ('2024-01-04', 'holiday', 'Новогодние каникулы'),
+       ('2024-01-05', 'holiday', 'Новогодние каникулы'),
+       ('2024-01-06', 'holiday', 'Новогодние каникулы'),
+       ('2024-01-07', 'holiday', 'Рождество Христово'),
+       ('2024-01-08', 'holiday', 'Новогодние каникулы'),
+       ('2024-02-22', 'shortened', ''),
+       ('2024-02-23', 'holiday', 'День защитника Отечества'),
+       ('2024-03-07', 'shortened', ''),
+       ('2024-03-08', 'holiday', 'Международный женский день'),
+       ('2024-04-27', 'working', ''),
+       ('2024-04-29', 'day_off', 'перенос с 04.27'),
+       ('2024-04-30', 'day_off', 'перенос с 11.02'),
+       ('2024-05-01', 'holiday', 'Праздник Весны и Труда'),
+       ('2024-05-08', 'shortened', ''),
+       ('2024-05-09', 'holiday', 'День Победы'),
+       ('2024-05-10', 'day_off', 'перенос с 01.06'),
+       ('2024-06-11', 'shortened', ''),
+       ('2024-06-12', 'holiday', 'День России'),
+       ('2024-11-02', 'shortened', ''),
+       ('2024-11-04', 'holiday', 'День народного единства'),
+       ('2024-12-28', 'shortened', ''),
+       ('2024-12-30', 'day_off', 'перенос с 12.28'),
+       ('2024-12-31', 'day_off', 'перенос с 01.07');
+
+INSERT INTO public.vacation_schedules (year)
+VALUES (2024);
+
+INSERT INTO public.vacation_schedule_items (year, user_id, date_begin, date_end)
+VALUES (2024, 1, '2024-02-12', '2024-02-25'),
+       (2024, 1, '2024-08-05', '2024-08-18'),
+       (2024, 2, '2024-06-17', '2024-07-14'),
+       (2024, 3, '2024-07-01', '2024-07-14'),
+       (2024, 4, '2024-09-02', '2024-09-15');
+
+INSERT INTO public.vacation_requests (user_id, date_begin, date_end, comment, status, head_id, submitted_at)
+VALUES (5, '2024-10-07', '2024-10-20', 'По графику отпусков', 'pending', 1, now()),
+       (6, '2024-11-11', '2024-11-17', '', 'draft', NULL, NULL);
+
+INSERT INTO public.absences (user_id, type, date_begin, date_end, document_number, comment)
+VALUES (1, 'sick', '2024-01-15', '2024-01-19', '910123456789', ''),
+       (2, 'business_trip', '2024-01-22', '2024-01-26', '', 'Командировка в Казань'),
+       (3, 'study', '2024-01-29', '2024-02-09', '', 'Сессия'),
+       (5, 'unpaid', '2024-01-10', '2024-01-12', '', 'Семейные обстоятельства'),
+       (6, 'sick', '2024-01-09', '2024-01-16', '910223344556', '');
+
+INSERT INTO public.timesheet_corrections (user_id, date, code, hours, note, corrected_by)
+VALUES (4, '2024-01-31', 'Я', 4, 'Ушла раньше по служебной записке', 2);
+
+INSERT INTO public.relatives (user_id, type, lastname, firstname, middlename, date_of_birth, disabled)
+VALUES (1, 'spouse', 'Иванова', 'Мария', 'Петровна', '1990-04-12', false),
+       (1, 'child', 'Иванов', 'Артём', 'Иванович', '2013-09-03', false),
+       (1, 'child', 'Иванова', 'Анна', 'Ивановна', '2017-02-20', false),
+       (3, 'child', 'Сидоров', 'Михаил', 'Петрович', '2008-11-30', true),
+       (4, 'parent', 'Кузнецова', 'Валентина', 'Сергеевна', '1958-06-07', false);
+
+INSERT INTO public.probation_results (contract_id, outcome, extended_to, comment)
+VALUES (12, 'passed', NULL, ''),
+       (14, 'passed', NULL, 'Рекомендован к повышению'),
+       (8, 'extended', '2024-03-29', 'Период болезни не засчитан в срок испытания');
+
+INSERT INTO public.contract_amendments (contract_id, number, date, effective_from, work_type_id, position_id,
+                                        salary, date_end, description)
+VALUES (2, '1', '2023-10-25', '2023-11-01', NULL, 3, NULL, NULL, 'Перевод на должность главного специалиста'),
+       (2, '2', '2024-01-20', '2024-02-01', NULL, NULL, 9500000, NULL, ''),
+       (6, '1', '2024-01-10', '2024-01-15', 2, NULL, NULL, '2025-12-31', 'Продление срока договора');
+
+INSERT INTO public.orders (type, status, title, effective_date, user_id, contract_id, vacation_id,
+                           sequence_id, year, number, date)
+VALUES ('hiring', 'signed', 'О приёме работника на работу', '2023-05-12', 2, 2, NULL, 3, 2023, '1-к', '2023-05-11'),
+       ('vacation', 'signed', 'О предоставлении отпуска работнику', '2023-06-22', 2, NULL, 2, 4, 2023, '1-о',
+        '2023-06-08'),
+       ('hiring', 'draft', 'О приёме работника на работу', '2023-12-31', 3, 3, NULL, NULL, NULL, NULL, NULL);
+
+INSERT INTO public.number_counters (sequence_id, year, last_number)
+VALUES (1, 0, 70),
+       (3, 2023, 1),
+       (4, 2023, 1);
+
+INSERT INTO public.vacancies (title, description, position_id, department_id, status)
+VALUES ('Бухгалтер', 'Ведение первичной документации', 4, 4, 'open'),
+       ('Рекрутер', 'Подбор ИТ-специалистов', 4, 3, 'open'),
+       ('Главный бухгалтер', '', 3, 4, 'closed');
+
+INSERT INTO public.candidates (vacancy_id, lastname, firstname, middlename, email, phone, date_of_birth, stage)
+VALUES (1, 'Смирнова', 'Ольга', 'Андреевна', 'smirnova.olga@example.com', '79161234567', '1995-03-14', 'interview'),
+       (1, 'Попов', 'Дмитрий', 'Олегович', 'popov.d@example.com', '79267654321', NULL, 'new'),
+       (2, 'Волкова', 'Екатерина', 'Игоревна', 'volkova.e@example.com', '79031112233', '1998-08-01', 'offer'),
+       (3, 'Лебедев', 'Андрей', 'Викторович', 'lebedev@example.com', '', '1985-12-25', 'rejected');
+
+INSERT INTO public.candidate_notes (candidate_id, author_id, stage, text)
+VALUES (1, 3, 'screening', 'Опыт работы с 1С 4 года, готова выйти через две недели'),
+       (3, 3, 'interview', 'Сильные коммуникативные навыки, согласна на предложенную зарплату');
+
 -- commit the change
 COMMIT;
\ No newline at end of file
@@ -0,0 +1,109 @@
+// Package docnumber проверяет номера российских документов: СНИЛС, ИНН физического лица,
+// паспорта и визы. Номера передаются без пробелов и разделителей.
+package docnumber
+
+import "strings"
+
+// Номера СНИЛС не больше этого не имеют контрольной суммы.
+const snilsWithoutChecksum = 1001998
+
+// SNILS проверяет СНИЛС из 11 цифр: 9 цифр номера и 2 контрольные цифры.
+func SNILS(s string) bool {
+	if len(s) != 11 || !onlyDigits(s) {
+		return false
+	}
+	if atoi(s[:9]) <= snilsWithoutChecksum {
+		return true
+	}
+	var sum int
+	for i := 0; i < 9; i++ {
+		sum += (9 - i) * digit(s[i])
+	}
+	switch {
+	case sum < 100:
+	case sum == 100, sum == 101:
+		sum = 0
+	default:
+		sum %= 101
+		if sum == 100 {
+			sum = 0
+		}
+	}
+	return sum == atoi(s[9:])
+}
+
+var (
+	innWeights11 = []int{7, 2, 4, 10, 3, 5, 9, 4, 6, 8}
+	innWeights12 = []int{3, 7, 2, 4, 10, 3, 5, 9, 4, 6, 8}
+)
+
+// PersonalINN проверяет ИНН физического лица из 12 цифр с двумя контрольными цифрами.
+func PersonalINN(s string) bool {
+	if len(s) != 12 || !onlyDigits(s) {
+		return false
+	}
+	return innControl(s, innWeights11) == digit(s[10]) &&
+		innControl(s, innWeights12) == digit(s[11])
+}
+
+func innControl(s string, weights []int) int {
+	var sum int
+	for i, w := range weights {
+		sum += w * digit(s[i])
+	}
+	return sum % 11 % 10
+}
+
+// InternalPassport проверяет серию и номер паспорта гражданина РФ:
+// 4 цифры серии и 6 цифр номера.
+func InternalPassport(s string) bool {
+	return len(s) == 10 && onlyDigits(s) && s[:4] != "0000" && s[4:] != "000000"
+}
+
+// ExternalPassport проверяет серию и номер заграничного паспорта гражданина РФ:
+// 2 цифры серии и 7 цифр номера.
+func ExternalPassport(s string) bool {
+	return len(s) == 9 && onlyDigits(s) && s[2:] != "0000000"
+}
+
+// ForeignPassport проверяет номер паспорта иностранного гражданина:
+// от 5 до 20 латинских букв в верхнем регистре и цифр, хотя бы одна цифра.
+func ForeignPassport(s string) bool {
+	return alphanumeric(s, 5, 20)
+}
+
+// RussianVisa проверяет номер визы РФ: 9 цифр.
+func RussianVisa(s string) bool {
+	return len(s) == 9 && onlyDigits(s)
+}
+
+// Visa проверяет номер визы иностранного государства:
+// от 5 до 20 латинских букв в верхнем регистре и цифр, хотя бы одна цифра.
+func Visa(s string) bool {
+	return alphanumeric(s, 5, 20)
+}
+
+func alphanumeric(s string, min, max int) bool {
+	if len(s) < min || len(s) > max || !strings.ContainsAny(s, "0123456789") {
+		return false
+	}
+	return strings.IndexFunc(s, func(c rune) bool {
+		return (c < '0' || c > '9') && (c < 'A' || c > 'Z')
+	}) == -1
+}
+
+func onlyDigits(s string) bool {
+	return strings.IndexFunc(s, func(c rune) bool { return c < '0' || c > '9' }) == -1
+}
+
+func digit(c byte) int {
+	return int(c - '0')
+}
+
+func atoi(s string) int {
+	var n int
+	for i := 0; i < len(s); i++ {
+		n = n*10 + digit(s[i])
+	}
+	return n
+}
@@ -0,0 +1,42 @@
+package docnumber
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSNILS(t *testing.T) {
+	assert.True(t, SNILS("11223344595"))
+	assert.True(t, SNILS("08336732477"))
+	assert.True(t, SNILS("00100199800"), "old numbers have no checksum")
+	assert.False(t, SNILS("08336732478"))
+	assert.False(t, SNILS("0833673247"))
+	assert.False(t, SNILS("083-367-324"))
+}
+
+func TestPersonalINN(t *testing.T) {
+	assert.True(t, PersonalINN("500100732259"))
+	assert.False(t, PersonalINN("500100732258"))
+	assert.False(t, PersonalINN("500100732249"))
+	assert.False(t, PersonalINN("1181111110"), "legal entity INN")
+}
+
+func TestPassports(t *testing.T) {
+	assert.True(t, InternalPassport("4509123456"))
+	assert.False(t, InternalPassport("45 09123456"))
+	assert.False(t, InternalPassport("0000123456"))
+	assert.True(t, ExternalPassport("751234567"))
+	assert.False(t, ExternalPassport("4509123456"))
+	assert.True(t, ForeignPassport("33592222"))
+	assert.True(t, ForeignPassport("AB1234567"))
+	assert.False(t, ForeignPassport("ab1234567"))
+	assert.False(t, ForeignPassport("ABCDEFG"))
+}
+
+func TestVisa(t *testing.T) {
+	assert.True(t, RussianVisa("123456789"))
+	assert.False(t, RussianVisa("A23456789"))
+	assert.True(t, Visa("A23456789"))
+	assert.False(t, Visa("A2-3456789"))
+}
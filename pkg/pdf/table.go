@@ -0,0 +1,320 @@
+// Package pdf формирует простые печатные документы в формате PDF.
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"sort"
+
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/goregular"
+	"golang.org/x/image/font/sfnt"
+	"golang.org/x/image/math/fixed"
+)
+
+// Размеры страницы A4 в альбомной ориентации и поля в пунктах.
+const (
+	pageWidth  = 842.0
+	pageHeight = 595.0
+	margin     = 28.0
+
+	titleSize   = 12.0
+	maxFontSize = 8.0
+	minFontSize = 4.0
+	cellPadding = 2.0
+)
+
+// Table записывает в w документ с заголовком title и таблицей table.
+// Первая строка таблицы считается шапкой и повторяется на каждой странице.
+// Размер шрифта подбирается так, чтобы таблица уместилась по ширине страницы.
+func Table(w io.Writer, title string, table [][]string) error {
+	f, err := newFace()
+	if err != nil {
+		return err
+	}
+
+	ncols := 0
+	for _, row := range table {
+		ncols = max(ncols, len(row))
+	}
+
+	// ширины столбцов при размере шрифта 1pt
+	widths := make([]float64, ncols)
+	for _, row := range table {
+		for i, cell := range row {
+			widths[i] = max(widths[i], f.width(cell))
+		}
+	}
+	var textWidth float64
+	for _, cw := range widths {
+		textWidth += cw
+	}
+	size := maxFontSize
+	if textWidth > 0 {
+		size = (pageWidth - 2*margin - 2*cellPadding*float64(ncols)) / textWidth
+		size = min(max(size, minFontSize), maxFontSize)
+	}
+	for i := range widths {
+		widths[i] = widths[i]*size + 2*cellPadding
+	}
+
+	rowHeight := size * 1.8
+	top := pageHeight - margin - titleSize*1.5
+	perPage := max(int((top-margin)/rowHeight), 2)
+
+	var pages [][]byte
+	body := table
+	if len(body) > 0 {
+		body = body[1:]
+	}
+	for first := true; first || len(body) > 0; first = false {
+		var rows [][]string
+		if len(table) > 0 {
+			rows = append(rows, table[0])
+		}
+		n := min(perPage-1, len(body))
+		rows = append(rows, body[:n]...)
+		body = body[n:]
+
+		var c bytes.Buffer
+		f.text(&c, margin, pageHeight-margin-titleSize, titleSize, title)
+		y := top
+		for _, row := range rows {
+			x := margin
+			for i, cw := range widths {
+				fmt.Fprintf(&c, "%.2f %.2f %.2f %.2f re S\n", x, y-rowHeight, cw, rowHeight)
+				if i < len(row) && row[i] != "" {
+					// обрезка слишком длинного текста по границе ячейки
+					fmt.Fprintf(&c, "q %.2f %.2f %.2f %.2f re W n\n", x, y-rowHeight, cw, rowHeight)
+					f.text(&c, x+cellPadding, y-rowHeight+(rowHeight-size)/2+size*0.2, size, row[i])
+					c.WriteString("Q\n")
+				}
+				x += cw
+			}
+			y -= rowHeight
+		}
+		pages = append(pages, c.Bytes())
+	}
+
+	return f.write(w, pages)
+}
+
+// face шрифт Go Regular, встраиваемый в документ.
+type face struct {
+	font *sfnt.Font
+	buf  sfnt.Buffer
+	upem float64
+	// ширины используемых глифов в тысячных долях кегля
+	used map[sfnt.GlyphIndex]glyphInfo
+}
+
+type glyphInfo struct {
+	r     rune
+	width int
+}
+
+func newFace() (*face, error) {
+	f, err := sfnt.Parse(goregular.TTF)
+	if err != nil {
+		return nil, err
+	}
+	return &face{
+		font: f,
+		upem: float64(f.UnitsPerEm()),
+		used: make(map[sfnt.GlyphIndex]glyphInfo),
+	}, nil
+}
+
+// glyph возвращает индекс глифа руны и его ширину в тысячных долях кегля.
+func (f *face) glyph(r rune) (sfnt.GlyphIndex, int) {
+	gi, err := f.font.GlyphIndex(&f.buf, r)
+	if err != nil {
+		gi = 0
+	}
+	if u, ok := f.used[gi]; ok {
+		return gi, u.width
+	}
+	ppem := fixed.Int26_6(f.font.UnitsPerEm()) << 6
+	adv, err := f.font.GlyphAdvance(&f.buf, gi, ppem, font.HintingNone)
+	if err != nil {
+		adv = 0
+	}
+	w := int(float64(adv)/64*1000/f.upem + 0.5)
+	f.used[gi] = glyphInfo{r: r, width: w}
+	return gi, w
+}
+
+// width возвращает ширину строки при размере шрифта 1pt.
+func (f *face) width(s string) float64 {
+	var w int
+	for _, r := range s {
+		_, gw := f.glyph(r)
+		w += gw
+	}
+	return float64(w) / 1000
+}
+
+func (f *face) text(c *bytes.Buffer, x, y, size float64, s string) {
+	fmt.Fprintf(c, "BT /F1 %.2f Tf %.2f %.2f Td <", size, x, y)
+	for _, r := range s {
+		gi, _ := f.glyph(r)
+		fmt.Fprintf(c, "%04X", uint16(gi))
+	}
+	c.WriteString("> Tj ET\n")
+}
+
+// write записывает документ из страниц с содержимым pages.
+func (f *face) write(w io.Writer, pages [][]byte) error {
+	const (
+		catalogObj = iota + 1
+		pagesObj
+		fontObj
+		cidFontObj
+		descriptorObj
+		fontFileObj
+		toUnicodeObj
+		firstPageObj
+	)
+
+	d := &document{}
+	d.object(catalogObj, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pagesObj))
+
+	var kids bytes.Buffer
+	for i := range pages {
+		fmt.Fprintf(&kids, "%d 0 R ", firstPageObj+2*i)
+	}
+	d.object(pagesObj, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d /MediaBox [0 0 %.0f %.0f] >>",
+		kids.String(), len(pages), pageWidth, pageHeight))
+
+	d.object(fontObj, fmt.Sprintf("<< /Type /Font /Subtype /Type0 /BaseFont /GoRegular "+
+		"/Encoding /Identity-H /DescendantFonts [%d 0 R] /ToUnicode %d 0 R >>", cidFontObj, toUnicodeObj))
+
+	gids := make([]sfnt.GlyphIndex, 0, len(f.used))
+	for gi := range f.used {
+		gids = append(gids, gi)
+	}
+	sort.Slice(gids, func(i, j int) bool { return gids[i] < gids[j] })
+
+	var widths bytes.Buffer
+	for _, gi := range gids {
+		fmt.Fprintf(&widths, "%d [%d] ", gi, f.used[gi].width)
+	}
+	d.object(cidFontObj, fmt.Sprintf("<< /Type /Font /Subtype /CIDFontType2 /BaseFont /GoRegular "+
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (Identity) /Supplement 0 >> "+
+		"/FontDescriptor %d 0 R /CIDToGIDMap /Identity /DW 500 /W [%s] >>", descriptorObj, widths.String()))
+
+	ppem := fixed.Int26_6(f.font.UnitsPerEm()) << 6
+	bounds, err := f.font.Bounds(&f.buf, ppem, font.HintingNone)
+	if err != nil {
+		return err
+	}
+	metrics, err := f.font.Metrics(&f.buf, ppem, font.HintingNone)
+	if err != nil {
+		return err
+	}
+	// координата Y в sfnt направлена вниз
+	scale := func(v fixed.Int26_6) int { return int(float64(v) / 64 * 1000 / f.upem) }
+	d.object(descriptorObj, fmt.Sprintf("<< /Type /FontDescriptor /FontName /GoRegular /Flags 32 "+
+		"/FontBBox [%d %d %d %d] /ItalicAngle 0 /Ascent %d /Descent %d /CapHeight %d /StemV 80 "+
+		"/FontFile2 %d 0 R >>",
+		scale(bounds.Min.X), -scale(bounds.Max.Y), scale(bounds.Max.X), -scale(bounds.Min.Y),
+		scale(metrics.Ascent), -scale(metrics.Descent), scale(metrics.CapHeight), fontFileObj))
+
+	if err := d.stream(fontFileObj, fmt.Sprintf("/Length1 %d", len(goregular.TTF)), goregular.TTF); err != nil {
+		return err
+	}
+
+	var cmap bytes.Buffer
+	cmap.WriteString("/CIDInit /ProcSet findresource begin\n12 dict begin\nbegincmap\n" +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (UCS) /Supplement 0 >> def\n" +
+		"/CMapName /Adobe-Identity-UCS def\n/CMapType 2 def\n" +
+		"1 begincodespacerange\n<0000> <FFFF>\nendcodespacerange\n")
+	// в секции bfchar допускается не более 100 записей
+	for i := 0; i < len(gids); i += 100 {
+		chunk := gids[i:min(i+100, len(gids))]
+		fmt.Fprintf(&cmap, "%d beginbfchar\n", len(chunk))
+		for _, gi := range chunk {
+			fmt.Fprintf(&cmap, "<%04X> <%s>\n", uint16(gi), utf16Hex(f.used[gi].r))
+		}
+		cmap.WriteString("endbfchar\n")
+	}
+	cmap.WriteString("endcmap\nCMapName currentdict /CMap defineresource pop\nend\nend\n")
+	if err := d.stream(toUnicodeObj, "", cmap.Bytes()); err != nil {
+		return err
+	}
+
+	for i, content := range pages {
+		page, contents := firstPageObj+2*i, firstPageObj+2*i+1
+		d.object(page, fmt.Sprintf("<< /Type /Page /Parent %d 0 R "+
+			"/Resources << /Font << /F1 %d 0 R >> >> /Contents %d 0 R >>", pagesObj, fontObj, contents))
+		if err := d.stream(contents, "", content); err != nil {
+			return err
+		}
+	}
+
+	return d.write(w, catalogObj)
+}
+
+func utf16Hex(r rune) string {
+	if r < 0x10000 {
+		return fmt.Sprintf("%04X", r)
+	}
+	r -= 0x10000
+	return fmt.Sprintf("%04X%04X", 0xD800+(r>>10), 0xDC00+(r&0x3FF))
+}
+
+// document накапливает объекты PDF, пронумерованные по порядку добавления.
+type document struct {
+	buf     bytes.Buffer
+	offsets []int
+}
+
+func (d *document) begin(num int) {
+	if d.buf.Len() == 0 {
+		// двоичные символы в комментарии указывают, что файл содержит двоичные данные
+		d.buf.WriteString("%PDF-1.4\n%\xE2\xE3\xCF\xD3\n")
+	}
+	if num != len(d.offsets)+1 {
+		panic(fmt.Sprintf("pdf: object %d added out of order", num))
+	}
+	d.offsets = append(d.offsets, d.buf.Len())
+	fmt.Fprintf(&d.buf, "%d 0 obj\n", num)
+}
+
+func (d *document) object(num int, dict string) {
+	d.begin(num)
+	d.buf.WriteString(dict)
+	d.buf.WriteString("\nendobj\n")
+}
+
+// stream добавляет поток, сжатый методом FlateDecode, extra дополняет словарь потока.
+func (d *document) stream(num int, extra string, data []byte) error {
+	var z bytes.Buffer
+	zw := zlib.NewWriter(&z)
+	if _, err := zw.Write(data); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	d.begin(num)
+	fmt.Fprintf(&d.buf, "<< /Length %d /Filter /FlateDecode %s>>\nstream\n", z.Len(), extra)
+	d.buf.Write(z.Bytes())
+	d.buf.WriteString("\nendstream\nendobj\n")
+	return nil
+}
+
+func (d *document) write(w io.Writer, root int) error {
+	xref := d.buf.Len()
+	fmt.Fprintf(&d.buf, "xref\n0 %d\n0000000000 65535 f \n", len(d.offsets)+1)
+	for _, off := range d.offsets {
+		fmt.Fprintf(&d.buf, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&d.buf, "trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(d.offsets)+1, root, xref)
+	_, err := w.Write(d.buf.Bytes())
+	return err
+}
@@ -0,0 +1,73 @@
+package postgresql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type txKey struct{}
+
+// WithTx выполняет fn в транзакции. Запросы, выполняемые через DB с контекстом fn,
+// выполняются в этой транзакции, а Begin создаёт в ней точку сохранения.
+// Транзакция фиксируется, если fn не вернула ошибку.
+func (db *DB) WithTx(ctx context.Context, fn func(ctx context.Context) error) error {
+	const op = "postgresql: with tx"
+
+	tx, err := db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	defer tx.Rollback(ctx) //nolint:errcheck
+
+	if err := fn(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("%s: %w", op, err)
+	}
+	return nil
+}
+
+// Begin начинает транзакцию или, если контекст уже содержит транзакцию, вложенную транзакцию.
+func (db *DB) Begin(ctx context.Context) (pgx.Tx, error) {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx.Begin(ctx)
+	}
+	return db.Pool.Begin(ctx)
+}
+
+// Exec выполняет запрос в транзакции контекста, если она есть.
+func (db *DB) Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx.Exec(ctx, sql, args...)
+	}
+	return db.Pool.Exec(ctx, sql, args...)
+}
+
+// Query выполняет запрос в транзакции контекста, если она есть.
+func (db *DB) Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error) {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx.Query(ctx, sql, args...)
+	}
+	return db.Pool.Query(ctx, sql, args...)
+}
+
+// QueryRow выполняет запрос в транзакции контекста, если она есть.
+func (db *DB) QueryRow(ctx context.Context, sql string, args ...any) pgx.Row {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx.QueryRow(ctx, sql, args...)
+	}
+	return db.Pool.QueryRow(ctx, sql, args...)
+}
+
+// SendBatch отправляет пакет запросов в транзакции контекста, если она есть.
+func (db *DB) SendBatch(ctx context.Context, b *pgx.Batch) pgx.BatchResults {
+	if tx, ok := ctx.Value(txKey{}).(pgx.Tx); ok {
+		return tx.SendBatch(ctx, b)
+	}
+	return db.Pool.SendBatch(ctx, b)
+}
@@ -8,4 +8,5 @@ var (
 	ErrRecordNotAffected      = errors.New("record(-s) not affected")
 	ErrRecordNotModifiedSince = errors.New("record not modified since last query")
 	ErrConflict               = errors.New("conflict: cannot be completed due to some kind of mismatch")
+	ErrVersionMismatch        = errors.New("record version mismatch: changed since last query")
 )